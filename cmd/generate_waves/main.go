@@ -0,0 +1,90 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/systems"
+)
+
+var (
+	levelID       = flag.String("level", "1-1", "Level ID to generate waves for (e.g., 1-1, survival-day)")
+	seed          = flag.Int64("seed", 0, "Random seed (0 = use current time)")
+	round         = flag.Int("round", 0, "Round number (adventure replays / completed survival flag sets)")
+	wavesPerRound = flag.Int("waves-per-round", 20, "Waves per round used by the level capacity formula")
+	verbose       = flag.Bool("verbose", false, "Enable verbose logging")
+)
+
+// 程序化波次生成工具
+//
+// 按 WaveGenerator 的规则生成关卡波次并打印，用于调试 ExtraPoints 波次和省略 waves 的关卡。
+// 生存模式关卡按一组旗帜（SurvivalFlagsPerSet 面）生成。
+//
+// 用法：
+//
+//	go run ./cmd/generate_waves -level 1-1 -seed 42 -round 0
+func main() {
+	flag.Parse()
+
+	if !*verbose {
+		log.SetOutput(io.Discard)
+	}
+
+	levelPath := fmt.Sprintf("data/levels/level-%s.yaml", *levelID)
+	levelConfig, err := config.LoadLevelConfig(levelPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to load level: %v\n", err)
+		os.Exit(1)
+	}
+	zombieStats, err := config.LoadZombieStats("data/zombie_stats.yaml")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to load zombie stats: %v\n", err)
+		os.Exit(1)
+	}
+	spawnRules, err := config.LoadSpawnRules("data/spawn_rules.yaml")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to load spawn rules: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+	if levelConfig.Survival {
+		levelConfig.Flags = config.SurvivalFlagsPerSet
+	}
+
+	generator := systems.NewWaveGenerator(zombieStats, spawnRules, levelConfig, *wavesPerRound, *seed)
+	waves := generator.GenerateWaves(levelConfig, *round)
+	engine := systems.NewDifficultyEngine(zombieStats)
+
+	fmt.Printf("Level %s (%s), scene=%s, round=%d, seed=%d\n", levelConfig.ID, levelConfig.Name, levelConfig.SceneType, *round, *seed)
+	if !systems.NeedsWaveGeneration(levelConfig) && !levelConfig.Survival {
+		fmt.Println("(no ExtraPoints waves, fixed waves shown unchanged)")
+	}
+	fmt.Println(strings.Repeat("-", 60))
+
+	for _, wave := range waves {
+		totalLevel := 0
+		groups := make([]string, 0, len(wave.Zombies))
+		for _, group := range wave.Zombies {
+			groups = append(groups, fmt.Sprintf("%s×%d", group.Type, group.Count))
+			if group.Type != "flag" {
+				totalLevel += engine.GetZombieLevel(group.Type) * group.Count
+			}
+		}
+
+		marker := ""
+		if wave.IsFlag {
+			marker = fmt.Sprintf(" [flag %d]", wave.FlagIndex)
+		}
+		capacity := engine.CalculateLevelCapacity(wave.WaveNum, *round, *wavesPerRound, wave.IsFlag) + wave.ExtraPoints
+		fmt.Printf("Wave %2d%s (%s) level %d/%d: %s\n", wave.WaveNum, marker, wave.Type, totalLevel, capacity, strings.Join(groups, ", "))
+	}
+}
@@ -118,7 +118,7 @@ func NewVerifyBowlingGame() (*VerifyBowlingGame, error) {
 
 	// 创建草坪网格系统（启用所有行）
 	enabledLanes := []int{1, 2, 3, 4, 5}
-	lawnGridSystem := systems.NewLawnGridSystem(em, enabledLanes)
+	lawnGridSystem := systems.NewLawnGridSystem(em, config.GetLawnLayout("day"), enabledLanes)
 
 	// 创建行为系统
 	behaviorSystem := behavior.NewBehaviorSystem(em, rm, gs, lawnGridSystem, 0)
@@ -252,10 +252,10 @@ func (vg *VerifyBowlingGame) spawnZombie(row int) {
 
 	// 50% 普通僵尸, 50% 路障僵尸
 	if vg.zombieKillCount%2 == 0 {
-		zombieID, err = entities.NewZombieEntity(vg.entityManager, vg.resourceManager, row, spawnX)
+		zombieID, err = entities.NewZombieEntity(vg.entityManager, vg.resourceManager, config.GetLawnLayout("day"), row, spawnX)
 		zombieType = "basic"
 	} else {
-		zombieID, err = entities.NewConeheadZombieEntity(vg.entityManager, vg.resourceManager, row, spawnX)
+		zombieID, err = entities.NewConeheadZombieEntity(vg.entityManager, vg.resourceManager, config.GetLawnLayout("day"), row, spawnX)
 		zombieType = "conehead"
 	}
 
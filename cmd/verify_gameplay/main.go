@@ -154,14 +154,14 @@ func NewVerifyGameplayGame() (*VerifyGameplayGame, error) {
 
 	// 创建草坪网格系统（启用所有行）
 	enabledLanes := []int{1, 2, 3, 4, 5}
-	lawnGridSystem := systems.NewLawnGridSystem(em, enabledLanes)
+	lawnGridSystem := systems.NewLawnGridSystem(em, config.GetLawnLayout("day"), enabledLanes)
 
 	// Bug Fix: 先创建草坪网格实体，再传给 BehaviorSystem
 	lawnGridEntityID := em.CreateEntity()
 	ecs.AddComponent(em, lawnGridEntityID, &components.LawnGridComponent{})
 
 	// 创建除草车系统
-	lawnmowerSystem := systems.NewLawnmowerSystem(em, rm, gs)
+	lawnmowerSystem := systems.NewLawnmowerSystem(em, rm, gs, lawnGridSystem.Layout())
 
 	// 创建行为系统（传入草坪网格系统和实体ID）
 	behaviorSystem := behavior.NewBehaviorSystem(em, rm, gs, lawnGridSystem, lawnGridEntityID)
@@ -170,7 +170,7 @@ func NewVerifyGameplayGame() (*VerifyGameplayGame, error) {
 	physicsSystem := systems.NewPhysicsSystem(em, rm)
 
 	// 创建奖励动画系统
-	rewardSystem := systems.NewRewardAnimationSystem(em, gs, rm, nil, reanimSystem, particleSystem, renderSystem)
+	rewardSystem := systems.NewRewardAnimationSystem(em, gs, rm, nil, reanimSystem, particleSystem, renderSystem, config.GetLawnLayout("day"))
 
 	// 计算阳光收集目标位置
 	sunTargetX := float64(config.SeedBankX + config.SunPoolOffsetX)
@@ -240,7 +240,7 @@ func NewVerifyGameplayGame() (*VerifyGameplayGame, error) {
 	log.Println("════════════════════════════════════════════════════════")
 
 	// 创建植物预览系统
-	plantPreviewSystem := systems.NewPlantPreviewSystem(em, gs, lawnGridSystem)
+	plantPreviewSystem := systems.NewPlantPreviewSystem(em, gs, lawnGridSystem.Layout(), lawnGridSystem)
 	plantPreviewRenderSystem := systems.NewPlantPreviewRenderSystem(em, plantPreviewSystem)
 
 	vg := &VerifyGameplayGame{
@@ -325,7 +325,7 @@ func (vg *VerifyGameplayGame) createPlantCards() {
 // createLawnmowers 创建除草车
 func (vg *VerifyGameplayGame) createLawnmowers() {
 	for lane := 1; lane <= 5; lane++ {
-		lawnmowerID, err := entities.NewLawnmowerEntity(vg.entityManager, vg.resourceManager, lane)
+		lawnmowerID, err := entities.NewLawnmowerEntity(vg.entityManager, vg.resourceManager, config.GetLawnLayout("day"), lane)
 		if err != nil {
 			log.Printf("Warning: Failed to create lawnmower for lane %d: %v", lane, err)
 			continue
@@ -344,20 +344,11 @@ func (vg *VerifyGameplayGame) spawnZombie(row int) {
 	// 僵尸出生在屏幕可视范围最右端（世界坐标 = 相机X + 屏幕宽度 - 边距）
 	spawnX := config.GameCameraX + float64(screenWidth) - 30.0
 
-	var zombieID ecs.EntityID
-	var err error
-
-	switch zombieType {
-	case "basic":
-		zombieID, err = entities.NewZombieEntity(vg.entityManager, vg.resourceManager, row, spawnX)
-	case "conehead":
-		zombieID, err = entities.NewConeheadZombieEntity(vg.entityManager, vg.resourceManager, row, spawnX)
-	case "buckethead":
-		zombieID, err = entities.NewBucketheadZombieEntity(vg.entityManager, vg.resourceManager, row, spawnX)
-	case "flag":
-		zombieID, err = entities.NewFlagZombieEntity(vg.entityManager, vg.resourceManager, row, spawnX)
-	default:
-		zombieID, err = entities.NewZombieEntity(vg.entityManager, vg.resourceManager, row, spawnX)
+	zombieID, err := entities.NewZombieEntityByType(vg.entityManager, vg.resourceManager, zombieType, config.GetLawnLayout("day"), row, spawnX)
+	if err != nil {
+		// 未知类型回退为普通僵尸
+		log.Printf("Warning: Failed to spawn %s zombie, falling back to basic: %v", zombieType, err)
+		zombieID, err = entities.NewZombieEntity(vg.entityManager, vg.resourceManager, config.GetLawnLayout("day"), row, spawnX)
 	}
 
 	if err != nil {
@@ -662,11 +653,12 @@ func (vg *VerifyGameplayGame) handleLawnClick() {
 	worldY := float64(my)
 
 	// 计算格子坐标
+	layout := vg.lawnGridSystem.Layout()
 	col := int((worldX - config.GridWorldStartX) / config.CellWidth)
-	row := int((worldY - config.GridWorldStartY) / config.CellHeight)
+	row := int((worldY - layout.StartY) / layout.CellHeight)
 
 	// 检查是否在有效范围内
-	if col < 0 || col >= config.GridColumns || row < 0 || row >= config.GridRows {
+	if col < 0 || col >= config.GridColumns || row < 0 || row >= layout.Rows {
 		return
 	}
 
@@ -695,6 +687,7 @@ func (vg *VerifyGameplayGame) handleLawnClick() {
 			vg.gameState,
 			vg.reanimSystem,
 			vg.selectedPlantType,
+			config.GetLawnLayout("day"),
 			col, row,
 		)
 	case components.PlantWallnut:
@@ -703,6 +696,7 @@ func (vg *VerifyGameplayGame) handleLawnClick() {
 			vg.resourceManager,
 			vg.gameState,
 			vg.reanimSystem,
+			config.GetLawnLayout("day"),
 			col, row,
 		)
 	case components.PlantCherryBomb:
@@ -710,6 +704,7 @@ func (vg *VerifyGameplayGame) handleLawnClick() {
 			vg.entityManager,
 			vg.resourceManager,
 			vg.gameState,
+			config.GetLawnLayout("day"),
 			col, row,
 		)
 	case components.PlantPotatoMine:
@@ -717,6 +712,7 @@ func (vg *VerifyGameplayGame) handleLawnClick() {
 			vg.entityManager,
 			vg.resourceManager,
 			vg.gameState,
+			config.GetLawnLayout("day"),
 			col, row,
 		)
 	default:
@@ -77,10 +77,10 @@ func main() {
 	reanimSys := systems.NewReanimSystem(em)
 	particleSys := systems.NewParticleSystem(em, rm)
 	renderSys := systems.NewRenderSystem(em)
-	rewardSys := systems.NewRewardAnimationSystem(em, gs, rm, nil, reanimSys, particleSys, renderSys)
+	rewardSys := systems.NewRewardAnimationSystem(em, gs, rm, nil, reanimSys, particleSys, renderSys, config.GetLawnLayout("day"))
 
 	// 创建 LevelSystem（用于验证系统集成）
-	_ = systems.NewLevelSystem(em, gs, nil, rm, rewardSys, nil)
+	_ = systems.NewLevelSystem(em, gs, nil, rm, rewardSys, nil, config.GetLawnLayout("day"))
 
 	log.Printf("✅ Systems initialized\n")
 
@@ -124,7 +124,7 @@ func NewOpeningVerifyGame() (*OpeningVerifyGame, error) {
 	enabledLanes := []int{1, 2, 3, 4, 5}
 	lawnGridEntity := em.CreateEntity()
 	em.AddComponent(lawnGridEntity, &components.LawnGridComponent{})
-	lawnGridSystem := systems.NewLawnGridSystem(em, enabledLanes)
+	lawnGridSystem := systems.NewLawnGridSystem(em, config.GetLawnLayout(levelConfig.SceneType), enabledLanes)
 
 	// Create systems
 	reanimSystem := systems.NewReanimSystem(em)
@@ -109,7 +109,7 @@ func NewVerifyRewardAnimationGame() (*VerifyRewardAnimationGame, error) {
 
 	// Story 8.4重构：RewardAnimationSystem完全封装所有渲染逻辑
 	// 内部自动创建和管理所有渲染系统（Reanim、粒子、卡片、面板）
-	rewardSystem := systems.NewRewardAnimationSystem(em, gs, rm, nil, reanimSystem, particleSystem, renderSystem)
+	rewardSystem := systems.NewRewardAnimationSystem(em, gs, rm, nil, reanimSystem, particleSystem, renderSystem, config.GetLawnLayout("day"))
 
 	// 创建植物选择栏卡片（用于测试渲染顺序）
 	sunFont, err := rm.LoadFont("assets/fonts/SimHei.ttf", config.PlantCardSunCostFontSize)
@@ -284,6 +284,7 @@ func (vg *VerifyRewardAnimationGame) reset() {
 		vg.reanimSystem,
 		vg.particleSystem,
 		vg.renderSystem,
+		config.GetLawnLayout("day"),
 	)
 
 	vg.triggered = false
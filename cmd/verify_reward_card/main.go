@@ -95,7 +95,7 @@ func NewVerifyGame() (*VerifyGame, error) {
 	reanimSystem.SetConfigManager(reanimConfigManager)
 	particleSystem := systems.NewParticleSystem(em, rm) // 粒子系统用于光晕效果
 	renderSystem := systems.NewRenderSystem(em)
-	rewardSystem := systems.NewRewardAnimationSystem(em, gs, rm, nil, reanimSystem, particleSystem, renderSystem)
+	rewardSystem := systems.NewRewardAnimationSystem(em, gs, rm, nil, reanimSystem, particleSystem, renderSystem, config.GetLawnLayout("day"))
 
 	// 加载中文调试字体
 	debugFont, err := rm.LoadFont("assets/fonts/SimHei.ttf", 14)
@@ -228,6 +228,7 @@ func (vg *VerifyGame) reset() {
 		vg.reanimSystem,
 		vg.particleSystem,
 		vg.renderSystem,
+		config.GetLawnLayout("day"),
 	)
 
 	vg.triggered = false
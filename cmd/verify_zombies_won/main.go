@@ -205,7 +205,7 @@ func NewVerifyZombiesWonGame() (*VerifyZombiesWonGame, error) {
 func (vg *VerifyZombiesWonGame) setupTestScene() {
 	// 创建测试用僵尸（从屏幕右侧开始）
 	var err error
-	vg.zombieID, err = entities.NewZombieEntity(vg.entityManager, vg.resourceManager, 0, 300.0)
+	vg.zombieID, err = entities.NewZombieEntity(vg.entityManager, vg.resourceManager, config.GetLawnLayout("day"), 0, 300.0)
 	if err != nil {
 		log.Printf("Warning: Failed to create zombie entity: %v", err)
 		// 创建简化版僵尸
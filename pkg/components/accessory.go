@@ -0,0 +1,131 @@
+package components
+
+import "github.com/gonewx/pvz/pkg/types"
+
+// AccessoryPiece 僵尸身上的一件可拆卸饰品
+//
+// 设计说明:
+//   - 每件饰品独立记录标签、等级、耐久和移除状态，可以被单独移除（如磁力菇吸走铁桶）
+//   - I类饰品（头部防具）优先承受所有伤害，耐久耗尽后被打掉
+//   - II类饰品（手持防具）只阻挡来自正面的直线子弹
+//   - 功能性道具（跳跳杆、小丑盒子等）Tier 为 0，没有护甲值
+type AccessoryPiece struct {
+	Tag  types.AccessoryTag // 饰品标签（轨道名、图片等资源见 config.AccessoryConfigs）
+	Tier int                // 饰品等级：1=头部防具，2=手持防具，0=功能性道具
+
+	// Durability, MaxDurability 防具的当前/最大耐久值（功能性道具为 0）
+	// 耐久可以降到负数，BehaviorSystem 会检查 <= 0 的情况并移除饰品
+	Durability    int
+	MaxDurability int
+
+	// Removed 饰品已离开僵尸（被打掉、被吸走或架到植物上）
+	// 移除的饰品仍保留在列表中，渲染时据此隐藏饰品轨道
+	Removed bool
+}
+
+// IsMetal 判断饰品是否为金属材质（可被磁力菇吸走）
+func (p *AccessoryPiece) IsMetal() bool {
+	return p.Tag.IsMetal()
+}
+
+// IsIntact 判断防具是否仍在僵尸身上且有剩余耐久
+func (p *AccessoryPiece) IsIntact() bool {
+	return !p.Removed && p.Durability > 0
+}
+
+// DamageStage 根据剩余耐久返回受损外观阶段
+// 阶段0: 完整 (66% - 100%)
+// 阶段1: 轻微受损 (33% - 66%)
+// 阶段2: 严重受损 (0% - 33%)
+// 没有耐久的功能性道具始终返回 0
+func (p *AccessoryPiece) DamageStage() int {
+	if p.MaxDurability <= 0 {
+		return 0
+	}
+	ratio := float64(p.Durability) / float64(p.MaxDurability)
+	if ratio > 0.66 {
+		return 0
+	}
+	if ratio > 0.33 {
+		return 1
+	}
+	return 2
+}
+
+// AccessoryComponent 存储僵尸身上所有可拆卸的饰品
+//
+// 伤害计算（护甲值、受击音效）、磁力菇吸取和饰品外观都以这里的饰品状态为准。
+// 查询方法只返回仍在僵尸身上的饰品（Removed 为 false）
+type AccessoryComponent struct {
+	Pieces []*AccessoryPiece
+}
+
+// Has 检查是否拥有指定标签的饰品
+func (c *AccessoryComponent) Has(tag types.AccessoryTag) bool {
+	return c.Get(tag) != nil
+}
+
+// Get 获取指定标签的饰品，不存在时返回 nil
+func (c *AccessoryComponent) Get(tag types.AccessoryTag) *AccessoryPiece {
+	for _, piece := range c.Pieces {
+		if !piece.Removed && piece.Tag == tag {
+			return piece
+		}
+	}
+	return nil
+}
+
+// FirstMetal 返回第一件金属饰品，没有时返回 nil
+// 饰品按添加顺序排列，因此工厂函数应按"最外层优先"的顺序添加
+func (c *AccessoryComponent) FirstMetal() *AccessoryPiece {
+	for _, piece := range c.Pieces {
+		if !piece.Removed && piece.IsMetal() {
+			return piece
+		}
+	}
+	return nil
+}
+
+// Helmet 返回头部防具（I类饰品），没有时返回 nil
+// 耐久耗尽但尚未被行为系统移除的防具也会返回
+func (c *AccessoryComponent) Helmet() *AccessoryPiece {
+	for _, piece := range c.Pieces {
+		if !piece.Removed && piece.Tier == 1 {
+			return piece
+		}
+	}
+	return nil
+}
+
+// Shield 返回手持防具（II类饰品），没有时返回 nil
+// 耐久耗尽但尚未被行为系统移除的防具也会返回
+func (c *AccessoryComponent) Shield() *AccessoryPiece {
+	for _, piece := range c.Pieces {
+		if !piece.Removed && piece.Tier == 2 {
+			return piece
+		}
+	}
+	return nil
+}
+
+// Remove 将指定标签的饰品标记为已移除
+// 返回被移除的饰品，不存在或已移除时返回 nil
+func (c *AccessoryComponent) Remove(tag types.AccessoryTag) *AccessoryPiece {
+	piece := c.Get(tag)
+	if piece == nil {
+		return nil
+	}
+	piece.Removed = true
+	return piece
+}
+
+// Tags 返回仍在僵尸身上的饰品标签列表（用于存档）
+func (c *AccessoryComponent) Tags() []string {
+	tags := make([]string, 0, len(c.Pieces))
+	for _, piece := range c.Pieces {
+		if !piece.Removed {
+			tags = append(tags, string(piece.Tag))
+		}
+	}
+	return tags
+}
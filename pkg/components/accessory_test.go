@@ -0,0 +1,87 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// TestAccessoryComponent 测试饰品组件的查询与移除
+func TestAccessoryComponent(t *testing.T) {
+	accessory := &AccessoryComponent{
+		Pieces: []*AccessoryPiece{
+			{Tag: types.AccessoryCone, Tier: 1},
+			{Tag: types.AccessoryScreenDoor, Tier: 2},
+		},
+	}
+
+	if !accessory.Has(types.AccessoryCone) {
+		t.Error("Expected cone to be present")
+	}
+	if accessory.Has(types.AccessoryBucket) {
+		t.Error("Expected bucket to be absent")
+	}
+
+	metal := accessory.FirstMetal()
+	if metal == nil || metal.Tag != types.AccessoryScreenDoor {
+		t.Errorf("Expected first metal to be screendoor, got %v", metal)
+	}
+
+	shield := accessory.Shield()
+	if shield == nil || shield.Tag != types.AccessoryScreenDoor {
+		t.Errorf("Expected shield to be screendoor, got %v", shield)
+	}
+
+	removed := accessory.Remove(types.AccessoryScreenDoor)
+	if removed == nil || removed.Tier != 2 {
+		t.Errorf("Expected to remove tier 2 screendoor, got %v", removed)
+	}
+	if accessory.FirstMetal() != nil {
+		t.Error("Expected no metal accessory after removal")
+	}
+	if accessory.Shield() != nil {
+		t.Error("Expected no shield after removal")
+	}
+	if accessory.Remove(types.AccessoryScreenDoor) != nil {
+		t.Error("Expected second removal to return nil")
+	}
+
+	tags := accessory.Tags()
+	if len(tags) != 1 || tags[0] != "cone" {
+		t.Errorf("Expected tags [cone], got %v", tags)
+	}
+}
+
+// TestAccessoryPieceDurability 测试饰品耐久、受损阶段与移除状态
+func TestAccessoryPieceDurability(t *testing.T) {
+	cone := &AccessoryPiece{Tag: types.AccessoryCone, Tier: 1, Durability: 370, MaxDurability: 370}
+	accessory := &AccessoryComponent{Pieces: []*AccessoryPiece{cone}}
+
+	tests := []struct {
+		durability int
+		stage      int
+	}{
+		{370, 0},
+		{240, 1},
+		{120, 2},
+		{0, 2},
+	}
+	for _, tt := range tests {
+		cone.Durability = tt.durability
+		if stage := cone.DamageStage(); stage != tt.stage {
+			t.Errorf("durability %d: expected stage %d, got %d", tt.durability, tt.stage, stage)
+		}
+	}
+
+	if cone.IsIntact() {
+		t.Error("Expected cone with 0 durability not to be intact")
+	}
+	if accessory.Helmet() != cone {
+		t.Error("Expected depleted cone to remain on the zombie until removed")
+	}
+
+	accessory.Remove(types.AccessoryCone)
+	if !cone.Removed || accessory.Helmet() != nil || len(accessory.Pieces) != 1 {
+		t.Errorf("Expected cone to be marked removed and kept in Pieces, got %+v", accessory.Pieces)
+	}
+}
@@ -0,0 +1,27 @@
+package components
+
+// BalloonState 气球僵尸的气球状态
+type BalloonState int
+
+const (
+	// BalloonStateFlying 乘气球飞行，越过地面植物
+	BalloonStateFlying BalloonState = iota
+	// BalloonStatePopped 气球刚被击破（由击破者设置），等待行为系统播放坠落动画
+	BalloonStatePopped
+	// BalloonStateFalling 正在坠落到地面（播放 pop 动画，原地不动）
+	BalloonStateFalling
+)
+
+// BalloonComponent 气球僵尸组件
+//
+// 飞行时僵尸的碰撞盒离地（CollisionComponent.Altitude > 0），
+// 不啃食植物，普通直线子弹从下方穿过，只有对空子弹能击破气球。
+// 落地后移除此组件，之后按普通僵尸行走和啃食。
+type BalloonComponent struct {
+	State BalloonState
+}
+
+// IsFlying 是否仍在空中飞行
+func (c *BalloonComponent) IsFlying() bool {
+	return c.State == BalloonStateFlying
+}
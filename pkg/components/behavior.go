@@ -9,7 +9,7 @@ const (
 	BehaviorSunflower BehaviorType = iota
 	// BehaviorPeashooter 豌豆射手行为：攻击同行僵尸
 	BehaviorPeashooter
-	// BehaviorPeaProjectile 豌豆子弹行为：向右移动并检测碰撞
+	// BehaviorPeaProjectile 豌豆子弹行为：按速度方向移动并检测碰撞（裂荚射手的后头向左发射）
 	BehaviorPeaProjectile
 	// BehaviorPeaBulletHit 豌豆子弹击中效果：显示击中水花动画，短暂显示后消失
 	BehaviorPeaBulletHit
@@ -60,8 +60,82 @@ const (
 	BehaviorPotatoMine
 	// BehaviorZombiePreview 僵尸预告行为：开场动画中的僵尸预览，不移动、不攻击、只播放 idle 动画
 	BehaviorZombiePreview
+	// BehaviorMagnetshroom 磁力菇行为：周期性吸走范围内最近僵尸的金属饰品
+	// 吸走的饰品吸附在磁力菇上逐渐消失，消失前磁力菇处于冷却状态
+	BehaviorMagnetshroom
+	// BehaviorHypnoshroom 催眠菇行为：没有主动行为，被僵尸啃食时使该僵尸被魅惑
+	// 被魅惑的僵尸倒戈到植物阵营，向右移动并啃食其他僵尸
+	BehaviorHypnoshroom
+	// BehaviorKernelpult 玉米投手行为：向同行最近的僵尸抛投玉米粒
+	BehaviorKernelpult
+	// BehaviorKernelProjectile 玉米粒子弹行为：沿抛物线飞向目标僵尸，落地时造成伤害
+	BehaviorKernelProjectile
+	// BehaviorCobCannon 玉米加农炮行为：装填完成后等待玩家选择目标，发射玉米炮弹
+	BehaviorCobCannon
+	// BehaviorCobProjectile 玉米炮弹行为：飞向玩家选择的落点，落地时对 3x3 范围造成爆炸伤害
+	BehaviorCobProjectile
+	// BehaviorSplitPea 裂荚射手行为：前头向右发射一颗豌豆，后头在身后有僵尸时向左连发两颗豌豆
+	BehaviorSplitPea
+	// BehaviorStarfruit 杨桃行为：任意弹道上有僵尸时向五个固定方向各发射一颗星星
+	BehaviorStarfruit
+	// BehaviorCattail 香蒲行为：向草坪上任意位置最近的僵尸发射追踪尖刺
+	BehaviorCattail
+	// BehaviorStarProjectile 星星子弹行为：沿固定方向直线飞行，可击中任意行的僵尸
+	BehaviorStarProjectile
+	// BehaviorCattailSpike 香蒲尖刺行为：持续转向目标僵尸，目标死亡后重新锁定最近的僵尸
+	BehaviorCattailSpike
+	// BehaviorBasketballProjectile 篮球行为：投篮车抛出的篮球沿抛物线飞向目标植物，落地时对植物造成伤害
+	BehaviorBasketballProjectile
+	// BehaviorGraveBuster 墓碑吞噬者行为：落到墓碑上后啃食墓碑，一段时间后连同墓碑一起消失
+	BehaviorGraveBuster
+	// BehaviorLilyPad 睡莲行为：没有主动行为，承载种在水路上的陆生植物
+	BehaviorLilyPad
+	// BehaviorTangleKelp 缠绕海草行为：缠住第一个进入所在格子的僵尸，把它拖入水中
+	BehaviorTangleKelp
+	// BehaviorSeaShroom 海蘑菇行为：向同行近处的僵尸喷射短程孢子
+	BehaviorSeaShroom
+	// BehaviorPuffProjectile 孢子子弹行为：沿直线飞行，飞出射程后消散
+	BehaviorPuffProjectile
+	// BehaviorPlantern 植物灯笼行为：没有主动行为，驱散周围的雾（由 FogSystem 处理）
+	BehaviorPlantern
+	// BehaviorBlover 三叶草行为：种下后吹散雾和空中的气球僵尸，然后消失
+	BehaviorBlover
+	// BehaviorFlowerPot 花盆行为：没有主动行为，承载种在屋顶上的植物
+	BehaviorFlowerPot
+	// BehaviorZombieBoss 僵王博士行为：不走路，由 ZombossComponent 状态机驱动攻击循环
+	BehaviorZombieBoss
+	// BehaviorJalapeno 火爆辣椒行为：种植后进入引信倒计时，结束时烧毁所在行的僵尸，融化冰球
+	BehaviorJalapeno
+	// BehaviorIceShroom 寒冰菇行为：种植后进入引信倒计时，结束时冻结场上所有僵尸，冻结火球
+	BehaviorIceShroom
+	// BehaviorSpikeweed 地刺行为：定时扎伤所在格子的地面僵尸，扎破驶过的车辆僵尸
+	BehaviorSpikeweed
+	// BehaviorChomper 大嘴花行为：吞下面前的僵尸后咀嚼一段时间，巨人无法吞下只会被咬伤
+	BehaviorChomper
+	// BehaviorUmbrellaLeaf 叶子保护伞行为：挡开周围 3x3 格子内落下的蹦极僵尸后恢复待机动画
+	BehaviorUmbrellaLeaf
 )
 
+// IsDirectProjectile 判断行为类型是否为直接飞行的子弹（由 PhysicsSystem 检测碰撞）
+// 抛物线子弹（玉米粒、玉米炮弹）由 BehaviorSystem 在落地时结算，不在此列
+func IsDirectProjectile(t BehaviorType) bool {
+	switch t {
+	case BehaviorPeaProjectile, BehaviorStarProjectile, BehaviorCattailSpike, BehaviorPuffProjectile:
+		return true
+	}
+	return false
+}
+
+// IsAntiAirProjectile 判断子弹是否能击中空中的僵尸（如气球僵尸）
+// 其他直线子弹从空中僵尸的下方穿过
+func IsAntiAirProjectile(t BehaviorType) bool {
+	switch t {
+	case BehaviorCattailSpike:
+		return true
+	}
+	return false
+}
+
 // ZombieAnimState 定义僵尸的动画状态
 type ZombieAnimState int
 
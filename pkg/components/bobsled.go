@@ -0,0 +1,47 @@
+package components
+
+import "github.com/gonewx/pvz/pkg/ecs"
+
+// BobsledTeamSize 一支雪橇队的僵尸数量（共乘一架雪橇）
+const BobsledTeamSize = 4
+
+// BobsledState 雪橇队僵尸的行为状态
+type BobsledState int
+
+const (
+	// BobsledStateRiding 坐在雪橇上沿冰道滑行（不啃食植物）
+	BobsledStateRiding BobsledState = iota
+	// BobsledStateJumping 冰道到头，跳下雪橇（播放 jump 动画，原地不动）
+	BobsledStateJumping
+	// BobsledStateWalking 跳下雪橇后像普通僵尸一样行走、啃食
+	BobsledStateWalking
+)
+
+// BobsledComponent 雪橇队僵尸组件
+//
+// 雪橇队僵尸只有在所在格子有冰道时才乘雪橇快速滑行；
+// 离开冰道（或入场时所在行没有冰道）时跳下雪橇，之后像普通僵尸一样行走
+type BobsledComponent struct {
+	State BobsledState
+
+	// Sled 共乘的雪橇实体，0 表示独自滑行（读档恢复的队员）
+	Sled ecs.EntityID
+
+	// TeamSummoned 是否已召集过队员
+	// 队长激活时召集队员；被召集的队员和读档恢复的僵尸不再召集
+	TeamSummoned bool
+}
+
+// IsRiding 是否仍在雪橇上（滑行或正在跳下）
+func (c *BobsledComponent) IsRiding() bool {
+	return c.State != BobsledStateWalking
+}
+
+// BobsledSledComponent 雪橇队共乘的雪橇
+//
+// 雪橇带着全队沿冰道滑行，队员按座位依次排在雪橇上；
+// 冰道到头时全队一起跳下，雪橇随之消失
+type BobsledSledComponent struct {
+	// Riders 各座位上的队员（0 号座位在最前方），0 表示空缺
+	Riders [BobsledTeamSize]ecs.EntityID
+}
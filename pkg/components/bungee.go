@@ -0,0 +1,55 @@
+package components
+
+import "github.com/gonewx/pvz/pkg/ecs"
+
+// BungeeState 蹦极僵尸的行为状态
+type BungeeState int
+
+const (
+	// BungeeStateTargeting 在目标格子上显示准星，等待下落（僵尸在屏幕上方）
+	BungeeStateTargeting BungeeState = iota
+	// BungeeStateDropping 沿蹦极绳从高空落向目标格子
+	BungeeStateDropping
+	// BungeeStateGrabbing 落地抓取目标植物（播放 grab 动画，可被攻击）
+	BungeeStateGrabbing
+	// BungeeStateRaising 拎着植物（或空手）升回高空，离开后移除
+	BungeeStateRaising
+)
+
+// BungeeComponent 蹦极僵尸组件
+//
+// 蹦极僵尸不属于任何行：它不走路，也不参与按行判定的进家失败和除草车，
+// 但被消灭或离开草坪时都计入波次的消灭数量。
+// 在空中时碰撞盒离地（CollisionComponent.Altitude > 0），只有落地抓取时能被普通子弹击中；
+// 抓取动画结束前受到伤害会放弃偷取植物。
+type BungeeComponent struct {
+	State BungeeState
+
+	// HasTarget 是否已选定目标格子（激活后的第一帧选定）
+	HasTarget bool
+
+	// TargetCol, TargetRow 目标格子
+	TargetCol int
+	TargetRow int
+
+	// Timer 当前状态的计时器（秒）：瞄准时为剩余等待时间，下落和上升时为已用时间
+	Timer float64
+
+	// GroundY 落地时实体的 Y 坐标（目标行的地面高度）
+	GroundY float64
+
+	// LandedHealth 落地时的生命值，用于判断抓取期间是否受到伤害
+	LandedHealth int
+
+	// PlantID 被拎走的植物（空手离开时为 0）
+	PlantID ecs.EntityID
+
+	// TargetEntityID 准星实体，CordEntityID 蹦极绳实体
+	TargetEntityID ecs.EntityID
+	CordEntityID   ecs.EntityID
+}
+
+// IsOnGround 是否落在地面上（抓取中）
+func (c *BungeeComponent) IsOnGround() bool {
+	return c.State == BungeeStateGrabbing
+}
@@ -0,0 +1,32 @@
+package components
+
+import "github.com/gonewx/pvz/pkg/ecs"
+
+// CatapultState 投篮车僵尸的行为状态
+type CatapultState int
+
+const (
+	// CatapultStateDriving 行驶（篮球用完或同行没有植物时一直前进，碾压挡路的植物）
+	CatapultStateDriving CatapultState = iota
+	// CatapultStateAiming 停车等待下一次投篮
+	CatapultStateAiming
+	// CatapultStateShooting 投篮（播放 shoot 动画，动画结束时篮球出手）
+	CatapultStateShooting
+)
+
+// CatapultComponent 投篮车僵尸组件
+//
+// 投篮车行驶到 CatapultStopX 后停下，向同行最靠后（最左侧）的植物抛投篮球；
+// 篮球用完后继续前进，碾压挡路的植物而不是啃食。被爆炸杀死时使用投篮车专用的烧焦动画。
+type CatapultComponent struct {
+	State CatapultState
+
+	// Basketballs 剩余篮球数量
+	Basketballs int
+
+	// FireTimer 距离下一次投篮的剩余时间（秒）
+	FireTimer float64
+
+	// TargetPlantID 本次投篮的目标植物
+	TargetPlantID ecs.EntityID
+}
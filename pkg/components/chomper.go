@@ -0,0 +1,31 @@
+package components
+
+import "github.com/gonewx/pvz/pkg/ecs"
+
+// ChomperState 大嘴花的行为状态
+type ChomperState int
+
+const (
+	// ChomperStateReady 待机，等待僵尸进入咬合范围
+	ChomperStateReady ChomperState = iota
+	// ChomperStateBiting 咬（播放 bite 动画，动画结束时吞下或咬伤目标）
+	ChomperStateBiting
+	// ChomperStateChewing 咀嚼吞下的僵尸，期间不能再咬
+	ChomperStateChewing
+	// ChomperStateSwallowing 咀嚼完毕，播放 swallow 动画后恢复待机
+	ChomperStateSwallowing
+)
+
+// ChomperComponent 大嘴花组件
+//
+// 大嘴花一口吞下面前的僵尸，之后咀嚼 ChomperChewDuration 秒；
+// 无法吞下的僵尸（见 systems.CanBeSwallowed）只会被咬伤，大嘴花立即恢复待机
+type ChomperComponent struct {
+	State ChomperState
+
+	// TargetID 正在咬的僵尸
+	TargetID ecs.EntityID
+
+	// ChewTimer 剩余咀嚼时间（秒）
+	ChewTimer float64
+}
@@ -0,0 +1,25 @@
+package components
+
+// CobCannonComponent 玉米加农炮状态组件
+//
+// 玉米加农炮发射后需要装填，装填完成后玩家点击加农炮进入瞄准模式，
+// 再点击草坪任意位置作为落点，由 BehaviorSystem 在下一帧发射玉米炮弹
+type CobCannonComponent struct {
+	// ReloadTimer 剩余装填时间（秒），<= 0 表示装填完成
+	ReloadTimer float64
+
+	// Armed 是否已装填完毕（装填完成动画已播放，可以响应玩家点击）
+	Armed bool
+
+	// FireRequested 玩家是否已选择落点，等待发射
+	FireRequested bool
+
+	// TargetX, TargetY 玩家选择的落点（世界坐标）
+	TargetX float64
+	TargetY float64
+}
+
+// IsReady 判断玉米加农炮是否可以被玩家选中瞄准
+func (c *CobCannonComponent) IsReady() bool {
+	return c.Armed && !c.FireRequested
+}
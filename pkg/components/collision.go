@@ -7,4 +7,13 @@ type CollisionComponent struct {
 	Height  float64 // 碰撞盒高度（像素）
 	OffsetX float64 // 碰撞盒相对于实体位置的X偏移量（像素），正值向右偏移
 	OffsetY float64 // 碰撞盒相对于实体位置的Y偏移量（像素），正值向下偏移
+
+	// Altitude 碰撞盒离地高度（像素），0 表示在地面
+	// 空中的实体（如气球僵尸）不与地面植物碰撞，只能被对空子弹击中
+	Altitude float64
+}
+
+// IsAirborne 实体是否在空中
+func (c *CollisionComponent) IsAirborne() bool {
+	return c.Altitude > 0
 }
@@ -0,0 +1,75 @@
+package components
+
+import "github.com/gonewx/pvz/pkg/ecs"
+
+// DancerRole 舞者在舞团中的角色
+type DancerRole int
+
+const (
+	// DancerRoleLeader 领舞（舞王僵尸），负责召唤伴舞并统一舞步
+	DancerRoleLeader DancerRole = iota
+	// DancerRoleBackup 伴舞，跟随领舞的舞步
+	DancerRoleBackup
+)
+
+// DancePhase 舞步阶段
+type DancePhase int
+
+const (
+	// DancePhaseMoonwalk 太空步入场（仅领舞）
+	DancePhaseMoonwalk DancePhase = iota
+	// DancePhasePoint 原地指向天空，召唤伴舞（仅领舞）
+	DancePhasePoint
+	// DancePhaseWalk 舞团齐步前进
+	DancePhaseWalk
+	// DancePhaseArmRaise 舞团原地举手
+	DancePhaseArmRaise
+)
+
+// DancerSlot 伴舞相对领舞的站位
+type DancerSlot int
+
+const (
+	// DancerSlotAbove 上方一行
+	DancerSlotAbove DancerSlot = iota
+	// DancerSlotBelow 下方一行
+	DancerSlotBelow
+	// DancerSlotFront 同行前方（左侧）一格
+	DancerSlotFront
+	// DancerSlotBehind 同行后方（右侧）一格
+	DancerSlotBehind
+	// DancerSlotCount 站位数量
+	DancerSlotCount
+)
+
+// DancerComponent 舞王僵尸和伴舞僵尸的舞蹈状态
+//
+// 舞团由领舞统一驱动：领舞决定舞步阶段和移动速度，并同步给所有伴舞，
+// 使整个舞团以相同的动画和速度前进。领舞死亡后，剩余伴舞各自继续跳舞。
+type DancerComponent struct {
+	Role  DancerRole
+	Phase DancePhase
+
+	// PhaseTimer 当前舞步（齐步/举手）剩余时间（秒）
+	PhaseTimer float64
+
+	// SummonTimer 领舞距离下次补召伴舞的剩余时间（秒）
+	SummonTimer float64
+
+	// Backups 领舞各站位上的伴舞，0 表示空缺
+	Backups [DancerSlotCount]ecs.EntityID
+
+	// LeaderID 伴舞所属的领舞，0 表示没有领舞
+	LeaderID ecs.EntityID
+
+	// RiseTimer 伴舞从地下升起的剩余时间（秒），> 0 时正在出土
+	RiseTimer float64
+
+	// RiseTargetY 伴舞出土完成后的 Y 坐标
+	RiseTargetY float64
+}
+
+// IsRising 伴舞是否正在从地下升起
+func (c *DancerComponent) IsRising() bool {
+	return c.RiseTimer > 0
+}
@@ -0,0 +1,44 @@
+package components
+
+// DiggerState 矿工僵尸的挖掘状态
+type DiggerState int
+
+const (
+	// DiggerStateTunneling 在地下挖掘前进，无法被攻击，不啃食植物
+	DiggerStateTunneling DiggerState = iota
+	// DiggerStateDrilling 钻出地面（播放 drill 动画，仍无法被攻击）
+	DiggerStateDrilling
+	// DiggerStateLanding 落地（播放 landing 动画，原地不动）
+	DiggerStateLanding
+	// DiggerStateDizzy 出土后头晕（播放 dizzy 动画，原地不动）
+	DiggerStateDizzy
+	// DiggerStateWalking 出土后在地面行走
+	DiggerStateWalking
+)
+
+// DiggerComponent 矿工僵尸组件
+//
+// 矿工僵尸在地下挖掘前进，到达草坪最左列后钻出地面，头晕片刻后
+// 转身向右行走，从背后啃食植物。矿工镐被磁力菇吸走时就地钻出，之后朝房子方向行走。
+type DiggerComponent struct {
+	State DiggerState
+
+	// DizzyTimer 头晕剩余时间（秒）
+	DizzyTimer float64
+
+	// Backward 出土后是否向右行走（在草坪最左列出土）
+	Backward bool
+
+	// Burrowed 是否已钻入地下（已播放入土的土堆效果）
+	Burrowed bool
+}
+
+// IsUnderground 是否在地下（无法被攻击，不会触发除草车和进家判定）
+func (c *DiggerComponent) IsUnderground() bool {
+	return c.State == DiggerStateTunneling || c.State == DiggerStateDrilling
+}
+
+// IsSurfacing 是否正在出土（钻出、落地、头晕期间原地不动）
+func (c *DiggerComponent) IsSurfacing() bool {
+	return c.State == DiggerStateDrilling || c.State == DiggerStateLanding || c.State == DiggerStateDizzy
+}
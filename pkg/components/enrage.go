@@ -0,0 +1,29 @@
+package components
+
+// EnrageState 狂暴状态
+type EnrageState int
+
+const (
+	// EnrageStateCalm 平静（读报僵尸仍持有报纸）
+	EnrageStateCalm EnrageState = iota
+	// EnrageStateGasping 报纸刚被打碎，原地播放惊愕动画，不移动也不啃食
+	EnrageStateGasping
+	// EnrageStateEnraged 狂暴：永久加快移动和啃食速度
+	EnrageStateEnraged
+)
+
+// EnrageComponent 失去饰品后狂暴的僵尸（读报僵尸）
+//
+// 狂暴后的动画组合在原组合名后加 ComboSuffix（如 "walk" → "walk_nopaper"），
+// 加快的移动和啃食速度由动画配置中的 speed 倍率决定
+type EnrageComponent struct {
+	State EnrageState
+
+	// ComboSuffix 狂暴后动画组合名称的后缀
+	ComboSuffix string
+}
+
+// IsEnraged 是否已进入狂暴状态
+func (c *EnrageComponent) IsEnraged() bool {
+	return c.State == EnrageStateEnraged
+}
@@ -0,0 +1,24 @@
+package components
+
+// Faction 实体所属阵营
+type Faction int
+
+const (
+	// FactionZombie 僵尸阵营（默认）：从右向左移动，啃食植物
+	FactionZombie Faction = iota
+	// FactionPlant 植物阵营：被魅惑的僵尸（如啃食催眠菇后），从左向右移动，啃食僵尸
+	FactionPlant
+)
+
+// FactionComponent 阵营组件
+//
+// 用于区分敌我关系，而非假设所有僵尸都向左移动、都是敌人
+// 没有此组件的僵尸默认属于僵尸阵营
+type FactionComponent struct {
+	Faction Faction
+}
+
+// IsCharmed 判断是否为被魅惑（倒戈到植物阵营）的僵尸
+func (c *FactionComponent) IsCharmed() bool {
+	return c.Faction == FactionPlant
+}
@@ -0,0 +1,52 @@
+package components
+
+import (
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// FogComponent 雾夜关卡的雾（单例）
+//
+// 雾覆盖草坪最右侧的 Columns 列，关卡开始时从右侧逐渐推进；
+// 植物灯笼周围的格子没有雾，三叶草把雾整体吹散一段时间后雾重新推进。
+// 雾下的僵尸和子弹被雾遮挡，雷雨关卡的闪电会短暂照亮整个草坪
+type FogComponent struct {
+	// Columns 被雾覆盖的最右侧列数
+	Columns int
+
+	// Density 每个格子当前的雾浓度（0 表示无雾，1 表示完全被雾遮挡）
+	Density [config.MaxGridRows][config.GridColumns]float64
+
+	// RollIn 雾从右侧推进的进度（0~1），1 表示 Columns 列全部被雾覆盖
+	RollIn float64
+
+	// BlowTimer 被三叶草吹散后雾重新推进前的剩余时间（秒）
+	BlowTimer float64
+
+	// Storm 是否是雷雨关卡
+	Storm bool
+
+	// LightningTimer 距离下一次闪电的时间（秒）
+	LightningTimer float64
+
+	// FlashTimer 当前闪电照亮草坪的剩余时间（秒）
+	FlashTimer float64
+
+	// Image 雾图集（fog.jpg 与 fog_.jpg 蒙板合成，横向 FogImageFrames 帧）
+	Image *ebiten.Image
+}
+
+// IsFlashing 闪电是否正在照亮草坪
+func (c *FogComponent) IsFlashing() bool {
+	return c.FlashTimer > 0
+}
+
+// BloverComponent 三叶草组件
+// 三叶草种下后播放吹风动画，吹风时吹散雾和空中的气球僵尸，之后消失
+type BloverComponent struct {
+	// Blown 是否已经吹过风
+	Blown bool
+
+	// Timer 吹风后消失前的剩余时间（秒）
+	Timer float64
+}
@@ -0,0 +1,9 @@
+package components
+
+// FrozenComponent 被寒冰菇冻结的僵尸
+//
+// 冻结期间僵尸原地不动、不啃食，动画暂停；Timer 归零后解冻并移除该组件
+type FrozenComponent struct {
+	// Timer 解冻前的剩余时间（秒）
+	Timer float64
+}
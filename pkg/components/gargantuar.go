@@ -0,0 +1,65 @@
+package components
+
+import "github.com/gonewx/pvz/pkg/ecs"
+
+// GargantuarState 巨人僵尸的行为状态
+type GargantuarState int
+
+const (
+	// GargantuarStateWalking 行走
+	GargantuarStateWalking GargantuarState = iota
+	// GargantuarStateSmashing 举起电线杆砸向前方的植物（播放 smash 动画，原地不动）
+	GargantuarStateSmashing
+	// GargantuarStateThrowing 扔出背上的小鬼（播放 throw 动画，原地不动）
+	GargantuarStateThrowing
+)
+
+// GargantuarComponent 巨人僵尸组件（白眼巨人、红眼巨人）
+//
+// 巨人不啃食植物，而是用电线杆一击砸扁挡路的植物；大嘴花无法吞下巨人。
+// 生命值降到一半以下时，把背上的小鬼沿抛物线扔向草坪深处。
+type GargantuarComponent struct {
+	State GargantuarState
+
+	// HasImp 背上是否还有小鬼
+	HasImp bool
+
+	// SmashTargetID 正在砸的目标（植物或敌对僵尸）
+	SmashTargetID ecs.EntityID
+}
+
+// ImpState 被扔出的小鬼的状态
+type ImpState int
+
+const (
+	// ImpStateFlying 在空中沿抛物线飞行
+	ImpStateFlying ImpState = iota
+	// ImpStateLanding 落地（播放 land 动画，原地不动）
+	ImpStateLanding
+)
+
+// ImpComponent 被巨人扔出的小鬼组件，落地动画结束后移除
+type ImpComponent struct {
+	State ImpState
+
+	// StartX, StartY 出手位置（巨人手的位置）
+	StartX float64
+	StartY float64
+
+	// TargetX, GroundY 落点位置（落点所在行的地面高度）
+	TargetX float64
+	GroundY float64
+
+	// Elapsed 已飞行的时间（秒）
+	Elapsed float64
+}
+
+// ArcPosition 计算飞行 t（0 到 1）时刻在抛物线上的位置
+//
+// 水平方向匀速移动；竖直方向在出手点和落点的连线之上叠加
+// 最高为 arcHeight 的抛物线
+func (c *ImpComponent) ArcPosition(t, arcHeight float64) (x, y float64) {
+	x = c.StartX + (c.TargetX-c.StartX)*t
+	y = c.StartY + (c.GroundY-c.StartY)*t - 4*arcHeight*t*(1-t)
+	return x, y
+}
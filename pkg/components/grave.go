@@ -0,0 +1,46 @@
+package components
+
+import (
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// GraveComponent 墓碑组件
+//
+// 夜间关卡的墓碑立在草坪格子上（格子记录在 LawnGridComponent.Graves 中），
+// 墓碑所在格子只能种植墓碑吞噬者；最后一波时僵尸从墓碑下爬出
+type GraveComponent struct {
+	Col int
+	Row int
+
+	// Image 土堆和墓碑合成后的图片，底边中心对齐格子底部中心
+	Image *ebiten.Image
+}
+
+// GraveBusterComponent 墓碑吞噬者组件
+//
+// 墓碑吞噬者落到墓碑上（anim_land）后开始啃食，GraveBusterEatDuration 后
+// 连同墓碑一起消失，格子恢复可种植
+type GraveBusterComponent struct {
+	// GraveID 正在吞噬的墓碑
+	GraveID ecs.EntityID
+
+	// Landed 落地动画是否已播放完毕（开始吞噬）
+	Landed bool
+
+	// EatTimer 吞噬墓碑的剩余时间（秒）
+	EatTimer float64
+}
+
+// ZombieRiseComponent 正从地下爬出的僵尸（如最后一波时从墓碑下爬出）
+// 爬出期间原地不动，Y 坐标从地下逐渐回到行中心
+type ZombieRiseComponent struct {
+	// Timer 爬出的剩余时间（秒）
+	Timer float64
+
+	// TargetY 爬出完成后的 Y 坐标
+	TargetY float64
+
+	// Duration 爬出的总时间（秒），为 0 时使用 config.GraveZombieRiseDuration
+	Duration float64
+}
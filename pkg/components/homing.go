@@ -0,0 +1,18 @@
+package components
+
+import "github.com/gonewx/pvz/pkg/ecs"
+
+// HomingComponent 追踪子弹组件
+//
+// 追踪子弹（香蒲尖刺）不局限于所在行，每帧将速度方向向目标僵尸旋转，
+// 旋转角度受 TurnRate 限制，因此飞行轨迹是一条平滑的弧线
+type HomingComponent struct {
+	// TargetEntity 当前追踪的僵尸实体（0 表示暂无目标，沿当前方向直线飞行）
+	TargetEntity ecs.EntityID
+
+	// Speed 飞行速度（像素/秒），转向时保持不变
+	Speed float64
+
+	// TurnRate 每秒最多转向的角度（弧度）
+	TurnRate float64
+}
@@ -0,0 +1,14 @@
+package components
+
+// JackComponent 小丑僵尸的八音盒状态
+//
+// 小丑僵尸持有八音盒时边走边播放音乐，引信耗尽后原地打开盒子，
+// 弹出动画结束时爆炸，摧毁以自身所在格子为中心 3x3 范围内的植物。
+// 八音盒被磁力菇吸走或僵尸被魅惑后移除此组件，之后按普通僵尸行走。
+type JackComponent struct {
+	// PopTimer 距离打开盒子的剩余时间（秒）
+	PopTimer float64
+
+	// Popping 是否正在打开盒子（播放弹出动画，原地不动）
+	Popping bool
+}
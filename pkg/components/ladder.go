@@ -0,0 +1,49 @@
+package components
+
+import "github.com/gonewx/pvz/pkg/ecs"
+
+// LadderZombieComponent 扶梯僵尸组件
+//
+// 扶梯僵尸扛着梯子（II类金属饰品，由 AccessoryComponent 承载）时使用扛梯行走/啃食动画，
+// 遇到的第一株坚果墙或高坚果会被架上梯子；架梯后（或梯子被打坏、被磁力菇吸走后）像普通僵尸一样行走
+type LadderZombieComponent struct {
+	// PlacingPlantID 正在架梯的植物（0 表示未在架梯）
+	PlacingPlantID ecs.EntityID
+}
+
+// IsPlacing 是否正在架梯
+func (c *LadderZombieComponent) IsPlacing() bool {
+	return c.PlacingPlantID != 0
+}
+
+// LadderedComponent 标记植物已被架上梯子
+// 同一行之后的地面僵尸遇到该植物时顺着梯子翻过去，而不是啃食
+type LadderedComponent struct {
+	// LadderEntityID 靠在植物上的梯子实体
+	LadderEntityID ecs.EntityID
+}
+
+// PlacedLadderComponent 架在植物上的梯子实体组件
+// 植物被吃掉、铲除后梯子随之移除
+type PlacedLadderComponent struct {
+	PlantID ecs.EntityID
+}
+
+// ClimbComponent 僵尸正顺着梯子翻越植物
+//
+// 翻越期间不检测碰撞，在 LadderClimbDuration 内从起点沿抛物线移动到落点，
+// 落地后恢复行走
+type ClimbComponent struct {
+	// PlantID 正在翻越的植物，落地后不会立即啃食它
+	PlantID ecs.EntityID
+
+	// StartX, TargetX 翻越的起点和落点（世界坐标）
+	StartX  float64
+	TargetX float64
+
+	// BaseY 翻越开始时的 Y 坐标（地面高度）
+	BaseY float64
+
+	// Elapsed 已翻越的时间（秒）
+	Elapsed float64
+}
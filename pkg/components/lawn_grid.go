@@ -1,14 +1,29 @@
 package components
 
-import "github.com/gonewx/pvz/pkg/ecs"
+import (
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+)
 
 // LawnGridComponent 标识草坪网格管理器实体
-// 用于跟踪哪些格子已被植物占用
+// 用于跟踪哪些格子已被植物占用，以及格子上的地形覆盖状态（如冰道、墓碑、睡莲）
 //
 // Occupancy 是一个二维数组，存储每个格子的占用状态
 // [row][col] = EntityID，其中 0 表示空格子
-// 网格规格: 5行 x 9列
+// 网格规格: 最多 6行 x 9列（前院只使用前 5 行）
 type LawnGridComponent struct {
 	// Occupancy 存储每个格子的占用状态 (0 表示空格子)
-	Occupancy [5][9]ecs.EntityID
+	Occupancy [config.MaxGridRows][config.GridColumns]ecs.EntityID
+
+	// Ice 存储每个格子上冰道的剩余时间（秒），0 表示没有冰道
+	// 雪橇车僵尸在身后留下冰道，冰道上不能种植，雪橇队僵尸可以在冰道上滑行
+	Ice [config.MaxGridRows][config.GridColumns]float64
+
+	// Graves 存储每个格子上的墓碑实体 (0 表示没有墓碑)
+	// 墓碑所在格子只能种植墓碑吞噬者，墓碑吞噬者与墓碑同时存在于一个格子
+	Graves [config.MaxGridRows][config.GridColumns]ecs.EntityID
+
+	// Platforms 存储每个格子上的种植平台实体（水路上的睡莲、屋顶上的花盆，0 表示没有平台）
+	// 水路上的陆生植物只能种在睡莲上，屋顶上的植物只能种在花盆上，平台与其上的植物同时存在于一个格子
+	Platforms [config.MaxGridRows][config.GridColumns]ecs.EntityID
 }
@@ -8,6 +8,9 @@ type LawnmowerComponent struct {
 	IsMoving    bool    // 是否正在移动
 	Speed       float64 // 移动速度（像素/秒），默认 300.0
 
+	// IsPoolCleaner 是否是泳池清洁车（水路行），触发后切换为水中动画
+	IsPoolCleaner bool
+
 	// 入场动画状态
 	IsEntering   bool    // 是否正在播放入场动画
 	EnterStartX  float64 // 入场动画起始X位置（屏幕左侧外）
@@ -0,0 +1,50 @@
+package components
+
+import "github.com/gonewx/pvz/pkg/ecs"
+
+// LobbedProjectileComponent 抛物线子弹组件
+//
+// 投手类植物的子弹（玉米粒）、玉米炮弹和投篮车的篮球不受物理系统的直线碰撞检测，
+// 而是在固定飞行时间内沿抛物线从起点飞到落点，落地时结算伤害
+type LobbedProjectileComponent struct {
+	// StartX, StartY 起点（世界坐标）
+	StartX float64
+	StartY float64
+
+	// TargetX, TargetY 落点（世界坐标）
+	TargetX float64
+	TargetY float64
+
+	// TargetEntity 追踪的目标实体（0 表示落点固定）
+	// 目标僵尸存活时每帧更新落点，使子弹命中移动中的僵尸；目标植物不移动，落点保持不变
+	TargetEntity ecs.EntityID
+
+	// ArcHeight 抛物线顶点相对起点和落点连线的高度（像素）
+	ArcHeight float64
+
+	// Duration 飞行总时间（秒）
+	Duration float64
+
+	// Elapsed 已飞行时间（秒）
+	Elapsed float64
+
+	// Damage 落地时造成的伤害
+	Damage int
+}
+
+// Progress 返回飞行进度（0.0 - 1.0）
+func (c *LobbedProjectileComponent) Progress() float64 {
+	if c.Duration <= 0 {
+		return 1.0
+	}
+	progress := c.Elapsed / c.Duration
+	if progress > 1.0 {
+		return 1.0
+	}
+	return progress
+}
+
+// IsLanded 判断子弹是否已落地
+func (c *LobbedProjectileComponent) IsLanded() bool {
+	return c.Elapsed >= c.Duration
+}
@@ -0,0 +1,26 @@
+package components
+
+import (
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// MagnetshroomComponent 磁力菇状态组件
+//
+// 磁力菇吸走僵尸的金属饰品后，饰品会吸附在磁力菇上并逐渐消失，
+// 在此期间磁力菇处于冷却状态，无法再次吸取
+type MagnetshroomComponent struct {
+	// HeldAccessory 当前吸附的饰品标签（AccessoryNone 表示空闲）
+	HeldAccessory types.AccessoryTag
+
+	// HeldEntity 吸附饰品的显示实体（0 表示无）
+	HeldEntity ecs.EntityID
+
+	// RechargeTimer 剩余冷却时间（秒），<= 0 表示可以吸取
+	RechargeTimer float64
+}
+
+// IsReady 判断磁力菇是否可以吸取饰品
+func (c *MagnetshroomComponent) IsReady() bool {
+	return c.RechargeTimer <= 0
+}
@@ -81,3 +81,35 @@ var shooterPlants = map[PlantType]bool{
 func IsShooterPlant(plantType PlantType) bool {
 	return shooterPlants[plantType]
 }
+
+// multiCellPlants 占用多个格子的植物及其占用的列数
+// 多格植物以 GridCol 为最左侧格子，向右占用连续的格子
+var multiCellPlants = map[PlantType]int{
+	PlantCobCannon: 2,
+}
+
+// PlantFootprintWidth 返回植物在草坪上占用的列数（普通植物为 1）
+func PlantFootprintWidth(plantType PlantType) int {
+	if width, ok := multiCellPlants[plantType]; ok {
+		return width
+	}
+	return 1
+}
+
+// upgradePlants 升级植物及其需要种植在其上的基础植物
+// 升级植物只能种植在基础植物上，种植时替换掉占用格子内的所有基础植物
+var upgradePlants = map[PlantType]PlantType{
+	PlantCobCannon: PlantKernelpult,
+}
+
+// UpgradeBasePlant 返回升级植物需要的基础植物类型
+// 返回 false 表示该植物不是升级植物，可以直接种植在空格子上
+func UpgradeBasePlant(plantType PlantType) (PlantType, bool) {
+	base, ok := upgradePlants[plantType]
+	return base, ok
+}
+
+// OccupiesCell 判断植物是否占用指定格子（考虑多格植物）
+func (p *PlantComponent) OccupiesCell(col, row int) bool {
+	return row == p.GridRow && col >= p.GridCol && col < p.GridCol+PlantFootprintWidth(p.PlantType)
+}
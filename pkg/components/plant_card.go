@@ -10,12 +10,32 @@ type PlantType = types.PlantType
 
 // 植物类型常量（从 types 包重新导出，保持向后兼容）
 const (
-	PlantUnknown    = types.PlantUnknown
-	PlantSunflower  = types.PlantSunflower
-	PlantPeashooter = types.PlantPeashooter
-	PlantWallnut    = types.PlantWallnut
-	PlantCherryBomb = types.PlantCherryBomb
-	PlantPotatoMine = types.PlantPotatoMine // Story 19.10
+	PlantUnknown      = types.PlantUnknown
+	PlantSunflower    = types.PlantSunflower
+	PlantPeashooter   = types.PlantPeashooter
+	PlantWallnut      = types.PlantWallnut
+	PlantCherryBomb   = types.PlantCherryBomb
+	PlantPotatoMine   = types.PlantPotatoMine // Story 19.10
+	PlantMagnetshroom = types.PlantMagnetshroom
+	PlantHypnoshroom  = types.PlantHypnoshroom
+	PlantKernelpult   = types.PlantKernelpult
+	PlantCobCannon    = types.PlantCobCannon
+	PlantSplitPea     = types.PlantSplitPea
+	PlantStarfruit    = types.PlantStarfruit
+	PlantCattail      = types.PlantCattail
+	PlantTallnut      = types.PlantTallnut
+	PlantGraveBuster  = types.PlantGraveBuster
+	PlantLilyPad      = types.PlantLilyPad
+	PlantTangleKelp   = types.PlantTangleKelp
+	PlantSeaShroom    = types.PlantSeaShroom
+	PlantPlantern     = types.PlantPlantern
+	PlantBlover       = types.PlantBlover
+	PlantFlowerPot    = types.PlantFlowerPot
+	PlantJalapeno     = types.PlantJalapeno
+	PlantIceShroom    = types.PlantIceShroom
+	PlantSpikeweed    = types.PlantSpikeweed
+	PlantChomper      = types.PlantChomper
+	PlantUmbrellaLeaf = types.PlantUmbrellaLeaf
 )
 
 // PlantCardComponent 表示植物选择卡片的数据
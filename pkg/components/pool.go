@@ -0,0 +1,63 @@
+package components
+
+import "github.com/gonewx/pvz/pkg/ecs"
+
+// TangleKelpComponent 缠绕海草组件
+//
+// 缠绕海草潜伏在水中，第一个进入所在格子的地面僵尸被它缠住，
+// 拖入水中 TangleKelpDragDuration 后僵尸死亡，缠绕海草随之消失
+type TangleKelpComponent struct {
+	// TargetID 被缠住的僵尸（0 表示还没有缠住僵尸）
+	TargetID ecs.EntityID
+
+	// DragTimer 拖拽的剩余时间（秒）
+	DragTimer float64
+}
+
+// TangledComponent 被缠绕海草缠住的僵尸
+// 被缠住期间原地不动，Y 坐标逐渐下沉到水中
+type TangledComponent struct {
+	// KelpID 缠住僵尸的缠绕海草
+	KelpID ecs.EntityID
+
+	// StartY 被缠住时的 Y 坐标
+	StartY float64
+}
+
+// PuffComponent 孢子子弹组件（海蘑菇）
+// 孢子射程很短，飞过 MaxX 后消散
+type PuffComponent struct {
+	// MaxX 孢子消散的世界坐标X
+	MaxX float64
+}
+
+// SwimState 水路僵尸的游泳状态
+type SwimState int
+
+const (
+	// SwimStateLand 还在水池右侧的陆地上行走
+	SwimStateLand SwimState = iota
+	// SwimStateEntering 正在跳入水中（原地播放入水动画）
+	SwimStateEntering
+	// SwimStateSwimming 在水中游动
+	SwimStateSwimming
+)
+
+// SwimComponent 水路僵尸组件（鸭子救生圈僵尸、潜水僵尸、海豚骑士僵尸）
+//
+// 水路僵尸先在水池右侧的陆地上行走，到达水池边缘后跳入水中。
+// 入水后行走、啃食、死亡动画切换为 swim、swim_eat、swim_death 组合
+type SwimComponent struct {
+	State SwimState
+
+	// EnterCombo 入水动画组合（为空时直接进入游泳状态）
+	EnterCombo string
+
+	// Dives 是否潜水：潜水僵尸游动时潜在水下，子弹和植物都无法攻击，啃食时浮出水面
+	Dives bool
+}
+
+// InWater 是否已经进入水中（入水中或游泳中）
+func (c *SwimComponent) InWater() bool {
+	return c.State != SwimStateLand
+}
@@ -19,6 +19,9 @@ type RenderPartData struct {
 
 	// OffsetY 父子偏移 Y
 	OffsetY float64
+
+	// TrackName 部件所属的轨道名（用于按轨道替换或隐藏饰品部件）
+	TrackName string
 }
 
 // InterlayerDrawRequest 中间层绘制请求
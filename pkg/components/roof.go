@@ -0,0 +1,18 @@
+package components
+
+// SlopeFollowerComponent 屋顶斜坡上跟随地面高度的实体（植物、僵尸、清洁车）
+//
+// 屋顶左侧的斜坡越往左越低，实体的世界坐标Y包含斜坡偏移，
+// RoofSystem 每帧按实体当前X坐标重新计算偏移，并把变化量叠加到Y坐标上
+type SlopeFollowerComponent struct {
+	// OffsetY 当前已叠加到 PositionComponent.Y 上的斜坡偏移（0 表示位于平坦区域）
+	OffsetY float64
+}
+
+// RoofProjectileComponent 屋顶上向右飞行的直线子弹
+//
+// 子弹水平飞行，斜坡向右升高，子弹飞到高于发射点 RoofProjectileClearance 的斜坡时撞上屋顶消失
+type RoofProjectileComponent struct {
+	// LaunchOffsetY 子弹发射位置的斜坡偏移
+	LaunchOffsetY float64
+}
@@ -0,0 +1,13 @@
+package components
+
+// SplitPeaComponent 裂荚射手后头的状态组件
+//
+// 裂荚射手的前头使用 TimerComponent 计时（与豌豆射手相同），
+// 后头朝向左侧，独立计时，只在身后有僵尸时才发射
+type SplitPeaComponent struct {
+	// BackTimer 后头距离上次发射经过的时间（秒）
+	BackTimer float64
+
+	// BackInterval 后头的攻击间隔（秒）
+	BackInterval float64
+}
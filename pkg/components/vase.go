@@ -0,0 +1,49 @@
+package components
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// VaseComponent 砸罐子关卡的罐子
+//
+// 罐子占据所在的草坪格子（记录在 LawnGridComponent.Occupancy 中），格子上不能种植；
+// 点击罐子后罐子破碎，露出种子包或僵尸
+type VaseComponent struct {
+	Col int
+	Row int
+
+	// Type 罐子类型（config.VaseTypeRegular/VaseTypePlant/VaseTypeZombie），决定外观
+	Type string
+
+	// PlantType 罐子里的种子包（ZombieType 为空时有效）
+	PlantType PlantType
+
+	// ZombieType 罐子里的僵尸类型，为空表示罐子里是种子包
+	ZombieType string
+
+	// Image 罐子图片，底边中心对齐格子底部中心
+	Image *ebiten.Image
+}
+
+// SeedPacketComponent 草坪上可以拾取的种子包（从罐子里弹出）
+//
+// 种子包弹出后落到地面，玩家点击拾起后进入种植模式，种下植物后种子包消失；
+// 取消种植时种子包留在原地
+type SeedPacketComponent struct {
+	PlantType PlantType
+
+	// Card 种子包外观（复用植物卡片的渲染，不显示阳光数）
+	Card *PlantCardComponent
+
+	// VelocityY 弹出时的竖直速度（像素/秒）
+	VelocityY float64
+
+	// TargetY 落地后的 Y 坐标
+	TargetY float64
+
+	// Landed 是否已经落地（落地后才能拾取）
+	Landed bool
+
+	// Held 是否已被拾起（正在种植）
+	Held bool
+}
@@ -0,0 +1,58 @@
+package components
+
+import "github.com/gonewx/pvz/pkg/ecs"
+
+// VaultKind 跳跃方式
+type VaultKind int
+
+const (
+	// VaultKindPole 撑杆跳：越过遇到的第一株植物后丢弃撑杆
+	VaultKindPole VaultKind = iota
+	// VaultKindPogo 跳跳杆：越过遇到的每一株植物，直到跳跳杆被磁力菇吸走
+	VaultKindPogo
+	// VaultKindDolphin 海豚：入水后骑海豚快速游动，越过水中遇到的第一株植物后失去海豚
+	VaultKindDolphin
+)
+
+// VaultState 跳跃状态
+type VaultState int
+
+const (
+	// VaultStateReady 持有道具，遇到植物时起跳
+	VaultStateReady VaultState = iota
+	// VaultStateJumping 正在越过植物
+	VaultStateJumping
+	// VaultStateDone 已失去道具，像普通僵尸一样行走和啃食
+	VaultStateDone
+)
+
+// VaultComponent 跳跃僵尸组件（撑杆跳僵尸、跳跳僵尸、海豚骑士僵尸）
+//
+// 跳跃僵尸仍使用普通僵尸的行为类型，由此组件改变其遇到植物时的反应：
+// 持有道具时起跳越过植物而不是啃食，失去道具后恢复普通僵尸行为
+type VaultComponent struct {
+	Kind  VaultKind
+	State VaultState
+
+	// MoveSpeed 持有道具时的移动速度（像素/秒，负值向左）
+	// 持有道具时使用固定速度移动，失去道具后恢复根运动行走
+	MoveSpeed float64
+
+	// MoveCombo 持有道具时的移动动画组合（如 "run", "pogo"）
+	MoveCombo string
+
+	// PlantID 正在越过（或最近越过）的植物，落地后不会立即啃食它
+	PlantID ecs.EntityID
+
+	// Elapsed 本次跳跃已进行的时间（秒，仅跳跳杆使用）
+	Elapsed float64
+
+	// StartX, TargetX 本次跳跃的起点和落点（世界坐标，仅跳跳杆使用）
+	StartX  float64
+	TargetX float64
+}
+
+// HasProp 是否仍持有跳跃道具
+func (c *VaultComponent) HasProp() bool {
+	return c.State != VaultStateDone
+}
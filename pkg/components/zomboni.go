@@ -0,0 +1,8 @@
+package components
+
+// ZomboniComponent 雪橇车僵尸组件（标记）
+//
+// 雪橇车行驶时不啃食植物，而是碾过挡路的植物，并在身后（所在格子到草坪右边缘）持续铺设冰道；
+// 冰道状态记录在 LawnGridComponent.Ice 中，雪橇车消失后冰道在一段时间后淡出。
+// 被地刺扎破或生命值耗尽时直接报废，播放雪橇车专用的烧焦动画。
+type ZomboniComponent struct{}
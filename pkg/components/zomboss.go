@@ -0,0 +1,89 @@
+package components
+
+// ZombossState 僵王博士的状态
+type ZombossState int
+
+const (
+	// ZombossStateEntering 从草坪右侧入场
+	ZombossStateEntering ZombossState = iota
+	// ZombossStateIdle 待机，计时结束后选择下一次攻击
+	ZombossStateIdle
+	// ZombossStateStomping 踩踏右侧几列的植物
+	ZombossStateStomping
+	// ZombossStateBungeeEnter 伸手放下蹦极僵尸
+	ZombossStateBungeeEnter
+	// ZombossStateBungeeLeave 放下蹦极僵尸后收回手臂
+	ZombossStateBungeeLeave
+	// ZombossStateSummoning 在一行中召唤一群僵尸
+	ZombossStateSummoning
+	// ZombossStateRV 把房车扔到 3x2 的植物上
+	ZombossStateRV
+	// ZombossStateHeadEnter 低下头部
+	ZombossStateHeadEnter
+	// ZombossStateHeadIdle 头部低垂，露出弱点
+	ZombossStateHeadIdle
+	// ZombossStateHeadAttack 从口中吐出火球或冰球
+	ZombossStateHeadAttack
+	// ZombossStateHeadLeave 抬起头部
+	ZombossStateHeadLeave
+	// ZombossStateDying 被击败，播放死亡动画
+	ZombossStateDying
+)
+
+// ZombossComponent 僵王博士（单例）
+//
+// 僵王博士入场后在待机和各种攻击之间循环：踩踏植物、放下蹦极僵尸、召唤僵尸、扔房车；
+// 每进行 ZombossAttacksPerHead 次攻击后低下头部露出弱点，并吐出滚向左侧的火球或冰球。
+// 只有头部低垂时才能被植物攻击，生命值驱动关卡进度条
+type ZombossComponent struct {
+	// State 当前状态
+	State ZombossState
+
+	// Timer 当前状态的剩余时间（秒），用于待机和头部低垂
+	Timer float64
+
+	// AttackCount 上次低头之后进行的攻击次数
+	AttackCount int
+
+	// TargetRow 当前攻击的目标行（踩踏、召唤、房车为起始行，吐球为滚动行）
+	TargetRow int
+
+	// TargetCol 房车落点的起始列
+	TargetCol int
+
+	// BallElement 本次低头要吐出的球的属性
+	BallElement ZombossElement
+}
+
+// IsHeadExposed 头部是否低垂露出弱点（只有此时才能被植物攻击）
+func (c *ZombossComponent) IsHeadExposed() bool {
+	return c.State == ZombossStateHeadIdle || c.State == ZombossStateHeadAttack
+}
+
+// ZombossElement 僵王博士吐出的球的属性
+type ZombossElement int
+
+const (
+	// ZombossElementFire 火球，只能被寒冰菇冻结消除
+	ZombossElementFire ZombossElement = iota
+	// ZombossElementIce 冰球，只能被火爆辣椒融化消除
+	ZombossElementIce
+)
+
+// Counter 返回能消除该属性球的属性（火克冰、冰克火）
+func (e ZombossElement) Counter() ZombossElement {
+	if e == ZombossElementFire {
+		return ZombossElementIce
+	}
+	return ZombossElementFire
+}
+
+// ZombossBallComponent 僵王博士吐出的火球或冰球
+// 球在一行中向左滚动，碾碎经过的植物，只能被相反属性的攻击消除
+type ZombossBallComponent struct {
+	// Element 球的属性
+	Element ZombossElement
+
+	// Row 滚动所在的行
+	Row int
+}
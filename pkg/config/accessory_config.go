@@ -0,0 +1,106 @@
+package config
+
+import "github.com/gonewx/pvz/pkg/types"
+
+// AccessoryResourceConfig 僵尸饰品资源配置
+// 集中管理饰品对应的 Reanim 轨道和图片资源
+type AccessoryResourceConfig struct {
+	TrackName string // 饰品在僵尸 Reanim 中的轨道名（移除时隐藏）
+	HeldImage string // 被磁力菇吸走后吸附在磁力菇上显示的图片
+
+	// ImageKey, DamageImages 受损外观阶段表：按耐久从高到低依次替换 PartImages[ImageKey]
+	// 阶段划分：完整 (66% - 100%) → 轻微受损 (33% - 66%) → 严重受损 (0% - 33%)
+	ImageKey     string
+	DamageImages []string
+
+	// DropParticle 饰品被打坏时的掉落粒子效果（为空时不播放）
+	DropParticle string
+}
+
+// AccessoryConfigs 饰品配置表（使用 types.AccessoryTag 作为键）
+var AccessoryConfigs = map[types.AccessoryTag]*AccessoryResourceConfig{
+	types.AccessoryCone: {
+		TrackName: "anim_cone",
+		ImageKey:  "IMAGE_REANIM_ZOMBIE_CONE1",
+		DamageImages: []string{
+			"assets/reanim/Zombie_cone1.png",
+			"assets/reanim/Zombie_cone2.png",
+			"assets/reanim/Zombie_cone3.png",
+		},
+		DropParticle: "ZombieTrafficCone",
+	},
+	types.AccessoryBucket: {
+		TrackName: "anim_bucket",
+		HeldImage: "assets/reanim/Zombie_bucket1.png",
+		ImageKey:  "IMAGE_REANIM_ZOMBIE_BUCKET1",
+		DamageImages: []string{
+			"assets/reanim/Zombie_bucket1.png",
+			"assets/reanim/Zombie_bucket2.png",
+			"assets/reanim/Zombie_bucket3.png",
+		},
+		DropParticle: "ZombiePail",
+	},
+	types.AccessoryFootballHelmet: {
+		TrackName: "zombie_football_helmet",
+		HeldImage: "assets/reanim/Zombie_football_helmet.png",
+		ImageKey:  "IMAGE_REANIM_ZOMBIE_FOOTBALL_HELMET",
+		DamageImages: []string{
+			"assets/reanim/Zombie_football_helmet.png",
+			"assets/reanim/Zombie_football_helmet2.png",
+			"assets/reanim/Zombie_football_helmet3.png",
+		},
+		DropParticle: "ZombieHelmet",
+	},
+	types.AccessoryNewspaper: {
+		TrackName: "Zombie_paper_paper",
+		ImageKey:  "IMAGE_REANIM_ZOMBIE_PAPER_PAPER1",
+		DamageImages: []string{
+			"assets/reanim/Zombie_paper_paper1.png",
+			"assets/reanim/Zombie_paper_paper2.png",
+			"assets/reanim/Zombie_paper_paper3.png",
+		},
+		DropParticle: "ZombieNewspaper",
+	},
+	types.AccessoryScreenDoor: {
+		TrackName: "anim_screendoor",
+		HeldImage: "assets/reanim/Zombie_screendoor1.png",
+		ImageKey:  "IMAGE_REANIM_ZOMBIE_SCREENDOOR1",
+		DamageImages: []string{
+			"assets/reanim/Zombie_screendoor1.png",
+			"assets/reanim/Zombie_screendoor2.png",
+			"assets/reanim/Zombie_screendoor3.png",
+		},
+		DropParticle: "ZombieDoor",
+	},
+	types.AccessoryLadder: {
+		TrackName: "Zombie_ladder_1",
+		HeldImage: "assets/reanim/Zombie_ladder_1.png",
+		ImageKey:  "IMAGE_REANIM_ZOMBIE_LADDER_1",
+		DamageImages: []string{
+			"assets/reanim/Zombie_ladder_1.png",
+			"assets/reanim/Zombie_ladder_1_damage1.png",
+			"assets/reanim/Zombie_ladder_1_damage2.png",
+		},
+	},
+	types.AccessoryPogoStick: {
+		TrackName: "Zombie_pogo_stick",
+		HeldImage: "assets/reanim/Zombie_pogo_stick.png",
+	},
+	types.AccessoryJackBox: {
+		TrackName: "Zombie_jackbox_box",
+		HeldImage: "assets/reanim/Zombie_jackbox_box.png",
+	},
+	types.AccessoryPickaxe: {
+		TrackName: "Zombie_digger_pickaxe",
+		HeldImage: "assets/reanim/Zombie_digger_pickaxe.png",
+	},
+}
+
+// GetAccessoryConfig 获取饰品配置
+// 如果饰品标签未配置，返回 nil
+func GetAccessoryConfig(tag types.AccessoryTag) *AccessoryResourceConfig {
+	if cfg, ok := AccessoryConfigs[tag]; ok {
+		return cfg
+	}
+	return nil
+}
@@ -1,5 +1,7 @@
 package config
 
+import "math"
+
 // 布局配置常量
 // 本文件定义了游戏场景中的布局参数，包括网格系统、UI元素位置等
 
@@ -22,6 +24,109 @@ const (
 	BackgroundHeight = 600.0
 )
 
+// Lawn Row Layout (草坪行布局)
+// 行数、行高和坡度随场景变化（前院 5 行，后院泳池 6 行，屋顶左侧是斜坡），由 LawnLayout 描述。
+// 下面的常量是前院布局，关卡中的网格坐标计算使用场景传入的 LawnLayout
+const (
+	// GridWorldStartY 是草坪网格在背景图片中的起始Y坐标（世界坐标）
+	// Y轴不受摄像机水平移动影响，因此世界坐标等于屏幕坐标
+	GridWorldStartY = 78.0
+
+	// GridRows 是草坪的行数（纵向格子数）
+	GridRows = 5
+
+	// CellHeight 是每个格子的高度（像素）
+	CellHeight = 100.0
+)
+
+// LawnLayout 草坪行布局
+type LawnLayout struct {
+	Rows           int     // 行数
+	StartY         float64 // 网格起始Y坐标（世界坐标）
+	CellHeight     float64 // 行高（像素）
+	WaterRows      []int   // 水路行（0-based）
+	SlopeColumns   int     // 坡面列数（从最左列开始）
+	SlopeHeight    float64 // 坡面每列的高度差（像素），越靠左越低
+	NeedsFlowerPot bool    // 植物是否必须种在花盆上
+}
+
+// lawnLayouts 各场景的草坪行布局，未列出的场景使用前院布局
+var lawnLayouts = map[string]LawnLayout{
+	"day":  {Rows: GridRows, StartY: GridWorldStartY, CellHeight: CellHeight},
+	"pool": {Rows: 6, StartY: 78.0, CellHeight: 85.0, WaterRows: []int{2, 3}},
+	"fog":  {Rows: 6, StartY: 78.0, CellHeight: 85.0, WaterRows: []int{2, 3}},
+	"roof": {Rows: 5, StartY: 70.0, CellHeight: 85.0, SlopeColumns: 5, SlopeHeight: 20.0, NeedsFlowerPot: true},
+}
+
+// GetLawnLayout 获取场景的草坪行布局
+func GetLawnLayout(sceneType string) LawnLayout {
+	if layout, ok := lawnLayouts[sceneType]; ok {
+		return layout
+	}
+	return lawnLayouts["day"]
+}
+
+// HasSlope 草坪是否有坡面（屋顶）
+func (l LawnLayout) HasSlope() bool {
+	return l.SlopeColumns > 0
+}
+
+// SlopeOffsetY 返回世界X坐标处坡面相对平坦部分的Y偏移（像素），平坦草坪返回 0
+// 屋顶从坡面右边缘（第 SlopeColumns 列左边缘）向左逐渐降低，越靠左 Y 越大
+func (l LawnLayout) SlopeOffsetY(worldX float64) float64 {
+	if l.SlopeColumns == 0 {
+		return 0
+	}
+	slopeEndX := GridWorldStartX + float64(l.SlopeColumns)*CellWidth
+	if worldX >= slopeEndX {
+		return 0
+	}
+	return (slopeEndX - worldX) / CellWidth * l.SlopeHeight
+}
+
+// CellSlopeOffsetY 返回指定列（0-based）格子中心的坡面Y偏移（像素）
+func (l LawnLayout) CellSlopeOffsetY(col int) float64 {
+	return l.SlopeOffsetY(GridWorldStartX + float64(col)*CellWidth + CellWidth/2)
+}
+
+// IsWaterRow 指定行（0-based）是否是水路
+func (l LawnLayout) IsWaterRow(row int) bool {
+	for _, r := range l.WaterRows {
+		if r == row {
+			return true
+		}
+	}
+	return false
+}
+
+// CellCenter 返回格子中心的世界坐标（斜坡上叠加坡面偏移）
+func (l LawnLayout) CellCenter(col, row int) (worldX, worldY float64) {
+	worldX = GridWorldStartX + float64(col)*CellWidth + CellWidth/2
+	worldY = l.StartY + float64(row)*l.CellHeight + l.CellHeight/2 + l.SlopeOffsetY(worldX)
+	return worldX, worldY
+}
+
+// CellAt 返回世界坐标所在的格子（斜坡上先减去坡面偏移，换算到平坦网格）
+// 不在草坪范围内时返回 (0, 0, false)
+func (l LawnLayout) CellAt(worldX, worldY float64) (col, row int, ok bool) {
+	flatY := worldY - l.SlopeOffsetY(worldX)
+	gridEndY := l.StartY + float64(l.Rows)*l.CellHeight
+	if worldX < GridWorldStartX || worldX >= GridWorldEndX || flatY < l.StartY || flatY >= gridEndY {
+		return 0, 0, false
+	}
+
+	// 防止浮点数计算误差导致的越界
+	col = min(int((worldX-GridWorldStartX)/CellWidth), GridColumns-1)
+	row = min(int((flatY-l.StartY)/l.CellHeight), l.Rows-1)
+	return col, row, true
+}
+
+// RowAt 返回世界坐标所在的行（0-based，斜坡上先减去坡面偏移）
+// 不做范围检查，草坪上方返回负数；僵尸坐标需先减去 ZombieVerticalOffset
+func (l LawnLayout) RowAt(worldX, worldY float64) int {
+	return int(math.Floor((worldY - l.SlopeOffsetY(worldX) - l.StartY) / l.CellHeight))
+}
+
 // Camera Configuration (摄像机配置)
 const (
 	// GameCameraX 是游戏摄像机的X位置（世界坐标）
@@ -41,22 +146,15 @@ const (
 	// 计算方式：屏幕坐标 + 游戏摄像机位置
 	GridWorldStartX = GridScreenStartX + GameCameraX
 
-	// GridWorldStartY 是草坪网格在背景图片中的起始Y坐标（世界坐标）
-	// Y轴不受摄像机水平移动影响，因此世界坐标等于屏幕坐标
-	GridWorldStartY = 78.0
-
 	// GridColumns 是草坪的列数（横向格子数）
 	GridColumns = 9
 
-	// GridRows 是草坪的行数（纵向格子数）
-	GridRows = 5
+	// MaxGridRows 是所有场景中草坪的最大行数（后院泳池 6 行），用于网格数组的大小
+	MaxGridRows = 6
 
 	// CellWidth 是每个格子的宽度（像素）
 	CellWidth = 80.0
 
-	// CellHeight 是每个格子的高度（像素）
-	CellHeight = 100.0
-
 	// GridWorldEndX 是草坪网格在背景图片中的结束X坐标（世界坐标）
 	// 计算方式：起始X + 列数 * 格子宽度 = 251 + 9*80 = 971
 	// 用于判断实体是否在草坪范围内（如豌豆射手攻击范围检测）
@@ -344,6 +442,12 @@ const (
 	LawnmowerStartX       = 223.0
 	LawnmowerStartOffsetY = 20.0
 
+	// PoolCleanerStartOffsetY 泳池清洁车相对水路行中心的Y偏移（像素）
+	PoolCleanerStartOffsetY = 10.0
+
+	// RoofCleanerStartOffsetY 屋顶清洁车相对行中心的Y偏移（像素）
+	RoofCleanerStartOffsetY = 15.0
+
 	// LawnmowerSpeed 除草车移动速度（像素/秒）
 	// 原版除草车快速向右移动的速度
 	// 建议值范围：200.0 - 400.0
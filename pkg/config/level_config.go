@@ -2,6 +2,8 @@ package config
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/gonewx/pvz/pkg/embedded"
 	"gopkg.in/yaml.v3"
@@ -89,6 +91,120 @@ type LevelConfig struct {
 	// 设置为 0 表示立即开始（传送带关卡等特殊关卡）
 	// 设置为 -1 表示使用默认值（不覆盖）
 	FirstWaveDelay *float64 `yaml:"firstWaveDelay"`
+
+	// Graves 墓碑配置（夜间关卡）
+	// 墓碑所在格子不能种植（墓碑吞噬者除外），最后一波时僵尸从墓碑下爬出
+	Graves []GravePosition `yaml:"graves"`
+
+	// FogColumns 雾夜关卡中被雾覆盖的最右侧列数（0-9）
+	// 雾夜场景未配置时默认为 FogDefaultColumns，其他场景没有雾
+	FogColumns int `yaml:"fogColumns"`
+
+	// Music 战斗中播放的背景音乐资源ID（如 "SOUND_MAINMUSIC"），默认使用场景的标准音乐，空表示不播放
+	Music string `yaml:"music"`
+
+	// AmbientParticles 战斗中持续显示在草坪上的环境粒子效果名称，默认使用场景的标准环境粒子，空表示没有
+	AmbientParticles string `yaml:"ambientParticles"`
+
+	// Storm 是否是雷雨关卡：闪电周期性照亮整个草坪，短暂显示雾中的僵尸
+	Storm bool `yaml:"storm"`
+
+	// Boss 是否是僵王博士关卡（必须是屋顶场景）
+	// Boss 关卡由僵王博士的攻击循环驱动，不使用波次流程，可以不配置波次；
+	// 进度条显示僵王博士的剩余生命值，击败僵王博士即获胜
+	Boss bool `yaml:"boss"`
+
+	// Survival 是否是生存模式关卡
+	// 生存模式的波次由难度引擎按旗帜组（每组 SurvivalFlagsPerSet 面旗帜）随机生成，可以不配置波次；
+	// 每完成一组旗帜后重新选择植物并继续，直到僵尸进屋
+	Survival bool `yaml:"survival"`
+
+	// Vases 罐子配置（砸罐子关卡）
+	// 罐子占据所在格子，点击后破碎，露出种子包或僵尸
+	Vases []VasePosition `yaml:"vases"`
+
+	// VaseContents 罐子内容表：每一项是一种植物的种子包或一种僵尸及其数量，总数必须等于罐子数
+	// 植物罐子只装种子包，僵尸罐子只装僵尸，普通罐子装剩下的内容
+	VaseContents []VaseContent `yaml:"vaseContents"`
+
+	// VaseSeed 分配罐子内容的随机种子（0 表示每次进入关卡随机分配）
+	VaseSeed int64 `yaml:"vaseSeed"`
+}
+
+// GravePosition 墓碑位置配置
+type GravePosition struct {
+	Row int `yaml:"row"` // 行号 (1-based，1-5)
+	Col int `yaml:"col"` // 列号 (1-based，1-9)
+}
+
+// 罐子类型（决定罐子的外观和可以装的内容）
+const (
+	VaseTypeRegular = "regular" // 普通罐子（问号），可能装种子包或僵尸
+	VaseTypePlant   = "plant"   // 植物罐子（叶子），只装种子包
+	VaseTypeZombie  = "zombie"  // 僵尸罐子（僵尸头），只装僵尸
+)
+
+// VasePosition 罐子位置配置
+type VasePosition struct {
+	Row  int    `yaml:"row"`  // 行号 (1-based，1-5)
+	Col  int    `yaml:"col"`  // 列号 (1-based，1-9)
+	Type string `yaml:"type"` // 罐子类型：regular（默认）、plant、zombie
+}
+
+// VaseContent 罐子内容表的一项（Plant 和 Zombie 二选一）
+type VaseContent struct {
+	Plant  string `yaml:"plant"`  // 种子包的植物ID，如 "peashooter"
+	Zombie string `yaml:"zombie"` // 僵尸类型，如 "conehead"
+	Count  int    `yaml:"count"`  // 数量（默认 1）
+}
+
+// SceneBackground 场景的标准背景
+type SceneBackground struct {
+	ImageID       string // 背景图片ID，如 "IMAGE_BACKGROUND2"
+	ResourceGroup string // 背景所在的资源组（包含僵尸获胜时的房门图片）
+
+	// MusicID 场景的标准背景音乐，AmbientParticles 场景的标准环境粒子（关卡可用 music、ambientParticles 覆盖）
+	// 原版各场景的音乐都在 sounds/mainmusic.mo3 中，音频加载器不支持 .mo3，
+	// 粒子资源中也没有夜晚的环境粒子，因此目前所有场景都为空，补充资源后在此填写
+	MusicID          string
+	AmbientParticles string
+}
+
+// sceneBackgrounds 各场景类型的标准背景
+var sceneBackgrounds = map[string]SceneBackground{
+	"day":   {ImageID: "IMAGE_BACKGROUND1", ResourceGroup: "DelayLoad_Background1"},
+	"night": {ImageID: "IMAGE_BACKGROUND2", ResourceGroup: "DelayLoad_Background2"},
+	"pool":  {ImageID: "IMAGE_BACKGROUND3", ResourceGroup: "DelayLoad_Background3"},
+	"fog":   {ImageID: "IMAGE_BACKGROUND4", ResourceGroup: "DelayLoad_Background4"},
+	"roof":  {ImageID: "IMAGE_BACKGROUND5", ResourceGroup: "DelayLoad_Background5"},
+}
+
+// GetSceneBackground 获取场景类型的标准背景，未配置的场景类型使用白天前院背景
+func GetSceneBackground(sceneType string) SceneBackground {
+	if background, ok := sceneBackgrounds[sceneType]; ok {
+		return background
+	}
+	return sceneBackgrounds["day"]
+}
+
+// IsNight 是否是夜间场景（天上不掉落阳光）
+func (c *LevelConfig) IsNight() bool {
+	return c.SceneType == "night" || c.SceneType == "fog"
+}
+
+// ResolveAdventureLevel 把冒险模式的下一关映射到已实现的关卡
+//
+// 第 1 章只实现了前 AdventureImplementedDayLevels 关，第 2~5 章尚未实现：
+// 之后的第 1 章关卡映射到僵王博士最终关 BossLevelID，其他关卡ID原样返回
+func ResolveAdventureLevel(levelID string) string {
+	var chapter, level int
+	if _, err := fmt.Sscanf(levelID, "%d-%d", &chapter, &level); err != nil {
+		return levelID
+	}
+	if chapter == 1 && level > AdventureImplementedDayLevels {
+		return BossLevelID
+	}
+	return levelID
 }
 
 // PresetPlant 预设植物配置（Story 19.4）
@@ -221,9 +337,16 @@ func LoadLevelConfig(filepath string) (*LevelConfig, error) {
 // applyDefaults 为 LevelConfig 中缺失的可选字段设置默认值
 // 确保向后兼容性（旧配置文件可正常加载）
 func applyDefaults(config *LevelConfig) {
-	// 如果 EnabledLanes 为空，设置为所有5行
+	// RowMax 默认为场景的草坪行数（前院 5 行，后院泳池 6 行）
+	if config.RowMax == 0 {
+		config.RowMax = GetLawnLayout(config.SceneType).Rows
+	}
+
+	// 如果 EnabledLanes 为空，设置为所有行
 	if len(config.EnabledLanes) == 0 {
-		config.EnabledLanes = []int{1, 2, 3, 4, 5}
+		for lane := 1; lane <= config.RowMax; lane++ {
+			config.EnabledLanes = append(config.EnabledLanes, lane)
+		}
 	}
 
 	// 如果 OpeningType 为空，设置为标准开场
@@ -238,9 +361,17 @@ func applyDefaults(config *LevelConfig) {
 	}
 
 	// Story 8.2 QA改进：背景和草皮默认值
-	// 如果 BackgroundImage 为空，设置为标准背景
+	// 如果 BackgroundImage 为空，设置为场景的标准背景（如夜间使用 IMAGE_BACKGROUND2）
 	if config.BackgroundImage == "" {
-		config.BackgroundImage = "IMAGE_BACKGROUND1"
+		config.BackgroundImage = GetSceneBackground(config.SceneType).ImageID
+	}
+
+	// 背景音乐和环境粒子默认使用场景的标准配置
+	if config.Music == "" {
+		config.Music = GetSceneBackground(config.SceneType).MusicID
+	}
+	if config.AmbientParticles == "" {
+		config.AmbientParticles = GetSceneBackground(config.SceneType).AmbientParticles
 	}
 
 	// Story 17.2: 新字段默认值
@@ -249,9 +380,21 @@ func applyDefaults(config *LevelConfig) {
 		config.SceneType = "day"
 	}
 
-	// RowMax 默认 5
-	if config.RowMax == 0 {
-		config.RowMax = 5
+	// 雾夜场景默认由雾覆盖最右侧 FogDefaultColumns 列
+	if config.SceneType == "fog" && config.FogColumns == 0 {
+		config.FogColumns = FogDefaultColumns
+	}
+
+	// 罐子类型默认为普通罐子，内容数量默认为 1
+	for i := range config.Vases {
+		if config.Vases[i].Type == "" {
+			config.Vases[i].Type = VaseTypeRegular
+		}
+	}
+	for i := range config.VaseContents {
+		if config.VaseContents[i].Count == 0 {
+			config.VaseContents[i].Count = 1
+		}
 	}
 
 	// Flags 默认从 waves 中的 isFlag 数量推断
@@ -298,6 +441,36 @@ func applyDefaults(config *LevelConfig) {
 	// SkipOpening 默认为 false（bool 零值），无需处理
 }
 
+// validSpecialRules 合法的 specialRules 规则名 -> 规则是否自行生成僵尸
+// 关卡验证不依赖小游戏规则模块的注册，新增规则模块时需要在这里登记规则名
+var validSpecialRules = map[string]bool{
+	"bowling":      false,
+	"conveyor":     false,
+	"vasebreaker":  true, // 僵尸来自罐子
+	"whack_zombie": true, // 僵尸从墓碑下生成
+}
+
+// IsValidSpecialRule 检查规则名是否是合法的 specialRules
+func IsValidSpecialRule(rule string) bool {
+	_, ok := validSpecialRules[rule]
+	return ok
+}
+
+// SpecialRuleSpawnsZombies 检查规则是否自行生成僵尸（为 true 时关卡可以不配置波次）
+func SpecialRuleSpawnsZombies(rule string) bool {
+	return validSpecialRules[rule]
+}
+
+// validSpecialRuleNames 返回合法的规则名（按名称排序，用于错误信息）
+func validSpecialRuleNames() []string {
+	rules := make([]string, 0, len(validSpecialRules))
+	for rule := range validSpecialRules {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+	return rules
+}
+
 // validateLevelConfig 验证关卡配置的完整性和合法性
 func validateLevelConfig(config *LevelConfig) error {
 	// 验证关卡ID
@@ -334,11 +507,17 @@ func validateLevelConfig(config *LevelConfig) error {
 		return fmt.Errorf("rowMax must be 5 or 6, got %d", config.RowMax)
 	}
 
-	// 验证波次配置
-	if len(config.Waves) == 0 {
+	// 验证波次配置（Boss 关卡不使用波次流程，生存模式关卡和只配置 flags 的关卡的波次在运行时生成，
+	// 自行生成僵尸的小游戏规则不需要波次）
+	if len(config.Waves) == 0 && !config.Boss && !config.Survival && config.Flags == 0 && !SpecialRuleSpawnsZombies(config.SpecialRules) {
 		return fmt.Errorf("at least one wave is required")
 	}
 
+	// Boss 关卡必须在屋顶上
+	if config.Boss && config.SceneType != "roof" {
+		return fmt.Errorf("boss level must use the roof scene, got %q", config.SceneType)
+	}
+
 	// Story 17.2: 波次类型有效值
 	validWaveTypes := map[string]bool{
 		"Fixed":       true,
@@ -381,7 +560,8 @@ func validateLevelConfig(config *LevelConfig) error {
 		}
 
 		// Story 8.6: 支持 ZombieGroup 和旧格式 ZombieSpawn
-		if len(wave.Zombies) == 0 && len(wave.OldZombies) == 0 {
+		// ExtraPoints 波次的僵尸由 WaveGenerator 在运行时补满，可以不配置
+		if len(wave.Zombies) == 0 && len(wave.OldZombies) == 0 && wave.Type != "ExtraPoints" {
 			return fmt.Errorf("wave %d: at least one zombie group or spawn is required", i)
 		}
 
@@ -438,10 +618,14 @@ func validateLevelConfig(config *LevelConfig) error {
 		}
 	}
 
-	// 验证 EnabledLanes（所有值必须在 1-5 范围内）
+	// 验证 EnabledLanes（所有值必须在 1-RowMax 范围内，后院 6 行）
+	maxEnabledLane := config.RowMax
+	if maxEnabledLane == 0 {
+		maxEnabledLane = 5
+	}
 	for i, lane := range config.EnabledLanes {
-		if lane < 1 || lane > 5 {
-			return fmt.Errorf("enabledLanes[%d]: lane must be between 1 and 5, got %d", i, lane)
+		if lane < 1 || lane > maxEnabledLane {
+			return fmt.Errorf("enabledLanes[%d]: lane must be between 1 and %d, got %d", i, maxEnabledLane, lane)
 		}
 	}
 
@@ -455,13 +639,9 @@ func validateLevelConfig(config *LevelConfig) error {
 		return fmt.Errorf("openingType must be one of: tutorial, standard, special, got %q", config.OpeningType)
 	}
 
-	// 验证 SpecialRules（必须是合法值或空）
-	validSpecialRules := map[string]bool{
-		"bowling":  true,
-		"conveyor": true,
-	}
-	if config.SpecialRules != "" && !validSpecialRules[config.SpecialRules] {
-		return fmt.Errorf("specialRules must be one of: bowling, conveyor, got %q", config.SpecialRules)
+	// 验证 SpecialRules（必须是合法的规则名或空）
+	if config.SpecialRules != "" && !IsValidSpecialRule(config.SpecialRules) {
+		return fmt.Errorf("specialRules must be one of: %s, got %q", strings.Join(validSpecialRuleNames(), ", "), config.SpecialRules)
 	}
 
 	// Story 8.6 QA修正: 验证 SoddingAnimLanes（如果配置了）
@@ -504,5 +684,89 @@ func validateLevelConfig(config *LevelConfig) error {
 		}
 	}
 
+	// 验证雾覆盖的列数
+	if config.FogColumns < 0 || config.FogColumns > GridColumns {
+		return fmt.Errorf("fogColumns must be between 0 and %d, got %d", GridColumns, config.FogColumns)
+	}
+
+	// 验证墓碑配置
+	for i, grave := range config.Graves {
+		maxRow := config.RowMax
+		if maxRow == 0 {
+			maxRow = 5
+		}
+		if grave.Row < 1 || grave.Row > maxRow {
+			return fmt.Errorf("graves[%d]: row must be between 1 and %d, got %d", i, maxRow, grave.Row)
+		}
+		if grave.Col < 1 || grave.Col > GridColumns {
+			return fmt.Errorf("graves[%d]: col must be between 1 and %d, got %d", i, GridColumns, grave.Col)
+		}
+	}
+
+	// 验证罐子配置
+	if err := validateVases(config); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateVases 验证罐子位置和内容表
+// 罐子不能重叠，内容总数必须等于罐子数，且植物罐子和僵尸罐子都能分到对应的内容
+func validateVases(config *LevelConfig) error {
+	maxRow := config.RowMax
+	if maxRow == 0 {
+		maxRow = 5
+	}
+
+	occupied := make(map[[2]int]bool, len(config.Vases))
+	vasesByType := make(map[string]int)
+	for i, vase := range config.Vases {
+		if vase.Row < 1 || vase.Row > maxRow {
+			return fmt.Errorf("vases[%d]: row must be between 1 and %d, got %d", i, maxRow, vase.Row)
+		}
+		if vase.Col < 1 || vase.Col > GridColumns {
+			return fmt.Errorf("vases[%d]: col must be between 1 and %d, got %d", i, GridColumns, vase.Col)
+		}
+		switch vase.Type {
+		case "", VaseTypeRegular, VaseTypePlant, VaseTypeZombie:
+		default:
+			return fmt.Errorf("vases[%d]: type must be one of: regular, plant, zombie, got %q", i, vase.Type)
+		}
+		cell := [2]int{vase.Row, vase.Col}
+		if occupied[cell] {
+			return fmt.Errorf("vases[%d]: duplicate vase at row %d, col %d", i, vase.Row, vase.Col)
+		}
+		occupied[cell] = true
+		vasesByType[vase.Type]++
+	}
+
+	plants, zombies := 0, 0
+	for i, content := range config.VaseContents {
+		if (content.Plant == "") == (content.Zombie == "") {
+			return fmt.Errorf("vaseContents[%d]: exactly one of plant or zombie is required", i)
+		}
+		if content.Count < 0 {
+			return fmt.Errorf("vaseContents[%d]: count must be >= 0, got %d", i, content.Count)
+		}
+		if content.Plant != "" {
+			plants += content.Count
+		} else {
+			zombies += content.Count
+		}
+	}
+
+	if len(config.Vases) == 0 && len(config.VaseContents) == 0 {
+		return nil
+	}
+	if plants+zombies != len(config.Vases) {
+		return fmt.Errorf("vaseContents: total count %d must equal the number of vases %d", plants+zombies, len(config.Vases))
+	}
+	if vasesByType[VaseTypePlant] > plants {
+		return fmt.Errorf("vaseContents: %d plant vases but only %d seed packets", vasesByType[VaseTypePlant], plants)
+	}
+	if vasesByType[VaseTypeZombie] > zombies {
+		return fmt.Errorf("vaseContents: %d zombie vases but only %d zombies", vasesByType[VaseTypeZombie], zombies)
+	}
 	return nil
 }
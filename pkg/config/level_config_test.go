@@ -146,6 +146,28 @@ func TestLevelConfigValidation(t *testing.T) {
 		}
 	})
 
+	t.Run("no waves with flags", func(t *testing.T) {
+		config := &LevelConfig{
+			ID:    "1-1",
+			Name:  "Test Level",
+			Flags: 2,
+		}
+		if err := validateLevelConfig(config); err != nil {
+			t.Errorf("Expected waves to be generated from flags, got error: %v", err)
+		}
+	})
+
+	t.Run("ExtraPoints wave with no zombies", func(t *testing.T) {
+		config := &LevelConfig{
+			ID:    "1-1",
+			Name:  "Test Level",
+			Waves: []WaveConfig{{Type: "ExtraPoints", ExtraPoints: 4}},
+		}
+		if err := validateLevelConfig(config); err != nil {
+			t.Errorf("Expected ExtraPoints wave zombies to be generated, got error: %v", err)
+		}
+	})
+
 	t.Run("wave with no zombies", func(t *testing.T) {
 		config := &LevelConfig{
 			ID:   "1-1",
@@ -453,6 +475,8 @@ func TestValidateLevelConfig_InvalidSpecialRules(t *testing.T) {
 	}{
 		{"Valid bowling", "bowling", false},
 		{"Valid conveyor", "conveyor", false},
+		{"Valid whack_zombie", "whack_zombie", false},
+		{"Valid vasebreaker", "vasebreaker", false},
 		{"Invalid rule", "invalid", true},
 		{"Empty (valid)", "", false},
 	}
@@ -484,6 +508,32 @@ func TestValidateLevelConfig_InvalidSpecialRules(t *testing.T) {
 	}
 }
 
+// TestValidateLevelConfig_SpecialRulesWithoutWaves 测试自行生成僵尸的规则可以不配置波次
+// 验证不依赖小游戏规则模块的注册
+func TestValidateLevelConfig_SpecialRulesWithoutWaves(t *testing.T) {
+	tests := []struct {
+		specialRules string
+		expectError  bool
+	}{
+		{"whack_zombie", false},
+		{"vasebreaker", false},
+		{"bowling", true}, // 不生成僵尸的规则仍然需要波次
+		{"", true},
+	}
+
+	for _, tt := range tests {
+		config := &LevelConfig{ID: "test", Name: "Test", SpecialRules: tt.specialRules}
+		applyDefaults(config)
+		err := validateLevelConfig(config)
+		if tt.expectError && err == nil {
+			t.Errorf("Expected validation error for specialRules %q without waves", tt.specialRules)
+		}
+		if !tt.expectError && err != nil {
+			t.Errorf("Unexpected validation error for specialRules %q without waves: %v", tt.specialRules, err)
+		}
+	}
+}
+
 // TestLoadLevelConfig_BackwardCompatibility 测试向后兼容性 (Story 8.1)
 // 验证旧版配置文件（无新字段）仍能正常加载
 func TestLoadLevelConfig_BackwardCompatibility(t *testing.T) {
@@ -1288,6 +1338,24 @@ func TestApplyDefaults_ZeroRowMax(t *testing.T) {
 	}
 }
 
+// TestApplyDefaults_LevelAmbience 测试背景音乐和环境粒子：未配置时使用场景的标准配置，关卡配置优先
+func TestApplyDefaults_LevelAmbience(t *testing.T) {
+	config := &LevelConfig{ID: "test", Name: "Test", SceneType: "night"}
+	applyDefaults(config)
+
+	night := GetSceneBackground("night")
+	if config.Music != night.MusicID || config.AmbientParticles != night.AmbientParticles {
+		t.Errorf("Expected night scene ambience (%q, %q), got (%q, %q)", night.MusicID, night.AmbientParticles, config.Music, config.AmbientParticles)
+	}
+
+	config = &LevelConfig{ID: "test", Name: "Test", SceneType: "night", Music: "SOUND_MAINMUSIC", AmbientParticles: "Credits_fog"}
+	applyDefaults(config)
+
+	if config.Music != "SOUND_MAINMUSIC" || config.AmbientParticles != "Credits_fog" {
+		t.Errorf("Expected level ambience to override scene defaults, got (%q, %q)", config.Music, config.AmbientParticles)
+	}
+}
+
 // ============================================================================
 // Story 19.4: PresetPlant Tests
 // ============================================================================
@@ -1609,3 +1677,383 @@ func TestLoadLevelConfig_Bowling_WavePhases(t *testing.T) {
 		}
 	}
 }
+
+// TestLoadLevelConfig_NightScene 测试夜晚关卡加载：默认使用夜晚背景并解析墓碑位置
+func TestLoadLevelConfig_NightScene(t *testing.T) {
+	tempDir := t.TempDir()
+	testFile := filepath.Join(tempDir, "night-level.yaml")
+
+	yamlContent := `id: "2-1"
+name: "Night Level"
+sceneType: "night"
+graves:
+  - row: 2
+    col: 7
+  - row: 4
+    col: 9
+waves:
+  - zombies:
+      - type: basic
+        lanes: [1, 2, 3, 4, 5]
+        count: 1
+`
+	if err := os.WriteFile(testFile, []byte(yamlContent), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	config, err := LoadLevelConfig(testFile)
+	if err != nil {
+		t.Fatalf("LoadLevelConfig() failed: %v", err)
+	}
+
+	if !config.IsNight() {
+		t.Error("Expected night level")
+	}
+	if config.BackgroundImage != "IMAGE_BACKGROUND2" {
+		t.Errorf("Expected night background IMAGE_BACKGROUND2, got %s", config.BackgroundImage)
+	}
+	if len(config.Graves) != 2 || config.Graves[0] != (GravePosition{Row: 2, Col: 7}) || config.Graves[1] != (GravePosition{Row: 4, Col: 9}) {
+		t.Errorf("Unexpected graves: %+v", config.Graves)
+	}
+	if bg := GetSceneBackground("day"); bg.ImageID != "IMAGE_BACKGROUND1" || bg.ResourceGroup != "DelayLoad_Background1" {
+		t.Errorf("Unexpected day background: %+v", bg)
+	}
+}
+
+// TestGrave_Validation 测试墓碑位置超出草坪范围时校验失败
+func TestGrave_Validation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		grave   GravePosition
+		wantErr bool
+	}{
+		{name: "valid", grave: GravePosition{Row: 3, Col: 5}, wantErr: false},
+		{name: "row 0", grave: GravePosition{Row: 0, Col: 5}, wantErr: true},
+		{name: "row 6", grave: GravePosition{Row: 6, Col: 5}, wantErr: true},
+		{name: "col 0", grave: GravePosition{Row: 3, Col: 0}, wantErr: true},
+		{name: "col 10", grave: GravePosition{Row: 3, Col: 10}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := &LevelConfig{
+				ID:        "2-1",
+				Name:      "Test",
+				SceneType: "night",
+				Graves:    []GravePosition{tc.grave},
+				Waves: []WaveConfig{
+					{Zombies: []ZombieGroup{{Type: "basic", Lanes: []int{3}, Count: 1}}},
+				},
+			}
+			err := validateLevelConfig(config)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("validateLevelConfig() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+// TestVase_Validation 测试罐子位置和内容表的校验
+func TestVase_Validation(t *testing.T) {
+	twoVases := []VasePosition{{Row: 1, Col: 9, Type: VaseTypePlant}, {Row: 2, Col: 9, Type: VaseTypeRegular}}
+	testCases := []struct {
+		name     string
+		vases    []VasePosition
+		contents []VaseContent
+		wantErr  bool
+	}{
+		{name: "valid", vases: twoVases, contents: []VaseContent{{Plant: "peashooter", Count: 1}, {Zombie: "basic", Count: 1}}, wantErr: false},
+		{name: "row out of range", vases: []VasePosition{{Row: 6, Col: 9}}, contents: []VaseContent{{Zombie: "basic", Count: 1}}, wantErr: true},
+		{name: "unknown type", vases: []VasePosition{{Row: 1, Col: 9, Type: "gold"}}, contents: []VaseContent{{Zombie: "basic", Count: 1}}, wantErr: true},
+		{name: "duplicate cell", vases: []VasePosition{{Row: 1, Col: 9}, {Row: 1, Col: 9}}, contents: []VaseContent{{Zombie: "basic", Count: 2}}, wantErr: true},
+		{name: "plant and zombie", vases: []VasePosition{{Row: 1, Col: 9}}, contents: []VaseContent{{Plant: "peashooter", Zombie: "basic", Count: 1}}, wantErr: true},
+		{name: "count mismatch", vases: twoVases, contents: []VaseContent{{Plant: "peashooter", Count: 1}}, wantErr: true},
+		{name: "plant vase without packet", vases: twoVases, contents: []VaseContent{{Zombie: "basic", Count: 2}}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := &LevelConfig{
+				ID:           "vasebreaker",
+				Name:         "Test",
+				Vases:        tc.vases,
+				VaseContents: tc.contents,
+				Waves: []WaveConfig{
+					{Zombies: []ZombieGroup{{Type: "basic", Lanes: []int{3}, Count: 1}}},
+				},
+			}
+			err := validateLevelConfig(config)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("validateLevelConfig() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+// TestLoadLevelConfig_PoolScene 测试后院泳池关卡加载：默认使用泳池背景和 6 行草坪
+func TestLoadLevelConfig_PoolScene(t *testing.T) {
+	tempDir := t.TempDir()
+	testFile := filepath.Join(tempDir, "pool-level.yaml")
+
+	yamlContent := `id: "3-1"
+name: "Pool Level"
+sceneType: "pool"
+waves:
+  - zombies:
+      - type: ducky
+        lanes: [3, 4]
+        count: 1
+`
+	if err := os.WriteFile(testFile, []byte(yamlContent), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	config, err := LoadLevelConfig(testFile)
+	if err != nil {
+		t.Fatalf("LoadLevelConfig() failed: %v", err)
+	}
+
+	if config.BackgroundImage != "IMAGE_BACKGROUND3" {
+		t.Errorf("Expected pool background IMAGE_BACKGROUND3, got %s", config.BackgroundImage)
+	}
+	if config.RowMax != 6 {
+		t.Errorf("Expected RowMax 6, got %d", config.RowMax)
+	}
+	if len(config.EnabledLanes) != 6 || config.EnabledLanes[5] != 6 {
+		t.Errorf("Expected all 6 lanes enabled, got %v", config.EnabledLanes)
+	}
+}
+
+// TestGetLawnLayout_Pool 测试后院泳池布局的行数、行高和水路行，前院布局与网格常量一致
+func TestGetLawnLayout_Pool(t *testing.T) {
+	pool := GetLawnLayout("pool")
+	if pool.Rows != 6 || pool.CellHeight != 85.0 {
+		t.Errorf("Expected 6 rows of height 85, got %d rows of height %.1f", pool.Rows, pool.CellHeight)
+	}
+	for row, want := range []bool{false, false, true, true, false, false} {
+		if pool.IsWaterRow(row) != want {
+			t.Errorf("IsWaterRow(%d) = %v, want %v", row, !want, want)
+		}
+	}
+
+	day := GetLawnLayout("unknown")
+	if day.Rows != GridRows || day.CellHeight != CellHeight || day.StartY != GridWorldStartY || day.IsWaterRow(2) {
+		t.Errorf("Expected day layout for unknown scene, got %d rows of height %.1f", day.Rows, day.CellHeight)
+	}
+}
+
+// TestLoadLevelConfig_FogScene 测试雾夜关卡加载：默认使用雾夜背景、6 行草坪和默认雾列数
+func TestLoadLevelConfig_FogScene(t *testing.T) {
+	tempDir := t.TempDir()
+	testFile := filepath.Join(tempDir, "fog-level.yaml")
+
+	yamlContent := `id: "4-1"
+name: "Fog Level"
+sceneType: "fog"
+storm: true
+waves:
+  - zombies:
+      - type: balloon
+        lanes: [1, 2]
+        count: 1
+`
+	if err := os.WriteFile(testFile, []byte(yamlContent), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	config, err := LoadLevelConfig(testFile)
+	if err != nil {
+		t.Fatalf("LoadLevelConfig() failed: %v", err)
+	}
+
+	if config.BackgroundImage != "IMAGE_BACKGROUND4" {
+		t.Errorf("Expected fog background IMAGE_BACKGROUND4, got %s", config.BackgroundImage)
+	}
+	if !config.IsNight() {
+		t.Error("Expected fog level to be a night level")
+	}
+	if config.FogColumns != FogDefaultColumns {
+		t.Errorf("Expected default fog columns %d, got %d", FogDefaultColumns, config.FogColumns)
+	}
+	if !config.Storm {
+		t.Error("Expected storm level")
+	}
+	if config.RowMax != 6 {
+		t.Errorf("Expected RowMax 6, got %d", config.RowMax)
+	}
+}
+
+// TestLoadLevelConfig_RoofScene 测试屋顶关卡加载：默认使用屋顶背景和 5 行草坪
+func TestLoadLevelConfig_RoofScene(t *testing.T) {
+	tempDir := t.TempDir()
+	testFile := filepath.Join(tempDir, "roof-level.yaml")
+
+	yamlContent := `id: "5-1"
+name: "Roof Level"
+sceneType: "roof"
+waves:
+  - zombies:
+      - type: basic
+        lanes: [1, 2, 3, 4, 5]
+        count: 1
+`
+	if err := os.WriteFile(testFile, []byte(yamlContent), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	config, err := LoadLevelConfig(testFile)
+	if err != nil {
+		t.Fatalf("LoadLevelConfig() failed: %v", err)
+	}
+
+	if config.BackgroundImage != "IMAGE_BACKGROUND5" {
+		t.Errorf("Expected roof background IMAGE_BACKGROUND5, got %s", config.BackgroundImage)
+	}
+	if config.IsNight() {
+		t.Error("Expected roof level to be a day level")
+	}
+	if config.RowMax != 5 {
+		t.Errorf("Expected RowMax 5, got %d", config.RowMax)
+	}
+}
+
+// TestLawnLayout_Roof 测试屋顶布局：左侧斜坡越往左越低，植物必须种在花盆上
+func TestLawnLayout_Roof(t *testing.T) {
+	roof := GetLawnLayout("roof")
+	if !roof.HasSlope() || !roof.NeedsFlowerPot {
+		t.Fatal("Expected roof layout to have a slope and require flower pots")
+	}
+	for col := 1; col < GridColumns; col++ {
+		if roof.CellSlopeOffsetY(col) > roof.CellSlopeOffsetY(col-1) {
+			t.Errorf("Slope offset should not increase to the right: col %d = %.1f, col %d = %.1f",
+				col-1, roof.CellSlopeOffsetY(col-1), col, roof.CellSlopeOffsetY(col))
+		}
+	}
+	if roof.CellSlopeOffsetY(0) <= 0 {
+		t.Errorf("Expected leftmost column to be lower than the ridge, got offset %.1f", roof.CellSlopeOffsetY(0))
+	}
+	if roof.CellSlopeOffsetY(GridColumns-1) != 0 {
+		t.Errorf("Expected rightmost column to be flat, got offset %.1f", roof.CellSlopeOffsetY(GridColumns-1))
+	}
+
+	day := GetLawnLayout("day")
+	if day.HasSlope() || day.NeedsFlowerPot || day.SlopeOffsetY(GridWorldStartX) != 0 {
+		t.Error("Expected day layout to be flat")
+	}
+}
+
+// TestLawnLayout_RowAt 测试行换算：格子中心和僵尸坐标（减去垂直偏移后）都落在所在行，斜坡上也一样
+func TestLawnLayout_RowAt(t *testing.T) {
+	for _, sceneType := range []string{"day", "pool", "roof"} {
+		layout := GetLawnLayout(sceneType)
+		for row := 0; row < layout.Rows; row++ {
+			for _, col := range []int{0, GridColumns - 1} {
+				x, y := layout.CellCenter(col, row)
+				if got := layout.RowAt(x, y); got != row {
+					t.Errorf("%s: cell (%d, %d) center maps to row %d", sceneType, col, row, got)
+				}
+				zombieY := y + ZombieVerticalOffset
+				if got := layout.RowAt(x, zombieY-ZombieVerticalOffset); got != row {
+					t.Errorf("%s: zombie in cell (%d, %d) maps to row %d", sceneType, col, row, got)
+				}
+			}
+		}
+		if got := layout.RowAt(GridWorldStartX, layout.StartY-1); got >= 0 {
+			t.Errorf("%s: expected a negative row above the lawn, got %d", sceneType, got)
+		}
+	}
+}
+
+// TestValidateLevelConfig_BossLevel 测试 Boss 关卡可以不配置波次，但必须在屋顶上
+func TestValidateLevelConfig_BossLevel(t *testing.T) {
+	config := &LevelConfig{
+		ID:        "5-10",
+		Name:      "Dr. Zomboss",
+		SceneType: "roof",
+		Boss:      true,
+	}
+	applyDefaults(config)
+	if err := validateLevelConfig(config); err != nil {
+		t.Errorf("Expected boss level without waves to be valid, got: %v", err)
+	}
+
+	config.SceneType = "day"
+	err := validateLevelConfig(config)
+	if err == nil || !containsString(err.Error(), "roof") {
+		t.Errorf("Expected error for boss level outside the roof, got: %v", err)
+	}
+
+	config.SceneType = "roof"
+	config.Boss = false
+	if err := validateLevelConfig(config); err == nil {
+		t.Error("Expected error for non-boss level without waves, got nil")
+	}
+}
+
+// TestLoadLevelConfig_BossLevelFile 测试僵王博士关卡配置：屋顶场景、不配置波次、左侧预设花盆
+func TestLoadLevelConfig_BossLevelFile(t *testing.T) {
+	config, err := LoadLevelConfig("../../data/levels/level-" + BossLevelID + ".yaml")
+	if err != nil {
+		t.Fatalf("Failed to load boss level: %v", err)
+	}
+	if !config.Boss || config.SceneType != "roof" || len(config.Waves) != 0 {
+		t.Errorf("Expected roof boss level without waves, got boss=%v scene=%q waves=%d",
+			config.Boss, config.SceneType, len(config.Waves))
+	}
+	for _, plant := range []string{"flowerpot", "jalapeno", "iceshroom"} {
+		found := false
+		for _, available := range config.AvailablePlants {
+			found = found || available == plant
+		}
+		if !found {
+			t.Errorf("Expected %q in available plants, got %v", plant, config.AvailablePlants)
+		}
+	}
+	for _, preset := range config.PresetPlants {
+		if preset.Type != "flowerpot" {
+			t.Errorf("Expected only preset flower pots, got %q", preset.Type)
+		}
+	}
+}
+
+// TestResolveAdventureLevel 测试已实现的白天关卡之后进入僵王博士最终关
+func TestResolveAdventureLevel(t *testing.T) {
+	tests := []struct {
+		levelID string
+		want    string
+	}{
+		{"1-1", "1-1"},
+		{"1-5", "1-5"},
+		{"1-6", BossLevelID},
+		{"1-10", BossLevelID},
+		{BossLevelID, BossLevelID},
+		{SurvivalLevelID, SurvivalLevelID},
+	}
+	for _, tt := range tests {
+		if got := ResolveAdventureLevel(tt.levelID); got != tt.want {
+			t.Errorf("ResolveAdventureLevel(%q) = %q, want %q", tt.levelID, got, tt.want)
+		}
+	}
+}
+
+// TestLoadLevelConfig_SurvivalLevel 测试生存模式关卡可以不配置波次
+func TestLoadLevelConfig_SurvivalLevel(t *testing.T) {
+	config, err := LoadLevelConfig("../../data/levels/level-" + SurvivalLevelID + ".yaml")
+	if err != nil {
+		t.Fatalf("Failed to load survival level: %v", err)
+	}
+	if !config.Survival || config.ID != SurvivalLevelID {
+		t.Errorf("Expected survival level %q, got ID=%q Survival=%v", SurvivalLevelID, config.ID, config.Survival)
+	}
+	if len(config.Waves) != 0 || config.Flags != 0 {
+		t.Errorf("Expected no configured waves, got %d waves and %d flags", len(config.Waves), config.Flags)
+	}
+	if len(config.AvailablePlants) <= SurvivalSeedSlots {
+		t.Errorf("Expected more than %d plants to choose from, got %d", SurvivalSeedSlots, len(config.AvailablePlants))
+	}
+
+	config.Survival = false
+	if err := validateLevelConfig(config); err == nil {
+		t.Error("Expected error for non-survival level without waves, got nil")
+	}
+}
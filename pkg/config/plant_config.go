@@ -56,6 +56,179 @@ var PlantConfigs = map[types.PlantType]*PlantResourceConfig{
 			"anim_blink", // 隐藏眨眼轨道
 		},
 	},
+	types.PlantMagnetshroom: {
+		ResourceName:     "Magnetshroom",
+		ConfigID:         "magnetshroom",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks:     nil, // 无需隐藏轨道
+	},
+	types.PlantHypnoshroom: {
+		ResourceName:     "Hypnoshroom",
+		ConfigID:         "hypnoshroom",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks:     nil, // 无需隐藏轨道
+	},
+	types.PlantKernelpult: {
+		ResourceName:     "Cornpult",
+		ConfigID:         "cornpult",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks: []string{
+			"anim_blink",      // 隐藏眨眼轨道
+			"Cornpult_butter", // 隐藏黄油（只在投掷黄油时显示）
+		},
+	},
+	types.PlantCobCannon: {
+		ResourceName:     "CobCannon",
+		ConfigID:         "cobcannon",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks: []string{
+			"anim_blink", // 隐藏眨眼轨道
+		},
+	},
+	types.PlantSplitPea: {
+		ResourceName:     "SplitPea",
+		ConfigID:         "splitpea",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks: []string{
+			"anim_blink",          // 隐藏前头眨眼轨道
+			"anim_SplitPea_blink", // 隐藏后头眨眼轨道
+		},
+	},
+	types.PlantStarfruit: {
+		ResourceName:     "Starfruit",
+		ConfigID:         "starfruit",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks: []string{
+			"anim_blink", // 隐藏眨眼轨道
+		},
+	},
+	types.PlantCattail: {
+		ResourceName:     "Cattail",
+		ConfigID:         "cattail",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks: []string{
+			"anim_blink", // 隐藏眨眼轨道
+		},
+	},
+	types.PlantTallnut: {
+		ResourceName: "Tallnut",
+		ConfigID:     "tallnut",
+		PreviewFrame: -1, // 自动选择
+		HiddenTracks: []string{
+			"anim_blink_twice",  // 隐藏眨眼轨道
+			"anim_blink_thrice", // 隐藏眨眼轨道
+		},
+	},
+	types.PlantGraveBuster: {
+		ResourceName:     "Gravebuster",
+		ConfigID:         "gravebuster",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks:     nil, // 无需隐藏轨道
+	},
+	types.PlantLilyPad: {
+		ResourceName:     "Lilypad",
+		ConfigID:         "lilypad",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks: []string{
+			"anim_blink", // 隐藏眨眼轨道
+		},
+	},
+	types.PlantTangleKelp: {
+		ResourceName:     "Tanglekelp",
+		ConfigID:         "tanglekelp",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks: []string{
+			"anim_blink", // 隐藏眨眼轨道
+		},
+	},
+	types.PlantSeaShroom: {
+		ResourceName:     "SeaShroom",
+		ConfigID:         "seashroom",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks: []string{
+			"anim_blink", // 隐藏眨眼轨道
+		},
+	},
+	types.PlantPlantern: {
+		ResourceName:     "Plantern",
+		ConfigID:         "plantern",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks: []string{
+			"anim_blink", // 隐藏眨眼轨道
+		},
+	},
+	types.PlantBlover: {
+		ResourceName:     "Blover",
+		ConfigID:         "blover",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks:     nil, // 无需隐藏轨道
+	},
+	types.PlantFlowerPot: {
+		ResourceName:     "Pot",
+		ConfigID:         "pot",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks:     nil, // 无需隐藏轨道
+	},
+	types.PlantJalapeno: {
+		ResourceName:     "Jalapeno",
+		ConfigID:         "jalapeno",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks: []string{
+			"anim_blink", // 隐藏眨眼轨道
+		},
+	},
+	types.PlantIceShroom: {
+		ResourceName:     "Iceshroom",
+		ConfigID:         "iceshroom",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks: []string{
+			"anim_blink", // 隐藏眨眼轨道
+		},
+	},
+	types.PlantSpikeweed: {
+		ResourceName:     "Caltrop",
+		ConfigID:         "caltrop",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks: []string{
+			"anim_blink", // 隐藏眨眼轨道
+		},
+	},
+	types.PlantChomper: {
+		ResourceName:     "Chomper",
+		ConfigID:         "chomper",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks: []string{
+			"Zombie_outerarm_hand", // 隐藏被咬住的僵尸手臂
+			"Zombie_outerarm_lower",
+		},
+	},
+	types.PlantUmbrellaLeaf: {
+		ResourceName:     "Umbrellaleaf",
+		ConfigID:         "umbrellaleaf",
+		PreviewFrame:     -1, // 自动选择
+		PreviewAnimation: "anim_idle",
+		HiddenTracks: []string{
+			"anim_blink", // 隐藏眨眼轨道
+		},
+	},
 }
 
 // GetPlantConfig 获取植物配置
@@ -42,11 +42,13 @@ var ShadowSizes = map[string]ShadowSize{
 	"splitpea":      {Width: 50, Height: 25},
 	"starfruit":     {Width: 55, Height: 28},
 	"pumpkin":       {Width: 70, Height: 35},
+	"pot":           {Width: 60, Height: 30},
 	"magnetshroom":  {Width: 55, Height: 28},
 	"cabbagepult":   {Width: 60, Height: 30},
 	"kernelpult":    {Width: 60, Height: 30},
 	"melonpult":     {Width: 65, Height: 32},
 	"gatlingpea":    {Width: 55, Height: 28},
+	"cattail":       {Width: 60, Height: 30},
 	"torchwood":     {Width: 60, Height: 30},
 	"cobcannon":     {Width: 90, Height: 45},
 	"garlic":        {Width: 55, Height: 28},
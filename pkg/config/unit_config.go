@@ -24,6 +24,10 @@ const (
 	// ZombieCollisionHeight 普通僵尸碰撞盒高度（像素）
 	ZombieCollisionHeight = 115.0
 
+	// ZombieClickPaddingX 点击僵尸时碰撞盒左右两侧各扩展的宽度（像素）
+	// 碰撞盒只覆盖躯干，扩展后点击手臂和头部也能命中
+	ZombieClickPaddingX = 20.0
+
 	// ZombieFlagCollisionOffsetX 旗帜僵尸碰撞盒X偏移量（像素）
 	// 正值向右偏移，使碰撞盒只检测身体部分而非旗子手
 	// 旗子手向前伸出约40像素，偏移量设可使碰撞盒居中于身体
@@ -90,6 +94,15 @@ const (
 	// PeaBulletDeletionBoundary 子弹删除边界（屏幕坐标X）
 	// 子弹移出此边界后将被删除
 	PeaBulletDeletionBoundary = 1500.0
+
+	// ProjectileDeletionLeftBoundary 向左飞行子弹的删除边界（世界坐标X）
+	ProjectileDeletionLeftBoundary = -100.0
+
+	// ProjectileDeletionTopBoundary 向上飞行子弹的删除边界（世界坐标Y）
+	ProjectileDeletionTopBoundary = -100.0
+
+	// ProjectileDeletionBottomBoundary 向下飞行子弹的删除边界（世界坐标Y）
+	ProjectileDeletionBottomBoundary = 800.0
 )
 
 // Sun Configuration (阳光配置)
@@ -267,3 +280,952 @@ const (
 	// Story 19.8: 使用 Powie.xml 粒子配置（3个发射器）
 	ExplosiveNutParticleEffect = "Powie"
 )
+
+// Jalapeno Configuration (火爆辣椒配置)
+const (
+	// JalapenoSunCost 火爆辣椒的阳光消耗
+	JalapenoSunCost = 125
+
+	// JalapenoRechargeTime 火爆辣椒卡片的冷却时间（秒）
+	JalapenoRechargeTime = 50.0
+
+	// JalapenoFuseTime 火爆辣椒种植后到点燃的延迟时间（秒）
+	JalapenoFuseTime = 1.0
+
+	// JalapenoDamage 火爆辣椒对所在行每个僵尸造成的伤害
+	// 与樱桃炸弹相同，足以秒杀所有普通僵尸
+	JalapenoDamage = 1800
+
+	// JalapenoFireDuration 火焰沿整行燃烧的持续时间（秒），之后火焰消失
+	JalapenoFireDuration = 1.5
+)
+
+// Ice-shroom Configuration (寒冰菇配置)
+const (
+	// IceShroomSunCost 寒冰菇的阳光消耗
+	IceShroomSunCost = 75
+
+	// IceShroomRechargeTime 寒冰菇卡片的冷却时间（秒）
+	IceShroomRechargeTime = 50.0
+
+	// IceShroomFuseTime 寒冰菇种植后到释放寒气的延迟时间（秒）
+	IceShroomFuseTime = 1.0
+
+	// IceShroomDamage 寒冰菇对场上每个僵尸造成的伤害
+	IceShroomDamage = 20
+
+	// IceShroomFreezeDuration 僵尸被寒冰菇冻结、原地不动的时间（秒）
+	IceShroomFreezeDuration = 4.0
+
+	// IceShroomParticleEffect 寒冰菇释放寒气时的粒子效果名称
+	IceShroomParticleEffect = "IceTrap"
+)
+
+// Magnet-shroom Configuration (磁力菇配置)
+const (
+	// MagnetshroomSunCost 磁力菇的阳光消耗
+	MagnetshroomSunCost = 100
+
+	// MagnetshroomRechargeTime 磁力菇卡片的冷却时间（秒）
+	MagnetshroomRechargeTime = 7.5
+
+	// MagnetshroomDefaultHealth 磁力菇默认生命值
+	MagnetshroomDefaultHealth = 300
+
+	// MagnetshroomRange 磁力菇吸取范围半径（像素）
+	// 以磁力菇为圆心，约覆盖周围 2.5 格的僵尸
+	MagnetshroomRange = 200.0
+
+	// MagnetshroomCooldown 磁力菇吸取饰品后的冷却时间（秒）
+	// 冷却期间吸走的饰品吸附在磁力菇上，并随冷却进度逐渐缩小消失
+	MagnetshroomCooldown = 15.0
+
+	// MagnetshroomHeldOffsetX 吸附饰品相对磁力菇中心的水平偏移（像素）
+	MagnetshroomHeldOffsetX = 0.0
+
+	// MagnetshroomHeldOffsetY 吸附饰品相对磁力菇中心的垂直偏移（像素）
+	// 负值表示向上，饰品吸附在磁力菇头部
+	MagnetshroomHeldOffsetY = -30.0
+)
+
+// Hypno-shroom Configuration (催眠菇配置)
+const (
+	// HypnoshroomSunCost 催眠菇的阳光消耗
+	HypnoshroomSunCost = 75
+
+	// HypnoshroomRechargeTime 催眠菇卡片的冷却时间（秒）
+	HypnoshroomRechargeTime = 30.0
+
+	// HypnoshroomDefaultHealth 催眠菇默认生命值
+	// 僵尸咬下第一口即被魅惑，生命值只影响其他伤害来源
+	HypnoshroomDefaultHealth = 300
+)
+
+// Charmed Zombie Configuration (魅惑僵尸配置)
+const (
+	// CharmedZombieWalkSpeed 被魅惑僵尸的移动速度（像素/秒）
+	// 与普通僵尸速度相同，方向相反（从左向右移动）
+	CharmedZombieWalkSpeed = -ZombieWalkSpeed
+
+	// CharmedZombieDeletionBoundary 被魅惑僵尸的删除边界（世界坐标X）
+	// 被魅惑僵尸走出草坪右侧后将被删除
+	CharmedZombieDeletionBoundary = BackgroundWidth
+
+	// ZombieBiteRange 僵尸啃食敌对僵尸的距离（像素）
+	// 两个敌对僵尸碰撞盒中心在同一行且水平距离小于此值时开始互相啃食
+	ZombieBiteRange = 40.0
+)
+
+// Kernel-pult Configuration (玉米投手配置)
+const (
+	// KernelpultSunCost 玉米投手的阳光消耗
+	KernelpultSunCost = 100
+
+	// KernelpultRechargeTime 玉米投手卡片的冷却时间（秒）
+	KernelpultRechargeTime = 7.5
+
+	// KernelpultDefaultHealth 玉米投手默认生命值
+	KernelpultDefaultHealth = 300
+
+	// KernelpultAttackInterval 玉米投手的攻击间隔（秒）
+	KernelpultAttackInterval = 3.0
+
+	// KernelDamage 玉米粒伤害值
+	KernelDamage = 20
+
+	// KernelFlightTime 玉米粒飞行时间（秒）
+	KernelFlightTime = 0.8
+
+	// KernelArcHeight 玉米粒抛物线高度（像素）
+	KernelArcHeight = 120.0
+
+	// KernelLaunchOffsetY 玉米粒起点相对玉米投手中心的垂直偏移（像素）
+	KernelLaunchOffsetY = -40.0
+)
+
+// Cob Cannon Configuration (玉米加农炮配置)
+const (
+	// CobCannonSunCost 玉米加农炮的阳光消耗（需种植在两株相邻的玉米投手上）
+	CobCannonSunCost = 500
+
+	// CobCannonRechargeTime 玉米加农炮卡片的冷却时间（秒）
+	CobCannonRechargeTime = 50.0
+
+	// CobCannonDefaultHealth 玉米加农炮默认生命值
+	CobCannonDefaultHealth = 300
+
+	// CobCannonReloadTime 玉米加农炮的装填时间（秒）
+	// 新种植的加农炮和每次发射后都需要装填
+	CobCannonReloadTime = 35.0
+
+	// CobCannonDamage 玉米炮弹爆炸伤害（与樱桃炸弹相同）
+	CobCannonDamage = 1800
+
+	// CobFlightTime 玉米炮弹从发射到落地的时间（秒）
+	CobFlightTime = 1.5
+
+	// CobArcHeight 玉米炮弹抛物线高度（像素）
+	// 足够高以飞出屏幕顶部，再从落点上方落下
+	CobArcHeight = 700.0
+
+	// CobLaunchOffsetY 玉米炮弹起点相对加农炮中心的垂直偏移（像素）
+	CobLaunchOffsetY = -60.0
+
+	// CobExplosionRowRange 玉米炮弹爆炸覆盖落点上下各几行（1 表示 3 行）
+	CobExplosionRowRange = 1
+
+	// CobExplosionHalfWidth 玉米炮弹爆炸覆盖落点左右的水平距离（像素）
+	// 1.5 格宽度，形成以落点为中心的 3x3 格子范围
+	CobExplosionHalfWidth = CellWidth * 1.5
+)
+
+// Split Pea Configuration (裂荚射手配置)
+const (
+	// SplitPeaSunCost 裂荚射手的阳光消耗
+	SplitPeaSunCost = 125
+
+	// SplitPeaRechargeTime 裂荚射手卡片的冷却时间（秒）
+	SplitPeaRechargeTime = 7.5
+
+	// SplitPeaDefaultHealth 裂荚射手默认生命值
+	SplitPeaDefaultHealth = 300
+
+	// SplitPeaAttackInterval 裂荚射手前后两个头各自的攻击间隔（秒）
+	SplitPeaAttackInterval = 1.4
+
+	// SplitPeaBackPeaSpacing 后头一次发射的两颗豌豆之间的水平间距（像素）
+	SplitPeaBackPeaSpacing = 30.0
+)
+
+// Starfruit Configuration (杨桃配置)
+const (
+	// StarfruitSunCost 杨桃的阳光消耗
+	StarfruitSunCost = 125
+
+	// StarfruitRechargeTime 杨桃卡片的冷却时间（秒）
+	StarfruitRechargeTime = 7.5
+
+	// StarfruitDefaultHealth 杨桃默认生命值
+	StarfruitDefaultHealth = 300
+
+	// StarfruitAttackInterval 杨桃的攻击间隔（秒）
+	StarfruitAttackInterval = 1.4
+
+	// StarfruitDetectionHalfWidth 星星弹道两侧的目标检测宽度（像素）
+	// 僵尸到任意一条弹道的距离小于此值时杨桃开始攻击（前院半个行高）
+	StarfruitDetectionHalfWidth = 50.0
+
+	// StarSpeed 星星子弹的飞行速度（像素/秒）
+	StarSpeed = 333.0
+
+	// StarDamage 星星子弹伤害值
+	StarDamage = 20
+
+	// StarWidth 星星子弹碰撞盒宽度（像素）
+	StarWidth = 28.0
+
+	// StarHeight 星星子弹碰撞盒高度（像素）
+	StarHeight = 28.0
+
+	// StarLaunchOffsetY 星星子弹起点相对杨桃中心的垂直偏移（像素）
+	StarLaunchOffsetY = -20.0
+)
+
+// Cattail Configuration (香蒲配置)
+const (
+	// CattailSunCost 香蒲的阳光消耗
+	CattailSunCost = 225
+
+	// CattailRechargeTime 香蒲卡片的冷却时间（秒）
+	CattailRechargeTime = 7.5
+
+	// CattailDefaultHealth 香蒲默认生命值
+	CattailDefaultHealth = 300
+
+	// CattailAttackInterval 香蒲的攻击间隔（秒）
+	CattailAttackInterval = 1.4
+
+	// CattailSpikeSpeed 尖刺的飞行速度（像素/秒）
+	CattailSpikeSpeed = 400.0
+
+	// CattailSpikeTurnRate 尖刺每秒最多转向的角度（弧度）
+	// 值越大追踪越灵敏，过小时可能绕着目标打转
+	CattailSpikeTurnRate = 6.0
+
+	// CattailSpikeDamage 尖刺伤害值
+	CattailSpikeDamage = 20
+
+	// CattailSpikeWidth 尖刺碰撞盒宽度（像素）
+	CattailSpikeWidth = 28.0
+
+	// CattailSpikeHeight 尖刺碰撞盒高度（像素）
+	CattailSpikeHeight = 28.0
+
+	// CattailLaunchOffsetY 尖刺起点相对香蒲中心的垂直偏移（像素）
+	CattailLaunchOffsetY = -40.0
+)
+
+// Tall-nut Configuration (高坚果配置)
+const (
+	// TallnutSunCost 高坚果的阳光消耗
+	TallnutSunCost = 125
+
+	// TallnutRechargeTime 高坚果卡片的冷却时间（秒）
+	TallnutRechargeTime = 30.0
+
+	// TallnutDefaultHealth 高坚果默认生命值（坚果墙的两倍）
+	// 高坚果无法被撑杆跳僵尸和跳跳僵尸越过
+	TallnutDefaultHealth = 8000
+)
+
+// Chomper Configuration (大嘴花配置)
+const (
+	// ChomperSunCost 大嘴花的阳光消耗
+	ChomperSunCost = 150
+
+	// ChomperRechargeTime 大嘴花卡片的冷却时间（秒）
+	ChomperRechargeTime = 7.5
+
+	// ChomperDefaultHealth 大嘴花默认生命值
+	ChomperDefaultHealth = 300
+
+	// ChomperBiteRange 大嘴花向前（右侧）能咬到的最远距离（像素，从植物中心到僵尸碰撞盒中心）
+	ChomperBiteRange = CellWidth
+
+	// ChomperBiteDamage 大嘴花咬住无法吞下的僵尸（巨人）时造成的伤害
+	ChomperBiteDamage = 40
+
+	// ChomperChewDuration 大嘴花吞下僵尸后咀嚼的时间（秒），咀嚼期间不能再咬
+	ChomperChewDuration = 42.0
+)
+
+// Umbrella Leaf Configuration (叶子保护伞配置)
+const (
+	// UmbrellaLeafSunCost 叶子保护伞的阳光消耗
+	UmbrellaLeafSunCost = 100
+
+	// UmbrellaLeafRechargeTime 叶子保护伞卡片的冷却时间（秒）
+	UmbrellaLeafRechargeTime = 7.5
+
+	// UmbrellaLeafDefaultHealth 叶子保护伞默认生命值
+	UmbrellaLeafDefaultHealth = 300
+
+	// UmbrellaLeafProtectRange 叶子保护伞保护的格子范围（以自身为中心，向四周各 1 格，即 3x3）
+	UmbrellaLeafProtectRange = 1
+)
+
+// Spikeweed Configuration (地刺配置)
+const (
+	// SpikeweedSunCost 地刺的阳光消耗
+	SpikeweedSunCost = 100
+
+	// SpikeweedRechargeTime 地刺卡片的冷却时间（秒）
+	SpikeweedRechargeTime = 7.5
+
+	// SpikeweedDefaultHealth 地刺默认生命值
+	// 僵尸从地刺上走过而不会啃食，只有篮球等远程攻击能伤害它
+	SpikeweedDefaultHealth = 300
+
+	// SpikeweedDamage 地刺每次扎刺对所在格子每个地面僵尸造成的伤害
+	SpikeweedDamage = 20
+
+	// SpikeweedAttackInterval 地刺扎刺的间隔时间（秒）
+	SpikeweedAttackInterval = 1.0
+)
+
+// Grave Buster Configuration (墓碑吞噬者配置)
+const (
+	// GraveBusterSunCost 墓碑吞噬者的阳光消耗
+	GraveBusterSunCost = 75
+
+	// GraveBusterRechargeTime 墓碑吞噬者卡片的冷却时间（秒）
+	GraveBusterRechargeTime = 7.5
+
+	// GraveBusterDefaultHealth 墓碑吞噬者默认生命值
+	GraveBusterDefaultHealth = 300
+
+	// GraveBusterEatDuration 墓碑吞噬者落到墓碑上后吞掉墓碑所需的时间（秒）
+	GraveBusterEatDuration = 5.0
+)
+
+// Lily Pad Configuration (睡莲配置)
+const (
+	// LilyPadSunCost 睡莲的阳光消耗
+	LilyPadSunCost = 25
+
+	// LilyPadRechargeTime 睡莲卡片的冷却时间（秒）
+	LilyPadRechargeTime = 7.5
+
+	// LilyPadDefaultHealth 睡莲默认生命值
+	LilyPadDefaultHealth = 300
+)
+
+// Tangle Kelp Configuration (缠绕海草配置)
+const (
+	// TangleKelpSunCost 缠绕海草的阳光消耗
+	TangleKelpSunCost = 25
+
+	// TangleKelpRechargeTime 缠绕海草卡片的冷却时间（秒）
+	TangleKelpRechargeTime = 30.0
+
+	// TangleKelpDefaultHealth 缠绕海草默认生命值
+	TangleKelpDefaultHealth = 300
+
+	// TangleKelpDragDuration 缠住僵尸后把僵尸拖入水中所需的时间（秒）
+	TangleKelpDragDuration = 1.0
+
+	// TangleKelpDragDepth 僵尸被拖入水中的下沉距离（像素）
+	TangleKelpDragDepth = 80.0
+)
+
+// Sea-shroom Configuration (海蘑菇配置)
+const (
+	// SeaShroomSunCost 海蘑菇的阳光消耗（免费）
+	SeaShroomSunCost = 0
+
+	// SeaShroomRechargeTime 海蘑菇卡片的冷却时间（秒）
+	SeaShroomRechargeTime = 30.0
+
+	// SeaShroomDefaultHealth 海蘑菇默认生命值
+	SeaShroomDefaultHealth = 300
+
+	// SeaShroomAttackInterval 海蘑菇的攻击间隔（秒）
+	SeaShroomAttackInterval = 1.5
+
+	// SeaShroomRange 海蘑菇的射程（像素），约 3 格
+	SeaShroomRange = 240.0
+
+	// PuffSpeed 孢子子弹的飞行速度（像素/秒）
+	PuffSpeed = 300.0
+
+	// PuffDamage 孢子子弹伤害值
+	PuffDamage = 20
+
+	// PuffWidth 孢子子弹碰撞盒宽度（像素）
+	PuffWidth = 28.0
+
+	// PuffHeight 孢子子弹碰撞盒高度（像素）
+	PuffHeight = 28.0
+
+	// PuffLaunchOffsetX 孢子起点相对海蘑菇中心的水平偏移（像素）
+	PuffLaunchOffsetX = 20.0
+
+	// PuffLaunchOffsetY 孢子起点相对海蘑菇中心的垂直偏移（像素）
+	PuffLaunchOffsetY = -10.0
+)
+
+// Plantern Configuration (植物灯笼配置)
+const (
+	// PlanternSunCost 植物灯笼的阳光消耗
+	PlanternSunCost = 25
+
+	// PlanternRechargeTime 植物灯笼卡片的冷却时间（秒）
+	PlanternRechargeTime = 30.0
+
+	// PlanternDefaultHealth 植物灯笼默认生命值
+	PlanternDefaultHealth = 300
+
+	// PlanternLightRadius 植物灯笼驱散雾的半径（格子数）
+	// 与植物灯笼所在格子的距离不超过该半径的格子没有雾（5x5 范围去掉四角）
+	PlanternLightRadius = 2.5
+)
+
+// Blover Configuration (三叶草配置)
+const (
+	// BloverSunCost 三叶草的阳光消耗
+	BloverSunCost = 100
+
+	// BloverRechargeTime 三叶草卡片的冷却时间（秒）
+	BloverRechargeTime = 7.5
+
+	// BloverDefaultHealth 三叶草默认生命值
+	BloverDefaultHealth = 300
+
+	// BloverLingerDuration 三叶草吹完风后继续旋转的时间（秒），之后消失
+	BloverLingerDuration = 1.5
+)
+
+// Fog Configuration (雾配置)
+const (
+	// FogDefaultColumns 雾夜关卡默认被雾覆盖的最右侧列数
+	FogDefaultColumns = 4
+
+	// FogRollInDuration 雾从草坪右侧推进到位所需的时间（秒）
+	// 关卡开始时和被三叶草吹散后，雾都会重新推进
+	FogRollInDuration = 8.0
+
+	// FogBlowAwayDuration 被三叶草吹散后雾重新推进前的时间（秒）
+	FogBlowAwayDuration = 20.0
+
+	// FogFadeSpeed 格子雾浓度每秒的变化量（植物灯笼种下或消失时雾逐渐散开或聚拢）
+	FogFadeSpeed = 2.0
+
+	// FogHiddenDensity 格子雾浓度达到该值时，格子里的僵尸和子弹被视为隐藏
+	FogHiddenDensity = 0.5
+
+	// FogImageFrames 雾图集（fog.jpg）横向的帧数
+	FogImageFrames = 8
+
+	// FogStreetColumns 草坪右侧街道上同样被雾覆盖的列数（与最右侧一列的雾浓度相同）
+	FogStreetColumns = 4
+
+	// FogLightningInterval 雷雨关卡两次闪电之间的间隔（秒）
+	FogLightningInterval = 10.0
+
+	// FogLightningFlashDuration 闪电照亮草坪的持续时间（秒）
+	FogLightningFlashDuration = 0.6
+
+	// FogLightningFlashAlpha 闪电时白色闪光的最大不透明度
+	FogLightningFlashAlpha = 0.6
+)
+
+// Flower Pot Configuration (花盆配置)
+const (
+	// FlowerPotSunCost 花盆的阳光消耗
+	FlowerPotSunCost = 25
+
+	// FlowerPotRechargeTime 花盆卡片的冷却时间（秒）
+	FlowerPotRechargeTime = 7.5
+
+	// FlowerPotDefaultHealth 花盆默认生命值
+	FlowerPotDefaultHealth = 300
+)
+
+// Roof Configuration (屋顶配置)
+const (
+	// RoofProjectileClearance 直线子弹在坡面上的离地高度（像素）
+	// 子弹水平飞行，坡面比发射处升高超过该值时子弹撞上屋顶
+	RoofProjectileClearance = 30.0
+)
+
+// Pool Zombie Configuration (泳池僵尸配置)
+const (
+	// SnorkelZombieDefaultHealth 潜水僵尸的默认生命值
+	SnorkelZombieDefaultHealth = 270
+
+	// DolphinRiderDefaultHealth 海豚骑士僵尸的默认生命值
+	DolphinRiderDefaultHealth = 500
+
+	// DolphinRideSpeed 海豚骑士僵尸入水后骑海豚游动的速度（像素/秒）
+	// 负值表示从右向左移动，约为普通僵尸的两倍多
+	DolphinRideSpeed = -70.0
+
+	// DolphinJumpTrack 用于计算海豚跳跃位移的身体轨道
+	// anim_dolphinjump 期间 _ground 轨道静止，跳跃位移体现在身体轨道上
+	DolphinJumpTrack = "Zombie_dolphinrider_body1"
+
+	// DolphinJumpDistance 海豚跳跃的后备位移（像素）
+	// 无法从 Reanim 数据计算位移时使用
+	DolphinJumpDistance = 105.0
+)
+
+// Pole Vaulting Zombie Configuration (撑杆跳僵尸配置)
+const (
+	// PoleVaulterDefaultHealth 撑杆跳僵尸的默认生命值
+	PoleVaulterDefaultHealth = 500
+
+	// PoleVaulterRunSpeed 撑杆跳僵尸持杆奔跑的速度（像素/秒）
+	// 负值表示从右向左移动，约为普通僵尸的两倍
+	PoleVaulterRunSpeed = -60.0
+
+	// PoleVaulterJumpTrack 用于计算撑杆跳位移的身体轨道
+	// anim_jump 期间 _ground 轨道静止，跳跃位移体现在身体轨道上
+	PoleVaulterJumpTrack = "Zombie_polevaulter_body1"
+
+	// PoleVaulterJumpDistance 撑杆跳的后备位移（像素）
+	// 无法从 Reanim 数据计算位移时使用
+	PoleVaulterJumpDistance = 150.0
+)
+
+// Pogo Zombie Configuration (跳跳僵尸配置)
+const (
+	// PogoZombieDefaultHealth 跳跳僵尸的默认生命值
+	PogoZombieDefaultHealth = 500
+
+	// PogoZombieSpeed 跳跳僵尸持杆跳跃前进的速度（像素/秒）
+	PogoZombieSpeed = -40.0
+
+	// PogoHopDuration 跳跳僵尸越过一株植物所需的时间（秒）
+	PogoHopDuration = 0.6
+
+	// PogoLandingMargin 跳跳僵尸落地时碰撞盒中心越过植物左边缘的距离（像素）
+	PogoLandingMargin = 15.0
+)
+
+// Newspaper Zombie Configuration (读报僵尸配置)
+const (
+	// NewspaperZombieDefaultHealth 读报僵尸身体的默认生命值
+	NewspaperZombieDefaultHealth = 270
+
+	// NewspaperHealth 报纸（II类饰品）的耐久值
+	// 报纸被打碎后读报僵尸惊愕片刻，然后永久加快移动和啃食速度
+	NewspaperHealth = 150
+)
+
+// Screen Door Zombie Configuration (铁栅门僵尸配置)
+const (
+	// ScreenDoorHealth 铁栅门（II类饰品）的耐久值
+	// 铁栅门只阻挡来自正面的直线子弹，爆炸和投掷类攻击直接伤害僵尸本体
+	ScreenDoorHealth = 1100
+)
+
+// Football Zombie Configuration (橄榄球僵尸配置)
+const (
+	// FootballHelmetHealth 橄榄球头盔（I类金属饰品）的护甲值
+	// 头盔被打坏后橄榄球僵尸仍保持冲刺速度
+	FootballHelmetHealth = 1400
+)
+
+// Dancing Zombie Configuration (舞王僵尸配置)
+const (
+	// DancingZombieDefaultHealth 舞王僵尸的默认生命值
+	DancingZombieDefaultHealth = 500
+
+	// DancingMoonwalkSpeed 舞王僵尸太空步入场的速度（像素/秒）
+	DancingMoonwalkSpeed = -60.0
+
+	// DancingSummonX 舞王僵尸停止太空步、召唤伴舞的位置（世界坐标X）
+	// 太空步途中被植物阻挡时，啃食结束后同样立即召唤
+	DancingSummonX = GridWorldStartX + 7*CellWidth
+
+	// DancingSummonInterval 舞王僵尸补召缺失伴舞的间隔（秒）
+	DancingSummonInterval = 12.0
+
+	// DancerWalkSpeed 舞团齐步前进的速度（像素/秒）
+	// 舞团使用固定速度移动（而非根运动），保证所有舞者步调一致
+	DancerWalkSpeed = -20.0
+
+	// DancerWalkDuration 舞团每次齐步前进的时长（秒）
+	DancerWalkDuration = 3.5
+
+	// DancerArmRaiseDuration 舞团每次原地举手的时长（秒）
+	DancerArmRaiseDuration = 1.5
+
+	// BackupDancerDefaultHealth 伴舞僵尸的默认生命值
+	BackupDancerDefaultHealth = 270
+
+	// BackupDancerRiseDuration 伴舞从地下升起所需的时间（秒）
+	BackupDancerRiseDuration = 1.0
+
+	// BackupDancerRiseDepth 伴舞出土前位于地下的深度（像素）
+	BackupDancerRiseDepth = 80.0
+)
+
+// Jack-in-the-Box Zombie Configuration (小丑僵尸配置)
+const (
+	// JackZombieDefaultHealth 小丑僵尸的默认生命值
+	JackZombieDefaultHealth = 500
+
+	// JackPopTimeMin, JackPopTimeMax 小丑僵尸行走多久后打开盒子（秒，随机范围）
+	JackPopTimeMin = 9.0
+	JackPopTimeMax = 15.0
+
+	// JackEarlyPopChance 小丑僵尸提前打开盒子的概率（引信缩短为三分之一）
+	JackEarlyPopChance = 0.05
+
+	// JackExplosionRange 小丑爆炸的范围（以小丑所在格子为中心向四周扩展的格数，1 表示 3x3）
+	JackExplosionRange = 1
+
+	// JackExplodeParticleEffect 小丑爆炸粒子效果名称（JackExplode.xml）
+	JackExplodeParticleEffect = "JackExplode"
+)
+
+// Balloon Zombie Configuration (气球僵尸配置)
+const (
+	// BalloonZombieDefaultHealth 气球僵尸的默认生命值
+	BalloonZombieDefaultHealth = 270
+
+	// BalloonFlySpeed 气球僵尸飞行的速度（像素/秒）
+	BalloonFlySpeed = -30.0
+
+	// BalloonAltitude 气球僵尸飞行时碰撞盒的离地高度（像素）
+	BalloonAltitude = 60.0
+)
+
+// Digger Zombie Configuration (矿工僵尸配置)
+const (
+	// DiggerZombieDefaultHealth 矿工僵尸的默认生命值
+	DiggerZombieDefaultHealth = 270
+
+	// DiggerTunnelSpeed 矿工僵尸在地下挖掘前进的速度（像素/秒）
+	DiggerTunnelSpeed = -40.0
+
+	// DiggerSurfaceCol 矿工僵尸钻出地面的列（草坪最左列）
+	DiggerSurfaceCol = 0
+
+	// DiggerDizzyDuration 矿工僵尸出土后头晕的时长（秒）
+	DiggerDizzyDuration = 2.0
+)
+
+// Gargantuar Zombie Configuration (巨人僵尸配置)
+const (
+	// GargantuarDefaultHealth 白眼巨人的默认生命值
+	GargantuarDefaultHealth = 3000
+
+	// GargantuarRedeyeHealth 红眼巨人的默认生命值（白眼巨人的两倍）
+	GargantuarRedeyeHealth = 6000
+
+	// GargantuarThrowMinCol 巨人扔出小鬼的最左列（离房子太近时不再扔出小鬼）
+	GargantuarThrowMinCol = 5
+
+	// ImpDefaultHealth 小鬼僵尸的默认生命值
+	ImpDefaultHealth = 180
+
+	// ImpWalkSpeed 小鬼僵尸的移动速度（像素/秒，根运动失败时使用）
+	ImpWalkSpeed = -45.0
+
+	// ImpThrowDistanceMin, ImpThrowDistanceMax 小鬼被扔出的水平距离（格，随机范围）
+	ImpThrowDistanceMin = 3.0
+	ImpThrowDistanceMax = 5.0
+
+	// ImpLandMinCol 小鬼落地的最左列
+	ImpLandMinCol = 1
+
+	// ImpThrowDuration 小鬼在空中飞行的时长（秒）
+	ImpThrowDuration = 1.0
+
+	// ImpThrowReleaseHeight 小鬼出手时离地的高度（像素，巨人手的位置）
+	ImpThrowReleaseHeight = 80.0
+
+	// ImpThrowArcHeight 小鬼飞行弧线在出手点和落点连线之上的最大高度（像素）
+	ImpThrowArcHeight = 100.0
+)
+
+// GargantuarImpTracks 巨人 Reanim 中背上小鬼的轨道（扔出小鬼后隐藏）
+var GargantuarImpTracks = []string{
+	"Zombie_imp_body1", "Zombie_imp_body2", "Zombie_imp_head", "Zombie_imp_jaw",
+	"Zombie_imp_innerarm_upper", "Zombie_imp_innerarm_lower",
+	"Zombie_imp_outerarm_upper", "Zombie_imp_outerarm_lower",
+	"Zombie_imp_innerleg_upper", "Zombie_imp_innerleg_lower", "Zombie_imp_innerleg_foot",
+	"Zombie_imp_outerleg_upper", "Zombie_imp_outerleg_lower", "Zombie_imp_outerleg_foot",
+}
+
+// Bungee Zombie Configuration (蹦极僵尸配置)
+const (
+	// BungeeZombieDefaultHealth 蹦极僵尸的默认生命值
+	BungeeZombieDefaultHealth = 450
+
+	// BungeeTargetDelay 准星出现后到蹦极僵尸开始下落的延迟（秒）
+	BungeeTargetDelay = 3.0
+
+	// BungeeDropHeight 蹦极僵尸开始下落时离地的高度（像素，从屏幕上方落下）
+	BungeeDropHeight = 600.0
+
+	// BungeeDropDuration 蹦极僵尸从高空落到地面的时长（秒）
+	BungeeDropDuration = 1.0
+
+	// BungeeRaiseDuration 蹦极僵尸拎着植物升回高空的时长（秒）
+	BungeeRaiseDuration = 1.0
+
+	// BungeeCordImageHeight 蹦极绳图片的原始高度（像素，按绳长纵向拉伸）
+	BungeeCordImageHeight = 64.0
+)
+
+// Ladder Zombie Configuration (扶梯僵尸配置)
+const (
+	// LadderZombieDefaultHealth 扶梯僵尸身体的默认生命值
+	LadderZombieDefaultHealth = 500
+
+	// LadderShieldHealth 梯子（II类金属饰品）的耐久值
+	// 梯子架到坚果墙上后不再保护扶梯僵尸
+	LadderShieldHealth = 500
+
+	// LadderClimbDuration 僵尸顺着梯子翻过一株植物所需的时间（秒）
+	LadderClimbDuration = 1.0
+
+	// LadderClimbHeight 僵尸翻越梯子时的最大离地高度（像素）
+	LadderClimbHeight = 40.0
+
+	// LadderLandingMargin 僵尸翻越后碰撞盒中心越过植物左边缘的距离（像素）
+	LadderLandingMargin = 15.0
+
+	// LadderPlacedOffsetX 架好的梯子相对植物中心的水平偏移（像素，梯子靠在植物右侧）
+	LadderPlacedOffsetX = 20.0
+
+	// LadderPlacedOffsetY 架好的梯子相对植物中心的垂直偏移（像素）
+	LadderPlacedOffsetY = -10.0
+)
+
+// Catapult Zombie Configuration (投篮车僵尸配置)
+const (
+	// CatapultZombieDefaultHealth 投篮车僵尸的默认生命值
+	CatapultZombieDefaultHealth = 850
+
+	// CatapultDriveSpeed 投篮车行驶的速度（像素/秒）
+	CatapultDriveSpeed = -15.0
+
+	// CatapultStopX 投篮车停车投篮的位置（世界坐标X，第 8 列中心）
+	CatapultStopX = GridWorldStartX + 7.5*CellWidth
+
+	// CatapultBasketballCount 投篮车携带的篮球数量
+	// 篮球用完后投篮车继续前进，碾压挡路的植物
+	CatapultBasketballCount = 20
+
+	// CatapultFireInterval 投篮车两次投篮之间的间隔（秒）
+	CatapultFireInterval = 3.0
+
+	// BasketballDamage 篮球命中植物造成的伤害
+	BasketballDamage = 75
+
+	// BasketballFlightDuration 篮球从出手到落地的飞行时间（秒）
+	BasketballFlightDuration = 1.2
+
+	// BasketballArcHeight 篮球抛物线的最大高度（像素）
+	BasketballArcHeight = 120.0
+
+	// BasketballReleaseOffsetX, BasketballReleaseOffsetY 篮球出手点相对投篮车中心的偏移（像素）
+	BasketballReleaseOffsetX = 30.0
+	BasketballReleaseOffsetY = -60.0
+)
+
+// Zomboni Zombie Configuration (雪橇车僵尸配置)
+const (
+	// ZomboniZombieDefaultHealth 雪橇车僵尸默认生命值
+	ZomboniZombieDefaultHealth = 1350
+
+	// ZomboniDriveSpeed 雪橇车行驶速度（像素/秒，负值表示向左）
+	ZomboniDriveSpeed = -12.0
+
+	// ZomboniIceTrailDuration 冰道在雪橇车离开（或被摧毁）后保留的时间（秒）
+	// 雪橇车存活期间会持续刷新身后的冰道
+	ZomboniIceTrailDuration = 30.0
+
+	// ZomboniIceFadeDuration 冰道消失前淡出的时间（秒）
+	ZomboniIceFadeDuration = 2.0
+
+	// IceTrailOffsetY 冰道贴图底边相对格子底部的 Y 偏移（像素，负值向上）
+	IceTrailOffsetY = -10.0
+)
+
+// Bobsled Zombie Configuration (雪橇队僵尸配置)
+const (
+	// BobsledZombieDefaultHealth 雪橇队僵尸默认生命值
+	BobsledZombieDefaultHealth = 270
+
+	// BobsledSlideSpeed 雪橇在冰道上滑行的速度（像素/秒，负值表示向左）
+	BobsledSlideSpeed = -60.0
+
+	// BobsledRiderSpacing 雪橇上相邻座位的间距（像素，后排在前排右侧）
+	BobsledRiderSpacing = 60.0
+
+	// BobsledSledOffsetX 雪橇图片左边缘相对最前方座位的 X 偏移（像素）
+	BobsledSledOffsetX = -60.0
+
+	// BobsledSledOffsetY 雪橇图片底边相对格子底部的 Y 偏移（像素，负值向上）
+	BobsledSledOffsetY = -10.0
+)
+
+// Grave Configuration (墓碑配置)
+const (
+	// GraveZombieRiseDuration 最后一波时僵尸从墓碑下爬出所需的时间（秒）
+	GraveZombieRiseDuration = 1.0
+
+	// GraveZombieRiseDepth 墓碑僵尸爬出前位于地下的深度（像素）
+	GraveZombieRiseDepth = 80.0
+
+	// GraveOffsetY 墓碑图片底边相对格子底部的 Y 偏移（像素，负值向上）
+	GraveOffsetY = -6.0
+)
+
+// Adventure Progression Configuration (冒险模式进度配置)
+const (
+	// AdventureImplementedDayLevels 已实现的白天关卡数量（1-1 ~ 1-5）
+	AdventureImplementedDayLevels = 5
+
+	// BossLevelID 僵王博士关卡ID（屋顶最终关），对应 data/levels/level-5-10.yaml
+	// 第 2~5 章尚未实现，通关已实现的白天关卡后直接进入最终关
+	BossLevelID = "5-10"
+)
+
+// Dr. Zomboss Configuration (僵王博士配置)
+const (
+	// ZombossHealth 僵王博士的生命值
+	ZombossHealth = 40000
+
+	// ZombossEnterDelay 关卡开始后僵王博士入场前的延迟（秒）
+	ZombossEnterDelay = 10.0
+
+	// ZombossIdleDuration 两次攻击之间的待机时间（秒）
+	ZombossIdleDuration = 6.0
+
+	// ZombossAttacksPerHead 每进行多少次攻击后低下头部一次
+	ZombossAttacksPerHead = 3
+
+	// ZombossHeadExposedDuration 低头后吐球前头部低垂的时间（秒）
+	ZombossHeadExposedDuration = 4.0
+
+	// ZombossStompColumns 踩踏影响的最右侧列数（踩踏相邻两行）
+	ZombossStompColumns = 3
+
+	// ZombossRVMinCol 房车落点起始列的最小值（房车压扁 3x2 的植物）
+	ZombossRVMinCol = 4
+
+	// ZombossBungeeCount 每次放下的蹦极僵尸数量
+	ZombossBungeeCount = 2
+
+	// ZombossSummonCount 每次召唤的僵尸数量
+	ZombossSummonCount = 3
+
+	// ZombossSummonSpacing 召唤的僵尸之间的水平间距（像素）
+	ZombossSummonSpacing = 40.0
+
+	// ZombossBallSpeed 火球和冰球的滚动速度（像素/秒，负值表示向左）
+	ZombossBallSpeed = -40.0
+
+	// ZombossHeadOffsetX, ZombossHeadOffsetY 低垂的头部（弱点）中心相对僵王博士原点的偏移（像素）
+	// 僵王博士的 Reanim 原点位于屏幕左上角（center_offset 为 0）
+	ZombossHeadOffsetX = 600.0
+	ZombossHeadOffsetY = 270.0
+
+	// ZombossHeadWidth, ZombossHeadHeight 低垂的头部碰撞盒尺寸（像素）
+	ZombossHeadWidth  = 160.0
+	ZombossHeadHeight = 160.0
+)
+
+// ZombossSummonTypes 僵王博士召唤的僵尸类型（随机选择）
+var ZombossSummonTypes = []string{"basic", "conehead", "buckethead", "football", "pogo", "ladder", "jack"}
+
+// Survival Mode Configuration (生存模式配置)
+const (
+	// SurvivalLevelID 生存模式（白天）关卡ID，对应 data/levels/level-survival-day.yaml
+	SurvivalLevelID = "survival-day"
+
+	// SurvivalFlagsPerSet 每组旗帜的数量，每完成一组后重新选择植物
+	SurvivalFlagsPerSet = 2
+
+	// SurvivalWavesPerFlag 每面旗帜包含的波次数（旗帜波为每组的第 10、20 波）
+	SurvivalWavesPerFlag = 10
+
+	// SurvivalSeedSlots 生存模式植物选择栏的卡槽数
+	SurvivalSeedSlots = 6
+)
+
+// Whack-a-Zombie Configuration (锤僵尸配置)
+const (
+	// WhackZombieLevelID 锤僵尸关卡ID，对应 data/levels/level-whack-zombie.yaml
+	WhackZombieLevelID = "whack-zombie"
+
+	// WhackZombieKillQuota 获胜需要用锤子砸死的僵尸数
+	WhackZombieKillQuota = 30
+
+	// WhackZombieFirstRiseDelay 关卡开始后第一只僵尸爬出前的延迟（秒）
+	WhackZombieFirstRiseDelay = 5.0
+
+	// WhackZombieRiseInterval 僵尸从墓碑下爬出的初始间隔（秒）
+	WhackZombieRiseInterval = 3.0
+
+	// WhackZombieRiseIntervalMin 僵尸爬出间隔的下限（秒）
+	WhackZombieRiseIntervalMin = 0.8
+
+	// WhackZombieRiseIntervalStep 每砸死一只僵尸，爬出间隔缩短的时间（秒）
+	WhackZombieRiseIntervalStep = 0.08
+
+	// WhackZombieRiseDuration 僵尸从墓碑下爬出所需的时间（秒，比最后一波的墓碑僵尸快）
+	WhackZombieRiseDuration = 0.4
+
+	// WhackZombieHammerDamage 锤子每次击中的伤害
+	// 普通僵尸一锤毙命，路障僵尸需要两锤，铁桶僵尸需要四锤（护甲破碎时溢出的伤害不计入身体）
+	WhackZombieHammerDamage = 400
+
+	// WhackZombieConeheadChance 爬出的僵尸为路障僵尸的概率
+	WhackZombieConeheadChance = 0.25
+
+	// WhackZombieBucketheadChance 爬出的僵尸为铁桶僵尸的概率
+	WhackZombieBucketheadChance = 0.1
+
+	// WhackZombieSunIntervalScale 天空阳光掉落间隔的缩放系数（小于 1 表示掉落更快）
+	WhackZombieSunIntervalScale = 0.5
+
+	// WhackZombieHammerOffsetX, WhackZombieHammerOffsetY 锤子实体相对鼠标位置的偏移（像素）
+	// 使锤头的敲击点对准鼠标位置
+	WhackZombieHammerOffsetX = 30.0
+	WhackZombieHammerOffsetY = 10.0
+
+	// WhackZombieCounterX, WhackZombieCounterY 击杀计数文字的屏幕位置（像素）
+	WhackZombieCounterX = 600.0
+	WhackZombieCounterY = 575.0
+
+	// WhackZombieCounterFontSize 击杀计数文字的字号
+	WhackZombieCounterFontSize = 20.0
+)
+
+// Vasebreaker Configuration (砸罐子配置)
+const (
+	// VasebreakerLevelID 砸罐子关卡ID，对应 data/levels/level-vasebreaker.yaml
+	VasebreakerLevelID = "vasebreaker"
+
+	// VaseOffsetY 罐子图片底边相对格子底部的 Y 偏移（像素，负值向上）
+	VaseOffsetY = -10.0
+
+	// SeedPacketPopSpeed 种子包从破碎的罐子中弹出的初速度（像素/秒，负值向上）
+	SeedPacketPopSpeed = -220.0
+
+	// SeedPacketGravity 弹出的种子包下落的加速度（像素/秒²）
+	SeedPacketGravity = 700.0
+
+	// SeedPacketLandOffsetY 种子包落地时中心相对格子中心的 Y 偏移（像素）
+	SeedPacketLandOffsetY = 15.0
+
+	// SeedPacketHeldAlpha 被拾起（正在种植）的种子包在草坪上的透明度
+	SeedPacketHeldAlpha = 0.4
+)
@@ -37,6 +37,9 @@ type SpawnXConfig struct {
 	// FlagZombie 旗帜僵尸固定出生点
 	FlagZombie float64 `yaml:"flagZombie"`
 
+	// Football 橄榄球僵尸出生点范围
+	Football SpawnRange `yaml:"football"`
+
 	// Zomboni 冰车出生点范围
 	Zomboni SpawnRange `yaml:"zomboni"`
 
@@ -105,6 +108,12 @@ func (c *ZombiePhysicsConfig) Validate() error {
 			c.SpawnX.FlagWave.Min, c.SpawnX.FlagWave.Max)
 	}
 
+	// 验证橄榄球僵尸出生点范围
+	if c.SpawnX.Football.Min > c.SpawnX.Football.Max {
+		return fmt.Errorf("football spawn range invalid: min(%.1f) > max(%.1f)",
+			c.SpawnX.Football.Min, c.SpawnX.Football.Max)
+	}
+
 	// 验证冰车出生点范围
 	if c.SpawnX.Zomboni.Min > c.SpawnX.Zomboni.Max {
 		return fmt.Errorf("zomboni spawn range invalid: min(%.1f) > max(%.1f)",
@@ -171,6 +180,8 @@ func (c *ZombiePhysicsConfig) GetSpawnXRange(zombieType string, isFlagWave bool,
 
 	// 特殊僵尸类型：使用专属范围
 	switch zombieType {
+	case "football":
+		return c.SpawnX.Football.Min, c.SpawnX.Football.Max
 	case "zomboni":
 		return c.SpawnX.Zomboni.Min, c.SpawnX.Zomboni.Max
 	case "gargantuar", "gargantuar_redeye":
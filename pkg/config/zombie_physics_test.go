@@ -292,6 +292,7 @@ func TestGetSpawnXRange(t *testing.T) {
 			Normal:     SpawnRange{Min: 780, Max: 819},
 			FlagWave:   SpawnRange{Min: 820, Max: 859},
 			FlagZombie: 800,
+			Football:   SpawnRange{Min: 800, Max: 839},
 			Zomboni:    SpawnRange{Min: 800, Max: 809},
 			Gargantuar: SpawnRange{Min: 845, Max: 854},
 		},
@@ -327,6 +328,13 @@ func TestGetSpawnXRange(t *testing.T) {
 			wantMin:      800,
 			wantMax:      800,
 		},
+		{
+			name:       "football",
+			zombieType: "football",
+			isFlagWave: false,
+			wantMin:    800,
+			wantMax:    839,
+		},
 		{
 			name:       "zomboni",
 			zombieType: "zomboni",
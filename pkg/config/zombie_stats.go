@@ -13,7 +13,7 @@ type ZombieStats struct {
 	Weight               int `yaml:"weight"`               // 权重，用于随机选择僵尸类型
 	BaseHealth           int `yaml:"baseHealth"`           // 本体血量
 	Tier1AccessoryHealth int `yaml:"tier1AccessoryHealth"` // I类饰品血量（如路障、铁桶）
-	Tier2AccessoryHealth int `yaml:"tier2AccessoryHealth"` // II类饰品血量（预留）
+	Tier2AccessoryHealth int `yaml:"tier2AccessoryHealth"` // II类饰品血量（报纸、铁栅门）
 }
 
 // ZombieStatsConfig 僵尸属性配置文件结构
@@ -199,6 +199,60 @@ func CreateFinalWaveEntity(em *ecs.EntityManager, rm ResourceLoader, x, y float6
 	return entityID, nil
 }
 
+// NewDiggerDirtEffect 创建矿工僵尸入土、出土时的土堆效果实体
+// 土堆动画（Digger_rising_dirt.reanim）播放一次后自动删除
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载土堆 Reanim 资源）
+//   - x, y: 土堆位置（世界坐标，通常为矿工僵尸的位置）
+//
+// 返回:
+//   - ecs.EntityID: 创建的土堆实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewDiggerDirtEffect(em *ecs.EntityManager, rm ResourceLoader, x, y float64) (ecs.EntityID, error) {
+	if em == nil {
+		return 0, fmt.Errorf("entity manager cannot be nil")
+	}
+	if rm == nil {
+		return 0, fmt.Errorf("resource manager cannot be nil")
+	}
+
+	reanimXML := rm.GetReanimXML("Digger_rising_dirt")
+	partImages := rm.GetReanimPartImages("Digger_rising_dirt")
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Digger_rising_dirt Reanim resources")
+	}
+
+	entityID := em.CreateEntity()
+
+	ecs.AddComponent(em, entityID, &components.PositionComponent{
+		X: x,
+		Y: y,
+	})
+
+	ecs.AddComponent(em, entityID, &components.ReanimComponent{
+		ReanimName: "Digger_rising_dirt",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "digger_rising_dirt",
+		ComboName: "rise",
+		Processed: false,
+	})
+
+	// 动画播放完毕后自动删除
+	// Digger_rising_dirt.reanim 有 25 帧，FPS=12，播放时长约 2.1 秒
+	animDuration := float64(25) / float64(reanimXML.FPS)
+	ecs.AddComponent(em, entityID, &components.LifetimeComponent{
+		MaxLifetime: animDuration,
+	})
+
+	return entityID, nil
+}
+
 // NewPlantingParticleEffect 创建植物种植粒子效果
 // Story 10.4: 土粒飞溅效果，抛物线运动
 //
@@ -266,3 +320,54 @@ func NewPlantingParticleEffect(
 
 	return entityID, nil
 }
+
+// NewJalapenoFireEffect 创建火爆辣椒点燃整行时的火焰效果实体
+// 火焰动画（fire.reanim）在一个格子中播放，JalapenoFireDuration 秒后自动删除
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载火焰 Reanim 资源）
+//   - x, y: 火焰位置（世界坐标，通常为格子中心）
+//
+// 返回:
+//   - ecs.EntityID: 创建的火焰实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewJalapenoFireEffect(em *ecs.EntityManager, rm ResourceLoader, x, y float64) (ecs.EntityID, error) {
+	if em == nil {
+		return 0, fmt.Errorf("entity manager cannot be nil")
+	}
+	if rm == nil {
+		return 0, fmt.Errorf("resource manager cannot be nil")
+	}
+
+	reanimXML := rm.GetReanimXML("fire")
+	partImages := rm.GetReanimPartImages("fire")
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load fire Reanim resources")
+	}
+
+	entityID := em.CreateEntity()
+
+	ecs.AddComponent(em, entityID, &components.PositionComponent{
+		X: x,
+		Y: y,
+	})
+
+	ecs.AddComponent(em, entityID, &components.ReanimComponent{
+		ReanimName: "fire",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "fire",
+		ComboName: "flame",
+		Processed: false,
+	})
+
+	ecs.AddComponent(em, entityID, &components.LifetimeComponent{
+		MaxLifetime: config.JalapenoFireDuration,
+	})
+
+	return entityID, nil
+}
@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// NewFogEntity 创建雾夜关卡的雾实体
+// 雾在关卡开始时尚未推进（RollIn = 0），由 FogSystem 逐渐推进到最右侧 columns 列
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载并合成雾图片）
+//   - columns: 被雾覆盖的最右侧列数
+//   - storm: 是否是雷雨关卡（周期性闪电）
+//
+// 返回:
+//   - ecs.EntityID: 创建的雾实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewFogEntity(em *ecs.EntityManager, rm *game.ResourceManager, columns int, storm bool) (ecs.EntityID, error) {
+	// fog.jpg 没有透明通道，需要与 fog_.jpg 蒙板合成
+	fogImage, err := rm.LoadImageWithAlphaMask("assets/images/fog.jpg", "assets/images/fog_.jpg")
+	if err != nil {
+		return 0, fmt.Errorf("failed to load fog image: %w", err)
+	}
+
+	entityID := em.CreateEntity()
+	em.AddComponent(entityID, &components.FogComponent{
+		Columns:        columns,
+		Storm:          storm,
+		LightningTimer: config.FogLightningInterval,
+		Image:          fogImage,
+	})
+
+	log.Printf("[FogFactory] 雾 %d: 覆盖最右侧 %d 列，雷雨=%v", entityID, columns, storm)
+
+	return entityID, nil
+}
@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"fmt"
+	"image"
+	"log"
+	"math/rand"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// NewGraveEntity 创建墓碑实体
+// 墓碑立在格子底部中心，外观从 Tombstones 图集中随机选取一列，行由所在草坪行决定；
+// 土堆（Tombstone_mounds）与墓碑使用同一个图集单元，合成为一张图片
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载并合成墓碑图片）
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-4)
+//
+// 返回:
+//   - ecs.EntityID: 创建的墓碑实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+//
+// 注意：墓碑占据的格子由调用方通过 LawnGridSystem.PlaceGrave 记录
+func NewGraveEntity(em *ecs.EntityManager, rm *game.ResourceManager, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// Tombstones.jpg 没有透明通道，需要与 Tombstones_.png 蒙板合成
+	tombstones, err := rm.LoadImageWithAlphaMask("assets/images/Tombstones.jpg", "assets/images/Tombstones_.png")
+	if err != nil {
+		return 0, fmt.Errorf("failed to load tombstone image: %w", err)
+	}
+	mounds, err := rm.LoadImageByID("IMAGE_TOMBSTONE_MOUNDS")
+	if err != nil {
+		return 0, fmt.Errorf("failed to load tombstone mound image: %w", err)
+	}
+
+	cols, rows, ok := rm.GetImageMetadata("IMAGE_TOMBSTONES")
+	if !ok || cols == 0 || rows == 0 {
+		return 0, fmt.Errorf("tombstone image has no sprite sheet metadata")
+	}
+	bounds := tombstones.Bounds()
+	cellW := bounds.Dx() / cols
+	cellH := bounds.Dy() / rows
+	cell := image.Rect(0, 0, cellW, cellH).Add(image.Pt(rand.Intn(cols)*cellW, (row%rows)*cellH))
+
+	graveImage := ebiten.NewImage(cellW, cellH)
+	graveImage.DrawImage(mounds.SubImage(cell).(*ebiten.Image), nil)
+	graveImage.DrawImage(tombstones.SubImage(cell).(*ebiten.Image), nil)
+
+	entityID := em.CreateEntity()
+
+	// 位置为格子中心（世界坐标），渲染时图片底边对齐格子底部
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2,
+		Y: layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2,
+	})
+
+	em.AddComponent(entityID, &components.GraveComponent{
+		Col:   col,
+		Row:   row,
+		Image: graveImage,
+	})
+
+	log.Printf("[GraveFactory] 墓碑 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
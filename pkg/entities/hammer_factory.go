@@ -0,0 +1,60 @@
+package entities
+
+import (
+	"fmt"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/ecs"
+)
+
+// NewHammerEntity 创建锤子光标实体（锤僵尸、砸罐子模式）
+//
+// 锤子使用 Hammer.reanim 渲染，标记为 UI 实体（屏幕坐标，由 DrawUIElements 绘制在最上层），
+// 由小游戏规则每帧更新位置，点击时重新播放 anim_whack_zombie。
+// 创建时播放一次挥锤动画，结束后停在举起的姿势
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载锤子 Reanim 资源）
+//   - x, y: 初始位置（屏幕坐标）
+//
+// 返回:
+//   - ecs.EntityID: 创建的锤子实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewHammerEntity(em *ecs.EntityManager, rm ResourceLoader, x, y float64) (ecs.EntityID, error) {
+	if em == nil {
+		return 0, fmt.Errorf("entity manager cannot be nil")
+	}
+	if rm == nil {
+		return 0, fmt.Errorf("resource manager cannot be nil")
+	}
+
+	reanimXML := rm.GetReanimXML("Hammer")
+	partImages := rm.GetReanimPartImages("Hammer")
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Hammer Reanim resources")
+	}
+
+	entityID := em.CreateEntity()
+
+	ecs.AddComponent(em, entityID, &components.PositionComponent{
+		X: x,
+		Y: y,
+	})
+
+	ecs.AddComponent(em, entityID, &components.ReanimComponent{
+		ReanimName: "Hammer",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:        "hammer",
+		AnimationName: "anim_whack_zombie",
+		Processed:     false,
+	})
+
+	ecs.AddComponent(em, entityID, &components.UIComponent{})
+
+	return entityID, nil
+}
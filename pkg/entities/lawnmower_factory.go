@@ -15,6 +15,7 @@ import (
 // 参数:
 //   - em: 实体管理器
 //   - rm: 资源管理器（用于加载除草车 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
 //   - lane: 所在行（1-5，与 EnabledLanes 一致）
 //
 // 返回:
@@ -26,7 +27,64 @@ import (
 func NewLawnmowerEntity(
 	em *ecs.EntityManager,
 	rm ResourceLoader,
+	layout config.LawnLayout,
 	lane int,
+) (ecs.EntityID, error) {
+	return newLaneCleanerEntity(em, rm, layout, lane, "LawnMower", "anim_normal", config.LawnmowerStartOffsetY, false)
+}
+
+// NewPoolCleanerEntity 创建泳池清洁车实体
+// 泳池清洁车替代水路行的除草车，触发后在水中前进并吸走路径上的僵尸
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载泳池清洁车 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - lane: 所在行（1-based，必须是水路行）
+//
+// 返回:
+//   - ecs.EntityID: 创建的泳池清洁车实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewPoolCleanerEntity(
+	em *ecs.EntityManager,
+	rm ResourceLoader,
+	layout config.LawnLayout,
+	lane int,
+) (ecs.EntityID, error) {
+	return newLaneCleanerEntity(em, rm, layout, lane, "PoolCleaner", "anim_land", config.PoolCleanerStartOffsetY, true)
+}
+
+// NewRoofCleanerEntity 创建屋顶清洁车实体
+// 屋顶清洁车替代屋顶场景的除草车，触发后沿坡面前进并消灭路径上的僵尸
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载屋顶清洁车 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - lane: 所在行（1-based）
+//
+// 返回:
+//   - ecs.EntityID: 创建的屋顶清洁车实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewRoofCleanerEntity(
+	em *ecs.EntityManager,
+	rm ResourceLoader,
+	layout config.LawnLayout,
+	lane int,
+) (ecs.EntityID, error) {
+	// RoofCleaner.reanim 没有动画轨道，按物理帧播放全部帧
+	return newLaneCleanerEntity(em, rm, layout, lane, "RoofCleaner", "anim_idle", config.RoofCleanerStartOffsetY, false)
+}
+
+// newLaneCleanerEntity 创建除草车、泳池清洁车或屋顶清洁车实体
+func newLaneCleanerEntity(
+	em *ecs.EntityManager,
+	rm ResourceLoader,
+	layout config.LawnLayout,
+	lane int,
+	reanimName, animName string,
+	offsetY float64,
+	isPoolCleaner bool,
 ) (ecs.EntityID, error) {
 	if em == nil {
 		return 0, fmt.Errorf("entity manager cannot be nil")
@@ -34,17 +92,17 @@ func NewLawnmowerEntity(
 	if rm == nil {
 		return 0, fmt.Errorf("resource manager cannot be nil")
 	}
-	if lane < 1 || lane > 5 {
-		return 0, fmt.Errorf("invalid lane %d, must be between 1 and 5", lane)
+	if lane < 1 || lane > layout.Rows {
+		return 0, fmt.Errorf("invalid lane %d, must be between 1 and %d", lane, layout.Rows)
 	}
 
 	// 计算除草车目标位置（世界坐标）- 入场动画完成后的最终位置
 	// X坐标：使用配置的起始X位置（左侧台阶）
 	targetX := config.LawnmowerStartX
 
-	// Y坐标：对应行的中心Y坐标
+	// Y坐标：对应行的中心Y坐标（屋顶的坡面偏移由 RoofSystem 随X坐标叠加）
 	// 行中心 = GridWorldStartY + (lane-1)*CellHeight + CellHeight/2.0
-	posY := config.GridWorldStartY + float64(lane-1)*config.CellHeight + config.CellHeight/2.0 + config.LawnmowerStartOffsetY
+	posY := layout.StartY + float64(lane-1)*layout.CellHeight + layout.CellHeight/2.0 + offsetY
 
 	// 入场动画起始位置（屏幕左侧外）
 	startX := config.LawnmowerEnterStartX
@@ -60,11 +118,11 @@ func NewLawnmowerEntity(
 
 	// Story 10.2: 使用 ReanimComponent 加载除草车动画
 	// 从 ResourceManager 获取除草车的 Reanim 数据和部件图片
-	reanimXML := rm.GetReanimXML("LawnMower")
-	partImages := rm.GetReanimPartImages("LawnMower")
+	reanimXML := rm.GetReanimXML(reanimName)
+	partImages := rm.GetReanimPartImages(reanimName)
 
 	if reanimXML == nil || partImages == nil {
-		return 0, fmt.Errorf("failed to load LawnMower Reanim resources")
+		return 0, fmt.Errorf("failed to load %s Reanim resources", reanimName)
 	}
 
 	// 添加 ReanimComponent
@@ -79,8 +137,8 @@ func NewLawnmowerEntity(
 	// ✅ Epic 14: 使用 AnimationCommand 触发动画（替代直接调用 ReanimSystem）
 	// 添加动画命令组件，让 ReanimSystem 在 Update 中处理
 	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
-		AnimationName: "anim_normal", // LawnMower.reanim 使用 anim_normal 动画
-		Processed:     false,         // 标记为未处理，等待 ReanimSystem 处理
+		AnimationName: animName, // LawnMower.reanim 使用 anim_normal，PoolCleaner.reanim 在岸上使用 anim_land
+		Processed:     false,    // 标记为未处理，等待 ReanimSystem 处理
 	})
 
 	// 计算入场动画延迟（每行错开一定时间）
@@ -88,10 +146,11 @@ func NewLawnmowerEntity(
 
 	// 添加除草车组件（包含入场动画状态）
 	ecs.AddComponent(em, entityID, &components.LawnmowerComponent{
-		Lane:        lane,
-		IsTriggered: false,
-		IsMoving:    false,
-		Speed:       config.LawnmowerSpeed,
+		Lane:          lane,
+		IsPoolCleaner: isPoolCleaner,
+		IsTriggered:   false,
+		IsMoving:      false,
+		Speed:         config.LawnmowerSpeed,
 		// 入场动画状态
 		IsEntering:   true,
 		EnterStartX:  startX,
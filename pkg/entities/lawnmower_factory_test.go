@@ -49,7 +49,7 @@ func TestNewLawnmowerEntity(t *testing.T) {
 
 	// 测试创建第3行的除草车
 	lane := 3
-	entityID, err := NewLawnmowerEntity(em, rm, lane)
+	entityID, err := NewLawnmowerEntity(em, rm, config.GetLawnLayout("day"), lane)
 
 	// 验证创建成功
 	if err != nil {
@@ -129,7 +129,7 @@ func TestNewLawnmowerEntityAllLanes(t *testing.T) {
 
 	// 创建所有5行的除草车
 	for lane := 1; lane <= 5; lane++ {
-		entityID, err := NewLawnmowerEntity(em, rm, lane)
+		entityID, err := NewLawnmowerEntity(em, rm, config.GetLawnLayout("day"), lane)
 		if err != nil {
 			t.Fatalf("Failed to create lawnmower for lane %d: %v", lane, err)
 		}
@@ -153,7 +153,7 @@ func TestNewLawnmowerEntityInvalidLane(t *testing.T) {
 	// 测试无效行号
 	invalidLanes := []int{0, 6, -1, 10}
 	for _, lane := range invalidLanes {
-		_, err := NewLawnmowerEntity(em, rm, lane)
+		_, err := NewLawnmowerEntity(em, rm, config.GetLawnLayout("day"), lane)
 		if err == nil {
 			t.Errorf("Expected error for invalid lane %d, got nil", lane)
 		}
@@ -166,13 +166,13 @@ func TestNewLawnmowerEntityNilParameters(t *testing.T) {
 	rm := &MockLawnmowerResourceLoader{}
 
 	// 测试 nil EntityManager
-	_, err := NewLawnmowerEntity(nil, rm, 3)
+	_, err := NewLawnmowerEntity(nil, rm, config.GetLawnLayout("day"), 3)
 	if err == nil {
 		t.Error("Expected error for nil EntityManager")
 	}
 
 	// 测试 nil ResourceLoader
-	_, err = NewLawnmowerEntity(em, nil, 3)
+	_, err = NewLawnmowerEntity(em, nil, config.GetLawnLayout("day"), 3)
 	if err == nil {
 		t.Error("Expected error for nil ResourceLoader")
 	}
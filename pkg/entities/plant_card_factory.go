@@ -27,14 +27,68 @@ import (
 //
 // 返回: 创建的实体ID和可能的错误
 func NewPlantCardEntity(em *ecs.EntityManager, rm *game.ResourceManager, rs ReanimSystemInterface, plantType components.PlantType, x, y, cardScale float64) (ecs.EntityID, error) {
+	card, err := NewPlantCardComponent(em, rm, rs, plantType, cardScale)
+	if err != nil {
+		return 0, err
+	}
+
+	// 获取卡片背景的实际尺寸（用于点击区域）
+	bounds := card.BackgroundImage.Bounds()
+	cardWidth := float64(bounds.Dx())
+	cardHeight := float64(bounds.Dy())
+
 	entity := em.CreateEntity()
 
+	// 添加 PositionComponent (卡片在选择栏的位置)
+	ecs.AddComponent(em, entity, &components.PositionComponent{
+		X: x,
+		Y: y,
+	})
+
+	// 添加 SpriteComponent (保留兼容性，设为 nil)
+	// 实际渲染由 PlantCardRenderSystem 使用 PlantCardComponent 的多层资源
+	ecs.AddComponent(em, entity, &components.SpriteComponent{
+		Image: nil,
+	})
+
+	// 添加 PlantCardComponent (卡片数据 + 渲染资源)
+	ecs.AddComponent(em, entity, card)
+
+	// 添加 UIComponent (标记为UI元素)
+	ecs.AddComponent(em, entity, &components.UIComponent{
+		State: components.UINormal,
+	})
+
+	// 添加 ClickableComponent (可点击)
+	// 使用缩放后的卡片尺寸作为点击区域
+	ecs.AddComponent(em, entity, &components.ClickableComponent{
+		Width:     cardWidth * cardScale,
+		Height:    cardHeight * cardScale,
+		IsEnabled: true,
+	})
+
+	log.Printf("[PlantCardFactory] Created plant card (Type: %v, Cost: %d, Icon: %dx%d)",
+		plantType, card.SunCost, card.PlantIconTexture.Bounds().Dx(), card.PlantIconTexture.Bounds().Dy())
+
+	return entity, nil
+}
+
+// NewPlantCardComponent 创建植物卡片组件（不创建实体）
+// 加载卡片背景并离屏渲染植物图标，供卡片实体和不在选择栏中的卡片（如生存模式重新选卡面板）共用
+//
+// 参数:
+//   - em: 实体管理器（用于离屏渲染植物图标的临时实体）
+//   - rm: 资源管理器
+//   - rs: Reanim 系统（用于渲染植物预览）
+//   - plantType: 植物类型
+//   - cardScale: 卡片整体缩放因子
+//
+// 返回: 卡片组件和可能的错误
+func NewPlantCardComponent(em *ecs.EntityManager, rm *game.ResourceManager, rs ReanimSystemInterface, plantType components.PlantType, cardScale float64) (*components.PlantCardComponent, error) {
 	// 从统一配置获取植物信息
 	cfg := config.GetPlantConfig(plantType)
 	if cfg == nil {
-		em.DestroyEntity(entity)
-		em.RemoveMarkedEntities()
-		return 0, fmt.Errorf("no config found for plant type: %v", plantType)
+		return nil, fmt.Errorf("no config found for plant type: %v", plantType)
 	}
 
 	// 根据植物类型设置阳光消耗和冷却时间
@@ -57,49 +111,85 @@ func NewPlantCardEntity(em *ecs.EntityManager, rm *game.ResourceManager, rs Rean
 	case components.PlantPotatoMine:
 		sunCost = config.PotatoMineSunCost
 		cooldownTime = config.PotatoMineRechargeTime
+	case components.PlantMagnetshroom:
+		sunCost = config.MagnetshroomSunCost
+		cooldownTime = config.MagnetshroomRechargeTime
+	case components.PlantHypnoshroom:
+		sunCost = config.HypnoshroomSunCost
+		cooldownTime = config.HypnoshroomRechargeTime
+	case components.PlantKernelpult:
+		sunCost = config.KernelpultSunCost
+		cooldownTime = config.KernelpultRechargeTime
+	case components.PlantCobCannon:
+		sunCost = config.CobCannonSunCost
+		cooldownTime = config.CobCannonRechargeTime
+	case components.PlantSplitPea:
+		sunCost = config.SplitPeaSunCost
+		cooldownTime = config.SplitPeaRechargeTime
+	case components.PlantStarfruit:
+		sunCost = config.StarfruitSunCost
+		cooldownTime = config.StarfruitRechargeTime
+	case components.PlantCattail:
+		sunCost = config.CattailSunCost
+		cooldownTime = config.CattailRechargeTime
+	case components.PlantTallnut:
+		sunCost = config.TallnutSunCost
+		cooldownTime = config.TallnutRechargeTime
+	case components.PlantGraveBuster:
+		sunCost = config.GraveBusterSunCost
+		cooldownTime = config.GraveBusterRechargeTime
+	case components.PlantLilyPad:
+		sunCost = config.LilyPadSunCost
+		cooldownTime = config.LilyPadRechargeTime
+	case components.PlantTangleKelp:
+		sunCost = config.TangleKelpSunCost
+		cooldownTime = config.TangleKelpRechargeTime
+	case components.PlantSeaShroom:
+		sunCost = config.SeaShroomSunCost
+		cooldownTime = config.SeaShroomRechargeTime
+	case components.PlantPlantern:
+		sunCost = config.PlanternSunCost
+		cooldownTime = config.PlanternRechargeTime
+	case components.PlantBlover:
+		sunCost = config.BloverSunCost
+		cooldownTime = config.BloverRechargeTime
+	case components.PlantFlowerPot:
+		sunCost = config.FlowerPotSunCost
+		cooldownTime = config.FlowerPotRechargeTime
+	case components.PlantJalapeno:
+		sunCost = config.JalapenoSunCost
+		cooldownTime = config.JalapenoRechargeTime
+	case components.PlantIceShroom:
+		sunCost = config.IceShroomSunCost
+		cooldownTime = config.IceShroomRechargeTime
+	case components.PlantSpikeweed:
+		sunCost = config.SpikeweedSunCost
+		cooldownTime = config.SpikeweedRechargeTime
+	case components.PlantChomper:
+		sunCost = config.ChomperSunCost
+		cooldownTime = config.ChomperRechargeTime
+	case components.PlantUmbrellaLeaf:
+		sunCost = config.UmbrellaLeafSunCost
+		cooldownTime = config.UmbrellaLeafRechargeTime
 	default:
-		em.DestroyEntity(entity)
-		em.RemoveMarkedEntities()
-		return 0, fmt.Errorf("unknown plant type: %v", plantType)
+		return nil, fmt.Errorf("unknown plant type: %v", plantType)
 	}
 
 	// 加载卡片背景框
 	backgroundImg, err := rm.LoadImageByID(config.PlantCardBackgroundID)
 	if err != nil {
-		em.DestroyEntity(entity)
-		em.RemoveMarkedEntities()
 		log.Printf("[PlantCardFactory] Failed to load card background: %v", err)
-		return 0, fmt.Errorf("failed to load card background: %w", err)
+		return nil, fmt.Errorf("failed to load card background: %w", err)
 	}
 
 	// 渲染植物预览图标（简化：直接传入 plantType）
 	plantIcon, err := RenderPlantIcon(em, rm, rs, plantType)
 	if err != nil {
-		em.DestroyEntity(entity)
-		em.RemoveMarkedEntities()
 		log.Printf("[PlantCardFactory] Failed to render plant icon for %s: %v", cfg.ResourceName, err)
-		return 0, fmt.Errorf("failed to render plant icon: %w", err)
+		return nil, fmt.Errorf("failed to render plant icon: %w", err)
 	}
 
-	// 获取卡片背景的实际尺寸（用于点击区域）
-	bounds := backgroundImg.Bounds()
-	cardWidth := float64(bounds.Dx())
-	cardHeight := float64(bounds.Dy())
-
-	// 添加 PositionComponent (卡片在选择栏的位置)
-	ecs.AddComponent(em, entity, &components.PositionComponent{
-		X: x,
-		Y: y,
-	})
-
-	// 添加 SpriteComponent (保留兼容性，设为 nil)
-	// 实际渲染由 PlantCardRenderSystem 使用 PlantCardComponent 的多层资源
-	ecs.AddComponent(em, entity, &components.SpriteComponent{
-		Image: nil,
-	})
-
-	// 添加 PlantCardComponent (卡片数据 + 渲染资源)
-	ecs.AddComponent(em, entity, &components.PlantCardComponent{
+	return &components.PlantCardComponent{
 		PlantType:        plantType,
 		SunCost:          sunCost,
 		CooldownTime:     cooldownTime,
@@ -109,25 +199,7 @@ func NewPlantCardEntity(em *ecs.EntityManager, rm *game.ResourceManager, rs Rean
 		PlantIconTexture: plantIcon,
 		CardScale:        cardScale, // Story 8.4: 保存卡片缩放因子
 		Alpha:            1.0,       // Story 8.4: 默认完全不透明
-	})
-
-	// 添加 UIComponent (标记为UI元素)
-	ecs.AddComponent(em, entity, &components.UIComponent{
-		State: components.UINormal,
-	})
-
-	// 添加 ClickableComponent (可点击)
-	// 使用缩放后的卡片尺寸作为点击区域
-	ecs.AddComponent(em, entity, &components.ClickableComponent{
-		Width:     cardWidth * cardScale,
-		Height:    cardHeight * cardScale,
-		IsEnabled: true,
-	})
-
-	log.Printf("[PlantCardFactory] Created plant card (Type: %v, Cost: %d, Icon: %dx%d)",
-		plantType, sunCost, plantIcon.Bounds().Dx(), plantIcon.Bounds().Dy())
-
-	return entity, nil
+	}, nil
 }
 
 // RenderPlantIcon 使用 Reanim 系统离屏渲染植物预览图标
@@ -34,18 +34,19 @@ type ReanimSystemInterface interface {
 //   - gs: 游戏状态（用于获取摄像机位置）
 //   - rs: Reanim 系统（用于初始化动画）
 //   - plantType: 植物类型（向日葵、豌豆射手等）
+//   - layout: 当前场景的草坪行布局
 //   - col: 网格列索引 (0-8)
 //   - row: 网格行索引 (0-4)
 //
 // 返回:
 //   - ecs.EntityID: 创建的植物实体ID，如果失败返回 0
 //   - error: 如果创建失败返回错误信息
-func NewPlantEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, rs ReanimSystemInterface, plantType components.PlantType, col, row int) (ecs.EntityID, error) {
+func NewPlantEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, rs ReanimSystemInterface, plantType components.PlantType, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
 	// 计算植物原点坐标（使用世界坐标系统）
 	// Reanim 坐标系统：部件坐标从原点开始绘制
 	// 中心偏移会由 ReanimSystem 自动计算并在渲染时应用
 	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
-	worldCenterY := config.GridWorldStartY + float64(row)*config.CellHeight + config.CellHeight/2 + config.PlantOffsetY
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2 + config.PlantOffsetY
 
 	// Story 6.3: Reanim 迁移完成
 	// 注意：旧版代码使用 SpriteComponent 和 GetPlantImagePath()
@@ -207,16 +208,17 @@ func NewPlantEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState
 //   - rm: 资源管理器（用于加载坚果墙图像和 Reanim 资源）
 //   - gs: 游戏状态（用于获取摄像机位置）
 //   - rs: Reanim 系统（用于初始化动画）
+//   - layout: 当前场景的草坪行布局
 //   - col: 网格列索引 (0-8)
 //   - row: 网格行索引 (0-4)
 //
 // 返回:
 //   - ecs.EntityID: 创建的坚果墙实体ID，如果失败返回 0
 //   - error: 如果创建失败返回错误信息
-func NewWallnutEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, rs ReanimSystemInterface, col, row int) (ecs.EntityID, error) {
+func NewWallnutEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, rs ReanimSystemInterface, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
 	// 计算格子中心坐标（使用世界坐标系统）
 	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
-	worldCenterY := config.GridWorldStartY + float64(row)*config.CellHeight + config.CellHeight/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
 
 	// 创建实体
 	entityID := em.CreateEntity()
@@ -278,7 +280,7 @@ func NewWallnutEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameSta
 	// 坚果墙的碰撞盒与普通植物类似
 	em.AddComponent(entityID, &components.CollisionComponent{
 		Width:  config.CellWidth * 0.8,  // 碰撞盒宽度略小于格子宽度
-		Height: config.CellHeight * 0.8, // 碰撞盒高度略小于格子高度
+		Height: layout.CellHeight * 0.8, // 碰撞盒高度略小于格子高度
 	})
 
 	// Story 10.7: 为坚果墙添加阴影组件
@@ -301,6 +303,7 @@ func NewWallnutEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameSta
 //   - em: 实体管理器
 //   - rm: 资源管理器（用于加载樱桃炸弹图像和 Reanim 资源）
 //   - gs: 游戏状态（用于获取摄像机位置）
+//   - layout: 当前场景的草坪行布局
 //   - col: 网格列索引 (0-8)
 //   - row: 网格行索引 (0-4)
 //
@@ -309,10 +312,10 @@ func NewWallnutEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameSta
 //   - error: 如果创建失败返回错误信息
 //
 // Story 14.3: Epic 14 - 移除 ReanimSystem 依赖，动画通过 AnimationCommand 组件初始化
-func NewCherryBombEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, col, row int) (ecs.EntityID, error) {
+func NewCherryBombEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
 	// 计算格子中心坐标（使用世界坐标系统）
 	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
-	worldCenterY := config.GridWorldStartY + float64(row)*config.CellHeight + config.CellHeight/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
 
 	// 创建实体
 	entityID := em.CreateEntity()
@@ -372,7 +375,7 @@ func NewCherryBombEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.Game
 	// 碰撞盒大小与格子大小一致
 	em.AddComponent(entityID, &components.CollisionComponent{
 		Width:  config.CellWidth,
-		Height: config.CellHeight,
+		Height: layout.CellHeight,
 	})
 
 	// Story 10.7: 为樱桃炸弹添加阴影组件
@@ -394,16 +397,17 @@ func NewCherryBombEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.Game
 //   - em: 实体管理器
 //   - rm: 资源管理器（用于加载土豆雷图像和 Reanim 资源）
 //   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
 //   - col: 网格列索引 (0-8)
 //   - row: 网格行索引 (0-4)
 //
 // 返回:
 //   - ecs.EntityID: 创建的土豆雷实体ID，如果失败返回 0
 //   - error: 如果创建失败返回错误信息
-func NewPotatoMineEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, col, row int) (ecs.EntityID, error) {
+func NewPotatoMineEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
 	// 计算格子中心坐标（使用世界坐标系统）
 	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
-	worldCenterY := config.GridWorldStartY + float64(row)*config.CellHeight + config.CellHeight/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
 
 	// 创建实体
 	entityID := em.CreateEntity()
@@ -454,7 +458,7 @@ func NewPotatoMineEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.Game
 	// 添加碰撞组件（用于后续爆炸范围检测）
 	em.AddComponent(entityID, &components.CollisionComponent{
 		Width:  config.CellWidth,
-		Height: config.CellHeight,
+		Height: layout.CellHeight,
 	})
 
 	// 添加阴影组件
@@ -468,3 +472,1909 @@ func NewPotatoMineEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.Game
 
 	return entityID, nil
 }
+
+// NewMagnetshroomEntity 创建磁力菇实体
+// 磁力菇周期性地吸走范围内僵尸身上的金属饰品（铁桶、铁栅门、橄榄球头盔等），
+// 吸走的饰品吸附在磁力菇上，冷却结束后消失
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载磁力菇 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-4)
+//
+// 返回:
+//   - ecs.EntityID: 创建的磁力菇实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewMagnetshroomEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取磁力菇的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("Magnetshroom")
+	partImages := rm.GetReanimPartImages("Magnetshroom")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Magnetshroom Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "Magnetshroom",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 使用 AnimationCommand 触发待机动画
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "magnetshroom",
+		ComboName: "idle",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于碰撞检测和网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantMagnetshroom,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加生命值组件
+	em.AddComponent(entityID, &components.HealthComponent{
+		CurrentHealth: config.MagnetshroomDefaultHealth,
+		MaxHealth:     config.MagnetshroomDefaultHealth,
+	})
+
+	// 添加行为组件（磁力菇行为）
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorMagnetshroom,
+	})
+
+	// 添加磁力菇状态组件（初始为空闲，可立即吸取）
+	em.AddComponent(entityID, &components.MagnetshroomComponent{})
+
+	// 添加碰撞组件（用于僵尸碰撞检测）
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth * 0.8,
+		Height: layout.CellHeight * 0.8,
+	})
+
+	// 添加阴影组件
+	shadowSize := config.GetShadowSize("magnetshroom")
+	em.AddComponent(entityID, &components.ShadowComponent{
+		Width:   shadowSize.Width,
+		Height:  shadowSize.Height,
+		Alpha:   config.DefaultShadowAlpha,
+		OffsetY: 0,
+	})
+
+	log.Printf("[PlantFactory] 磁力菇 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
+
+// NewHypnoshroomEntity 创建催眠菇实体
+// 催眠菇没有主动行为，僵尸咬下第一口时催眠菇消失，该僵尸被魅惑并倒戈为植物作战
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载催眠菇 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-4)
+//
+// 返回:
+//   - ecs.EntityID: 创建的催眠菇实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewHypnoshroomEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取催眠菇的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("Hypnoshroom")
+	partImages := rm.GetReanimPartImages("Hypnoshroom")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Hypnoshroom Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "Hypnoshroom",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 使用 AnimationCommand 触发待机动画
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "hypnoshroom",
+		ComboName: "idle",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于碰撞检测和网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantHypnoshroom,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加生命值组件
+	em.AddComponent(entityID, &components.HealthComponent{
+		CurrentHealth: config.HypnoshroomDefaultHealth,
+		MaxHealth:     config.HypnoshroomDefaultHealth,
+	})
+
+	// 添加行为组件（催眠菇行为）
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorHypnoshroom,
+	})
+
+	// 添加碰撞组件（用于僵尸碰撞检测）
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth * 0.8,
+		Height: layout.CellHeight * 0.8,
+	})
+
+	// 添加阴影组件
+	shadowSize := config.GetShadowSize("hypnoshroom")
+	em.AddComponent(entityID, &components.ShadowComponent{
+		Width:   shadowSize.Width,
+		Height:  shadowSize.Height,
+		Alpha:   config.DefaultShadowAlpha,
+		OffsetY: 0,
+	})
+
+	log.Printf("[PlantFactory] 催眠菇 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
+
+// NewKernelpultEntity 创建玉米投手实体
+// 玉米投手向同行最近的僵尸抛投玉米粒，两株相邻的玉米投手可以升级为玉米加农炮
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载玉米投手 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-4)
+//
+// 返回:
+//   - ecs.EntityID: 创建的玉米投手实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewKernelpultEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取玉米投手的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("Cornpult")
+	partImages := rm.GetReanimPartImages("Cornpult")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Cornpult Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "Cornpult",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 使用 AnimationCommand 触发待机动画
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "cornpult",
+		ComboName: "idle",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于碰撞检测和网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantKernelpult,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加生命值组件
+	em.AddComponent(entityID, &components.HealthComponent{
+		CurrentHealth: config.KernelpultDefaultHealth,
+		MaxHealth:     config.KernelpultDefaultHealth,
+	})
+
+	// 添加行为组件（玉米投手行为）
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorKernelpult,
+	})
+
+	// 添加攻击冷却计时器
+	em.AddComponent(entityID, &components.TimerComponent{
+		Name:        "attack_cooldown",
+		TargetTime:  config.KernelpultAttackInterval,
+		CurrentTime: 0,
+		IsReady:     false,
+	})
+
+	// 添加碰撞组件（用于僵尸碰撞检测）
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth * 0.8,
+		Height: layout.CellHeight * 0.8,
+	})
+
+	// 添加阴影组件
+	shadowSize := config.GetShadowSize("kernelpult")
+	em.AddComponent(entityID, &components.ShadowComponent{
+		Width:   shadowSize.Width,
+		Height:  shadowSize.Height,
+		Alpha:   config.DefaultShadowAlpha,
+		OffsetY: 0,
+	})
+
+	log.Printf("[PlantFactory] 玉米投手 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
+
+// NewCobCannonEntity 创建玉米加农炮实体
+// 玉米加农炮占用两个水平相邻的格子（col 和 col+1），种植后需要装填才能发射
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载玉米加农炮 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 占用的最左侧网格列索引 (0-7)
+//   - row: 网格行索引 (0-4)
+//
+// 返回:
+//   - ecs.EntityID: 创建的玉米加农炮实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewCobCannonEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	width := components.PlantFootprintWidth(components.PlantCobCannon)
+	if col < 0 || col+width > config.GridColumns {
+		return 0, fmt.Errorf("cob cannon at col %d does not fit in the lawn", col)
+	}
+
+	// 计算占用区域的中心坐标（两个格子的交界处）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + float64(width)*config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取玉米加农炮的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("CobCannon")
+	partImages := rm.GetReanimPartImages("CobCannon")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load CobCannon Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "CobCannon",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 新种植的加农炮处于未装填状态
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "cobcannon",
+		ComboName: "unarmed_idle",
+		Processed: false,
+	})
+
+	// 添加植物组件（GridCol 为占用的最左侧格子）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantCobCannon,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加生命值组件
+	em.AddComponent(entityID, &components.HealthComponent{
+		CurrentHealth: config.CobCannonDefaultHealth,
+		MaxHealth:     config.CobCannonDefaultHealth,
+	})
+
+	// 添加行为组件（玉米加农炮行为）
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorCobCannon,
+	})
+
+	// 添加加农炮状态组件（需要装填）
+	em.AddComponent(entityID, &components.CobCannonComponent{
+		ReloadTimer: config.CobCannonReloadTime,
+	})
+
+	// 添加碰撞组件（覆盖两个格子）
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth * float64(width) * 0.9,
+		Height: layout.CellHeight * 0.8,
+	})
+
+	// 添加阴影组件
+	shadowSize := config.GetShadowSize("cobcannon")
+	em.AddComponent(entityID, &components.ShadowComponent{
+		Width:   shadowSize.Width,
+		Height:  shadowSize.Height,
+		Alpha:   config.DefaultShadowAlpha,
+		OffsetY: 0,
+	})
+
+	log.Printf("[PlantFactory] 玉米加农炮 %d: 创建于 (%d-%d, %d)", entityID, col, col+width-1, row)
+
+	return entityID, nil
+}
+
+// NewSplitPeaEntity 创建裂荚射手实体
+// 裂荚射手的前头向右发射豌豆，后头在身后有僵尸时向左连发两颗豌豆
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载裂荚射手 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-4)
+//
+// 返回:
+//   - ecs.EntityID: 创建的裂荚射手实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewSplitPeaEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取裂荚射手的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("SplitPea")
+	partImages := rm.GetReanimPartImages("SplitPea")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load SplitPea Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "SplitPea",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 使用 AnimationCommand 触发待机动画
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "splitpea",
+		ComboName: "idle",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于碰撞检测和网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantSplitPea,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加生命值组件
+	em.AddComponent(entityID, &components.HealthComponent{
+		CurrentHealth: config.SplitPeaDefaultHealth,
+		MaxHealth:     config.SplitPeaDefaultHealth,
+	})
+
+	// 添加行为组件（裂荚射手行为）
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorSplitPea,
+	})
+
+	// 添加攻击冷却计时器（前头）
+	em.AddComponent(entityID, &components.TimerComponent{
+		Name:        "attack_cooldown",
+		TargetTime:  config.SplitPeaAttackInterval,
+		CurrentTime: 0,
+		IsReady:     false,
+	})
+
+	// 添加后头状态组件（后头独立计时）
+	em.AddComponent(entityID, &components.SplitPeaComponent{
+		BackTimer:    0,
+		BackInterval: config.SplitPeaAttackInterval,
+	})
+
+	// 添加碰撞组件（用于僵尸碰撞检测）
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth * 0.8,
+		Height: layout.CellHeight * 0.8,
+	})
+
+	// 添加阴影组件
+	shadowSize := config.GetShadowSize("splitpea")
+	em.AddComponent(entityID, &components.ShadowComponent{
+		Width:   shadowSize.Width,
+		Height:  shadowSize.Height,
+		Alpha:   config.DefaultShadowAlpha,
+		OffsetY: 0,
+	})
+
+	log.Printf("[PlantFactory] 裂荚射手 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
+
+// NewStarfruitEntity 创建杨桃实体
+// 杨桃同时向上、下、后方和右前方两个斜向共五个方向发射星星
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载杨桃 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-4)
+//
+// 返回:
+//   - ecs.EntityID: 创建的杨桃实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewStarfruitEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取杨桃的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("Starfruit")
+	partImages := rm.GetReanimPartImages("Starfruit")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Starfruit Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "Starfruit",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 使用 AnimationCommand 触发待机动画
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "starfruit",
+		ComboName: "idle",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于碰撞检测和网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantStarfruit,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加生命值组件
+	em.AddComponent(entityID, &components.HealthComponent{
+		CurrentHealth: config.StarfruitDefaultHealth,
+		MaxHealth:     config.StarfruitDefaultHealth,
+	})
+
+	// 添加行为组件（杨桃行为）
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorStarfruit,
+	})
+
+	// 添加攻击冷却计时器
+	em.AddComponent(entityID, &components.TimerComponent{
+		Name:        "attack_cooldown",
+		TargetTime:  config.StarfruitAttackInterval,
+		CurrentTime: 0,
+		IsReady:     false,
+	})
+
+	// 添加碰撞组件（用于僵尸碰撞检测）
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth * 0.8,
+		Height: layout.CellHeight * 0.8,
+	})
+
+	// 添加阴影组件
+	shadowSize := config.GetShadowSize("starfruit")
+	em.AddComponent(entityID, &components.ShadowComponent{
+		Width:   shadowSize.Width,
+		Height:  shadowSize.Height,
+		Alpha:   config.DefaultShadowAlpha,
+		OffsetY: 0,
+	})
+
+	log.Printf("[PlantFactory] 杨桃 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
+
+// NewCattailEntity 创建香蒲实体
+// 香蒲向草坪上任意位置最近的僵尸发射追踪尖刺
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载香蒲 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-4)
+//
+// 返回:
+//   - ecs.EntityID: 创建的香蒲实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewCattailEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取香蒲的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("Cattail")
+	partImages := rm.GetReanimPartImages("Cattail")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Cattail Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "Cattail",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 使用 AnimationCommand 触发待机动画
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "cattail",
+		ComboName: "idle",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于碰撞检测和网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantCattail,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加生命值组件
+	em.AddComponent(entityID, &components.HealthComponent{
+		CurrentHealth: config.CattailDefaultHealth,
+		MaxHealth:     config.CattailDefaultHealth,
+	})
+
+	// 添加行为组件（香蒲行为）
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorCattail,
+	})
+
+	// 添加攻击冷却计时器
+	em.AddComponent(entityID, &components.TimerComponent{
+		Name:        "attack_cooldown",
+		TargetTime:  config.CattailAttackInterval,
+		CurrentTime: 0,
+		IsReady:     false,
+	})
+
+	// 添加碰撞组件（用于僵尸碰撞检测）
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth * 0.8,
+		Height: layout.CellHeight * 0.8,
+	})
+
+	// 添加阴影组件
+	shadowSize := config.GetShadowSize("cattail")
+	em.AddComponent(entityID, &components.ShadowComponent{
+		Width:   shadowSize.Width,
+		Height:  shadowSize.Height,
+		Alpha:   config.DefaultShadowAlpha,
+		OffsetY: 0,
+	})
+
+	log.Printf("[PlantFactory] 香蒲 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
+
+// NewTallnutEntity 创建高坚果实体
+// 高坚果拥有两倍于坚果墙的生命值，并且无法被撑杆跳僵尸和跳跳僵尸越过
+// 外观状态切换复用坚果墙行为
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载高坚果 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-4)
+//
+// 返回:
+//   - ecs.EntityID: 创建的高坚果实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewTallnutEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取高坚果的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("Tallnut")
+	partImages := rm.GetReanimPartImages("Tallnut")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Tallnut Reanim resources")
+	}
+
+	// 复制部件图片，受损时会替换身体图片
+	clonedPartImages := make(map[string]*ebiten.Image, len(partImages))
+	for k, v := range partImages {
+		clonedPartImages[k] = v
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "Tallnut",
+		ReanimXML:  reanimXML,
+		PartImages: clonedPartImages,
+	})
+
+	// 使用 AnimationCommand 触发待机动画
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "tallnut",
+		ComboName: "idle",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于碰撞检测和网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantTallnut,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加生命值组件
+	em.AddComponent(entityID, &components.HealthComponent{
+		CurrentHealth: config.TallnutDefaultHealth,
+		MaxHealth:     config.TallnutDefaultHealth,
+	})
+
+	// 添加行为组件（与坚果墙共用行为）
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorWallnut,
+	})
+
+	// 添加碰撞组件（用于僵尸碰撞检测）
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth * 0.8,
+		Height: layout.CellHeight * 0.8,
+	})
+
+	// 添加阴影组件
+	shadowSize := config.GetShadowSize("tallnut")
+	em.AddComponent(entityID, &components.ShadowComponent{
+		Width:   shadowSize.Width,
+		Height:  shadowSize.Height,
+		Alpha:   config.DefaultShadowAlpha,
+		OffsetY: 0,
+	})
+
+	log.Printf("[PlantFactory] 高坚果 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
+
+// NewGraveBusterEntity 创建墓碑吞噬者植物实体
+// 墓碑吞噬者只能种在墓碑上，落到墓碑上后开始啃食，一段时间后连同墓碑一起消失
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载墓碑吞噬者 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-4)
+//   - graveID: 所在格子上的墓碑实体
+//
+// 返回:
+//   - ecs.EntityID: 创建的墓碑吞噬者实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewGraveBusterEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int, graveID ecs.EntityID) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取墓碑吞噬者的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("Gravebuster")
+	partImages := rm.GetReanimPartImages("Gravebuster")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Gravebuster Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "Gravebuster",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 使用 AnimationCommand 播放落到墓碑上的动画，落地后由行为系统切换到吞噬动画
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "gravebuster",
+		ComboName: "land",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于碰撞检测和网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantGraveBuster,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加生命值组件
+	em.AddComponent(entityID, &components.HealthComponent{
+		CurrentHealth: config.GraveBusterDefaultHealth,
+		MaxHealth:     config.GraveBusterDefaultHealth,
+	})
+
+	// 添加行为组件
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorGraveBuster,
+	})
+
+	// 添加墓碑吞噬者组件
+	em.AddComponent(entityID, &components.GraveBusterComponent{
+		GraveID:  graveID,
+		EatTimer: config.GraveBusterEatDuration,
+	})
+
+	// 添加碰撞组件（用于僵尸碰撞检测）
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth * 0.8,
+		Height: layout.CellHeight * 0.8,
+	})
+
+	// 添加阴影组件
+	shadowSize := config.GetShadowSize("gravebuster")
+	em.AddComponent(entityID, &components.ShadowComponent{
+		Width:   shadowSize.Width,
+		Height:  shadowSize.Height,
+		Alpha:   config.DefaultShadowAlpha,
+		OffsetY: 0,
+	})
+
+	log.Printf("[PlantFactory] 墓碑吞噬者 %d: 创建于 (%d, %d)，吞噬墓碑 %d", entityID, col, row, graveID)
+
+	return entityID, nil
+}
+
+// NewLilyPadEntity 创建睡莲植物实体
+// 睡莲只能种在水面上，本身不攻击，为陆地植物提供种植平台
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载睡莲 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-5)
+//
+// 返回:
+//   - ecs.EntityID: 创建的睡莲实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewLilyPadEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取睡莲的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("Lilypad")
+	partImages := rm.GetReanimPartImages("Lilypad")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Lilypad Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "Lilypad",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 使用 AnimationCommand 播放待机动画
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "lilypad",
+		ComboName: "idle",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于碰撞检测和网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantLilyPad,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加生命值组件
+	em.AddComponent(entityID, &components.HealthComponent{
+		CurrentHealth: config.LilyPadDefaultHealth,
+		MaxHealth:     config.LilyPadDefaultHealth,
+	})
+
+	// 添加行为组件
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorLilyPad,
+	})
+
+	// 添加碰撞组件（用于僵尸碰撞检测）
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth * 0.8,
+		Height: layout.CellHeight * 0.8,
+	})
+
+	// 水生植物浮在水面上，不添加阴影
+
+	log.Printf("[PlantFactory] 睡莲 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
+
+// NewTangleKelpEntity 创建缠绕水草植物实体
+// 缠绕水草只能种在水面上，缠住经过所在格子的僵尸并把它拖入水中，之后连同自身一起消失
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载缠绕水草 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-5)
+//
+// 返回:
+//   - ecs.EntityID: 创建的缠绕水草实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewTangleKelpEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取缠绕水草的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("Tanglekelp")
+	partImages := rm.GetReanimPartImages("Tanglekelp")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Tanglekelp Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "Tanglekelp",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 使用 AnimationCommand 播放待机动画
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "tanglekelp",
+		ComboName: "idle",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于碰撞检测和网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantTangleKelp,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加生命值组件
+	em.AddComponent(entityID, &components.HealthComponent{
+		CurrentHealth: config.TangleKelpDefaultHealth,
+		MaxHealth:     config.TangleKelpDefaultHealth,
+	})
+
+	// 添加行为组件
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorTangleKelp,
+	})
+
+	// 添加缠绕水草组件
+	em.AddComponent(entityID, &components.TangleKelpComponent{})
+
+	// 添加碰撞组件（用于僵尸碰撞检测）
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth * 0.8,
+		Height: layout.CellHeight * 0.8,
+	})
+
+	// 水生植物浮在水面上，不添加阴影
+
+	log.Printf("[PlantFactory] 缠绕水草 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
+
+// NewSeaShroomEntity 创建海蘑菇植物实体
+// 海蘑菇只能种在水面上，向同一行射程内的僵尸发射短程孢子
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载海蘑菇 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-5)
+//
+// 返回:
+//   - ecs.EntityID: 创建的海蘑菇实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewSeaShroomEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取海蘑菇的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("SeaShroom")
+	partImages := rm.GetReanimPartImages("SeaShroom")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load SeaShroom Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "SeaShroom",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 使用 AnimationCommand 播放待机动画
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "seashroom",
+		ComboName: "idle",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于碰撞检测和网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantSeaShroom,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加生命值组件
+	em.AddComponent(entityID, &components.HealthComponent{
+		CurrentHealth: config.SeaShroomDefaultHealth,
+		MaxHealth:     config.SeaShroomDefaultHealth,
+	})
+
+	// 添加行为组件
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorSeaShroom,
+	})
+
+	// 添加攻击冷却计时器
+	em.AddComponent(entityID, &components.TimerComponent{
+		Name:        "attack_cooldown",
+		TargetTime:  config.SeaShroomAttackInterval,
+		CurrentTime: 0,
+		IsReady:     false,
+	})
+
+	// 添加碰撞组件（用于僵尸碰撞检测）
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth * 0.8,
+		Height: layout.CellHeight * 0.8,
+	})
+
+	// 水生植物浮在水面上，不添加阴影
+
+	log.Printf("[PlantFactory] 海蘑菇 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
+
+// NewMagnetHeldAccessoryEntity 创建吸附在磁力菇上的饰品显示实体
+// 饰品以单图片实体的形式显示在磁力菇头部，由 BehaviorSystem 随冷却进度缩小，冷却结束后删除
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载饰品图片）
+//   - tag: 饰品标签
+//   - x, y: 显示位置（世界坐标）
+//
+// 返回:
+//   - ecs.EntityID: 创建的显示实体ID
+//   - error: 饰品未配置图片或图片加载失败时返回错误
+func NewMagnetHeldAccessoryEntity(em *ecs.EntityManager, rm ResourceLoader, tag types.AccessoryTag, x, y float64) (ecs.EntityID, error) {
+	cfg := config.GetAccessoryConfig(tag)
+	if cfg == nil || cfg.HeldImage == "" {
+		return 0, fmt.Errorf("no held image configured for accessory %s", tag)
+	}
+
+	image, err := rm.LoadImage(cfg.HeldImage)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load held accessory image %s: %w", cfg.HeldImage, err)
+	}
+
+	entityID := em.CreateEntity()
+
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: x,
+		Y: y,
+	})
+
+	// 单图片实体使用简化的 Reanim 包装
+	em.AddComponent(entityID, createSimpleReanimComponent(image, "held_"+string(tag)))
+
+	// 初始原始大小，由 BehaviorSystem 随冷却进度缩小
+	em.AddComponent(entityID, &components.ScaleComponent{
+		ScaleX: 1.0,
+		ScaleY: 1.0,
+	})
+
+	// 生命周期与磁力菇冷却一致，磁力菇中途死亡时饰品也会按时消失
+	em.AddComponent(entityID, &components.LifetimeComponent{
+		MaxLifetime: config.MagnetshroomCooldown,
+	})
+
+	return entityID, nil
+}
+
+// NewPlanternEntity 创建植物灯笼植物实体
+// 植物灯笼没有主动行为，由 FogSystem 驱散其周围 PlanternLightRadius 格以内的雾
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载植物灯笼 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-5)
+//
+// 返回:
+//   - ecs.EntityID: 创建的植物灯笼实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewPlanternEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取植物灯笼的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("Plantern")
+	partImages := rm.GetReanimPartImages("Plantern")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Plantern Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "Plantern",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 使用 AnimationCommand 播放待机动画
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "plantern",
+		ComboName: "idle",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于碰撞检测和网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantPlantern,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加生命值组件
+	em.AddComponent(entityID, &components.HealthComponent{
+		CurrentHealth: config.PlanternDefaultHealth,
+		MaxHealth:     config.PlanternDefaultHealth,
+	})
+
+	// 添加行为组件
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorPlantern,
+	})
+
+	// 添加碰撞组件（用于僵尸碰撞检测）
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth * 0.8,
+		Height: layout.CellHeight * 0.8,
+	})
+
+	// 添加阴影组件
+	shadowSize := config.GetShadowSize("plantern")
+	em.AddComponent(entityID, &components.ShadowComponent{
+		Width:   shadowSize.Width,
+		Height:  shadowSize.Height,
+		Alpha:   config.DefaultShadowAlpha,
+		OffsetY: 0,
+	})
+
+	log.Printf("[PlantFactory] 植物灯笼 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
+
+// NewBloverEntity 创建三叶草植物实体
+// 三叶草种下后立即播放吹风动画，吹风时吹散雾和空中的气球僵尸，之后消失
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载三叶草 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-5)
+//
+// 返回:
+//   - ecs.EntityID: 创建的三叶草实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewBloverEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取三叶草的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("Blover")
+	partImages := rm.GetReanimPartImages("Blover")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Blover Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "Blover",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 使用 AnimationCommand 播放吹风动画，播放完毕后由行为系统吹散雾
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "blover",
+		ComboName: "blow",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于碰撞检测和网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantBlover,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加生命值组件
+	em.AddComponent(entityID, &components.HealthComponent{
+		CurrentHealth: config.BloverDefaultHealth,
+		MaxHealth:     config.BloverDefaultHealth,
+	})
+
+	// 添加行为组件
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorBlover,
+	})
+
+	// 添加三叶草组件
+	em.AddComponent(entityID, &components.BloverComponent{})
+
+	// 添加碰撞组件（用于僵尸碰撞检测）
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth * 0.8,
+		Height: layout.CellHeight * 0.8,
+	})
+
+	// 添加阴影组件
+	shadowSize := config.GetShadowSize("blover")
+	em.AddComponent(entityID, &components.ShadowComponent{
+		Width:   shadowSize.Width,
+		Height:  shadowSize.Height,
+		Alpha:   config.DefaultShadowAlpha,
+		OffsetY: 0,
+	})
+
+	log.Printf("[PlantFactory] 三叶草 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
+
+// NewFlowerPotEntity 创建花盆植物实体
+// 屋顶上的植物必须种在花盆上，花盆本身不攻击，为其他植物提供种植平台
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载花盆 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-4)
+//
+// 返回:
+//   - ecs.EntityID: 创建的花盆实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewFlowerPotEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取花盆的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("Pot")
+	partImages := rm.GetReanimPartImages("Pot")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Pot Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "Pot",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 使用 AnimationCommand 播放待机动画
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "pot",
+		ComboName: "idle",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于碰撞检测和网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantFlowerPot,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加生命值组件
+	em.AddComponent(entityID, &components.HealthComponent{
+		CurrentHealth: config.FlowerPotDefaultHealth,
+		MaxHealth:     config.FlowerPotDefaultHealth,
+	})
+
+	// 添加行为组件
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorFlowerPot,
+	})
+
+	// 添加碰撞组件（用于僵尸碰撞检测）
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth * 0.8,
+		Height: layout.CellHeight * 0.8,
+	})
+
+	// 添加阴影组件（种在花盆上的植物共用花盆的阴影）
+	shadowSize := config.GetShadowSize("pot")
+	em.AddComponent(entityID, &components.ShadowComponent{
+		Width:   shadowSize.Width,
+		Height:  shadowSize.Height,
+		Alpha:   config.DefaultShadowAlpha,
+		OffsetY: 0,
+	})
+
+	log.Printf("[PlantFactory] 花盆 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
+
+// NewJalapenoEntity 创建火爆辣椒植物实体
+// 火爆辣椒种植后播放膨胀动画，引信时间结束后点燃所在的整行，
+// 烧毁该行所有僵尸，并融化僵王博士滚到该行的冰球
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载火爆辣椒 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-5)
+//
+// 返回:
+//   - ecs.EntityID: 创建的火爆辣椒实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewJalapenoEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取火爆辣椒的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("Jalapeno")
+	partImages := rm.GetReanimPartImages("Jalapeno")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Jalapeno Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "Jalapeno",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 使用 AnimationCommand 播放膨胀动画（引信）
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "jalapeno",
+		ComboName: "explode",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于碰撞检测和网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantJalapeno,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加行为组件
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorJalapeno,
+	})
+
+	// 添加引信计时器组件
+	em.AddComponent(entityID, &components.TimerComponent{
+		Name:        "fuse_timer",
+		TargetTime:  config.JalapenoFuseTime,
+		CurrentTime: 0,
+		IsReady:     false,
+	})
+
+	// 添加碰撞组件
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth,
+		Height: layout.CellHeight,
+	})
+
+	// 添加阴影组件
+	shadowSize := config.GetShadowSize("jalapeno")
+	em.AddComponent(entityID, &components.ShadowComponent{
+		Width:   shadowSize.Width,
+		Height:  shadowSize.Height,
+		Alpha:   config.DefaultShadowAlpha,
+		OffsetY: 0,
+	})
+
+	log.Printf("[PlantFactory] 火爆辣椒 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
+
+// NewIceShroomEntity 创建寒冰菇植物实体
+// 寒冰菇种植后经过引信时间释放寒气，冻结场上所有僵尸，
+// 并冻结僵王博士滚出的所有火球
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载寒冰菇 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-5)
+//
+// 返回:
+//   - ecs.EntityID: 创建的寒冰菇实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewIceShroomEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取寒冰菇的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("Iceshroom")
+	partImages := rm.GetReanimPartImages("Iceshroom")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Iceshroom Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "Iceshroom",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 使用 AnimationCommand 播放待机动画
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "iceshroom",
+		ComboName: "idle",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于碰撞检测和网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantIceShroom,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加行为组件
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorIceShroom,
+	})
+
+	// 添加引信计时器组件
+	em.AddComponent(entityID, &components.TimerComponent{
+		Name:        "fuse_timer",
+		TargetTime:  config.IceShroomFuseTime,
+		CurrentTime: 0,
+		IsReady:     false,
+	})
+
+	// 添加碰撞组件
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth,
+		Height: layout.CellHeight,
+	})
+
+	// 添加阴影组件
+	shadowSize := config.GetShadowSize("iceshroom")
+	em.AddComponent(entityID, &components.ShadowComponent{
+		Width:   shadowSize.Width,
+		Height:  shadowSize.Height,
+		Alpha:   config.DefaultShadowAlpha,
+		OffsetY: 0,
+	})
+
+	log.Printf("[PlantFactory] 寒冰菇 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
+
+// NewSpikeweedEntity 创建地刺植物实体
+// 地刺贴地生长，僵尸不会啃食它，而是从上面走过并被定时扎伤；
+// 驶过地刺的车辆僵尸（投石车、冰车）会被扎破轮胎，地刺同时被压碎
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载地刺 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-5)
+//
+// 返回:
+//   - ecs.EntityID: 创建的地刺实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewSpikeweedEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取地刺的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("Caltrop")
+	partImages := rm.GetReanimPartImages("Caltrop")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Caltrop Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "Caltrop",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 使用 AnimationCommand 播放待机动画
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "caltrop",
+		ComboName: "idle",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantSpikeweed,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加生命值组件
+	em.AddComponent(entityID, &components.HealthComponent{
+		CurrentHealth: config.SpikeweedDefaultHealth,
+		MaxHealth:     config.SpikeweedDefaultHealth,
+	})
+
+	// 添加行为组件
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorSpikeweed,
+	})
+
+	// 添加扎刺计时器组件
+	em.AddComponent(entityID, &components.TimerComponent{
+		Name:        "attack_cooldown",
+		TargetTime:  config.SpikeweedAttackInterval,
+		CurrentTime: 0,
+		IsReady:     false,
+	})
+
+	// 添加碰撞组件
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth,
+		Height: layout.CellHeight,
+	})
+
+	// 添加阴影组件
+	shadowSize := config.GetShadowSize("spikeweed")
+	em.AddComponent(entityID, &components.ShadowComponent{
+		Width:   shadowSize.Width,
+		Height:  shadowSize.Height,
+		Alpha:   config.DefaultShadowAlpha,
+		OffsetY: 0,
+	})
+
+	log.Printf("[PlantFactory] 地刺 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
+
+// NewChomperEntity 创建大嘴花植物实体
+// 大嘴花一口吞下面前的僵尸后咀嚼一段时间，巨人僵尸无法吞下，只会被咬伤
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载大嘴花 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-5)
+//
+// 返回:
+//   - ecs.EntityID: 创建的大嘴花实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewChomperEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取大嘴花的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("Chomper")
+	partImages := rm.GetReanimPartImages("Chomper")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Chomper Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "Chomper",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 使用 AnimationCommand 播放待机动画
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "chomper",
+		ComboName: "idle",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于碰撞检测和网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantChomper,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加生命值组件
+	em.AddComponent(entityID, &components.HealthComponent{
+		CurrentHealth: config.ChomperDefaultHealth,
+		MaxHealth:     config.ChomperDefaultHealth,
+	})
+
+	// 添加行为组件
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorChomper,
+	})
+
+	// 添加大嘴花组件（咬、咀嚼状态）
+	em.AddComponent(entityID, &components.ChomperComponent{
+		State: components.ChomperStateReady,
+	})
+
+	// 添加碰撞组件（用于僵尸碰撞检测）
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth * 0.8,
+		Height: layout.CellHeight * 0.8,
+	})
+
+	// 添加阴影组件
+	shadowSize := config.GetShadowSize("chomper")
+	em.AddComponent(entityID, &components.ShadowComponent{
+		Width:   shadowSize.Width,
+		Height:  shadowSize.Height,
+		Alpha:   config.DefaultShadowAlpha,
+		OffsetY: 0,
+	})
+
+	log.Printf("[PlantFactory] 大嘴花 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
+
+// NewUmbrellaLeafEntity 创建叶子保护伞植物实体
+// 叶子保护伞保护周围 3x3 格子内的植物：蹦极僵尸落到这些格子时被挡开，空手升回高空
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载叶子保护伞 Reanim 资源）
+//   - gs: 游戏状态
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-5)
+//
+// 返回:
+//   - ecs.EntityID: 创建的叶子保护伞实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewUmbrellaLeafEntity(em *ecs.EntityManager, rm ResourceLoader, gs *game.GameState, layout config.LawnLayout, col, row int) (ecs.EntityID, error) {
+	// 计算格子中心坐标（使用世界坐标系统）
+	worldCenterX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	worldCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2
+
+	// 从 ResourceManager 获取叶子保护伞的 Reanim 数据和部件图片
+	reanimXML := rm.GetReanimXML("Umbrellaleaf")
+	partImages := rm.GetReanimPartImages("Umbrellaleaf")
+
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Umbrellaleaf Reanim resources")
+	}
+
+	// 创建实体
+	entityID := em.CreateEntity()
+
+	// 添加位置组件（使用世界坐标）
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: worldCenterX,
+		Y: worldCenterY,
+	})
+
+	// 添加 ReanimComponent
+	em.AddComponent(entityID, &components.ReanimComponent{
+		ReanimName: "Umbrellaleaf",
+		ReanimXML:  reanimXML,
+		PartImages: partImages,
+	})
+
+	// 使用 AnimationCommand 播放待机动画
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    "umbrellaleaf",
+		ComboName: "idle",
+		Processed: false,
+	})
+
+	// 添加植物组件（用于碰撞检测和网格位置追踪）
+	em.AddComponent(entityID, &components.PlantComponent{
+		PlantType:       components.PlantUmbrellaLeaf,
+		GridRow:         row,
+		GridCol:         col,
+		AttackAnimState: components.AttackAnimIdle,
+	})
+
+	// 添加生命值组件
+	em.AddComponent(entityID, &components.HealthComponent{
+		CurrentHealth: config.UmbrellaLeafDefaultHealth,
+		MaxHealth:     config.UmbrellaLeafDefaultHealth,
+	})
+
+	// 添加行为组件
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorUmbrellaLeaf,
+	})
+
+	// 添加碰撞组件（用于僵尸碰撞检测）
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CellWidth * 0.8,
+		Height: layout.CellHeight * 0.8,
+	})
+
+	// 添加阴影组件
+	shadowSize := config.GetShadowSize("umbrellaleaf")
+	em.AddComponent(entityID, &components.ShadowComponent{
+		Width:   shadowSize.Width,
+		Height:  shadowSize.Height,
+		Alpha:   config.DefaultShadowAlpha,
+		OffsetY: 0,
+	})
+
+	log.Printf("[PlantFactory] 叶子保护伞 %d: 创建于 (%d, %d)", entityID, col, row)
+
+	return entityID, nil
+}
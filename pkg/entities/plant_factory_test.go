@@ -50,7 +50,7 @@ func TestNewPlantEntity(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// 创建植物实体
-			plantID, err := NewPlantEntity(em, rm, gs, mockRS, tt.plantType, tt.col, tt.row)
+			plantID, err := NewPlantEntity(em, rm, gs, mockRS, tt.plantType, config.GetLawnLayout("day"), tt.col, tt.row)
 			if err != nil {
 				t.Fatalf("Failed to create plant entity: %v", err)
 			}
@@ -126,7 +126,7 @@ func TestNewPlantEntity_AllPlantTypes(t *testing.T) {
 
 	for _, pt := range plantTypes {
 		t.Run(pt.name, func(t *testing.T) {
-			plantID, err := NewPlantEntity(em, rm, gs, mockRS, pt.plantType, 4, 2)
+			plantID, err := NewPlantEntity(em, rm, gs, mockRS, pt.plantType, config.GetLawnLayout("day"), 4, 2)
 			if err != nil {
 				t.Fatalf("Failed to create plant of type %s: %v", pt.name, err)
 			}
@@ -171,7 +171,7 @@ func TestNewPlantEntity_PositionCalculation(t *testing.T) {
 
 	for _, corner := range corners {
 		t.Run(corner.name, func(t *testing.T) {
-			plantID, err := NewPlantEntity(em, rm, gs, mockRS, components.PlantSunflower, corner.col, corner.row)
+			plantID, err := NewPlantEntity(em, rm, gs, mockRS, components.PlantSunflower, config.GetLawnLayout("day"), corner.col, corner.row)
 			if err != nil {
 				t.Fatalf("Failed to create plant at %s: %v", corner.name, err)
 			}
@@ -222,7 +222,7 @@ func TestPlantHasHealthComponent(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// 创建植物实体
-			plantID, err := NewPlantEntity(em, rm, gs, mockRS, tt.plantType, 4, 2)
+			plantID, err := NewPlantEntity(em, rm, gs, mockRS, tt.plantType, config.GetLawnLayout("day"), 4, 2)
 			if err != nil {
 				t.Fatalf("Failed to create plant: %v", err)
 			}
@@ -281,7 +281,7 @@ func TestNewWallnutEntity(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// 创建坚果墙实体
-			wallnutID, err := NewWallnutEntity(em, rm, gs, mockRS, tt.col, tt.row)
+			wallnutID, err := NewWallnutEntity(em, rm, gs, mockRS, config.GetLawnLayout("day"), tt.col, tt.row)
 			if err != nil {
 				t.Fatalf("Failed to create wallnut entity: %v", err)
 			}
@@ -393,7 +393,7 @@ func TestWallnutHealthConfiguration(t *testing.T) {
 	mockRS := &mockReanimSystem{em: em}
 
 	// 创建坚果墙
-	wallnutID, err := NewWallnutEntity(em, rm, gs, mockRS, 4, 2)
+	wallnutID, err := NewWallnutEntity(em, rm, gs, mockRS, config.GetLawnLayout("day"), 4, 2)
 	if err != nil {
 		t.Fatalf("Failed to create wallnut entity: %v", err)
 	}
@@ -456,7 +456,7 @@ func TestNewCherryBombEntity(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// 创建樱桃炸弹实体（Epic 14: 已删除 mockRS 参数）
-			cherryBombID, err := NewCherryBombEntity(em, rm, gs, tt.col, tt.row)
+			cherryBombID, err := NewCherryBombEntity(em, rm, gs, config.GetLawnLayout("day"), tt.col, tt.row)
 			if err != nil {
 				t.Fatalf("Failed to create cherry bomb entity: %v", err)
 			}
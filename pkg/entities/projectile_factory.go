@@ -32,6 +32,22 @@ func getAnimVisiblesMapKeys(m map[string][]int) []string {
 //   - ecs.EntityID: 创建的子弹实体ID，如果失败返回 0
 //   - error: 如果创建失败返回错误信息
 func NewPeaProjectile(em *ecs.EntityManager, rm ResourceLoader, startX, startY float64) (ecs.EntityID, error) {
+	return NewPeaProjectileWithVelocity(em, rm, startX, startY, config.PeaBulletSpeed, 0)
+}
+
+// NewPeaProjectileWithVelocity 创建指定速度的豌豆子弹实体
+// 用于不向右飞行的豌豆（如裂荚射手后头向左发射的豌豆）
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载子弹图像）
+//   - startX, startY: 子弹起始世界坐标
+//   - vx, vy: 子弹速度（像素/秒）
+//
+// 返回:
+//   - ecs.EntityID: 创建的子弹实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewPeaProjectileWithVelocity(em *ecs.EntityManager, rm ResourceLoader, startX, startY, vx, vy float64) (ecs.EntityID, error) {
 	if em == nil {
 		return 0, fmt.Errorf("entity manager cannot be nil")
 	}
@@ -64,10 +80,10 @@ func NewPeaProjectile(em *ecs.EntityManager, rm ResourceLoader, startX, startY f
 	log.Printf("[ProjectileFactory] 创建子弹 %d: ReanimName=%s, VisualTracks=%v, CurrentAnimations=%v, AnimVisiblesMap keys=%v",
 		entityID, reanimComp.ReanimName, reanimComp.VisualTracks, reanimComp.CurrentAnimations, getAnimVisiblesMapKeys(reanimComp.AnimVisiblesMap))
 
-	// 添加速度组件（向右移动）
+	// 添加速度组件
 	em.AddComponent(entityID, &components.VelocityComponent{
-		VX: config.PeaBulletSpeed,
-		VY: 0,
+		VX: vx,
+		VY: vy,
 	})
 
 	// 添加行为组件（标识为豌豆子弹）
@@ -83,3 +99,358 @@ func NewPeaProjectile(em *ecs.EntityManager, rm ResourceLoader, startX, startY f
 
 	return entityID, nil
 }
+
+// NewKernelProjectile 创建玉米粒子弹实体
+// 玉米粒沿抛物线飞向目标僵尸，落地时对目标造成伤害
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载玉米粒图像）
+//   - startX, startY: 起点世界坐标
+//   - targetID: 目标僵尸实体ID
+//   - targetX, targetY: 初始落点世界坐标（目标移动时由 BehaviorSystem 更新）
+//
+// 返回:
+//   - ecs.EntityID: 创建的子弹实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewKernelProjectile(em *ecs.EntityManager, rm ResourceLoader, startX, startY float64, targetID ecs.EntityID, targetX, targetY float64) (ecs.EntityID, error) {
+	if em == nil {
+		return 0, fmt.Errorf("entity manager cannot be nil")
+	}
+	if rm == nil {
+		return 0, fmt.Errorf("resource manager cannot be nil")
+	}
+
+	kernelImage, err := rm.LoadImage("assets/reanim/Cornpult_kernal.png")
+	if err != nil {
+		return 0, fmt.Errorf("failed to load kernel projectile image: %w", err)
+	}
+
+	entityID := em.CreateEntity()
+
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: startX,
+		Y: startY,
+	})
+
+	// 单图片实体使用简化的 Reanim 包装
+	em.AddComponent(entityID, createSimpleReanimComponent(kernelImage, "kernel"))
+
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorKernelProjectile,
+	})
+
+	em.AddComponent(entityID, &components.LobbedProjectileComponent{
+		StartX:       startX,
+		StartY:       startY,
+		TargetX:      targetX,
+		TargetY:      targetY,
+		TargetEntity: targetID,
+		ArcHeight:    config.KernelArcHeight,
+		Duration:     config.KernelFlightTime,
+		Damage:       config.KernelDamage,
+	})
+
+	return entityID, nil
+}
+
+// NewCobProjectile 创建玉米炮弹实体
+// 玉米炮弹从加农炮发射后飞出屏幕顶部，再落到玩家选择的落点并爆炸
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载玉米炮弹图像）
+//   - startX, startY: 起点世界坐标
+//   - targetX, targetY: 落点世界坐标
+//
+// 返回:
+//   - ecs.EntityID: 创建的炮弹实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewCobProjectile(em *ecs.EntityManager, rm ResourceLoader, startX, startY, targetX, targetY float64) (ecs.EntityID, error) {
+	if em == nil {
+		return 0, fmt.Errorf("entity manager cannot be nil")
+	}
+	if rm == nil {
+		return 0, fmt.Errorf("resource manager cannot be nil")
+	}
+
+	cobImage, err := rm.LoadImage("assets/reanim/CobCannon_cob.png")
+	if err != nil {
+		return 0, fmt.Errorf("failed to load cob projectile image: %w", err)
+	}
+
+	entityID := em.CreateEntity()
+
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: startX,
+		Y: startY,
+	})
+
+	// 单图片实体使用简化的 Reanim 包装
+	em.AddComponent(entityID, createSimpleReanimComponent(cobImage, "cob"))
+
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorCobProjectile,
+	})
+
+	em.AddComponent(entityID, &components.LobbedProjectileComponent{
+		StartX:    startX,
+		StartY:    startY,
+		TargetX:   targetX,
+		TargetY:   targetY,
+		ArcHeight: config.CobArcHeight,
+		Duration:  config.CobFlightTime,
+		Damage:    config.CobCannonDamage,
+	})
+
+	return entityID, nil
+}
+
+// NewBasketballProjectile 创建篮球实体
+// 篮球从投篮车抛出，沿抛物线飞向目标植物，落地时对植物造成伤害
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载篮球图像）
+//   - startX, startY: 起点世界坐标
+//   - targetID: 目标植物实体ID
+//   - targetX, targetY: 落点世界坐标（目标植物的位置）
+//
+// 返回:
+//   - ecs.EntityID: 创建的篮球实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewBasketballProjectile(em *ecs.EntityManager, rm ResourceLoader, startX, startY float64, targetID ecs.EntityID, targetX, targetY float64) (ecs.EntityID, error) {
+	if em == nil {
+		return 0, fmt.Errorf("entity manager cannot be nil")
+	}
+	if rm == nil {
+		return 0, fmt.Errorf("resource manager cannot be nil")
+	}
+
+	basketballImage, err := rm.LoadImage("assets/reanim/Zombie_catapult_basketball.png")
+	if err != nil {
+		return 0, fmt.Errorf("failed to load basketball image: %w", err)
+	}
+
+	entityID := em.CreateEntity()
+
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: startX,
+		Y: startY,
+	})
+
+	// 单图片实体使用简化的 Reanim 包装
+	em.AddComponent(entityID, createSimpleReanimComponent(basketballImage, "basketball"))
+
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorBasketballProjectile,
+	})
+
+	em.AddComponent(entityID, &components.LobbedProjectileComponent{
+		StartX:       startX,
+		StartY:       startY,
+		TargetX:      targetX,
+		TargetY:      targetY,
+		TargetEntity: targetID,
+		ArcHeight:    config.BasketballArcHeight,
+		Duration:     config.BasketballFlightDuration,
+		Damage:       config.BasketballDamage,
+	})
+
+	return entityID, nil
+}
+
+// NewStarProjectile 创建星星子弹实体
+// 星星沿固定方向直线飞行，可以击中任意行的僵尸
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载星星图像）
+//   - startX, startY: 起点世界坐标
+//   - vx, vy: 飞行速度（像素/秒）
+//
+// 返回:
+//   - ecs.EntityID: 创建的子弹实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewStarProjectile(em *ecs.EntityManager, rm ResourceLoader, startX, startY, vx, vy float64) (ecs.EntityID, error) {
+	if em == nil {
+		return 0, fmt.Errorf("entity manager cannot be nil")
+	}
+	if rm == nil {
+		return 0, fmt.Errorf("resource manager cannot be nil")
+	}
+
+	starImage, err := rm.LoadImage("assets/images/Projectile_star.png")
+	if err != nil {
+		return 0, fmt.Errorf("failed to load star projectile image: %w", err)
+	}
+
+	entityID := em.CreateEntity()
+
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: startX,
+		Y: startY,
+	})
+
+	// 单图片实体使用简化的 Reanim 包装
+	em.AddComponent(entityID, createSimpleReanimComponent(starImage, "star"))
+
+	em.AddComponent(entityID, &components.VelocityComponent{
+		VX: vx,
+		VY: vy,
+	})
+
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorStarProjectile,
+	})
+
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.StarWidth,
+		Height: config.StarHeight,
+	})
+
+	return entityID, nil
+}
+
+// NewPuffProjectile 创建孢子子弹实体（海蘑菇发射）
+// 孢子水平向右飞行，飞出射程（起点右侧 SeaShroomRange）后自动消失
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载孢子图像）
+//   - startX, startY: 起点世界坐标
+//
+// 返回:
+//   - ecs.EntityID: 创建的子弹实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewPuffProjectile(em *ecs.EntityManager, rm ResourceLoader, startX, startY float64) (ecs.EntityID, error) {
+	if em == nil {
+		return 0, fmt.Errorf("entity manager cannot be nil")
+	}
+	if rm == nil {
+		return 0, fmt.Errorf("resource manager cannot be nil")
+	}
+
+	puffImage, err := rm.LoadImage("assets/particles/PuffShroom_puff1.png")
+	if err != nil {
+		return 0, fmt.Errorf("failed to load puff projectile image: %w", err)
+	}
+
+	entityID := em.CreateEntity()
+
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: startX,
+		Y: startY,
+	})
+
+	// 单图片实体使用简化的 Reanim 包装
+	em.AddComponent(entityID, createSimpleReanimComponent(puffImage, "puff"))
+
+	em.AddComponent(entityID, &components.VelocityComponent{
+		VX: config.PuffSpeed,
+		VY: 0,
+	})
+
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorPuffProjectile,
+	})
+
+	em.AddComponent(entityID, &components.PuffComponent{
+		MaxX: startX + config.SeaShroomRange,
+	})
+
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.PuffWidth,
+		Height: config.PuffHeight,
+	})
+
+	return entityID, nil
+}
+
+// NewCattailSpike 创建香蒲尖刺实体
+// 尖刺持续转向目标僵尸，不局限于香蒲所在行
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载尖刺图像）
+//   - startX, startY: 起点世界坐标
+//   - targetID: 目标僵尸实体ID
+//   - vx, vy: 初始速度（像素/秒），之后由 BehaviorSystem 转向目标
+//
+// 返回:
+//   - ecs.EntityID: 创建的子弹实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewCattailSpike(em *ecs.EntityManager, rm ResourceLoader, startX, startY float64, targetID ecs.EntityID, vx, vy float64) (ecs.EntityID, error) {
+	if em == nil {
+		return 0, fmt.Errorf("entity manager cannot be nil")
+	}
+	if rm == nil {
+		return 0, fmt.Errorf("resource manager cannot be nil")
+	}
+
+	spikeImage, err := rm.LoadImage("assets/reanim/Cattail_spike.png")
+	if err != nil {
+		return 0, fmt.Errorf("failed to load cattail spike image: %w", err)
+	}
+
+	entityID := em.CreateEntity()
+
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: startX,
+		Y: startY,
+	})
+
+	// 单图片实体使用简化的 Reanim 包装
+	em.AddComponent(entityID, createSimpleReanimComponent(spikeImage, "cattail_spike"))
+
+	em.AddComponent(entityID, &components.VelocityComponent{
+		VX: vx,
+		VY: vy,
+	})
+
+	em.AddComponent(entityID, &components.HomingComponent{
+		TargetEntity: targetID,
+		Speed:        config.CattailSpikeSpeed,
+		TurnRate:     config.CattailSpikeTurnRate,
+	})
+
+	em.AddComponent(entityID, &components.BehaviorComponent{
+		Type: components.BehaviorCattailSpike,
+	})
+
+	em.AddComponent(entityID, &components.CollisionComponent{
+		Width:  config.CattailSpikeWidth,
+		Height: config.CattailSpikeHeight,
+	})
+
+	return entityID, nil
+}
+
+// NewCobTargetEntity 创建玉米加农炮瞄准准星实体
+// 准星在瞄准模式下跟随鼠标显示落点，由 InputSystem 负责更新位置和删除
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载准星图像）
+//   - x, y: 初始位置（世界坐标）
+//
+// 返回:
+//   - ecs.EntityID: 创建的准星实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewCobTargetEntity(em *ecs.EntityManager, rm ResourceLoader, x, y float64) (ecs.EntityID, error) {
+	targetImage, err := rm.LoadImage("assets/images/CobCannon_target.png")
+	if err != nil {
+		return 0, fmt.Errorf("failed to load cob target image: %w", err)
+	}
+
+	entityID := em.CreateEntity()
+
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: x,
+		Y: y,
+	})
+
+	em.AddComponent(entityID, createSimpleReanimComponent(targetImage, "cob_target"))
+
+	return entityID, nil
+}
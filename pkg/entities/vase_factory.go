@@ -0,0 +1,113 @@
+package entities
+
+import (
+	"fmt"
+	"image"
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// vaseImageColumns 罐子类型在 Scary_Pot 图集中的列（第 2 行是罐子正面）
+var vaseImageColumns = map[string]int{
+	config.VaseTypeRegular: 0,
+	config.VaseTypePlant:   1,
+	config.VaseTypeZombie:  2,
+}
+
+// NewVaseEntity 创建罐子实体
+// 罐子立在格子底部中心，外观从 Scary_Pot 图集中按罐子类型选取
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载罐子图集）
+//   - layout: 当前场景的草坪行布局
+//   - col: 网格列索引 (0-8)
+//   - row: 网格行索引 (0-4)
+//   - vaseType: 罐子类型（config.VaseTypeRegular/VaseTypePlant/VaseTypeZombie）
+//   - plantType: 罐子里的种子包（zombieType 为空时有效）
+//   - zombieType: 罐子里的僵尸类型，为空表示罐子里是种子包
+//
+// 返回:
+//   - ecs.EntityID: 创建的罐子实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+//
+// 注意：罐子占据的格子由调用方通过 LawnGridSystem.OccupyCell 记录
+func NewVaseEntity(em *ecs.EntityManager, rm *game.ResourceManager, layout config.LawnLayout, col, row int, vaseType string, plantType components.PlantType, zombieType string) (ecs.EntityID, error) {
+	column, ok := vaseImageColumns[vaseType]
+	if !ok {
+		return 0, fmt.Errorf("unknown vase type %q", vaseType)
+	}
+
+	pots, err := rm.LoadImageByID("IMAGE_SCARY_POT")
+	if err != nil {
+		return 0, fmt.Errorf("failed to load vase image: %w", err)
+	}
+	cols, rows, ok := rm.GetImageMetadata("IMAGE_SCARY_POT")
+	if !ok || cols == 0 || rows < 2 {
+		return 0, fmt.Errorf("vase image has no sprite sheet metadata")
+	}
+	bounds := pots.Bounds()
+	cellW := bounds.Dx() / cols
+	cellH := bounds.Dy() / rows
+	cell := image.Rect(0, 0, cellW, cellH).Add(image.Pt(column*cellW, cellH))
+
+	entityID := em.CreateEntity()
+
+	// 位置为格子中心（世界坐标），渲染时图片底边对齐格子底部
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2,
+		Y: layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2,
+	})
+
+	em.AddComponent(entityID, &components.VaseComponent{
+		Col:        col,
+		Row:        row,
+		Type:       vaseType,
+		PlantType:  plantType,
+		ZombieType: zombieType,
+		Image:      pots.SubImage(cell).(*ebiten.Image),
+	})
+
+	log.Printf("[VaseFactory] 罐子 %d: 创建于 (%d, %d), 类型=%s", entityID, col, row, vaseType)
+
+	return entityID, nil
+}
+
+// NewSeedPacketEntity 创建从罐子里弹出的种子包实体
+// 种子包从 (x, y) 向上弹出，落到 targetY 后可以拾取
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器
+//   - rs: Reanim 系统（用于离屏渲染植物图标）
+//   - plantType: 植物类型
+//   - x, y: 弹出位置（世界坐标，种子包中心）
+//   - targetY: 落地后的 Y 坐标
+//
+// 返回:
+//   - ecs.EntityID: 创建的种子包实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewSeedPacketEntity(em *ecs.EntityManager, rm *game.ResourceManager, rs ReanimSystemInterface, plantType components.PlantType, x, y, targetY float64) (ecs.EntityID, error) {
+	card, err := NewPlantCardComponent(em, rm, rs, plantType, config.PlantCardScale)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create seed packet card: %w", err)
+	}
+
+	entityID := em.CreateEntity()
+	em.AddComponent(entityID, &components.PositionComponent{X: x, Y: y})
+	em.AddComponent(entityID, &components.SeedPacketComponent{
+		PlantType: plantType,
+		Card:      card,
+		VelocityY: config.SeedPacketPopSpeed,
+		TargetY:   targetY,
+	})
+
+	log.Printf("[VaseFactory] 种子包 %d: 植物类型=%v, 位置=(%.1f, %.1f)", entityID, plantType, x, y)
+
+	return entityID, nil
+}
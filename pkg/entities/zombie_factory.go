@@ -2,6 +2,7 @@ package entities
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 
 	"github.com/gonewx/pvz/pkg/components"
@@ -17,6 +18,7 @@ import (
 //   - em: 实体管理器
 //   - rm: 资源管理器（用于加载僵尸 Reanim 资源）
 //   - rs: Reanim 系统（用于初始化动画）
+//   - layout: 当前场景的草坪行布局
 //   - row: 生成行索引 (0-4)
 //   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
 //
@@ -26,7 +28,7 @@ import (
 //
 // 注意：僵尸默认创建时速度为0（待命状态），需要通过 WaveSpawnSystem.ActivateWave() 激活
 // Story 14.3: Epic 14 - 移除 ReanimSystem 依赖，动画通过 AnimationCommand 组件初始化
-func NewZombieEntity(em *ecs.EntityManager, rm ResourceLoader, row int, spawnX float64) (ecs.EntityID, error) {
+func NewZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
 	if em == nil {
 		return 0, fmt.Errorf("entity manager cannot be nil")
 	}
@@ -38,7 +40,7 @@ func NewZombieEntity(em *ecs.EntityManager, rm ResourceLoader, row int, spawnX f
 	// 使用和植物相同的Y坐标计算，确保同一行的实体在同一高度
 	// 行中心 = GridWorldStartY + row*CellHeight + CellHeight/2.0
 	// 使用 config.ZombieVerticalOffset 以便手工调整
-	spawnY := config.GridWorldStartY + float64(row)*config.CellHeight + config.CellHeight/2.0 + config.ZombieVerticalOffset
+	spawnY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2.0 + config.ZombieVerticalOffset
 
 	// 创建实体
 	entityID := em.CreateEntity()
@@ -128,6 +130,7 @@ func NewZombieEntity(em *ecs.EntityManager, rm ResourceLoader, row int, spawnX f
 //   - em: 实体管理器
 //   - rm: 资源管理器（用于加载僵尸 Reanim 资源）
 //   - rs: Reanim 系统（用于初始化动画）
+//   - layout: 当前场景的草坪行布局
 //   - row: 生成行索引 (0-4)
 //   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
 //
@@ -136,7 +139,7 @@ func NewZombieEntity(em *ecs.EntityManager, rm ResourceLoader, row int, spawnX f
 //   - error: 如果创建失败返回错误信息
 //
 // Story 14.3: Epic 14 - 移除 ReanimSystem 依赖，动画通过 AnimationCommand 组件初始化
-func NewConeheadZombieEntity(em *ecs.EntityManager, rm ResourceLoader, row int, spawnX float64) (ecs.EntityID, error) {
+func NewConeheadZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
 	if em == nil {
 		return 0, fmt.Errorf("entity manager cannot be nil")
 	}
@@ -147,7 +150,7 @@ func NewConeheadZombieEntity(em *ecs.EntityManager, rm ResourceLoader, row int,
 	// 计算僵尸Y坐标（世界坐标，基于行）
 	// 行中心 = GridWorldStartY + row*CellHeight + CellHeight/2.0
 	// 使用 config.ZombieVerticalOffset 以便手工调整
-	spawnY := config.GridWorldStartY + float64(row)*config.CellHeight + config.CellHeight/2.0 + config.ZombieVerticalOffset
+	spawnY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2.0 + config.ZombieVerticalOffset
 
 	// 创建实体
 	entityID := em.CreateEntity()
@@ -208,11 +211,17 @@ func NewConeheadZombieEntity(em *ecs.EntityManager, rm ResourceLoader, row int,
 		UnitID:          types.UnitIDZombieConehead,
 	})
 
-	// 添加护甲组件（路障僵尸的关键特性）
-	em.AddComponent(entityID, &components.ArmorComponent{
-		CurrentArmor: config.ConeheadZombieArmorHealth,
-		MaxArmor:     config.ConeheadZombieArmorHealth,
-		Type:         components.ArmorTypePlastic, // 塑料护甲
+	// 添加饰品组件（路障僵尸的关键特性）
+	// 路障为 I 类塑料饰品，优先承受伤害，不会被磁力菇吸走
+	em.AddComponent(entityID, &components.AccessoryComponent{
+		Pieces: []*components.AccessoryPiece{
+			{
+				Tag:           types.AccessoryCone,
+				Tier:          1,
+				Durability:    config.ConeheadZombieArmorHealth,
+				MaxDurability: config.ConeheadZombieArmorHealth,
+			},
+		},
 	})
 
 	// 添加生命值组件（身体生命值270）
@@ -246,6 +255,7 @@ func NewConeheadZombieEntity(em *ecs.EntityManager, rm ResourceLoader, row int,
 //   - em: 实体管理器
 //   - rm: 资源管理器（用于加载僵尸 Reanim 资源）
 //   - rs: Reanim 系统（用于初始化动画）
+//   - layout: 当前场景的草坪行布局
 //   - row: 生成行索引 (0-4)
 //   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
 //
@@ -254,7 +264,7 @@ func NewConeheadZombieEntity(em *ecs.EntityManager, rm ResourceLoader, row int,
 //   - error: 如果创建失败返回错误信息
 //
 // Story 14.3: Epic 14 - 移除 ReanimSystem 依赖，动画通过 AnimationCommand 组件初始化
-func NewBucketheadZombieEntity(em *ecs.EntityManager, rm ResourceLoader, row int, spawnX float64) (ecs.EntityID, error) {
+func NewBucketheadZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
 	if em == nil {
 		return 0, fmt.Errorf("entity manager cannot be nil")
 	}
@@ -265,7 +275,7 @@ func NewBucketheadZombieEntity(em *ecs.EntityManager, rm ResourceLoader, row int
 	// 计算僵尸Y坐标（世界坐标，基于行）
 	// 行中心 = GridWorldStartY + row*CellHeight + CellHeight/2.0
 	// 使用 config.ZombieVerticalOffset 以便手工调整
-	spawnY := config.GridWorldStartY + float64(row)*config.CellHeight + config.CellHeight/2.0 + config.ZombieVerticalOffset
+	spawnY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2.0 + config.ZombieVerticalOffset
 
 	// 创建实体
 	entityID := em.CreateEntity()
@@ -326,11 +336,17 @@ func NewBucketheadZombieEntity(em *ecs.EntityManager, rm ResourceLoader, row int
 		UnitID:          types.UnitIDZombieBuckethead,
 	})
 
-	// 添加护甲组件（铁桶僵尸的关键特性）
-	em.AddComponent(entityID, &components.ArmorComponent{
-		CurrentArmor: config.BucketheadZombieArmorHealth,
-		MaxArmor:     config.BucketheadZombieArmorHealth,
-		Type:         components.ArmorTypeMetal, // 金属护甲
+	// 添加饰品组件（铁桶僵尸的关键特性）
+	// 铁桶为 I 类金属饰品，优先承受伤害，可被磁力菇吸走
+	em.AddComponent(entityID, &components.AccessoryComponent{
+		Pieces: []*components.AccessoryPiece{
+			{
+				Tag:           types.AccessoryBucket,
+				Tier:          1,
+				Durability:    config.BucketheadZombieArmorHealth,
+				MaxDurability: config.BucketheadZombieArmorHealth,
+			},
+		},
 	})
 
 	// 添加生命值组件（身体生命值270）
@@ -366,13 +382,14 @@ func NewBucketheadZombieEntity(em *ecs.EntityManager, rm ResourceLoader, row int
 // 参数:
 //   - em: 实体管理器
 //   - rm: 资源管理器（用于加载僵尸 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
 //   - row: 生成行索引 (0-4)
 //   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
 //
 // 返回:
 //   - ecs.EntityID: 创建的旗帜僵尸实体ID，如果失败返回 0
 //   - error: 如果创建失败返回错误信息
-func NewFlagZombieEntity(em *ecs.EntityManager, rm ResourceLoader, row int, spawnX float64) (ecs.EntityID, error) {
+func NewFlagZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
 	if em == nil {
 		return 0, fmt.Errorf("entity manager cannot be nil")
 	}
@@ -383,7 +400,7 @@ func NewFlagZombieEntity(em *ecs.EntityManager, rm ResourceLoader, row int, spaw
 	// 计算僵尸Y坐标（世界坐标，基于行）
 	// 行中心 = GridWorldStartY + row*CellHeight + CellHeight/2.0
 	// 使用 config.ZombieVerticalOffset 以便手工调整
-	spawnY := config.GridWorldStartY + float64(row)*config.CellHeight + config.CellHeight/2.0 + config.ZombieVerticalOffset
+	spawnY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2.0 + config.ZombieVerticalOffset
 
 	// 创建实体
 	entityID := em.CreateEntity()
@@ -483,6 +500,948 @@ func NewFlagZombieEntity(em *ecs.EntityManager, rm ResourceLoader, row int, spaw
 	return entityID, nil
 }
 
+// newZombieBaseEntity 创建僵尸实体的公共部分
+// 添加位置、Reanim、待机动画命令、速度（待命）、行为、生命值、碰撞和阴影组件，
+// 特殊僵尸在此基础上添加各自的能力组件
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置
+//   - reanimName: Reanim 资源名称（如 "Zombie_polevaulter"）
+//   - unitID: 动画配置 ID（如 types.UnitIDZombiePolevaulter）
+//   - health: 本体生命值
+//   - shadowKey: 阴影配置键
+func newZombieBaseEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64, reanimName, unitID string, health int, shadowKey string) (ecs.EntityID, error) {
+	if em == nil {
+		return 0, fmt.Errorf("entity manager cannot be nil")
+	}
+	if rm == nil {
+		return 0, fmt.Errorf("resource manager cannot be nil")
+	}
+
+	reanimXML := rm.GetReanimXML(reanimName)
+	partImages := rm.GetReanimPartImages(reanimName)
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load %s Reanim resources", reanimName)
+	}
+
+	// 行中心 = GridWorldStartY + row*CellHeight + CellHeight/2.0
+	spawnY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2.0 + config.ZombieVerticalOffset
+
+	entityID := em.CreateEntity()
+
+	ecs.AddComponent(em, entityID, &components.PositionComponent{
+		X: spawnX,
+		Y: spawnY,
+	})
+
+	// LastAnimFrame 初始化为 -1，表示尚未开始动画（根运动计算使用）
+	ecs.AddComponent(em, entityID, &components.ReanimComponent{
+		ReanimName:    reanimName,
+		ReanimXML:     reanimXML,
+		PartImages:    partImages,
+		LastAnimFrame: -1,
+	})
+
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    unitID,
+		ComboName: "idle",
+		Processed: false,
+	})
+
+	// 初始速度为0（待命状态），等待 ActivateZombie 激活
+	ecs.AddComponent(em, entityID, &components.VelocityComponent{})
+
+	ecs.AddComponent(em, entityID, &components.BehaviorComponent{
+		Type:            components.BehaviorZombieBasic,
+		ZombieAnimState: components.ZombieAnimIdle,
+		UnitID:          unitID,
+	})
+
+	ecs.AddComponent(em, entityID, &components.HealthComponent{
+		CurrentHealth: health,
+		MaxHealth:     health,
+	})
+
+	ecs.AddComponent(em, entityID, &components.CollisionComponent{
+		Width:  config.ZombieCollisionWidth,
+		Height: config.ZombieCollisionHeight,
+	})
+
+	shadowSize := config.GetShadowSize(shadowKey)
+	ecs.AddComponent(em, entityID, &components.ShadowComponent{
+		Width:   shadowSize.Width,
+		Height:  shadowSize.Height,
+		Alpha:   config.DefaultShadowAlpha,
+		OffsetY: 0,
+	})
+
+	return entityID, nil
+}
+
+// NewPolevaulterZombieEntity 创建撑杆跳僵尸实体
+// 撑杆跳僵尸持杆快速奔跑，越过遇到的第一株植物后丢弃撑杆，之后缓慢行走
+// 高坚果无法被越过
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载撑杆跳僵尸 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
+//
+// 返回:
+//   - ecs.EntityID: 创建的撑杆跳僵尸实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewPolevaulterZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie_polevaulter", types.UnitIDZombiePolevaulter, config.PoleVaulterDefaultHealth, "zombie_pole")
+	if err != nil {
+		return 0, err
+	}
+
+	ecs.AddComponent(em, entityID, &components.VaultComponent{
+		Kind:      components.VaultKindPole,
+		State:     components.VaultStateReady,
+		MoveSpeed: config.PoleVaulterRunSpeed,
+		MoveCombo: "run",
+	})
+
+	return entityID, nil
+}
+
+// NewPogoZombieEntity 创建跳跳僵尸实体
+// 跳跳僵尸越过遇到的每一株植物，跳跳杆被磁力菇吸走或被高坚果挡住后变为普通行走
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载跳跳僵尸 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
+//
+// 返回:
+//   - ecs.EntityID: 创建的跳跳僵尸实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewPogoZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie_pogo", types.UnitIDZombiePogo, config.PogoZombieDefaultHealth, "zombie_pogo")
+	if err != nil {
+		return 0, err
+	}
+
+	ecs.AddComponent(em, entityID, &components.VaultComponent{
+		Kind:      components.VaultKindPogo,
+		State:     components.VaultStateReady,
+		MoveSpeed: config.PogoZombieSpeed,
+		MoveCombo: "pogo",
+	})
+
+	// 跳跳杆为金属功能性道具，没有耐久，可被磁力菇吸走
+	ecs.AddComponent(em, entityID, &components.AccessoryComponent{
+		Pieces: []*components.AccessoryPiece{
+			{Tag: types.AccessoryPogoStick, Tier: 0},
+		},
+	})
+
+	return entityID, nil
+}
+
+// NewDuckyZombieEntity 创建鸭子救生圈僵尸实体
+// 鸭子救生圈僵尸套着游泳圈，只在水路上出现，到达水池边缘后直接下水游动
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载僵尸 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引（水路行）
+//   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
+//
+// 返回:
+//   - ecs.EntityID: 创建的鸭子救生圈僵尸实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewDuckyZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie", types.UnitIDZombieDucky, config.ZombieDefaultHealth, "zombie")
+	if err != nil {
+		return 0, err
+	}
+
+	ecs.AddComponent(em, entityID, &components.SwimComponent{})
+
+	return entityID, nil
+}
+
+// NewSnorkelZombieEntity 创建潜水僵尸实体
+// 潜水僵尸跳入水中后潜在水下游动，无法被攻击，只有啃食植物时才浮出水面
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载潜水僵尸 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引（水路行）
+//   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
+//
+// 返回:
+//   - ecs.EntityID: 创建的潜水僵尸实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewSnorkelZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie_snorkle", types.UnitIDZombieSnorkel, config.SnorkelZombieDefaultHealth, "zombie_snorkel")
+	if err != nil {
+		return 0, err
+	}
+
+	ecs.AddComponent(em, entityID, &components.SwimComponent{
+		EnterCombo: "jumpinpool",
+		Dives:      true,
+	})
+
+	return entityID, nil
+}
+
+// NewDolphinRiderZombieEntity 创建海豚骑士僵尸实体
+// 海豚骑士僵尸牵着海豚走到水池边缘，入水后骑海豚快速游动，
+// 越过水中遇到的第一株植物后失去海豚，之后像普通僵尸一样游动和啃食
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载海豚骑士僵尸 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引（水路行）
+//   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
+//
+// 返回:
+//   - ecs.EntityID: 创建的海豚骑士僵尸实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewDolphinRiderZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie_dolphinrider", types.UnitIDZombieDolphinRider, config.DolphinRiderDefaultHealth, "zombie_dolphin")
+	if err != nil {
+		return 0, err
+	}
+
+	// 入水前牵着海豚以普通速度行走，入水后由 BehaviorSystem 切换为骑海豚游动
+	ecs.AddComponent(em, entityID, &components.VaultComponent{
+		Kind:      components.VaultKindDolphin,
+		State:     components.VaultStateReady,
+		MoveSpeed: config.ZombieWalkSpeed,
+		MoveCombo: "walkdolphin",
+	})
+	ecs.AddComponent(em, entityID, &components.SwimComponent{
+		EnterCombo: "jumpinpool",
+	})
+
+	return entityID, nil
+}
+
+// NewFootballZombieEntity 创建橄榄球僵尸实体
+// 橄榄球僵尸冲刺前进，头盔为 I 类金属饰品，被打坏后仍保持冲刺速度
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载橄榄球僵尸 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
+//
+// 返回:
+//   - ecs.EntityID: 创建的橄榄球僵尸实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewFootballZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie_football", types.UnitIDZombieFootball, config.ZombieDefaultHealth, "zombie_football")
+	if err != nil {
+		return 0, err
+	}
+
+	// 头盔为 I 类金属饰品，可被磁力菇吸走
+	ecs.AddComponent(em, entityID, &components.AccessoryComponent{
+		Pieces: []*components.AccessoryPiece{
+			{
+				Tag:           types.AccessoryFootballHelmet,
+				Tier:          1,
+				Durability:    config.FootballHelmetHealth,
+				MaxDurability: config.FootballHelmetHealth,
+			},
+		},
+	})
+
+	return entityID, nil
+}
+
+// NewNewspaperZombieEntity 创建读报僵尸实体
+// 报纸为 II 类饰品，被打碎后读报僵尸惊愕片刻，然后永久加快移动和啃食速度
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载读报僵尸 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
+//
+// 返回:
+//   - ecs.EntityID: 创建的读报僵尸实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewNewspaperZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie_paper", types.UnitIDZombieNewspaper, config.NewspaperZombieDefaultHealth, "zombie_newspaper")
+	if err != nil {
+		return 0, err
+	}
+
+	ecs.AddComponent(em, entityID, &components.AccessoryComponent{
+		Pieces: []*components.AccessoryPiece{
+			{
+				Tag:           types.AccessoryNewspaper,
+				Tier:          2,
+				Durability:    config.NewspaperHealth,
+				MaxDurability: config.NewspaperHealth,
+			},
+		},
+	})
+
+	// 失去报纸后使用 *_nopaper 动画组合
+	ecs.AddComponent(em, entityID, &components.EnrageComponent{
+		State:       components.EnrageStateCalm,
+		ComboSuffix: "_nopaper",
+	})
+
+	return entityID, nil
+}
+
+// NewScreenDoorZombieEntity 创建铁栅门僵尸实体
+// 铁栅门为 II 类金属饰品，阻挡来自正面的直线子弹，可被磁力菇吸走
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载僵尸 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
+//
+// 返回:
+//   - ecs.EntityID: 创建的铁栅门僵尸实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewScreenDoorZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	// 铁栅门僵尸使用基础僵尸的动画，由 zombie_screendoor 配置显示铁栅门和持门手臂
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie", types.UnitIDZombieScreendoor, config.ZombieDefaultHealth, "zombie_door")
+	if err != nil {
+		return 0, err
+	}
+
+	ecs.AddComponent(em, entityID, &components.AccessoryComponent{
+		Pieces: []*components.AccessoryPiece{
+			{
+				Tag:           types.AccessoryScreenDoor,
+				Tier:          2,
+				Durability:    config.ScreenDoorHealth,
+				MaxDurability: config.ScreenDoorHealth,
+			},
+		},
+	})
+
+	return entityID, nil
+}
+
+// NewDancingZombieEntity 创建舞王僵尸实体
+// 舞王僵尸以太空步入场，停下后在上下前后召唤四名伴舞，并定时补召缺失的伴舞
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载舞王僵尸 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
+//
+// 返回:
+//   - ecs.EntityID: 创建的舞王僵尸实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewDancingZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie_Jackson", types.UnitIDZombieDancing, config.DancingZombieDefaultHealth, "zombie_dancer")
+	if err != nil {
+		return 0, err
+	}
+
+	ecs.AddComponent(em, entityID, &components.DancerComponent{
+		Role:  components.DancerRoleLeader,
+		Phase: components.DancePhaseMoonwalk,
+	})
+
+	return entityID, nil
+}
+
+// NewBackupDancerEntity 创建伴舞僵尸实体
+// 伴舞通常由舞王僵尸召唤，创建后尚未归属任何领舞，由召唤者设置 LeaderID
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载伴舞僵尸 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置
+//
+// 返回:
+//   - ecs.EntityID: 创建的伴舞僵尸实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewBackupDancerEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie_dancer", types.UnitIDZombieBackupDancer, config.BackupDancerDefaultHealth, "zombie_backup")
+	if err != nil {
+		return 0, err
+	}
+
+	ecs.AddComponent(em, entityID, &components.DancerComponent{
+		Role:       components.DancerRoleBackup,
+		Phase:      components.DancePhaseWalk,
+		PhaseTimer: config.DancerWalkDuration,
+	})
+
+	return entityID, nil
+}
+
+// NewJackZombieEntity 创建小丑僵尸实体
+// 小丑僵尸边走边播放八音盒音乐，行走随机时长后打开盒子爆炸，摧毁周围 3x3 范围内的植物
+// 八音盒为金属功能性道具，被磁力菇吸走后变为普通僵尸
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载小丑僵尸 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
+//
+// 返回:
+//   - ecs.EntityID: 创建的小丑僵尸实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewJackZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie_jackbox", types.UnitIDZombieJack, config.JackZombieDefaultHealth, "zombie_jack")
+	if err != nil {
+		return 0, err
+	}
+
+	ecs.AddComponent(em, entityID, &components.JackComponent{
+		PopTimer: randomJackPopTime(),
+	})
+
+	ecs.AddComponent(em, entityID, &components.AccessoryComponent{
+		Pieces: []*components.AccessoryPiece{
+			{Tag: types.AccessoryJackBox, Tier: 0},
+		},
+	})
+
+	return entityID, nil
+}
+
+// randomJackPopTime 随机生成小丑僵尸打开盒子前的行走时长
+// 少数小丑僵尸会提前打开盒子（时长缩短为三分之一）
+func randomJackPopTime() float64 {
+	popTime := config.JackPopTimeMin + rand.Float64()*(config.JackPopTimeMax-config.JackPopTimeMin)
+	if rand.Float64() < config.JackEarlyPopChance {
+		popTime /= 3
+	}
+	return popTime
+}
+
+// NewBalloonZombieEntity 创建气球僵尸实体
+// 气球僵尸乘气球从植物上方飞过，普通子弹无法击中，只有对空植物能击破气球；
+// 气球被击破后僵尸坠落到地面，之后按普通僵尸行走和啃食
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载气球僵尸 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
+//
+// 返回:
+//   - ecs.EntityID: 创建的气球僵尸实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewBalloonZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie_balloon", types.UnitIDZombieBalloon, config.BalloonZombieDefaultHealth, "zombie_balloon")
+	if err != nil {
+		return 0, err
+	}
+
+	ecs.AddComponent(em, entityID, &components.BalloonComponent{
+		State: components.BalloonStateFlying,
+	})
+	if collision, ok := ecs.GetComponent[*components.CollisionComponent](em, entityID); ok {
+		collision.Altitude = config.BalloonAltitude
+	}
+
+	return entityID, nil
+}
+
+// NewDiggerZombieEntity 创建矿工僵尸实体
+// 矿工僵尸在地下挖掘前进（无法被攻击），到达草坪最左列后钻出地面，
+// 头晕片刻后向右行走，从背后啃食植物
+// 矿工镐为金属功能性道具，被磁力菇吸走时就地钻出
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载矿工僵尸 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
+//
+// 返回:
+//   - ecs.EntityID: 创建的矿工僵尸实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewDiggerZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie_digger", types.UnitIDZombieDigger, config.DiggerZombieDefaultHealth, "zombie_digger")
+	if err != nil {
+		return 0, err
+	}
+
+	ecs.AddComponent(em, entityID, &components.DiggerComponent{
+		State: components.DiggerStateTunneling,
+	})
+
+	ecs.AddComponent(em, entityID, &components.AccessoryComponent{
+		Pieces: []*components.AccessoryPiece{
+			{Tag: types.AccessoryPickaxe, Tier: 0},
+		},
+	})
+
+	return entityID, nil
+}
+
+// NewGargantuarZombieEntity 创建白眼巨人实体
+// 巨人用电线杆一击砸扁挡路的植物，生命值降到一半以下时把背上的小鬼扔向草坪深处
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载巨人 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
+//
+// 返回:
+//   - ecs.EntityID: 创建的巨人实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewGargantuarZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	return newGargantuarEntity(em, rm, layout, row, spawnX, types.UnitIDZombieGargantuar, config.GargantuarDefaultHealth)
+}
+
+// NewRedeyeGargantuarZombieEntity 创建红眼巨人实体
+// 红眼巨人的行为与白眼巨人相同，生命值为白眼巨人的两倍
+// 红眼巨人的数量上限由生成规则按轮数限制（见 systems.CheckRedEyeLimit）
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载巨人 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
+//
+// 返回:
+//   - ecs.EntityID: 创建的红眼巨人实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewRedeyeGargantuarZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	return newGargantuarEntity(em, rm, layout, row, spawnX, types.UnitIDZombieGargantuarRedeye, config.GargantuarRedeyeHealth)
+}
+
+// newGargantuarEntity 创建巨人实体（白眼、红眼巨人共用，红眼的外观由 reanim 配置的图片覆盖区分）
+func newGargantuarEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64, unitID string, health int) (ecs.EntityID, error) {
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie_gargantuar", unitID, health, "zombie_gargantuar")
+	if err != nil {
+		return 0, err
+	}
+
+	ecs.AddComponent(em, entityID, &components.GargantuarComponent{
+		State:  components.GargantuarStateWalking,
+		HasImp: true,
+	})
+
+	return entityID, nil
+}
+
+// HideGargantuarImp 隐藏巨人背上的小鬼（已扔出小鬼的巨人，含读档恢复）
+func HideGargantuarImp(em *ecs.EntityManager, entityID ecs.EntityID) {
+	reanim, ok := ecs.GetComponent[*components.ReanimComponent](em, entityID)
+	if !ok {
+		return
+	}
+	if reanim.HiddenTracks == nil {
+		reanim.HiddenTracks = make(map[string]bool)
+	}
+	for _, track := range config.GargantuarImpTracks {
+		reanim.HiddenTracks[track] = true
+	}
+}
+
+// NewImpZombieEntity 创建小鬼僵尸实体
+// 小鬼通常由巨人扔出（由 BehaviorSystem 添加 ImpComponent 控制飞行和落地），
+// 落地后是一只移动很快、生命值很低的僵尸
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载小鬼 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置
+//
+// 返回:
+//   - ecs.EntityID: 创建的小鬼实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewImpZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	return newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie_imp", types.UnitIDZombieImp, config.ImpDefaultHealth, "zombie_imp")
+}
+
+// NewBungeeZombieEntity 创建蹦极僵尸实体
+// 蹦极僵尸不走路：激活后在随机一株植物所在的格子上显示准星，延迟后沿蹦极绳从高空落下，
+// 抓起植物升回高空（由 BehaviorSystem 控制）。抓取动画结束前受到伤害会放弃偷取
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载蹦极僵尸 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4，蹦极僵尸激活后会移动到目标格子所在的行)
+//   - spawnX: 生成的世界坐标X位置
+//
+// 返回:
+//   - ecs.EntityID: 创建的蹦极僵尸实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewBungeeZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie_bungi", types.UnitIDZombieBungee, config.BungeeZombieDefaultHealth, "zombie_bungee")
+	if err != nil {
+		return 0, err
+	}
+
+	ecs.AddComponent(em, entityID, &components.BungeeComponent{
+		State: components.BungeeStateTargeting,
+	})
+
+	return entityID, nil
+}
+
+// NewBungeeTargetEntity 创建蹦极僵尸的目标准星实体
+// 准星显示在蹦极僵尸将要落下的格子上，由 BehaviorSystem 负责删除
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载准星图像）
+//   - x, y: 目标格子中心位置（世界坐标）
+//
+// 返回:
+//   - ecs.EntityID: 创建的准星实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewBungeeTargetEntity(em *ecs.EntityManager, rm ResourceLoader, x, y float64) (ecs.EntityID, error) {
+	targetImage, err := rm.LoadImage("assets/images/BungeeTarget.png")
+	if err != nil {
+		return 0, fmt.Errorf("failed to load bungee target image: %w", err)
+	}
+
+	entityID := em.CreateEntity()
+
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: x,
+		Y: y,
+	})
+
+	em.AddComponent(entityID, createSimpleReanimComponent(targetImage, "bungee_target"))
+
+	return entityID, nil
+}
+
+// NewBungeeCordEntity 创建蹦极绳实体
+// 蹦极绳图片按绳长纵向拉伸（ScaleComponent），由 BehaviorSystem 随蹦极僵尸的高度更新位置和长度
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载蹦极绳图像）
+//   - x, y: 蹦极绳中点位置（世界坐标）
+//
+// 返回:
+//   - ecs.EntityID: 创建的蹦极绳实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewBungeeCordEntity(em *ecs.EntityManager, rm ResourceLoader, x, y float64) (ecs.EntityID, error) {
+	cordImage, err := rm.LoadImage("assets/images/BungeeCord.png")
+	if err != nil {
+		return 0, fmt.Errorf("failed to load bungee cord image: %w", err)
+	}
+
+	entityID := em.CreateEntity()
+
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: x,
+		Y: y,
+	})
+
+	em.AddComponent(entityID, &components.ScaleComponent{
+		ScaleX: 1.0,
+		ScaleY: 1.0,
+	})
+
+	em.AddComponent(entityID, createSimpleReanimComponent(cordImage, "bungee_cord"))
+
+	return entityID, nil
+}
+
+// NewLadderZombieEntity 创建扶梯僵尸实体
+// 梯子为 II 类金属饰品，阻挡来自正面的直线子弹，可被磁力菇吸走；
+// 扶梯僵尸把梯子架在遇到的第一株坚果墙或高坚果上，同一行之后的僵尸顺着梯子翻过去
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载扶梯僵尸 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
+//
+// 返回:
+//   - ecs.EntityID: 创建的扶梯僵尸实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewLadderZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie_ladder", types.UnitIDZombieLadder, config.LadderZombieDefaultHealth, "zombie_ladder")
+	if err != nil {
+		return 0, err
+	}
+
+	ecs.AddComponent(em, entityID, &components.AccessoryComponent{
+		Pieces: []*components.AccessoryPiece{
+			{
+				Tag:           types.AccessoryLadder,
+				Tier:          2,
+				Durability:    config.LadderShieldHealth,
+				MaxDurability: config.LadderShieldHealth,
+			},
+		},
+	})
+	ecs.AddComponent(em, entityID, &components.LadderZombieComponent{})
+
+	return entityID, nil
+}
+
+// NewPlacedLadderEntity 在植物上架梯子：创建靠在植物右侧的梯子实体，并为植物添加 LadderedComponent
+// 植物被吃掉或铲除后梯子由 BehaviorSystem 删除
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载梯子图像）
+//   - plantID: 被架上梯子的植物
+//
+// 返回:
+//   - ecs.EntityID: 创建的梯子实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewPlacedLadderEntity(em *ecs.EntityManager, rm ResourceLoader, plantID ecs.EntityID) (ecs.EntityID, error) {
+	plantPos, ok := ecs.GetComponent[*components.PositionComponent](em, plantID)
+	if !ok {
+		return 0, fmt.Errorf("plant %d has no position", plantID)
+	}
+
+	ladderImage, err := rm.LoadImage("assets/reanim/Zombie_ladder_1.png")
+	if err != nil {
+		return 0, fmt.Errorf("failed to load ladder image: %w", err)
+	}
+
+	entityID := em.CreateEntity()
+
+	em.AddComponent(entityID, &components.PositionComponent{
+		X: plantPos.X + config.LadderPlacedOffsetX,
+		Y: plantPos.Y + config.LadderPlacedOffsetY,
+	})
+
+	em.AddComponent(entityID, createSimpleReanimComponent(ladderImage, "placed_ladder"))
+
+	em.AddComponent(entityID, &components.PlacedLadderComponent{
+		PlantID: plantID,
+	})
+
+	ecs.AddComponent(em, plantID, &components.LadderedComponent{
+		LadderEntityID: entityID,
+	})
+
+	return entityID, nil
+}
+
+// NewCatapultZombieEntity 创建投篮车僵尸实体
+// 投篮车行驶到草坪右侧停下，向同行最靠后的植物抛投篮球；篮球用完后继续前进碾压植物
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载投篮车僵尸 Reanim 资源）
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
+//
+// 返回:
+//   - ecs.EntityID: 创建的投篮车僵尸实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewCatapultZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie_catapult", types.UnitIDZombieCatapult, config.CatapultZombieDefaultHealth, "zombie_catapult")
+	if err != nil {
+		return 0, err
+	}
+
+	ecs.AddComponent(em, entityID, &components.CatapultComponent{
+		State:       components.CatapultStateDriving,
+		Basketballs: config.CatapultBasketballCount,
+	})
+
+	return entityID, nil
+}
+
+// NewZomboniZombieEntity 创建雪橇车僵尸实体
+// 雪橇车碾过挡路的植物并在身后铺设冰道，冰道上不能种植，雪橇队僵尸可以在冰道上滑行
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
+//
+// 返回:
+//   - ecs.EntityID: 创建的雪橇车僵尸实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewZomboniZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie_zamboni", types.UnitIDZombieZomboni, config.ZomboniZombieDefaultHealth, "zombie_zomboni")
+	if err != nil {
+		return 0, err
+	}
+
+	ecs.AddComponent(em, entityID, &components.ZomboniComponent{})
+
+	return entityID, nil
+}
+
+// NewBobsledZombieEntity 创建雪橇队僵尸实体（队长）
+// 队长激活时由 BehaviorSystem 召集其余队员，全队共乘一架雪橇在冰道上滑行，冰道到头后一起跳下雪橇步行
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置（通常在屏幕右侧外）
+//
+// 返回:
+//   - ecs.EntityID: 创建的雪橇队僵尸实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewBobsledZombieEntity(em *ecs.EntityManager, rm ResourceLoader, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	entityID, err := newZombieBaseEntity(em, rm, layout, row, spawnX,
+		"Zombie_bobsled", types.UnitIDZombieBobsled, config.BobsledZombieDefaultHealth, "zombie_sled")
+	if err != nil {
+		return 0, err
+	}
+
+	ecs.AddComponent(em, entityID, &components.BobsledComponent{State: components.BobsledStateRiding})
+
+	return entityID, nil
+}
+
+// NewBobsledSledEntity 创建雪橇队共乘的雪橇实体
+// 雪橇位置为最前方座位的位置（X 为队员坐标，Y 为行中心），由 BehaviorSystem 带着队员滑行
+//
+// 参数:
+//   - em: 实体管理器
+//   - riders: 各座位上的队员
+//   - x, y: 最前方座位的世界坐标
+//
+// 返回:
+//   - ecs.EntityID: 创建的雪橇实体ID
+func NewBobsledSledEntity(em *ecs.EntityManager, riders [components.BobsledTeamSize]ecs.EntityID, x, y float64) ecs.EntityID {
+	entityID := em.CreateEntity()
+
+	ecs.AddComponent(em, entityID, &components.PositionComponent{X: x, Y: y})
+	ecs.AddComponent(em, entityID, &components.BobsledSledComponent{Riders: riders})
+
+	for _, riderID := range riders {
+		if bobsled, ok := ecs.GetComponent[*components.BobsledComponent](em, riderID); ok {
+			bobsled.Sled = entityID
+		}
+	}
+
+	return entityID
+}
+
+// NewZombieEntityByType 根据僵尸类型字符串创建僵尸实体
+// 类型字符串与关卡配置一致（如 "basic", "conehead", "polevaulter"）
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器
+//   - zombieType: 僵尸类型字符串
+//   - layout: 当前场景的草坪行布局
+//   - row: 生成行索引 (0-4)
+//   - spawnX: 生成的世界坐标X位置
+//
+// 返回:
+//   - ecs.EntityID: 创建的僵尸实体ID，如果失败返回 0
+//   - error: 未知类型或创建失败时返回错误信息
+func NewZombieEntityByType(em *ecs.EntityManager, rm ResourceLoader, zombieType string, layout config.LawnLayout, row int, spawnX float64) (ecs.EntityID, error) {
+	switch types.ZombieTypeFromString(zombieType) {
+	case types.ZombieBasic:
+		return NewZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieConehead:
+		return NewConeheadZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieBuckethead:
+		return NewBucketheadZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieFlag:
+		return NewFlagZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombiePolevaulter:
+		return NewPolevaulterZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombiePogo:
+		return NewPogoZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieFootball:
+		return NewFootballZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieNewspaper:
+		return NewNewspaperZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieScreendoor:
+		return NewScreenDoorZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieDancing:
+		return NewDancingZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieBackupDancer:
+		return NewBackupDancerEntity(em, rm, layout, row, spawnX)
+	case types.ZombieJack:
+		return NewJackZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieBalloon:
+		return NewBalloonZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieDigger:
+		return NewDiggerZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieGargantuar:
+		return NewGargantuarZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieGargantuarRedeye:
+		return NewRedeyeGargantuarZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieImp:
+		return NewImpZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieBungee:
+		return NewBungeeZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieLadder:
+		return NewLadderZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieCatapult:
+		return NewCatapultZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieZomboni:
+		return NewZomboniZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieBobsled:
+		return NewBobsledZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieDucky:
+		return NewDuckyZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieSnorkel:
+		return NewSnorkelZombieEntity(em, rm, layout, row, spawnX)
+	case types.ZombieDolphinRider:
+		return NewDolphinRiderZombieEntity(em, rm, layout, row, spawnX)
+	default:
+		return 0, fmt.Errorf("unknown zombie type '%s'", zombieType)
+	}
+}
+
 // ActivateZombie 激活僵尸实体，使其开始行走
 //
 // 该函数提供公共的僵尸激活逻辑，可被 WaveSpawnSystem 和验证程序等调用
@@ -498,9 +1457,73 @@ func NewFlagZombieEntity(em *ecs.EntityManager, rm ResourceLoader, row int, spaw
 // 注意：此函数不处理波次状态（ZombieWaveStateComponent），
 // 波次相关逻辑由 WaveSpawnSystem 负责
 func ActivateZombie(em *ecs.EntityManager, entityID ecs.EntityID) {
+	// 持有跳跃道具的僵尸使用道具的移动速度和动画
+	vault, hasVault := ecs.GetComponent[*components.VaultComponent](em, entityID)
+	hasVault = hasVault && vault.HasProp()
+
+	// 舞王僵尸以太空步入场
+	dancer, isDancer := ecs.GetComponent[*components.DancerComponent](em, entityID)
+	moonwalk := isDancer && dancer.Phase == components.DancePhaseMoonwalk
+
+	// 气球僵尸乘气球飞行入场
+	balloon, hasBalloon := ecs.GetComponent[*components.BalloonComponent](em, entityID)
+	flying := hasBalloon && balloon.IsFlying()
+
+	// 矿工僵尸在地下挖掘前进
+	digger, hasDigger := ecs.GetComponent[*components.DiggerComponent](em, entityID)
+	tunneling := hasDigger && digger.State == components.DiggerStateTunneling
+
+	// 蹦极僵尸不走路，从高空落下
+	bungee := ecs.HasComponent[*components.BungeeComponent](em, entityID)
+
+	// 扶梯僵尸扛着梯子入场
+	carryingLadder := false
+	if accessory, ok := ecs.GetComponent[*components.AccessoryComponent](em, entityID); ok {
+		carryingLadder = accessory.Has(types.AccessoryLadder)
+	}
+
+	// 投篮车、雪橇车使用固定速度行驶
+	catapult := ecs.HasComponent[*components.CatapultComponent](em, entityID)
+	zomboni := ecs.HasComponent[*components.ZomboniComponent](em, entityID)
+
+	// 雪橇队僵尸乘雪橇入场（没有冰道时由 BehaviorSystem 让其立即跳下）
+	bobsled, hasBobsled := ecs.GetComponent[*components.BobsledComponent](em, entityID)
+	riding := hasBobsled && bobsled.State == components.BobsledStateRiding
+
+	// 小鬼僵尸移动很快
+	behaviorComp, hasBehavior := ecs.GetComponent[*components.BehaviorComponent](em, entityID)
+	isImp := hasBehavior && behaviorComp.UnitID == types.UnitIDZombieImp
+
 	// 设置行走速度
 	if vel, ok := ecs.GetComponent[*components.VelocityComponent](em, entityID); ok {
 		vel.VX = config.ZombieWalkSpeed
+		if hasVault {
+			vel.VX = vault.MoveSpeed
+		}
+		if moonwalk {
+			vel.VX = config.DancingMoonwalkSpeed
+		}
+		if flying {
+			vel.VX = config.BalloonFlySpeed
+		}
+		if tunneling {
+			vel.VX = config.DiggerTunnelSpeed
+		}
+		if isImp {
+			vel.VX = config.ImpWalkSpeed
+		}
+		if bungee {
+			vel.VX = 0
+		}
+		if catapult {
+			vel.VX = config.CatapultDriveSpeed
+		}
+		if zomboni {
+			vel.VX = config.ZomboniDriveSpeed
+		}
+		if riding {
+			vel.VX = config.BobsledSlideSpeed
+		}
 	}
 
 	// 切换动画状态并添加行走动画命令
@@ -518,6 +1541,28 @@ func ActivateZombie(em *ecs.EntityManager, entityID ecs.EntityID) {
 		if rand.Float32() < 0.5 {
 			walkCombo = "walk2"
 		}
+		if hasVault {
+			walkCombo = vault.MoveCombo
+		}
+		if moonwalk {
+			walkCombo = "moonwalk"
+		}
+		if flying {
+			walkCombo = "fly"
+		}
+		if tunneling {
+			walkCombo = "dig"
+		}
+		if bungee {
+			behavior.ZombieAnimState = components.ZombieAnimIdle
+			walkCombo = "idle"
+		}
+		if carryingLadder {
+			walkCombo = "ladderwalk"
+		}
+		if riding {
+			walkCombo = "ride"
+		}
 
 		ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
 			UnitID:    unitID,
@@ -526,3 +1571,47 @@ func ActivateZombie(em *ecs.EntityManager, entityID ecs.EntityID) {
 		})
 	}
 }
+
+// FaceZombieRight 僵尸镜像显示，面朝右（被魅惑的僵尸、转身的矿工僵尸）
+func FaceZombieRight(em *ecs.EntityManager, entityID ecs.EntityID) {
+	if scale, ok := ecs.GetComponent[*components.ScaleComponent](em, entityID); ok {
+		scale.ScaleX = -math.Abs(scale.ScaleX)
+	} else {
+		ecs.AddComponent(em, entityID, &components.ScaleComponent{
+			ScaleX: -1.0,
+			ScaleY: 1.0,
+		})
+	}
+}
+
+// CharmZombie 将僵尸实体转为被魅惑状态（倒戈到植物阵营）
+//
+// 被魅惑的僵尸将：
+// - 加入植物阵营（FactionComponent），与其他僵尸互相视为敌人
+// - 水平镜像显示（ScaleComponent.ScaleX 取负）
+// - 向右移动（若有速度组件）
+//
+// 参数:
+//   - em: 实体管理器
+//   - entityID: 僵尸实体ID
+//
+// 注意：此函数只修改实体组件，不处理击杀计数、动画切换等游戏逻辑，
+// 这些由 BehaviorSystem 负责（存档恢复时也会直接调用此函数）
+func CharmZombie(em *ecs.EntityManager, entityID ecs.EntityID) {
+	ecs.AddComponent(em, entityID, &components.FactionComponent{
+		Faction: components.FactionPlant,
+	})
+
+	// 镜像显示（面朝右）
+	FaceZombieRight(em, entityID)
+
+	// 碰撞盒偏移随朝向镜像（如旗帜僵尸）
+	if collision, ok := ecs.GetComponent[*components.CollisionComponent](em, entityID); ok {
+		collision.OffsetX = -collision.OffsetX
+	}
+
+	// 向右移动
+	if vel, ok := ecs.GetComponent[*components.VelocityComponent](em, entityID); ok {
+		vel.VX = config.CharmedZombieWalkSpeed
+	}
+}
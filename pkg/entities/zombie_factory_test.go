@@ -44,7 +44,7 @@ func TestNewZombieEntity(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// 创建僵尸实体
-			zombieID, err := NewZombieEntity(em, rm, tt.row, tt.spawnX)
+			zombieID, err := NewZombieEntity(em, rm, config.GetLawnLayout("day"), tt.row, tt.spawnX)
 			if (err != nil) != tt.wantErr {
 				t.Fatalf("NewZombieEntity() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -173,7 +173,7 @@ func TestNewZombieEntity_ErrorHandling(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			zombieID, err := NewZombieEntity(tt.em, tt.rm, 0, 1450.0)
+			zombieID, err := NewZombieEntity(tt.em, tt.rm, config.GetLawnLayout("day"), 0, 1450.0)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NewZombieEntity() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -213,7 +213,7 @@ func TestNewConeheadZombieEntity(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// 创建路障僵尸实体
-			zombieID, err := NewConeheadZombieEntity(em, rm, tt.row, tt.spawnX)
+			zombieID, err := NewConeheadZombieEntity(em, rm, config.GetLawnLayout("day"), tt.row, tt.spawnX)
 			if (err != nil) != tt.wantErr {
 				t.Fatalf("NewConeheadZombieEntity() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -237,17 +237,18 @@ func TestNewConeheadZombieEntity(t *testing.T) {
 				}
 			}
 
-			// 验证 ArmorComponent (关键特性)
-			armorComp, ok := em.GetComponent(zombieID, reflect.TypeOf(&components.ArmorComponent{}))
+			// 验证头部防具 (关键特性)
+			accessoryComp, ok := em.GetComponent(zombieID, reflect.TypeOf(&components.AccessoryComponent{}))
 			if !ok {
-				t.Error("Conehead zombie entity should have ArmorComponent")
+				t.Error("Conehead zombie entity should have AccessoryComponent")
+			} else if armor := accessoryComp.(*components.AccessoryComponent).Helmet(); armor == nil {
+				t.Error("Conehead zombie entity should have a helmet")
 			} else {
-				armor := armorComp.(*components.ArmorComponent)
-				if armor.CurrentArmor != config.ConeheadZombieArmorHealth {
-					t.Errorf("Expected CurrentArmor %d, got %d", config.ConeheadZombieArmorHealth, armor.CurrentArmor)
+				if armor.Durability != config.ConeheadZombieArmorHealth {
+					t.Errorf("Expected Durability %d, got %d", config.ConeheadZombieArmorHealth, armor.Durability)
 				}
-				if armor.MaxArmor != config.ConeheadZombieArmorHealth {
-					t.Errorf("Expected MaxArmor %d, got %d", config.ConeheadZombieArmorHealth, armor.MaxArmor)
+				if armor.MaxDurability != config.ConeheadZombieArmorHealth {
+					t.Errorf("Expected MaxDurability %d, got %d", config.ConeheadZombieArmorHealth, armor.MaxDurability)
 				}
 			}
 
@@ -311,7 +312,7 @@ func TestNewBucketheadZombieEntity(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// 创建铁桶僵尸实体
-			zombieID, err := NewBucketheadZombieEntity(em, rm, tt.row, tt.spawnX)
+			zombieID, err := NewBucketheadZombieEntity(em, rm, config.GetLawnLayout("day"), tt.row, tt.spawnX)
 			if (err != nil) != tt.wantErr {
 				t.Fatalf("NewBucketheadZombieEntity() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -335,17 +336,18 @@ func TestNewBucketheadZombieEntity(t *testing.T) {
 				}
 			}
 
-			// 验证 ArmorComponent (关键特性)
-			armorComp, ok := em.GetComponent(zombieID, reflect.TypeOf(&components.ArmorComponent{}))
+			// 验证头部防具 (关键特性)
+			accessoryComp, ok := em.GetComponent(zombieID, reflect.TypeOf(&components.AccessoryComponent{}))
 			if !ok {
-				t.Error("Buckethead zombie entity should have ArmorComponent")
+				t.Error("Buckethead zombie entity should have AccessoryComponent")
+			} else if armor := accessoryComp.(*components.AccessoryComponent).Helmet(); armor == nil {
+				t.Error("Buckethead zombie entity should have a helmet")
 			} else {
-				armor := armorComp.(*components.ArmorComponent)
-				if armor.CurrentArmor != config.BucketheadZombieArmorHealth {
-					t.Errorf("Expected CurrentArmor %d, got %d", config.BucketheadZombieArmorHealth, armor.CurrentArmor)
+				if armor.Durability != config.BucketheadZombieArmorHealth {
+					t.Errorf("Expected Durability %d, got %d", config.BucketheadZombieArmorHealth, armor.Durability)
 				}
-				if armor.MaxArmor != config.BucketheadZombieArmorHealth {
-					t.Errorf("Expected MaxArmor %d, got %d", config.BucketheadZombieArmorHealth, armor.MaxArmor)
+				if armor.MaxDurability != config.BucketheadZombieArmorHealth {
+					t.Errorf("Expected MaxDurability %d, got %d", config.BucketheadZombieArmorHealth, armor.MaxDurability)
 				}
 			}
 
@@ -0,0 +1,138 @@
+package entities
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// NewZombossEntity 创建僵王博士实体
+// 僵王博士不走路，由 BehaviorSystem 的状态机驱动攻击循环；
+// Reanim 原点位于屏幕左上角，入场动画从草坪右侧走入
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载僵王博士 Reanim 资源）
+//
+// 返回:
+//   - ecs.EntityID: 创建的僵王博士实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewZombossEntity(em *ecs.EntityManager, rm ResourceLoader) (ecs.EntityID, error) {
+	if em == nil {
+		return 0, fmt.Errorf("entity manager cannot be nil")
+	}
+	if rm == nil {
+		return 0, fmt.Errorf("resource manager cannot be nil")
+	}
+
+	reanimXML := rm.GetReanimXML("Zombie_boss")
+	partImages := rm.GetReanimPartImages("Zombie_boss")
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load Zombie_boss Reanim resources")
+	}
+
+	entityID := em.CreateEntity()
+
+	ecs.AddComponent(em, entityID, &components.PositionComponent{
+		X: config.GameCameraX,
+		Y: 0,
+	})
+
+	ecs.AddComponent(em, entityID, &components.ReanimComponent{
+		ReanimName:    "Zombie_boss",
+		ReanimXML:     reanimXML,
+		PartImages:    partImages,
+		LastAnimFrame: -1,
+	})
+
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    types.UnitIDZombieDrZomboss,
+		ComboName: "enter",
+		Processed: false,
+	})
+
+	ecs.AddComponent(em, entityID, &components.BehaviorComponent{
+		Type:   components.BehaviorZombieBoss,
+		UnitID: types.UnitIDZombieDrZomboss,
+	})
+
+	ecs.AddComponent(em, entityID, &components.HealthComponent{
+		CurrentHealth: config.ZombossHealth,
+		MaxHealth:     config.ZombossHealth,
+	})
+
+	// 碰撞盒只覆盖低垂的头部，头部抬起时 PhysicsSystem 忽略僵王博士
+	ecs.AddComponent(em, entityID, &components.CollisionComponent{
+		Width:   config.ZombossHeadWidth,
+		Height:  config.ZombossHeadHeight,
+		OffsetX: config.ZombossHeadOffsetX,
+		OffsetY: config.ZombossHeadOffsetY,
+	})
+
+	ecs.AddComponent(em, entityID, &components.ZombossComponent{
+		State: components.ZombossStateEntering,
+	})
+
+	log.Printf("[ZombossFactory] 僵王博士 %d 入场，生命值 %d", entityID, config.ZombossHealth)
+
+	return entityID, nil
+}
+
+// NewZombossBallEntity 创建僵王博士吐出的火球或冰球
+// 球从草坪右边缘开始沿指定行向左滚动，碾碎经过的植物
+//
+// 参数:
+//   - em: 实体管理器
+//   - rm: 资源管理器（用于加载火球/冰球 Reanim 资源）
+//   - element: 球的属性（火球或冰球）
+//   - layout: 当前场景的草坪行布局
+//   - row: 滚动所在的行
+//
+// 返回:
+//   - ecs.EntityID: 创建的球实体ID，如果失败返回 0
+//   - error: 如果创建失败返回错误信息
+func NewZombossBallEntity(em *ecs.EntityManager, rm ResourceLoader, element components.ZombossElement, layout config.LawnLayout, row int) (ecs.EntityID, error) {
+	reanimName, unitID := "Zombie_boss_fireball", "zombie_boss_fireball"
+	if element == components.ZombossElementIce {
+		reanimName, unitID = "Zombie_boss_iceball", "zombie_boss_iceball"
+	}
+
+	reanimXML := rm.GetReanimXML(reanimName)
+	partImages := rm.GetReanimPartImages(reanimName)
+	if reanimXML == nil || partImages == nil {
+		return 0, fmt.Errorf("failed to load %s Reanim resources", reanimName)
+	}
+
+	entityID := em.CreateEntity()
+
+	ecs.AddComponent(em, entityID, &components.PositionComponent{
+		X: config.GridWorldEndX - config.CellWidth/2,
+		Y: layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2,
+	})
+
+	ecs.AddComponent(em, entityID, &components.ReanimComponent{
+		ReanimName:    reanimName,
+		ReanimXML:     reanimXML,
+		PartImages:    partImages,
+		LastAnimFrame: -1,
+	})
+
+	ecs.AddComponent(em, entityID, &components.AnimationCommandComponent{
+		UnitID:    unitID,
+		ComboName: "roll",
+		Processed: false,
+	})
+
+	ecs.AddComponent(em, entityID, &components.VelocityComponent{VX: config.ZombossBallSpeed})
+
+	ecs.AddComponent(em, entityID, &components.ZombossBallComponent{
+		Element: element,
+		Row:     row,
+	})
+
+	return entityID, nil
+}
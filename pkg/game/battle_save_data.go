@@ -2,6 +2,8 @@ package game
 
 import (
 	"time"
+
+	"github.com/gonewx/pvz/pkg/config"
 )
 
 // BattleSaveVersion 战斗存档版本号
@@ -31,8 +33,13 @@ type BattleSaveData struct {
 	SpawnedWaves        []bool  // 已生成波次标记
 	TotalZombiesSpawned int     // 已生成僵尸总数
 	ZombiesKilled       int     // 已消灭僵尸数
+	ZombiesSummoned     int     // 关卡配置之外被召唤的僵尸数（伴舞等）
+	BossSpawned         bool    // Boss 关卡的僵王博士是否已入场
 	Sun                 int     // 当前阳光数量
 
+	// 程序生成的波次（WaveGenerator 生成的波次每次进入关卡都不同，必须随存档保存）
+	GeneratedWaves []config.WaveConfig // 生成后的波次配置（可选，固定波次关卡和生存模式为 nil）
+
 	// 教学状态
 	Tutorial *TutorialSaveData // 教学进度数据（可选，非教学关卡为 nil）
 
@@ -42,6 +49,11 @@ type BattleSaveData struct {
 	Projectiles []ProjectileData // 子弹数据
 	Suns        []SunData        // 阳光数据
 	Lawnmowers  []LawnmowerData  // 除草车数据
+	IceTrail    []IceCellData    // 冰道格子数据（雪橇车僵尸留下）
+	Graves      []GraveData      // 墓碑数据（夜晚关卡）
+
+	// Boss 关卡数据（僵王博士本体随 Zombies 保存）
+	ZombossBalls []ZombossBallData // 僵王博士吐出的火球和冰球
 
 	// 保龄球模式数据（Level 1-5）
 	BowlingNuts    []BowlingNutData    // 保龄球坚果数据
@@ -49,6 +61,12 @@ type BattleSaveData struct {
 	LevelPhase     *LevelPhaseData     // 关卡阶段数据（可选）
 	DaveDialogue   *DaveDialogueData   // Dave 对话数据（可选）
 	GuidedTutorial *GuidedTutorialData // 强引导教学数据（可选）
+
+	// 生存模式数据
+	Survival *SurvivalSaveData // 生存模式数据（可选，非生存模式为 nil）
+
+	// 小游戏规则数据
+	MiniGame *MiniGameSaveData // 小游戏规则状态（可选，没有专用字段的规则使用）
 }
 
 // TutorialSaveData 教学进度序列化数据
@@ -76,12 +94,18 @@ type PlantData struct {
 	TimerTargetTime  float64 // 计时器目标时间（秒），用于恢复向日葵等变周期植物
 	BlinkTimer       float64 // 眨眼计时器（秒）
 	AttackAnimState  int     // 攻击动画状态 (0=空闲, 1=攻击中)
+	ReloadTimer      float64 // 玉米加农炮剩余装填时间（秒），0 表示已装填
+	HasLadder        bool    // 是否被扶梯僵尸架上了梯子
+
+	// 磁力菇状态：冷却中吸附着吸走的饰品
+	MagnetRechargeTimer float64 // 磁力菇剩余冷却时间（秒），0 表示可以吸取
+	MagnetHeldAccessory string  // 磁力菇吸附的饰品标签（如 "bucket"），空闲时为空
 }
 
 // ZombieData 僵尸序列化数据
 //
 // 包含僵尸实体的核心状态，用于恢复僵尸实体。
-// 字段与 BehaviorComponent、HealthComponent、ArmorComponent 等组件对应。
+// 字段与 BehaviorComponent、HealthComponent、AccessoryComponent 等组件对应。
 type ZombieData struct {
 	ZombieType   string  // 僵尸类型ID，如 "basic", "conehead", "buckethead"
 	X            float64 // X坐标（世界坐标）
@@ -89,21 +113,70 @@ type ZombieData struct {
 	VelocityX    float64 // X轴速度（像素/秒）
 	Health       int     // 当前生命值
 	MaxHealth    int     // 最大生命值
-	ArmorHealth  int     // 当前护甲值
-	ArmorMax     int     // 最大护甲值
+	ArmorHealth  int     // 头部防具（路障、铁桶等）的当前耐久
+	ArmorMax     int     // 头部防具的最大耐久
 	Lane         int     // 所在行号（1-5）
 	BehaviorType string  // 行为类型，如 "basic", "eating", "dying"
 	IsEating     bool    // 是否正在啃食
+
+	// Accessories 僵尸身上剩余的饰品标签（如 "bucket"）
+	// 用于恢复被磁力菇吸走饰品的僵尸；旧存档中为 nil，表示保持工厂默认饰品
+	Accessories []string
+
+	// IsCharmed 是否被魅惑（如啃食催眠菇后倒戈到植物阵营）
+	IsCharmed bool
+
+	// PropLost 跳跃僵尸是否已失去道具（撑杆跳僵尸已跳过植物），气球僵尸的气球已被击破，
+	// 巨人已扔出背上的小鬼，或雪橇队僵尸已跳下雪橇
+	// 跳跳杆是否仍在由 Accessories 记录
+	PropLost bool
+
+	// ShieldHealth 手持防具（报纸、铁栅门）的剩余耐久
+	// 旧存档中为 0，表示保持工厂默认耐久
+	ShieldHealth int
+
+	// DiggerSurfaced 矿工僵尸是否已钻出地面
+	// 出土中（钻出、落地、头晕）的矿工僵尸读档后直接行走
+	DiggerSurfaced bool
+
+	// DiggerBackward 已出土的矿工僵尸是否向右行走
+	DiggerBackward bool
+
+	// Basketballs 投篮车剩余的篮球数量
+	Basketballs int
+
+	// Zomboss 僵王博士的攻击循环状态（其他僵尸为 nil）
+	Zomboss *ZombossData
+}
+
+// ZombossData 僵王博士序列化数据
+//
+// 与 ZombossComponent 对应，读档后从保存的状态继续攻击循环。
+type ZombossData struct {
+	State       int     // 当前状态（components.ZombossState）
+	Timer       float64 // 当前状态的剩余时间（秒）
+	AttackCount int     // 上次低头之后进行的攻击次数
+	TargetRow   int     // 当前攻击的目标行
+	TargetCol   int     // 房车落点的起始列
+	BallElement int     // 本次低头要吐出的球的属性（components.ZombossElement）
+}
+
+// ZombossBallData 僵王博士吐出的火球或冰球序列化数据
+type ZombossBallData struct {
+	Element int     // 球的属性（components.ZombossElement）
+	Row     int     // 滚动所在的行
+	X       float64 // X坐标（世界坐标）
 }
 
 // ProjectileData 子弹序列化数据
 //
 // 包含子弹实体的核心状态，用于恢复子弹实体。
 type ProjectileData struct {
-	Type      string  // 子弹类型ID，如 "pea", "snow_pea"
+	Type      string  // 子弹类型ID，如 "pea", "star", "cattail_spike"
 	X         float64 // X坐标（世界坐标）
 	Y         float64 // Y坐标（世界坐标）
 	VelocityX float64 // X轴速度（像素/秒）
+	VelocityY float64 // Y轴速度（像素/秒，星星和香蒲尖刺使用）
 	Damage    int     // 伤害值
 	Lane      int     // 所在行号（1-5）
 }
@@ -132,6 +205,43 @@ type LawnmowerData struct {
 	Active    bool    // 是否激活（正在移动）
 }
 
+// IceCellData 冰道格子序列化数据
+//
+// 记录草坪上一个有冰道的格子及冰道剩余时间，用于恢复冰道。
+type IceCellData struct {
+	Row       int     // 行索引（0-4）
+	Col       int     // 列索引（0-8）
+	Remaining float64 // 冰道剩余时间（秒）
+}
+
+// GraveData 墓碑序列化数据
+//
+// 记录草坪上一块尚未被吞掉的墓碑所在格子。
+type GraveData struct {
+	Row int // 行索引（0-4）
+	Col int // 列索引（0-8）
+}
+
+// SurvivalSaveData 生存模式序列化数据
+//
+// 生存模式的波次在运行时生成，必须随存档保存当前这组旗帜的波次，读档后才能继续同一组旗帜。
+type SurvivalSaveData struct {
+	Flags  int                 // 已坚持的旗帜数
+	Waves  []config.WaveConfig // 当前这组旗帜的波次配置
+	Plants []string            // 植物选择栏中的植物
+
+	// AwaitingReselection 是否在两组旗帜之间等待重新选择植物（Waves 已是下一组旗帜的波次）
+	AwaitingReselection bool
+}
+
+// MiniGameSaveData 小游戏规则状态序列化数据
+//
+// 规则模块自行编码状态（通常为 gob），恢复时按 RuleID 校验后解码。
+type MiniGameSaveData struct {
+	RuleID string // 规则名（specialRules）
+	State  []byte // 规则模块编码的状态
+}
+
 // BattleSaveInfo 战斗存档信息预览
 //
 // 用于在不加载完整存档的情况下显示存档信息。
@@ -8,7 +8,9 @@ import (
 	"time"
 
 	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
 	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/types"
 	"github.com/quasilyte/gdata/v2"
 )
 
@@ -25,7 +27,8 @@ import (
 //   - 可以访问 EntityManager 收集实体数据
 //   - 不直接修改游戏状态，仅负责序列化/反序列化
 type BattleSerializer struct {
-	gdataManager *gdata.Manager // gdata 跨平台存储管理器，可为 nil（降级模式）
+	gdataManager *gdata.Manager        // gdata 跨平台存储管理器，可为 nil（降级模式）
+	saveHook     func(*BattleSaveData) // 编码前的额外收集回调（小游戏规则状态），可为 nil
 }
 
 // NewBattleSerializer 创建战斗序列化器实例
@@ -40,6 +43,13 @@ func NewBattleSerializer(gdataManager *gdata.Manager) *BattleSerializer {
 	}
 }
 
+// SetSaveHook 设置编码前的额外收集回调
+//
+// 小游戏规则通过该回调把自己的状态写入存档数据
+func (s *BattleSerializer) SetSaveHook(hook func(saveData *BattleSaveData)) {
+	s.saveHook = hook
+}
+
 // SaveBattle 保存战斗状态到 gdata
 //
 // Story 20.3: 从 EntityManager 收集所有实体数据，从 GameState 收集关卡状态，
@@ -76,17 +86,24 @@ func (s *BattleSerializer) SaveBattle(em *ecs.EntityManager, gs *GameState, user
 	saveData.Projectiles = s.collectProjectileData(em)
 	saveData.Suns = s.collectSunData(em)
 	saveData.Lawnmowers = s.collectLawnmowerData(em)
+	saveData.IceTrail = s.collectIceTrailData(em)
+	saveData.Graves = s.collectGraveData(em)
+	saveData.ZombossBalls = s.collectZombossBallData(em)
 
 	// 收集教学状态（如果是教学关卡）
 	saveData.Tutorial = s.collectTutorialData(em)
 
-	// 收集保龄球模式数据（Level 1-5）
-	saveData.BowlingNuts = s.collectBowlingNutData(em)
+	// 收集传送带和关卡阶段数据（Level 1-5）
 	saveData.ConveyorBelt = s.collectConveyorBeltData(em)
 	saveData.LevelPhase = s.collectLevelPhaseData(em)
 	saveData.DaveDialogue = s.collectDaveDialogueData(em)
 	saveData.GuidedTutorial = s.collectGuidedTutorialData(em)
 
+	// 收集小游戏规则状态（保龄球坚果等）
+	if s.saveHook != nil {
+		s.saveHook(saveData)
+	}
+
 	// 使用 gob 编码到内存 buffer
 	var buffer bytes.Buffer
 	encoder := gob.NewEncoder(&buffer)
@@ -168,6 +185,24 @@ func (s *BattleSerializer) collectLevelState(gs *GameState, saveData *BattleSave
 	// 僵尸计数
 	saveData.TotalZombiesSpawned = gs.TotalZombiesSpawned
 	saveData.ZombiesKilled = gs.ZombiesKilled
+	saveData.ZombiesSummoned = gs.ZombiesSummoned
+	saveData.BossSpawned = gs.BossSpawned
+
+	// 程序生成的波次（生存模式的波次随 Survival 一起保存）
+	if gs.WavesGenerated && gs.CurrentLevel != nil && !gs.CurrentLevel.Survival {
+		saveData.GeneratedWaves = append([]config.WaveConfig(nil), gs.CurrentLevel.Waves...)
+	}
+
+	// 生存模式：保存当前这组旗帜的波次和植物选择
+	if gs.CurrentLevel != nil && gs.CurrentLevel.Survival {
+		saveData.Survival = &SurvivalSaveData{
+			Flags:  gs.SurvivalFlags,
+			Waves:  append([]config.WaveConfig(nil), gs.CurrentLevel.Waves...),
+			Plants: append([]string(nil), gs.SurvivalPlants...),
+
+			AwaitingReselection: gs.SurvivalAwaitingReselection,
+		}
+	}
 }
 
 // collectPlantData 从 EntityManager 收集所有植物实体数据
@@ -210,16 +245,37 @@ func (s *BattleSerializer) collectPlantData(em *ecs.EntityManager) []PlantData {
 				timerComp.CurrentTime, timerComp.TargetTime, attackCooldown)
 		}
 
+		// 磁力菇冷却和吸附的饰品
+		var magnetRechargeTimer float64
+		var magnetHeldAccessory string
+		if magnet, ok := ecs.GetComponent[*components.MagnetshroomComponent](em, entity); ok {
+			magnetRechargeTimer = magnet.RechargeTimer
+			magnetHeldAccessory = string(magnet.HeldAccessory)
+		}
+
+		// 玉米加农炮装填进度
+		var reloadTimer float64
+		if cannon, ok := ecs.GetComponent[*components.CobCannonComponent](em, entity); ok {
+			reloadTimer = cannon.ReloadTimer
+		}
+
+		// 是否被架上了梯子
+		_, hasLadder := ecs.GetComponent[*components.LadderedComponent](em, entity)
+
 		plants = append(plants, PlantData{
-			PlantType:       plantComp.PlantType.String(),
-			GridRow:         plantComp.GridRow,
-			GridCol:         plantComp.GridCol,
-			Health:          health,
-			MaxHealth:       maxHealth,
-			AttackCooldown:  attackCooldown,
-			TimerTargetTime: timerTargetTime,
-			BlinkTimer:      plantComp.BlinkTimer,
-			AttackAnimState: int(plantComp.AttackAnimState),
+			PlantType:           plantComp.PlantType.String(),
+			GridRow:             plantComp.GridRow,
+			GridCol:             plantComp.GridCol,
+			Health:              health,
+			MaxHealth:           maxHealth,
+			AttackCooldown:      attackCooldown,
+			TimerTargetTime:     timerTargetTime,
+			BlinkTimer:          plantComp.BlinkTimer,
+			AttackAnimState:     int(plantComp.AttackAnimState),
+			ReloadTimer:         reloadTimer,
+			HasLadder:           hasLadder,
+			MagnetRechargeTimer: magnetRechargeTimer,
+			MagnetHeldAccessory: magnetHeldAccessory,
 		})
 	}
 
@@ -265,11 +321,81 @@ func (s *BattleSerializer) collectZombieData(em *ecs.EntityManager) []ZombieData
 			maxHealth = healthComp.MaxHealth
 		}
 
-		// 获取护甲组件
+		// 获取饰品状态（头部防具和手持防具的耐久各自保存）
 		var armorHealth, armorMax int
-		if armorComp, ok := ecs.GetComponent[*components.ArmorComponent](em, entity); ok {
-			armorHealth = armorComp.CurrentArmor
-			armorMax = armorComp.MaxArmor
+		var accessories []string
+		var shieldHealth int
+		if accessoryComp, ok := ecs.GetComponent[*components.AccessoryComponent](em, entity); ok {
+			accessories = accessoryComp.Tags()
+			if helmet := accessoryComp.Helmet(); helmet != nil {
+				armorHealth = helmet.Durability
+				armorMax = helmet.MaxDurability
+			}
+			if shield := accessoryComp.Shield(); shield != nil {
+				shieldHealth = shield.Durability
+			}
+		}
+
+		// 获取阵营（被魅惑的僵尸）
+		isCharmed := false
+		if factionComp, ok := ecs.GetComponent[*components.FactionComponent](em, entity); ok {
+			isCharmed = factionComp.IsCharmed()
+		}
+
+		// 获取跳跃僵尸（撑杆跳、跳跳杆）是否已失去道具
+		propLost := false
+		if vaultComp, ok := ecs.GetComponent[*components.VaultComponent](em, entity); ok {
+			propLost = !vaultComp.HasProp()
+		}
+		// 气球僵尸的气球已被击破（坠落中的气球僵尸读档后直接落地）
+		if balloonComp, ok := ecs.GetComponent[*components.BalloonComponent](em, entity); ok {
+			propLost = !balloonComp.IsFlying()
+		} else if behaviorComp.UnitID == types.UnitIDZombieBalloon {
+			propLost = true
+		}
+		// 巨人是否已扔出背上的小鬼
+		if gargantuarComp, ok := ecs.GetComponent[*components.GargantuarComponent](em, entity); ok {
+			propLost = !gargantuarComp.HasImp
+		}
+		// 雪橇队僵尸是否已跳下雪橇（正在跳下的读档后直接行走）
+		if bobsledComp, ok := ecs.GetComponent[*components.BobsledComponent](em, entity); ok {
+			propLost = bobsledComp.State != components.BobsledStateRiding
+		}
+
+		// 被扔出的小鬼按落点保存（读档后直接在落点行走）
+		posX, posY := posComp.X, posComp.Y
+		if impComp, ok := ecs.GetComponent[*components.ImpComponent](em, entity); ok {
+			posX, posY = impComp.TargetX, impComp.GroundY
+		}
+		// 翻越梯子中的僵尸按落点保存（读档后直接在植物左侧行走）
+		if climbComp, ok := ecs.GetComponent[*components.ClimbComponent](em, entity); ok {
+			posX, posY = climbComp.TargetX, climbComp.BaseY
+		}
+
+		// 获取矿工僵尸是否已出土
+		diggerSurfaced, diggerBackward := false, false
+		if diggerComp, ok := ecs.GetComponent[*components.DiggerComponent](em, entity); ok {
+			diggerSurfaced = !diggerComp.IsUnderground()
+			diggerBackward = diggerComp.Backward
+		}
+
+		// 获取投篮车剩余的篮球数量
+		var basketballs int
+		if catapultComp, ok := ecs.GetComponent[*components.CatapultComponent](em, entity); ok {
+			basketballs = catapultComp.Basketballs
+		}
+
+		// 获取僵王博士的攻击循环状态
+		var zomboss *ZombossData
+		if zombossComp, ok := ecs.GetComponent[*components.ZombossComponent](em, entity); ok {
+			zomboss = &ZombossData{
+				State:       int(zombossComp.State),
+				Timer:       zombossComp.Timer,
+				AttackCount: zombossComp.AttackCount,
+				TargetRow:   zombossComp.TargetRow,
+				TargetCol:   zombossComp.TargetCol,
+				BallElement: int(zombossComp.BallElement),
+			}
 		}
 
 		// 获取行号
@@ -284,9 +410,9 @@ func (s *BattleSerializer) collectZombieData(em *ecs.EntityManager) []ZombieData
 		}
 
 		zombies = append(zombies, ZombieData{
-			ZombieType:   behaviorTypeToZombieType(behaviorComp.Type),
-			X:            posComp.X,
-			Y:            posComp.Y,
+			ZombieType:   zombieTypeOf(behaviorComp),
+			X:            posX,
+			Y:            posY,
 			VelocityX:    velocityX,
 			Health:       health,
 			MaxHealth:    maxHealth,
@@ -295,6 +421,17 @@ func (s *BattleSerializer) collectZombieData(em *ecs.EntityManager) []ZombieData
 			Lane:         lane,
 			BehaviorType: behaviorTypeToString(behaviorComp.Type),
 			IsEating:     behaviorComp.Type == components.BehaviorZombieEating,
+			Accessories:  accessories,
+			IsCharmed:    isCharmed,
+			PropLost:     propLost,
+			ShieldHealth: shieldHealth,
+
+			DiggerSurfaced: diggerSurfaced,
+			DiggerBackward: diggerBackward,
+
+			Basketballs: basketballs,
+
+			Zomboss: zomboss,
 		})
 	}
 
@@ -317,8 +454,8 @@ func (s *BattleSerializer) collectProjectileData(em *ecs.EntityManager) []Projec
 			continue
 		}
 
-		// 判断是否是子弹
-		if behaviorComp.Type != components.BehaviorPeaProjectile {
+		// 判断是否是子弹（抛物线子弹、短程孢子飞行时间很短，不保存）
+		if !components.IsDirectProjectile(behaviorComp.Type) || behaviorComp.Type == components.BehaviorPuffProjectile {
 			continue
 		}
 
@@ -328,9 +465,10 @@ func (s *BattleSerializer) collectProjectileData(em *ecs.EntityManager) []Projec
 		}
 
 		// 获取速度组件
-		var velocityX float64
+		var velocityX, velocityY float64
 		if velComp, ok := ecs.GetComponent[*components.VelocityComponent](em, entity); ok {
 			velocityX = velComp.VX
+			velocityY = velComp.VY
 		}
 
 		// 获取碰撞组件（用于获取行号）
@@ -340,12 +478,24 @@ func (s *BattleSerializer) collectProjectileData(em *ecs.EntityManager) []Projec
 			// 从位置推算行号（后续可以优化）
 		}
 
+		projectileType := "pea"
+		damage := config.PeaBulletDamage
+		switch behaviorComp.Type {
+		case components.BehaviorStarProjectile:
+			projectileType = "star"
+			damage = config.StarDamage
+		case components.BehaviorCattailSpike:
+			projectileType = "cattail_spike"
+			damage = config.CattailSpikeDamage
+		}
+
 		projectiles = append(projectiles, ProjectileData{
-			Type:      "pea", // 目前只有豌豆子弹
+			Type:      projectileType,
 			X:         posComp.X,
 			Y:         posComp.Y,
 			VelocityX: velocityX,
-			Damage:    20, // 默认伤害值
+			VelocityY: velocityY,
+			Damage:    damage,
 			Lane:      lane,
 		})
 	}
@@ -447,6 +597,49 @@ func (s *BattleSerializer) collectLawnmowerData(em *ecs.EntityManager) []Lawnmow
 	return lawnmowers
 }
 
+// collectIceTrailData 从草坪网格收集所有冰道格子
+func (s *BattleSerializer) collectIceTrailData(em *ecs.EntityManager) []IceCellData {
+	var cells []IceCellData
+
+	for _, entity := range ecs.GetEntitiesWith1[*components.LawnGridComponent](em) {
+		gridComp, _ := ecs.GetComponent[*components.LawnGridComponent](em, entity)
+		for row := range gridComp.Ice {
+			for col, remaining := range gridComp.Ice[row] {
+				if remaining > 0 {
+					cells = append(cells, IceCellData{Row: row, Col: col, Remaining: remaining})
+				}
+			}
+		}
+	}
+
+	return cells
+}
+
+// collectGraveData 收集所有墓碑所在格子
+func (s *BattleSerializer) collectGraveData(em *ecs.EntityManager) []GraveData {
+	var graves []GraveData
+
+	for _, entity := range ecs.GetEntitiesWith1[*components.GraveComponent](em) {
+		grave, _ := ecs.GetComponent[*components.GraveComponent](em, entity)
+		graves = append(graves, GraveData{Row: grave.Row, Col: grave.Col})
+	}
+
+	return graves
+}
+
+// collectZombossBallData 收集僵王博士吐出的火球和冰球
+func (s *BattleSerializer) collectZombossBallData(em *ecs.EntityManager) []ZombossBallData {
+	var balls []ZombossBallData
+
+	for _, entity := range ecs.GetEntitiesWith2[*components.ZombossBallComponent, *components.PositionComponent](em) {
+		ball, _ := ecs.GetComponent[*components.ZombossBallComponent](em, entity)
+		pos, _ := ecs.GetComponent[*components.PositionComponent](em, entity)
+		balls = append(balls, ZombossBallData{Element: int(ball.Element), Row: ball.Row, X: pos.X})
+	}
+
+	return balls
+}
+
 // isZombieBehavior 判断行为类型是否是僵尸行为
 func isZombieBehavior(behaviorType components.BehaviorType) bool {
 	switch behaviorType {
@@ -457,13 +650,24 @@ func isZombieBehavior(behaviorType components.BehaviorType) bool {
 		components.BehaviorZombieDyingExplosion,
 		components.BehaviorZombieConehead,
 		components.BehaviorZombieBuckethead,
-		components.BehaviorZombiePreview:
+		components.BehaviorZombiePreview,
+		components.BehaviorZombieBoss:
 		return true
 	default:
 		return false
 	}
 }
 
+// zombieTypeOf 获取僵尸的类型字符串
+// 优先使用动画 UnitID 判断（撑杆跳僵尸等特殊僵尸沿用普通僵尸的行为类型），
+// 无法识别时按行为类型判断
+func zombieTypeOf(behaviorComp *components.BehaviorComponent) string {
+	if zt := types.ZombieTypeFromUnitID(behaviorComp.UnitID); zt != types.ZombieUnknown {
+		return zt.String()
+	}
+	return behaviorTypeToZombieType(behaviorComp.Type)
+}
+
 // behaviorTypeToZombieType 将行为类型转换为僵尸类型字符串
 func behaviorTypeToZombieType(behaviorType components.BehaviorType) string {
 	switch behaviorType {
@@ -497,6 +701,8 @@ func behaviorTypeToString(behaviorType components.BehaviorType) string {
 		return "buckethead"
 	case components.BehaviorZombiePreview:
 		return "preview"
+	case components.BehaviorZombieBoss:
+		return "boss"
 	default:
 		return "unknown"
 	}
@@ -554,10 +760,11 @@ func (s *BattleSerializer) collectTutorialData(em *ecs.EntityManager) *TutorialS
 // 保龄球模式数据收集方法（Level 1-5）
 // =============================================================================
 
-// collectBowlingNutData 从 EntityManager 收集所有保龄球坚果数据
+// CollectBowlingNutData 从 EntityManager 收集所有保龄球坚果数据
 //
-// 查找所有拥有 BowlingNutComponent 的实体并收集其状态
-func (s *BattleSerializer) collectBowlingNutData(em *ecs.EntityManager) []BowlingNutData {
+// 查找所有拥有 BowlingNutComponent 的实体并收集其状态，
+// 由保龄球规则模块在保存战斗时调用
+func CollectBowlingNutData(em *ecs.EntityManager) []BowlingNutData {
 	var bowlingNuts []BowlingNutData
 
 	// 查询所有拥有 BowlingNutComponent 和 PositionComponent 的实体
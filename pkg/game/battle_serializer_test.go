@@ -10,6 +10,7 @@ import (
 	"github.com/gonewx/pvz/pkg/components"
 	"github.com/gonewx/pvz/pkg/config"
 	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/types"
 	"github.com/quasilyte/gdata/v2"
 )
 
@@ -206,6 +207,96 @@ func TestBattleSerializer_SaveAndLoadBattle_WithPlants(t *testing.T) {
 	}
 }
 
+// TestBattleSerializer_SaveAndLoadBattle_CobCannonReload 测试玉米加农炮装填进度的保存和加载
+func TestBattleSerializer_SaveAndLoadBattle_CobCannonReload(t *testing.T) {
+	gdataManager := createTestGdataManagerForBattle(t, "cob_cannon_reload")
+	if gdataManager == nil {
+		t.Skip("Cannot create gdata manager for testing")
+	}
+
+	em := ecs.NewEntityManager()
+	gs := &GameState{
+		Sun:          150,
+		SpawnedWaves: []bool{true},
+		CurrentLevel: &config.LevelConfig{ID: "1-2"},
+	}
+
+	cannon := em.CreateEntity()
+	ecs.AddComponent(em, cannon, &components.PlantComponent{
+		PlantType: components.PlantCobCannon,
+		GridRow:   2,
+		GridCol:   3,
+	})
+	ecs.AddComponent(em, cannon, &components.PositionComponent{X: 400, Y: 300})
+	ecs.AddComponent(em, cannon, &components.CobCannonComponent{ReloadTimer: 12.5})
+
+	serializer := NewBattleSerializer(gdataManager)
+	if err := serializer.SaveBattle(em, gs, "testuser"); err != nil {
+		t.Fatalf("SaveBattle failed: %v", err)
+	}
+
+	data, err := serializer.LoadBattle("testuser")
+	if err != nil {
+		t.Fatalf("LoadBattle failed: %v", err)
+	}
+
+	if len(data.Plants) != 1 {
+		t.Fatalf("Expected 1 plant, got %d", len(data.Plants))
+	}
+	p := data.Plants[0]
+	if p.PlantType != components.PlantCobCannon.String() {
+		t.Errorf("Plant type mismatch: expected %s, got %s", components.PlantCobCannon.String(), p.PlantType)
+	}
+	if p.ReloadTimer != 12.5 {
+		t.Errorf("ReloadTimer mismatch: expected 12.5, got %f", p.ReloadTimer)
+	}
+}
+
+// TestBattleSerializer_SaveAndLoadBattle_MagnetshroomState 测试磁力菇冷却和吸附的饰品随存档保存
+func TestBattleSerializer_SaveAndLoadBattle_MagnetshroomState(t *testing.T) {
+	gdataManager := createTestGdataManagerForBattle(t, "magnetshroom_state")
+	if gdataManager == nil {
+		t.Skip("Cannot create gdata manager for testing")
+	}
+
+	em := ecs.NewEntityManager()
+	gs := &GameState{
+		SpawnedWaves: []bool{true},
+		CurrentLevel: &config.LevelConfig{ID: "2-6"},
+	}
+
+	magnet := em.CreateEntity()
+	ecs.AddComponent(em, magnet, &components.PlantComponent{
+		PlantType: components.PlantMagnetshroom,
+		GridRow:   2,
+		GridCol:   3,
+	})
+	ecs.AddComponent(em, magnet, &components.PositionComponent{X: 300, Y: 300})
+	ecs.AddComponent(em, magnet, &components.MagnetshroomComponent{
+		HeldAccessory: types.AccessoryBucket,
+		RechargeTimer: 8.5,
+	})
+
+	serializer := NewBattleSerializer(gdataManager)
+	if err := serializer.SaveBattle(em, gs, "testuser"); err != nil {
+		t.Fatalf("SaveBattle failed: %v", err)
+	}
+	data, err := serializer.LoadBattle("testuser")
+	if err != nil {
+		t.Fatalf("LoadBattle failed: %v", err)
+	}
+
+	if len(data.Plants) != 1 {
+		t.Fatalf("Expected 1 plant, got %d", len(data.Plants))
+	}
+	if data.Plants[0].MagnetRechargeTimer != 8.5 {
+		t.Errorf("Magnet recharge timer mismatch: expected 8.5, got %.2f", data.Plants[0].MagnetRechargeTimer)
+	}
+	if data.Plants[0].MagnetHeldAccessory != string(types.AccessoryBucket) {
+		t.Errorf("Magnet held accessory mismatch: expected %q, got %q", types.AccessoryBucket, data.Plants[0].MagnetHeldAccessory)
+	}
+}
+
 // TestBattleSerializer_SaveAndLoadBattle_WithZombies 测试带僵尸的战斗状态
 func TestBattleSerializer_SaveAndLoadBattle_WithZombies(t *testing.T) {
 	gdataManager := createTestGdataManagerForBattle(t, "with_zombies")
@@ -233,7 +324,9 @@ func TestBattleSerializer_SaveAndLoadBattle_WithZombies(t *testing.T) {
 	ecs.AddComponent(em, zombie2, &components.PositionComponent{X: 600, Y: 250})
 	ecs.AddComponent(em, zombie2, &components.VelocityComponent{VX: -23.0})
 	ecs.AddComponent(em, zombie2, &components.HealthComponent{CurrentHealth: 200, MaxHealth: 200})
-	ecs.AddComponent(em, zombie2, &components.ArmorComponent{CurrentArmor: 300, MaxArmor: 370})
+	ecs.AddComponent(em, zombie2, &components.AccessoryComponent{
+		Pieces: []*components.AccessoryPiece{{Tag: types.AccessoryCone, Tier: 1, Durability: 300, MaxDurability: 370}},
+	})
 	ecs.AddComponent(em, zombie2, &components.ZombieTargetLaneComponent{TargetRow: 2})
 
 	// 保存
@@ -419,6 +512,166 @@ func TestBattleSerializer_CollectLevelState(t *testing.T) {
 	}
 }
 
+// TestBattleSerializer_SaveAndLoadBattle_Survival 测试生存模式存档保存当前这组旗帜的波次、旗帜数、植物选择和重新选卡状态
+func TestBattleSerializer_SaveAndLoadBattle_Survival(t *testing.T) {
+	gdataManager := createTestGdataManagerForBattle(t, "survival")
+	if gdataManager == nil {
+		t.Skip("Cannot create gdata manager for testing")
+	}
+
+	em := ecs.NewEntityManager()
+	gs := &GameState{
+		SurvivalFlags:               4,
+		SurvivalPlants:              []string{"sunflower", "peashooter"},
+		SurvivalAwaitingReselection: true,
+		CurrentLevel: &config.LevelConfig{
+			ID:       config.SurvivalLevelID,
+			Survival: true,
+			Waves: []config.WaveConfig{
+				{WaveNum: 1, Zombies: []config.ZombieGroup{{Type: "basic", Count: 3}}},
+				{WaveNum: 2, IsFlag: true, Type: "Final", Zombies: []config.ZombieGroup{{Type: "flag", Count: 1}, {Type: "football", Count: 1}}},
+			},
+		},
+	}
+
+	serializer := NewBattleSerializer(gdataManager)
+	if err := serializer.SaveBattle(em, gs, "testuser"); err != nil {
+		t.Fatalf("SaveBattle failed: %v", err)
+	}
+	data, err := serializer.LoadBattle("testuser")
+	if err != nil {
+		t.Fatalf("LoadBattle failed: %v", err)
+	}
+
+	if data.Survival == nil {
+		t.Fatal("Expected survival data in save")
+	}
+	if data.Survival.Flags != 4 {
+		t.Errorf("Expected 4 flags, got %d", data.Survival.Flags)
+	}
+	if !data.Survival.AwaitingReselection {
+		t.Error("Expected save taken between flag sets to keep waiting for plant reselection")
+	}
+	if len(data.Survival.Plants) != 2 || data.Survival.Plants[1] != "peashooter" {
+		t.Errorf("Expected seed bank plants restored, got %v", data.Survival.Plants)
+	}
+	if len(data.Survival.Waves) != 2 {
+		t.Fatalf("Expected 2 waves, got %d", len(data.Survival.Waves))
+	}
+	last := data.Survival.Waves[1]
+	if !last.IsFlag || last.Type != "Final" || len(last.Zombies) != 2 || last.Zombies[1].Type != "football" {
+		t.Errorf("Final wave mismatch: %+v", last)
+	}
+
+	// 非生存模式不保存生存模式数据
+	gs.CurrentLevel = &config.LevelConfig{ID: "1-3"}
+	saveData := NewBattleSaveData()
+	serializer.collectLevelState(gs, saveData)
+	if saveData.Survival != nil {
+		t.Error("Expected no survival data for a regular level")
+	}
+}
+
+// TestBattleSerializer_CollectLevelState_GeneratedWaves 测试程序生成的波次随存档保存，固定波次不保存
+func TestBattleSerializer_CollectLevelState_GeneratedWaves(t *testing.T) {
+	serializer := NewBattleSerializer(nil)
+	gs := &GameState{
+		CurrentLevel: &config.LevelConfig{
+			ID: "1-3",
+			Waves: []config.WaveConfig{
+				{WaveNum: 1, Type: "ExtraPoints", ExtraPoints: 2, Zombies: []config.ZombieGroup{{Type: "conehead", Count: 1}}},
+			},
+		},
+	}
+
+	saveData := NewBattleSaveData()
+	serializer.collectLevelState(gs, saveData)
+	if saveData.GeneratedWaves != nil {
+		t.Errorf("Expected no generated waves for a fixed level, got %v", saveData.GeneratedWaves)
+	}
+
+	gs.WavesGenerated = true
+	saveData = NewBattleSaveData()
+	serializer.collectLevelState(gs, saveData)
+	if len(saveData.GeneratedWaves) != 1 || saveData.GeneratedWaves[0].Zombies[0].Type != "conehead" {
+		t.Errorf("Expected generated waves in save, got %v", saveData.GeneratedWaves)
+	}
+}
+
+// TestBattleSerializer_SaveAndLoadBattle_Zomboss 测试僵王博士、火球冰球和入场标记随存档保存
+func TestBattleSerializer_SaveAndLoadBattle_Zomboss(t *testing.T) {
+	gdataManager := createTestGdataManagerForBattle(t, "zomboss")
+	if gdataManager == nil {
+		t.Skip("Cannot create gdata manager for testing")
+	}
+
+	em := ecs.NewEntityManager()
+	gs := &GameState{
+		LevelTime:    42,
+		BossSpawned:  true,
+		CurrentLevel: &config.LevelConfig{ID: config.BossLevelID, Boss: true},
+	}
+
+	boss := em.CreateEntity()
+	ecs.AddComponent(em, boss, &components.BehaviorComponent{Type: components.BehaviorZombieBoss, UnitID: types.UnitIDZombieDrZomboss})
+	ecs.AddComponent(em, boss, &components.PositionComponent{X: config.GameCameraX})
+	ecs.AddComponent(em, boss, &components.HealthComponent{CurrentHealth: 12000, MaxHealth: config.ZombossHealth})
+	ecs.AddComponent(em, boss, &components.ZombossComponent{
+		State:       components.ZombossStateHeadIdle,
+		Timer:       2.5,
+		AttackCount: 3,
+		TargetRow:   4,
+		TargetCol:   6,
+		BallElement: components.ZombossElementIce,
+	})
+
+	ball := em.CreateEntity()
+	ecs.AddComponent(em, ball, &components.PositionComponent{X: 640, Y: 300})
+	ecs.AddComponent(em, ball, &components.ZombossBallComponent{Element: components.ZombossElementFire, Row: 2})
+
+	serializer := NewBattleSerializer(gdataManager)
+	if err := serializer.SaveBattle(em, gs, "testuser"); err != nil {
+		t.Fatalf("SaveBattle failed: %v", err)
+	}
+	data, err := serializer.LoadBattle("testuser")
+	if err != nil {
+		t.Fatalf("LoadBattle failed: %v", err)
+	}
+
+	if !data.BossSpawned {
+		t.Error("Expected BossSpawned to be saved")
+	}
+
+	if len(data.Zombies) != 1 {
+		t.Fatalf("Expected Dr. Zomboss in saved zombies, got %d zombies", len(data.Zombies))
+	}
+	saved := data.Zombies[0]
+	if saved.ZombieType != types.ZombieDrZomboss.String() || saved.BehaviorType != "boss" {
+		t.Errorf("Expected drzomboss/boss, got %s/%s", saved.ZombieType, saved.BehaviorType)
+	}
+	if saved.Health != 12000 || saved.MaxHealth != config.ZombossHealth {
+		t.Errorf("Expected health 12000/%d, got %d/%d", config.ZombossHealth, saved.Health, saved.MaxHealth)
+	}
+	want := ZombossData{
+		State:       int(components.ZombossStateHeadIdle),
+		Timer:       2.5,
+		AttackCount: 3,
+		TargetRow:   4,
+		TargetCol:   6,
+		BallElement: int(components.ZombossElementIce),
+	}
+	if saved.Zomboss == nil || *saved.Zomboss != want {
+		t.Errorf("Expected Zomboss state %+v, got %+v", want, saved.Zomboss)
+	}
+
+	if len(data.ZombossBalls) != 1 {
+		t.Fatalf("Expected 1 Zomboss ball, got %d", len(data.ZombossBalls))
+	}
+	if got := data.ZombossBalls[0]; got.Element != int(components.ZombossElementFire) || got.Row != 2 || got.X != 640 {
+		t.Errorf("Zomboss ball mismatch: %+v", got)
+	}
+}
+
 // TestIsZombieBehavior 测试僵尸行为判断
 func TestIsZombieBehavior(t *testing.T) {
 	tests := []struct {
@@ -433,6 +686,7 @@ func TestIsZombieBehavior(t *testing.T) {
 		{components.BehaviorZombieConehead, true},
 		{components.BehaviorZombieBuckethead, true},
 		{components.BehaviorZombiePreview, true},
+		{components.BehaviorZombieBoss, true},
 		{components.BehaviorPeashooter, false},
 		{components.BehaviorSunflower, false},
 		{components.BehaviorPeaProjectile, false},
@@ -482,6 +736,7 @@ func TestBehaviorTypeToString(t *testing.T) {
 		{components.BehaviorZombieConehead, "conehead"},
 		{components.BehaviorZombieBuckethead, "buckethead"},
 		{components.BehaviorZombiePreview, "preview"},
+		{components.BehaviorZombieBoss, "boss"},
 		{components.BehaviorPeashooter, "unknown"}, // 非僵尸类型应返回 unknown
 		{components.BehaviorSunflower, "unknown"},
 		{components.BehaviorType(999), "unknown"}, // 未定义的类型
@@ -663,7 +918,9 @@ func TestBattleSerializer_SaveAndLoadBattle_CompleteScenario(t *testing.T) {
 	ecs.AddComponent(em, zombie2, &components.PositionComponent{X: 550, Y: 200})
 	ecs.AddComponent(em, zombie2, &components.VelocityComponent{VX: -23})
 	ecs.AddComponent(em, zombie2, &components.HealthComponent{CurrentHealth: 200, MaxHealth: 200})
-	ecs.AddComponent(em, zombie2, &components.ArmorComponent{CurrentArmor: 900, MaxArmor: 1100})
+	ecs.AddComponent(em, zombie2, &components.AccessoryComponent{
+		Pieces: []*components.AccessoryPiece{{Tag: types.AccessoryBucket, Tier: 1, Durability: 900, MaxDurability: 1100}},
+	})
 	ecs.AddComponent(em, zombie2, &components.ZombieTargetLaneComponent{TargetRow: 2})
 
 	// 创建除草车
@@ -30,6 +30,9 @@ type GameState struct {
 	TotalZombiesInLevel   int                 // 关卡配置中的总僵尸数（用于胜利条件）
 	TotalZombiesSpawned   int                 // 已激活的僵尸总数（用于计算场上僵尸数）
 	ZombiesKilled         int                 // 已消灭的僵尸数量
+	ZombiesSummoned       int                 // 关卡配置之外被召唤的僵尸数（伴舞等，同样计入胜利条件）
+	BossSpawned           bool                // 僵王博士是否已入场（Boss 关卡，随存档保存以免读档后重复入场）
+	BossDefeated          bool                // 僵王博士是否已被击败（Boss 关卡的胜利条件）
 	LastWaveCompletedTime float64             // 上一波完成时间（用于计算延迟）
 	IsWaitingForNextWave  bool                // 是否正在等待下一波（延迟中）
 	IsLevelComplete       bool                // 关卡是否完成
@@ -59,6 +62,18 @@ type GameState struct {
 	TotalCompletedFlags int // 已完成的旗帜总数（跨关卡累计）
 	WavesPerRound       int // 每轮波次数（默认20）
 
+	// SurvivalFlags 生存模式本局已坚持的旗帜数（每完成一组旗帜增加 SurvivalFlagsPerSet）
+	SurvivalFlags int
+
+	// SurvivalPlants 生存模式植物选择栏中的植物（每组旗帜之间重新选择）
+	SurvivalPlants []string
+
+	// SurvivalAwaitingReselection 生存模式是否正在两组旗帜之间等待玩家重新选择植物（随战斗存档保存）
+	SurvivalAwaitingReselection bool
+
+	// WavesGenerated 当前关卡的波次是否由 WaveGenerator 程序生成（需要随战斗存档保存）
+	WavesGenerated bool
+
 	// Story 20.1: 跨平台存储管理器
 	// 使用 gdata 库实现跨平台数据存储（桌面端、移动端、WASM）
 	// 如果初始化失败，gdataManager 为 nil，游戏仍可运行但无法持久化数据
@@ -211,7 +226,8 @@ func (gs *GameState) GetNextLevelID() string {
 
 	// 简单递增关卡号
 	nextLevel := level + 1
-	nextLevelID := fmt.Sprintf("%d-%d", chapter, nextLevel)
+	// 已实现的白天关卡之后进入僵王博士最终关
+	nextLevelID := config.ResolveAdventureLevel(fmt.Sprintf("%d-%d", chapter, nextLevel))
 
 	// 检查下一关配置文件是否存在
 	// 如果不存在，返回空字符串表示没有下一关
@@ -250,6 +266,13 @@ func (gs *GameState) LoadLevel(levelConfig *config.LevelConfig) {
 	log.Printf("[GameState] LoadLevel: %s, Total zombies in config: %d", levelConfig.ID, totalZombies)
 
 	gs.ZombiesKilled = 0
+	gs.ZombiesSummoned = 0
+	gs.BossSpawned = false
+	gs.BossDefeated = false
+	gs.SurvivalFlags = 0
+	gs.SurvivalPlants = nil
+	gs.SurvivalAwaitingReselection = false
+	gs.WavesGenerated = false
 	gs.IsLevelComplete = false
 	gs.IsGameOver = false
 	gs.GameResult = ""
@@ -329,6 +352,13 @@ func (gs *GameState) IncrementZombiesSpawned(count int) {
 		count, gs.TotalZombiesSpawned, gs.TotalZombiesInLevel, gs.ZombiesKilled, gs.TotalZombiesSpawned-gs.ZombiesKilled)
 }
 
+// RecordZombiesSummoned 记录关卡配置之外被召唤的僵尸（如舞王召唤的伴舞）
+// 被召唤的僵尸同时计入已激活数和胜利条件所需的消灭数
+func (gs *GameState) RecordZombiesSummoned(count int) {
+	gs.ZombiesSummoned += count
+	gs.IncrementZombiesSpawned(count)
+}
+
 // IncrementZombiesKilled 增加已消灭僵尸计数
 // 在僵尸死亡时调用
 func (gs *GameState) IncrementZombiesKilled() {
@@ -339,13 +369,18 @@ func (gs *GameState) IncrementZombiesKilled() {
 }
 
 // CheckVictory 检查是否达成胜利条件
-// 胜利条件：所有波次已生成 且 所有僵尸已消灭
+// 胜利条件：所有波次已生成 且 所有僵尸已消灭（Boss 关卡为击败僵王博士）
 // 返回 true 表示玩家获胜
 func (gs *GameState) CheckVictory() bool {
 	if gs.CurrentLevel == nil {
 		return false
 	}
 
+	// Boss 关卡不使用波次流程，击败僵王博士即获胜
+	if gs.CurrentLevel.Boss {
+		return gs.BossDefeated
+	}
+
 	// 检查所有波次是否已生成
 	allWavesSpawned := true
 	for i, spawned := range gs.SpawnedWaves {
@@ -358,14 +393,15 @@ func (gs *GameState) CheckVictory() bool {
 
 	// 胜利条件：
 	// 1. 所有波次已生成（allWavesSpawned = true）
-	// 2. 已消灭的僵尸数量 >= 关卡配置中的总僵尸数
+	// 2. 已消灭的僵尸数量 >= 关卡配置中的总僵尸数 + 被召唤的僵尸数
 	// 注意：必须消灭配置中的所有僵尸，而不是已激活的僵尸
-	result := allWavesSpawned && gs.ZombiesKilled >= gs.TotalZombiesInLevel && gs.TotalZombiesInLevel > 0
+	required := gs.TotalZombiesInLevel + gs.ZombiesSummoned
+	result := allWavesSpawned && gs.ZombiesKilled >= required && gs.TotalZombiesInLevel > 0
 
 	// 调试日志：当接近胜利条件时输出
-	if allWavesSpawned || gs.ZombiesKilled >= gs.TotalZombiesInLevel-1 {
-		log.Printf("[GameState] CheckVictory: allWavesSpawned=%v, ZombiesKilled=%d, TotalZombiesInLevel=%d, result=%v",
-			allWavesSpawned, gs.ZombiesKilled, gs.TotalZombiesInLevel, result)
+	if allWavesSpawned || gs.ZombiesKilled >= required-1 {
+		log.Printf("[GameState] CheckVictory: allWavesSpawned=%v, ZombiesKilled=%d, TotalZombiesInLevel=%d, ZombiesSummoned=%d, result=%v",
+			allWavesSpawned, gs.ZombiesKilled, gs.TotalZombiesInLevel, gs.ZombiesSummoned, result)
 	}
 
 	return result
@@ -556,10 +592,14 @@ func (gs *GameState) IsToolUnlocked(toolID string) bool {
 
 // GetCurrentRoundNumber 获取当前轮数
 // 公式: RoundNumber = TotalCompletedFlags / 2 - 1
+// 生存模式下轮数为本局已完成的旗帜组数: RoundNumber = SurvivalFlags / SurvivalFlagsPerSet
 //
 // 返回:
 //   - int: 当前轮数（可能为负数，表示一周目早期关卡）
 func (gs *GameState) GetCurrentRoundNumber() int {
+	if gs.CurrentLevel != nil && gs.CurrentLevel.Survival {
+		return gs.SurvivalFlags / config.SurvivalFlagsPerSet
+	}
 	return gs.TotalCompletedFlags/2 - 1
 }
 
@@ -607,6 +647,81 @@ func (gs *GameState) IsSecondPlaythrough() bool {
 	return gs.TotalCompletedFlags >= 50
 }
 
+// ReplaceLevelWaves 用程序生成的波次替换当前关卡的波次
+// 重新计算旗帜和关卡总僵尸数，并重置波次和僵尸计数；
+// 场上的植物、阳光和关卡时间保持不变
+//
+// 注意：直接修改 CurrentLevel 指向的配置，WaveSpawnSystem 等持有同一指针的系统会读取到新的波次
+//
+// 参数:
+//   - waves: 生成的波次配置
+func (gs *GameState) ReplaceLevelWaves(waves []config.WaveConfig) {
+	if gs.CurrentLevel == nil {
+		return
+	}
+
+	level := gs.CurrentLevel
+	level.Waves = waves
+	level.Flags = 0
+	level.FlagWaves = nil
+	totalZombies := 0
+	for i, wave := range waves {
+		if wave.IsFlag {
+			level.Flags++
+			level.FlagWaves = append(level.FlagWaves, i)
+		}
+		for _, zombieGroup := range wave.Zombies {
+			totalZombies += zombieGroup.Count
+		}
+	}
+
+	gs.CurrentWaveIndex = 0
+	gs.SpawnedWaves = make([]bool, len(waves))
+	gs.TotalZombiesInLevel = totalZombies
+	gs.TotalZombiesSpawned = 0
+	gs.ZombiesKilled = 0
+	gs.ZombiesSummoned = 0
+	gs.LastWaveCompletedTime = 0
+	gs.IsWaitingForNextWave = false
+	gs.WavesGenerated = true
+
+	log.Printf("[GameState] ReplaceLevelWaves: level=%s, waves=%d, zombies=%d",
+		level.ID, len(waves), totalZombies)
+}
+
+// ========================================
+// 生存模式
+// ========================================
+
+// StartSurvivalFlagSet 开始生存模式的下一组旗帜
+// 用新生成的波次替换当前关卡的波次（见 ReplaceLevelWaves）
+//
+// 参数:
+//   - waves: 本组旗帜的波次配置
+func (gs *GameState) StartSurvivalFlagSet(waves []config.WaveConfig) {
+	gs.ReplaceLevelWaves(waves)
+	log.Printf("[GameState] StartSurvivalFlagSet: flags survived=%d", gs.SurvivalFlags)
+}
+
+// CompleteSurvivalFlagSet 记录生存模式完成一组旗帜
+// 增加本局坚持的旗帜数，并在超过个人最佳记录时保存
+//
+// 返回:
+//   - bool: true 表示刷新了个人最佳记录
+func (gs *GameState) CompleteSurvivalFlagSet() bool {
+	gs.SurvivalFlags += config.SurvivalFlagsPerSet
+	if gs.saveManager == nil || gs.SurvivalFlags <= gs.saveManager.GetSurvivalBestFlags() {
+		return false
+	}
+
+	gs.saveManager.SetSurvivalBestFlags(gs.SurvivalFlags)
+	if err := gs.SaveProgress(); err != nil {
+		log.Printf("[GameState] Warning: Failed to save survival best: %v", err)
+	}
+	log.Printf("[GameState] New survival best: %d flags", gs.SurvivalFlags)
+	return true
+}
+
 // ========================================
 // 音频管理器
 // ========================================
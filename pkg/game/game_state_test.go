@@ -521,6 +521,114 @@ func TestCheckVictory(t *testing.T) {
 	}
 }
 
+// TestCheckVictoryWithSummonedZombies 测试被召唤的僵尸（伴舞）同样需要消灭才能胜利
+func TestCheckVictoryWithSummonedZombies(t *testing.T) {
+	gs := GetGameState()
+	levelConfig := &config.LevelConfig{
+		ID:    "test-1",
+		Name:  "Test Level",
+		Waves: []config.WaveConfig{{OldZombies: []config.ZombieSpawn{{Type: "dancing", Lane: 2, Count: 1}}}},
+	}
+	gs.LoadLevel(levelConfig)
+	gs.MarkWaveSpawned(0)
+	gs.IncrementZombiesSpawned(1)
+
+	// 舞王召唤四名伴舞
+	gs.RecordZombiesSummoned(4)
+	if gs.TotalZombiesSpawned != 5 {
+		t.Errorf("Expected 5 activated zombies, got %d", gs.TotalZombiesSpawned)
+	}
+
+	// 消灭舞王后伴舞仍在场
+	gs.IncrementZombiesKilled()
+	if gs.CheckVictory() {
+		t.Error("Expected no victory while backup dancers remain")
+	}
+
+	for i := 0; i < 4; i++ {
+		gs.IncrementZombiesKilled()
+	}
+	if !gs.CheckVictory() {
+		t.Error("Expected victory after killing all summoned zombies")
+	}
+
+	// 重新加载关卡时清零
+	gs.LoadLevel(levelConfig)
+	if gs.ZombiesSummoned != 0 {
+		t.Errorf("Expected ZombiesSummoned reset to 0, got %d", gs.ZombiesSummoned)
+	}
+}
+
+// TestCheckVictoryBossLevel 测试 Boss 关卡击败僵王博士即获胜
+func TestCheckVictoryBossLevel(t *testing.T) {
+	gs := GetGameState()
+	levelConfig := &config.LevelConfig{
+		ID:        "5-10",
+		Name:      "Dr. Zomboss",
+		SceneType: "roof",
+		Boss:      true,
+	}
+	gs.LoadLevel(levelConfig)
+
+	if gs.CheckVictory() {
+		t.Error("Expected no victory before Dr. Zomboss is defeated")
+	}
+
+	gs.BossDefeated = true
+	if !gs.CheckVictory() {
+		t.Error("Expected victory after Dr. Zomboss is defeated")
+	}
+
+	// 重新加载关卡时清零
+	gs.LoadLevel(levelConfig)
+	if gs.BossDefeated {
+		t.Error("Expected BossDefeated reset on level load")
+	}
+}
+
+// TestStartSurvivalFlagSet 测试生存模式开始新一组旗帜时替换波次并重置计数，保留阳光
+func TestStartSurvivalFlagSet(t *testing.T) {
+	gs := &GameState{}
+	gs.LoadLevel(&config.LevelConfig{ID: config.SurvivalLevelID, Survival: true, InitialSun: 50})
+	if round := gs.GetCurrentRoundNumber(); round != 0 {
+		t.Errorf("Expected survival round 0 before any flag set, got %d", round)
+	}
+
+	gs.Sun = 375
+	gs.ZombiesKilled = 12
+	gs.ZombiesSummoned = 2
+	gs.CurrentWaveIndex = 20
+	waves := []config.WaveConfig{
+		{WaveNum: 1, Zombies: []config.ZombieGroup{{Type: "basic", Count: 2}}},
+		{WaveNum: 2, IsFlag: true, Zombies: []config.ZombieGroup{{Type: "flag", Count: 1}, {Type: "conehead", Count: 3}}},
+	}
+
+	if gs.CompleteSurvivalFlagSet() {
+		t.Error("Expected no personal best without a save manager")
+	}
+	gs.StartSurvivalFlagSet(waves)
+
+	if gs.SurvivalFlags != config.SurvivalFlagsPerSet {
+		t.Errorf("Expected %d flags survived, got %d", config.SurvivalFlagsPerSet, gs.SurvivalFlags)
+	}
+	if round := gs.GetCurrentRoundNumber(); round != 1 {
+		t.Errorf("Expected survival round 1 after one flag set, got %d", round)
+	}
+	if len(gs.CurrentLevel.Waves) != 2 || len(gs.SpawnedWaves) != 2 {
+		t.Fatalf("Expected 2 waves, got %d waves and %d spawn flags", len(gs.CurrentLevel.Waves), len(gs.SpawnedWaves))
+	}
+	if gs.CurrentLevel.Flags != 1 || len(gs.CurrentLevel.FlagWaves) != 1 || gs.CurrentLevel.FlagWaves[0] != 1 {
+		t.Errorf("Expected one flag at wave index 1, got Flags=%d FlagWaves=%v", gs.CurrentLevel.Flags, gs.CurrentLevel.FlagWaves)
+	}
+	if gs.TotalZombiesInLevel != 6 || gs.ZombiesKilled != 0 || gs.ZombiesSummoned != 0 || gs.CurrentWaveIndex != 0 {
+		t.Errorf("Expected counters reset for 6 zombies, got Total=%d Killed=%d Summoned=%d WaveIndex=%d",
+			gs.TotalZombiesInLevel, gs.ZombiesKilled, gs.ZombiesSummoned, gs.CurrentWaveIndex)
+	}
+	if gs.Sun != 375 {
+		t.Errorf("Expected sun kept between flag sets, got %d", gs.Sun)
+	}
+}
+
 // TestSetGameResult 测试设置游戏结果
 func TestSetGameResult(t *testing.T) {
 	gs := GetGameState()
@@ -24,6 +24,8 @@ type SaveData struct {
 	UnlockedPlants []string `yaml:"unlockedPlants"` // 已解锁植物ID列表
 	UnlockedTools  []string `yaml:"unlockedTools"`  // 已解锁工具ID列表，如 ["shovel"]
 	HasStartedGame bool     `yaml:"hasStartedGame"` // 是否已开始过游戏（用于区分新用户和老用户）
+
+	SurvivalBestFlags int `yaml:"survivalBestFlags"` // 生存模式坚持的最多旗帜数（个人最佳）
 }
 
 // UserMetadata 用户元数据
@@ -262,14 +264,19 @@ func (sm *SaveManager) GetHighestLevel() string {
 //
 // 只有当新关卡比当前记录更高时才更新
 //
+// 小游戏、生存模式等非冒险模式关卡（ID 不是 "X-Y" 格式）不影响冒险进度
+//
 // 参数：
 //   - levelID: 关卡ID，如 "1-3"
 func (sm *SaveManager) SetHighestLevel(levelID string) {
-	// 简单比较：只要levelID不为空，就更新
+	// 简单比较：只要是冒险模式关卡就更新
 	// TODO: 实现关卡ID的大小比较（如 "1-4" > "1-3"）
-	if levelID != "" {
-		sm.data.HighestLevel = levelID
+	var chapter, level int
+	if _, err := fmt.Sscanf(levelID, "%d-%d", &chapter, &level); err != nil {
+		log.Printf("[SaveManager] %q is not an adventure level, highest level unchanged", levelID)
+		return
 	}
+	sm.data.HighestLevel = levelID
 }
 
 // ResetHighestLevel 重置最高完成关卡为空
@@ -402,6 +409,26 @@ func (sm *SaveManager) IsToolUnlocked(toolID string) bool {
 	return false
 }
 
+// GetSurvivalBestFlags 获取生存模式的个人最佳记录
+//
+// 返回：
+//   - int: 生存模式坚持的最多旗帜数
+func (sm *SaveManager) GetSurvivalBestFlags() int {
+	return sm.data.SurvivalBestFlags
+}
+
+// SetSurvivalBestFlags 设置生存模式的个人最佳记录
+//
+// 只有当新记录比当前记录更高时才更新
+//
+// 参数：
+//   - flags: 本局坚持的旗帜数
+func (sm *SaveManager) SetSurvivalBestFlags(flags int) {
+	if flags > sm.data.SurvivalBestFlags {
+		sm.data.SurvivalBestFlags = flags
+	}
+}
+
 // --- 多用户管理方法 (Story 12.4) ---
 
 // LoadUserList 加载所有用户列表
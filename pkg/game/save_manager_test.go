@@ -206,6 +206,7 @@ func TestSaveManager_SaveAndLoad_WithUser(t *testing.T) {
 	sm1.UnlockPlant("peashooter")
 	sm1.UnlockPlant("sunflower")
 	sm1.UnlockTool("shovel")
+	sm1.SetSurvivalBestFlags(6)
 
 	// 保存
 	if err := sm1.Save(); err != nil {
@@ -241,6 +242,26 @@ func TestSaveManager_SaveAndLoad_WithUser(t *testing.T) {
 	if !sm2.IsToolUnlocked("shovel") {
 		t.Error("Expected shovel to be unlocked")
 	}
+
+	if sm2.GetSurvivalBestFlags() != 6 {
+		t.Errorf("Expected survival best 6 flags, got %d", sm2.GetSurvivalBestFlags())
+	}
+}
+
+// TestSaveManager_SurvivalBestFlags 测试生存模式个人最佳记录只在刷新时更新
+func TestSaveManager_SurvivalBestFlags(t *testing.T) {
+	sm, _ := NewSaveManager(nil)
+
+	sm.SetSurvivalBestFlags(4)
+	sm.SetSurvivalBestFlags(2)
+	if sm.GetSurvivalBestFlags() != 4 {
+		t.Errorf("Expected best to stay at 4 flags, got %d", sm.GetSurvivalBestFlags())
+	}
+
+	sm.SetSurvivalBestFlags(8)
+	if sm.GetSurvivalBestFlags() != 8 {
+		t.Errorf("Expected best 8 flags, got %d", sm.GetSurvivalBestFlags())
+	}
 }
 
 func TestSaveManager_RenameUser(t *testing.T) {
@@ -898,3 +919,19 @@ func TestSaveManager_GetNextLevelToPlay(t *testing.T) {
 		})
 	}
 }
+
+// TestSetHighestLevel_IgnoresNonAdventureLevels 测试小游戏关卡不影响冒险进度
+func TestSetHighestLevel_IgnoresNonAdventureLevels(t *testing.T) {
+	sm, _ := NewSaveManager(nil)
+
+	sm.SetHighestLevel("1-3")
+	sm.SetHighestLevel("whack-zombie")
+	sm.SetHighestLevel("survival-day")
+
+	if got := sm.GetHighestLevel(); got != "1-3" {
+		t.Errorf("GetHighestLevel() = %q, want %q", got, "1-3")
+	}
+	if got := sm.GetNextLevelToPlay(); got != "1-4" {
+		t.Errorf("GetNextLevelToPlay() = %q, want %q", got, "1-4")
+	}
+}
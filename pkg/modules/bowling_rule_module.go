@@ -0,0 +1,253 @@
+package modules
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// BowlingRuleID 坚果保龄球的规则名
+const BowlingRuleID = "bowling"
+
+func init() {
+	// 保龄球模式不使用阳光和植物卡片
+	RegisterMiniGame(MiniGameInfo{
+		ID:      BowlingRuleID,
+		Name:    "坚果保龄球",
+		LevelID: "1-5",
+		HUD: MiniGameHUD{
+			HideSeedBank:   true,
+			HideSunCounter: true,
+			DisableSkySun:  true,
+		},
+	}, func() MiniGameRule {
+		return NewBowlingRule()
+	})
+}
+
+// BowlingRule 坚果保龄球规则（Level 1-5）
+//
+// 职责：
+//   - 驱动保龄球坚果的滚动和弹射（BowlingNutSystem）
+//   - 在保龄球阶段绘制第 3、4 列之间的红线
+//   - 隐藏植物选择栏和阳光计数器，禁用天空阳光（坚果由传送带发放）
+//   - 铲子放在菜单按钮左侧，教学文本使用大字体
+//   - 铲子教学转场完成后激活保龄球阶段（传送带、红线限制、波次计时、除草车）
+//   - 保存和恢复滚动中的坚果
+//
+// 传送带、铲子教学和阶段转场的实现由 GameScene 管理（其他关卡也会使用）
+type BowlingRule struct {
+	BaseMiniGameRule
+
+	entityManager    *ecs.EntityManager
+	resourceManager  *game.ResourceManager
+	bowlingNutSystem *systems.BowlingNutSystem
+	redLine          *ebiten.Image // 保龄球红线图片
+
+	levelSystem              *systems.LevelSystem
+	conveyorBeltSystem       *systems.ConveyorBeltSystem
+	plantPreviewRenderSystem *systems.PlantPreviewRenderSystem
+}
+
+// NewBowlingRule 创建坚果保龄球规则
+func NewBowlingRule() *BowlingRule {
+	return &BowlingRule{}
+}
+
+// ID 规则名
+func (r *BowlingRule) ID() string {
+	return BowlingRuleID
+}
+
+// Setup 创建保龄球坚果滚动系统，加载红线图片和教学文本大字体，
+// 固定铲子位置，并在铲子教学转场完成时激活保龄球阶段
+func (r *BowlingRule) Setup(ctx *MiniGameContext) error {
+	r.entityManager = ctx.EntityManager
+	r.resourceManager = ctx.ResourceManager
+	r.levelSystem = ctx.LevelSystem
+	r.conveyorBeltSystem = ctx.ConveyorBeltSystem
+	r.plantPreviewRenderSystem = ctx.PlantPreviewRenderSystem
+	r.bowlingNutSystem = systems.NewBowlingNutSystem(ctx.EntityManager, ctx.ResourceManager)
+
+	if ctx.ResourceManager != nil {
+		redLine, err := ctx.ResourceManager.LoadImage("assets/images/Wallnut_bowlingstripe.png")
+		if err != nil {
+			log.Printf("[BowlingRule] Warning: Failed to load bowling red line image: %v", err)
+		} else {
+			r.redLine = redLine
+		}
+
+		if ctx.RenderSystem != nil {
+			font, err := ctx.ResourceManager.LoadFont("assets/fonts/SimHei.ttf", config.BowlingTutorialTextFontSize)
+			if err != nil {
+				log.Printf("[BowlingRule] Warning: Failed to load bowling tutorial font: %v", err)
+			} else {
+				ctx.RenderSystem.SetLargeTutorialFont(font)
+			}
+		}
+	}
+
+	placeShovelByMenu(ctx)
+
+	// 除草车在铲子教学完成后才出现
+	if ctx.LevelPhaseSystem != nil {
+		initLawnmowers := ctx.InitLawnmowers
+		ctx.LevelPhaseSystem.SetOnTransitionComplete(func() {
+			r.activateBowlingPhase()
+			if initLawnmowers != nil {
+				initLawnmowers()
+			}
+		})
+	}
+
+	log.Printf("[BowlingRule] Initialized bowling nut system")
+	return nil
+}
+
+// activateBowlingPhase 激活保龄球阶段：启动传送带、启用红线限制并恢复波次计时
+// 保龄球阶段的波次计时在 LevelSystem 初始化时暂停
+func (r *BowlingRule) activateBowlingPhase() {
+	log.Printf("[BowlingRule] Activating bowling phase")
+	if r.conveyorBeltSystem != nil {
+		r.conveyorBeltSystem.Activate()
+	}
+	// 网格预览在红线右侧不显示
+	if r.plantPreviewRenderSystem != nil {
+		r.plantPreviewRenderSystem.SetRedLineEnabled(true)
+	}
+	if r.levelSystem != nil {
+		r.levelSystem.ResumeWaveTiming()
+	}
+}
+
+// Update 更新保龄球坚果的滚动
+func (r *BowlingRule) Update(deltaTime float64) {
+	if r.bowlingNutSystem != nil {
+		r.bowlingNutSystem.Update(deltaTime)
+	}
+}
+
+// AllowsInput 保龄球模式只能种植传送带上的坚果
+func (r *BowlingRule) AllowsInput(input MiniGameInput) bool {
+	return input != MiniGameInputPlantCards
+}
+
+// DrawLawn 绘制保龄球红线（Story 19.4）
+//
+// 红线位于第 3 列和第 4 列之间，只在阶段转场显示红线后绘制，
+// 图片高度不足时拉伸覆盖整个草坪
+func (r *BowlingRule) DrawLawn(screen *ebiten.Image, cameraX float64) {
+	if r.redLine == nil || !r.shouldShowRedLine() {
+		return
+	}
+
+	redLineWorldX := config.GridWorldStartX + float64(config.BowlingRedLineColumn)*config.CellWidth
+	redLineBounds := r.redLine.Bounds()
+	redLineWidth := float64(redLineBounds.Dx())
+	redLineHeight := float64(redLineBounds.Dy())
+	totalLawnHeight := float64(config.GridRows) * config.CellHeight
+
+	op := &ebiten.DrawImageOptions{}
+	if redLineHeight < totalLawnHeight {
+		op.GeoM.Scale(1, totalLawnHeight/redLineHeight)
+	}
+	// 居中对齐到列线
+	op.GeoM.Translate(redLineWorldX-cameraX-redLineWidth/2, config.GridWorldStartY+config.BowlingRedLineOffsetY)
+	screen.DrawImage(r.redLine, op)
+}
+
+// shouldShowRedLine 检查关卡阶段是否已显示红线
+func (r *BowlingRule) shouldShowRedLine() bool {
+	phaseComp := r.levelPhase()
+	return phaseComp != nil && phaseComp.ShowRedLine
+}
+
+// levelPhase 返回关卡阶段组件（没有时返回 nil）
+func (r *BowlingRule) levelPhase() *components.LevelPhaseComponent {
+	if r.entityManager == nil {
+		return nil
+	}
+	for _, entityID := range ecs.GetEntitiesWith1[*components.LevelPhaseComponent](r.entityManager) {
+		if phaseComp, ok := ecs.GetComponent[*components.LevelPhaseComponent](r.entityManager, entityID); ok {
+			return phaseComp
+		}
+	}
+	return nil
+}
+
+// SaveState 保存滚动中的保龄球坚果
+func (r *BowlingRule) SaveState(saveData *game.BattleSaveData) {
+	if r.entityManager == nil {
+		return
+	}
+	saveData.BowlingNuts = game.CollectBowlingNutData(r.entityManager)
+}
+
+// RestoreState 从存档数据重建保龄球坚果实体，存档时已在保龄球阶段则重新激活该阶段
+// （除草车随存档恢复，不重新创建）
+//
+// 坚果恢复内容：
+//   - 位置和速度（X, Y, VelocityX, VelocityY）
+//   - 行号和弹射状态
+//   - 是否为爆炸坚果
+//   - 弹射次数和方向
+func (r *BowlingRule) RestoreState(saveData *game.BattleSaveData) {
+	if r.entityManager == nil {
+		return
+	}
+
+	if phaseComp := r.levelPhase(); phaseComp != nil && phaseComp.CurrentPhase == 2 && phaseComp.PhaseState == components.PhaseStateActive {
+		log.Printf("[BowlingRule] 恢复保龄球阶段")
+		r.activateBowlingPhase()
+	}
+
+	if len(saveData.BowlingNuts) == 0 {
+		return
+	}
+
+	log.Printf("[BowlingRule] 恢复 %d 个保龄球坚果...", len(saveData.BowlingNuts))
+
+	for _, nutData := range saveData.BowlingNuts {
+		// 使用工厂函数创建（col=0 作为临时值，后续会覆盖位置）
+		entityID, err := entities.NewBowlingNutEntity(
+			r.entityManager,
+			r.resourceManager,
+			nutData.Row,
+			0, // 临时 col，位置会被覆盖
+			nutData.IsExplosive,
+		)
+		if err != nil {
+			log.Printf("[BowlingRule] ERROR: Failed to restore bowling nut at (%.1f, %.1f): %v",
+				nutData.X, nutData.Y, err)
+			continue
+		}
+
+		// 恢复位置（覆盖工厂函数计算的默认位置）
+		if posComp, ok := ecs.GetComponent[*components.PositionComponent](r.entityManager, entityID); ok {
+			posComp.X = nutData.X
+			posComp.Y = nutData.Y
+		}
+
+		// 恢复保龄球坚果组件状态
+		if bowlingComp, ok := ecs.GetComponent[*components.BowlingNutComponent](r.entityManager, entityID); ok {
+			bowlingComp.VelocityX = nutData.VelocityX
+			bowlingComp.VelocityY = nutData.VelocityY
+			bowlingComp.Row = nutData.Row
+			bowlingComp.IsRolling = nutData.IsRolling
+			bowlingComp.IsBouncing = nutData.IsBouncing
+			bowlingComp.TargetRow = nutData.TargetRow
+			bowlingComp.BounceCount = nutData.BounceCount
+			bowlingComp.CollisionCooldown = nutData.CollisionCooldown
+			bowlingComp.BounceDirection = nutData.BounceDirection
+		}
+
+		log.Printf("[BowlingRule] Restored bowling nut at (%.1f, %.1f), row=%d, explosive=%v, bouncing=%v",
+			nutData.X, nutData.Y, nutData.Row, nutData.IsExplosive, nutData.IsBouncing)
+	}
+}
@@ -0,0 +1,213 @@
+package modules
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// MiniGameInput 小游戏规则可以禁用的玩家输入
+type MiniGameInput int
+
+const (
+	// MiniGameInputPlantCards 点击植物选择栏的卡片种植
+	MiniGameInputPlantCards MiniGameInput = iota
+	// MiniGameInputShovel 使用铲子
+	MiniGameInputShovel
+	// MiniGameInputCollectSun 点击收集阳光
+	MiniGameInputCollectSun
+)
+
+// 小游戏规则的胜负判定结果（与 GameState.GameResult 的取值一致）
+const (
+	MiniGameResultNone = ""     // 不覆盖，使用 LevelSystem 的默认胜负判定
+	MiniGameResultWin  = "win"  // 立即胜利
+	MiniGameResultLose = "lose" // 立即失败
+)
+
+// MiniGameHUD 小游戏对 HUD 元素的调整（注册时写入 MiniGameInfo）
+// 零值表示与普通关卡相同
+type MiniGameHUD struct {
+	HideSeedBank   bool // 隐藏植物选择栏（如使用传送带发卡的关卡）
+	HideSunCounter bool // 隐藏阳光计数器
+	DisableSkySun  bool // 禁用天空掉落的阳光
+}
+
+// MiniGameContext 小游戏规则可以访问的游戏对象
+type MiniGameContext struct {
+	EntityManager   *ecs.EntityManager
+	GameState       *game.GameState
+	ResourceManager *game.ResourceManager
+	LevelConfig     *config.LevelConfig
+	InputSystem     *systems.InputSystem    // 可能为 nil（测试）
+	SunSpawnSystem  *systems.SunSpawnSystem // 可能为 nil（测试）
+	ReanimSystem    *systems.ReanimSystem   // 可能为 nil（测试）
+
+	LawnGridSystem   *systems.LawnGridSystem // 可能为 nil（测试）
+	LawnGridEntityID ecs.EntityID
+	LawnLayout       config.LawnLayout // 关卡场景的草坪行布局
+
+	RenderSystem             *systems.RenderSystem             // 可能为 nil（测试）
+	LevelSystem              *systems.LevelSystem              // 可能为 nil（测试）
+	LevelPhaseSystem         *systems.LevelPhaseSystem         // 可能为 nil（测试）
+	ConveyorBeltSystem       *systems.ConveyorBeltSystem       // 可能为 nil（测试）
+	PlantPreviewRenderSystem *systems.PlantPreviewRenderSystem // 可能为 nil（测试）
+
+	// InitLawnmowers 为关卡启用的每一行创建除草车（可能为 nil，测试）
+	InitLawnmowers func()
+	// SetShovelSlot 将铲子卡槽固定在指定的屏幕位置，不再跟随植物选择栏（可能为 nil，测试）
+	SetShovelSlot func(x, y float64)
+}
+
+// MiniGameRule 小游戏规则接口
+//
+// 关卡通过 specialRules 指定规则名，GameScene 在加载关卡后创建对应的规则模块，
+// 并在以下时机调用钩子：
+//   - Setup: 所有系统初始化完成后（创建规则专属的系统和资源）
+//   - Update: 每帧游戏逻辑更新时
+//   - CheckResult: LevelSystem 判定胜负之前（返回 MiniGameResultNone 表示不覆盖）
+//   - AllowsInput: 处理玩家输入之前
+//   - DrawLawn / DrawHUD: 渲染时
+//   - SaveState / RestoreState: 保存和恢复战斗存档时
+//
+// HUD 元素的调整不随规则状态变化，在注册时通过 MiniGameInfo.HUD 声明。
+// 规则模块可以嵌入 BaseMiniGameRule，只实现需要的钩子
+type MiniGameRule interface {
+	// ID 规则名（与关卡配置的 specialRules 一致）
+	ID() string
+	// Setup 初始化规则
+	Setup(ctx *MiniGameContext) error
+	// Update 每帧更新
+	Update(deltaTime float64)
+	// CheckResult 覆盖胜负判定
+	CheckResult() string
+	// AllowsInput 是否允许某种玩家输入
+	AllowsInput(input MiniGameInput) bool
+	// DrawLawn 在草坪之上、植物之下绘制规则专属的元素
+	DrawLawn(screen *ebiten.Image, cameraX float64)
+	// DrawHUD 在 UI 层绘制规则专属的 HUD 元素
+	DrawHUD(screen *ebiten.Image)
+	// SaveState 将规则状态写入战斗存档
+	SaveState(saveData *game.BattleSaveData)
+	// RestoreState 从战斗存档恢复规则状态
+	RestoreState(saveData *game.BattleSaveData)
+}
+
+// BaseMiniGameRule 小游戏规则的默认实现（所有钩子都不改变普通关卡的行为）
+type BaseMiniGameRule struct{}
+
+// Setup 默认不做任何初始化
+func (BaseMiniGameRule) Setup(ctx *MiniGameContext) error { return nil }
+
+// Update 默认不做任何更新
+func (BaseMiniGameRule) Update(deltaTime float64) {}
+
+// CheckResult 默认不覆盖胜负判定
+func (BaseMiniGameRule) CheckResult() string { return MiniGameResultNone }
+
+// AllowsInput 默认允许所有输入
+func (BaseMiniGameRule) AllowsInput(input MiniGameInput) bool { return true }
+
+// DrawLawn 默认不绘制
+func (BaseMiniGameRule) DrawLawn(screen *ebiten.Image, cameraX float64) {}
+
+// DrawHUD 默认不绘制
+func (BaseMiniGameRule) DrawHUD(screen *ebiten.Image) {}
+
+// SaveState 默认没有需要保存的状态
+func (BaseMiniGameRule) SaveState(saveData *game.BattleSaveData) {}
+
+// RestoreState 默认没有需要恢复的状态
+func (BaseMiniGameRule) RestoreState(saveData *game.BattleSaveData) {}
+
+// placeShovelByMenu 将铲子卡槽固定在菜单按钮左侧（没有植物选择栏的小游戏使用）
+// 铲子右边缘到菜单按钮左边缘的距离为 BowlingShovelGapFromMenuButton
+func placeShovelByMenu(ctx *MiniGameContext) {
+	if ctx.SetShovelSlot == nil {
+		return
+	}
+	menuButtonX := float64(config.GameWindowWidth) - config.MenuButtonOffsetFromRight
+	ctx.SetShovelSlot(menuButtonX-float64(config.BowlingShovelGapFromMenuButton)-float64(config.ShovelWidth), float64(config.BowlingShovelY))
+}
+
+// MiniGameInfo 小游戏菜单中显示的模式信息
+type MiniGameInfo struct {
+	ID      string // 规则名（specialRules）
+	Name    string // 菜单显示名称
+	LevelID string // 进入该模式时加载的关卡ID
+
+	// SpawnsZombies 规则自行生成僵尸（关卡可以不配置波次）
+	SpawnsZombies bool
+
+	// HUD 对 HUD 元素的调整（零值表示与普通关卡相同）
+	HUD MiniGameHUD
+}
+
+// miniGameEntry 已注册的小游戏
+type miniGameEntry struct {
+	info    MiniGameInfo
+	factory func() MiniGameRule
+}
+
+// miniGameRegistry 已注册的小游戏（按注册顺序）
+var miniGameRegistry []miniGameEntry
+
+// RegisterMiniGame 注册小游戏规则
+//
+// 规则名必须同时登记为 config 包中合法的 specialRules，关卡配置才能通过验证；
+// LevelID 为空的规则（如冒险模式中的保龄球关卡）不会出现在小游戏菜单中
+//
+// 参数：
+//   - info: 模式信息
+//   - factory: 创建规则实例的函数（每次进入关卡创建新实例）
+func RegisterMiniGame(info MiniGameInfo, factory func() MiniGameRule) {
+	for i, entry := range miniGameRegistry {
+		if entry.info.ID == info.ID {
+			log.Printf("[MiniGame] Warning: rule %q registered twice, replacing", info.ID)
+			miniGameRegistry[i] = miniGameEntry{info: info, factory: factory}
+			return
+		}
+	}
+	miniGameRegistry = append(miniGameRegistry, miniGameEntry{info: info, factory: factory})
+}
+
+// NewMiniGameRule 根据规则名创建小游戏规则，未注册的规则名返回 nil
+func NewMiniGameRule(ruleID string) MiniGameRule {
+	for _, entry := range miniGameRegistry {
+		if entry.info.ID == ruleID {
+			return entry.factory()
+		}
+	}
+	return nil
+}
+
+// LookupMiniGame 根据规则名获取已注册的小游戏信息
+func LookupMiniGame(ruleID string) (MiniGameInfo, bool) {
+	for _, entry := range miniGameRegistry {
+		if entry.info.ID == ruleID {
+			return entry.info, true
+		}
+	}
+	return MiniGameInfo{}, false
+}
+
+// miniGameHidesSeedBank 检查规则是否隐藏植物选择栏（未注册的规则返回 false）
+func miniGameHidesSeedBank(ruleID string) bool {
+	info, _ := LookupMiniGame(ruleID)
+	return info.HUD.HideSeedBank
+}
+
+// RegisteredMiniGames 返回小游戏菜单中可选的模式（按注册顺序，不含没有关卡的规则）
+func RegisteredMiniGames() []MiniGameInfo {
+	games := make([]MiniGameInfo, 0, len(miniGameRegistry))
+	for _, entry := range miniGameRegistry {
+		if entry.info.LevelID != "" {
+			games = append(games, entry.info)
+		}
+	}
+	return games
+}
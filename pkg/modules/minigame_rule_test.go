@@ -0,0 +1,418 @@
+package modules
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// testMiniGameRule 只覆盖胜负判定的测试规则
+type testMiniGameRule struct {
+	BaseMiniGameRule
+}
+
+func (r *testMiniGameRule) ID() string          { return "test_minigame" }
+func (r *testMiniGameRule) CheckResult() string { return MiniGameResultWin }
+
+// TestRegisterMiniGame 测试注册小游戏规则
+func TestRegisterMiniGame(t *testing.T) {
+	defer func(registry []miniGameEntry) { miniGameRegistry = registry }(append([]miniGameEntry(nil), miniGameRegistry...))
+
+	RegisterMiniGame(MiniGameInfo{ID: "test_minigame", Name: "测试", LevelID: "test"}, func() MiniGameRule {
+		return &testMiniGameRule{}
+	})
+
+	rule := NewMiniGameRule("test_minigame")
+	if rule == nil {
+		t.Fatal("expected registered rule to be created")
+	}
+	if rule.CheckResult() != MiniGameResultWin {
+		t.Errorf("expected overridden CheckResult, got %q", rule.CheckResult())
+	}
+	// 未覆盖的钩子使用默认实现
+	if !rule.AllowsInput(MiniGameInputShovel) {
+		t.Error("expected base rule to allow all inputs")
+	}
+	if info, ok := LookupMiniGame("test_minigame"); !ok || info.HUD != (MiniGameHUD{}) {
+		t.Errorf("expected zero HUD, got %+v", info.HUD)
+	}
+
+	found := false
+	for _, info := range RegisteredMiniGames() {
+		if info.ID == "test_minigame" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected test_minigame in RegisteredMiniGames")
+	}
+}
+
+// TestMiniGamesAreValidSpecialRules 测试每个小游戏规则名都已登记为合法的 specialRules
+func TestMiniGamesAreValidSpecialRules(t *testing.T) {
+	for _, entry := range miniGameRegistry {
+		info := entry.info
+		if !config.IsValidSpecialRule(info.ID) {
+			t.Errorf("mini-game rule %q is not a valid specialRules in config", info.ID)
+			continue
+		}
+		if config.SpecialRuleSpawnsZombies(info.ID) != info.SpawnsZombies {
+			t.Errorf("mini-game rule %q: SpawnsZombies=%v, config says %v", info.ID, info.SpawnsZombies, config.SpecialRuleSpawnsZombies(info.ID))
+		}
+	}
+}
+
+// TestNewMiniGameRule_Unknown 测试未注册的规则名
+func TestNewMiniGameRule_Unknown(t *testing.T) {
+	if rule := NewMiniGameRule("no_such_rule"); rule != nil {
+		t.Errorf("expected nil for unknown rule, got %T", rule)
+	}
+	if rule := NewMiniGameRule(""); rule != nil {
+		t.Errorf("expected nil for empty rule, got %T", rule)
+	}
+}
+
+// TestBowlingRule 测试坚果保龄球规则的注册和钩子
+func TestBowlingRule(t *testing.T) {
+	rule := NewMiniGameRule(BowlingRuleID)
+	if _, ok := rule.(*BowlingRule); !ok {
+		t.Fatalf("expected *BowlingRule, got %T", rule)
+	}
+
+	info, _ := LookupMiniGame(BowlingRuleID)
+	hud := info.HUD
+	if !hud.HideSeedBank || !hud.HideSunCounter || !hud.DisableSkySun {
+		t.Errorf("expected bowling HUD to hide seed bank and sun, got %+v", hud)
+	}
+	if rule.AllowsInput(MiniGameInputPlantCards) {
+		t.Error("expected bowling to disallow plant cards")
+	}
+	if !rule.AllowsInput(MiniGameInputShovel) {
+		t.Error("expected bowling to allow shovel")
+	}
+	if !miniGameHidesSeedBank(BowlingRuleID) {
+		t.Error("expected bowling to hide seed bank")
+	}
+}
+
+// TestBowlingRule_Setup 测试保龄球规则固定铲子位置，并在铲子教学转场完成后创建除草车
+func TestBowlingRule_Setup(t *testing.T) {
+	em := ecs.NewEntityManager()
+	phaseSystem := systems.NewLevelPhaseSystem(em, game.GetGameState(), nil)
+
+	var shovelX, shovelY float64
+	shovelPlaced := false
+	lawnmowersCreated := false
+	rule := NewBowlingRule()
+	err := rule.Setup(&MiniGameContext{
+		EntityManager:    em,
+		LevelPhaseSystem: phaseSystem,
+		InitLawnmowers:   func() { lawnmowersCreated = true },
+		SetShovelSlot: func(x, y float64) {
+			shovelX, shovelY, shovelPlaced = x, y, true
+		},
+	})
+	if err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	// 铲子右边缘到菜单按钮左边缘留出间距
+	wantX := float64(config.GameWindowWidth) - config.MenuButtonOffsetFromRight - float64(config.BowlingShovelGapFromMenuButton) - float64(config.ShovelWidth)
+	if !shovelPlaced || shovelX != wantX || shovelY != float64(config.BowlingShovelY) {
+		t.Errorf("expected shovel slot at (%.0f, %d), got placed=%v (%.0f, %.0f)", wantX, config.BowlingShovelY, shovelPlaced, shovelX, shovelY)
+	}
+
+	phaseSystem.StartPhaseTransition(1, 2)
+	for i := 0; i < 300 && phaseSystem.IsTransitioning(); i++ {
+		phaseSystem.Update(0.016)
+	}
+	if !phaseSystem.IsInPhase(2) {
+		t.Fatal("expected phase transition to complete")
+	}
+	if !lawnmowersCreated {
+		t.Error("expected lawnmowers to be created when the bowling phase starts")
+	}
+}
+
+// TestBowlingRule_SaveState 测试保龄球规则保存滚动中的坚果
+func TestBowlingRule_SaveState(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rule := NewBowlingRule()
+	if err := rule.Setup(&MiniGameContext{EntityManager: em}); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	nut := em.CreateEntity()
+	ecs.AddComponent(em, nut, &components.PositionComponent{X: 320, Y: 250})
+	ecs.AddComponent(em, nut, &components.BowlingNutComponent{Row: 2, VelocityX: 250, IsRolling: true, IsExplosive: true})
+
+	saveData := game.NewBattleSaveData()
+	rule.SaveState(saveData)
+
+	if len(saveData.BowlingNuts) != 1 {
+		t.Fatalf("expected 1 bowling nut, got %d", len(saveData.BowlingNuts))
+	}
+	got := saveData.BowlingNuts[0]
+	if got.X != 320 || got.Row != 2 || got.VelocityX != 250 || !got.IsRolling || !got.IsExplosive {
+		t.Errorf("unexpected bowling nut data: %+v", got)
+	}
+}
+
+// TestWhackZombieRule 测试锤僵尸规则的注册和钩子
+func TestWhackZombieRule(t *testing.T) {
+	rule := NewMiniGameRule(WhackZombieRuleID)
+	if _, ok := rule.(*WhackZombieRule); !ok {
+		t.Fatalf("expected *WhackZombieRule, got %T", rule)
+	}
+	if rule.AllowsInput(MiniGameInputShovel) {
+		t.Error("expected whack-a-zombie to disallow shovel")
+	}
+	if !rule.AllowsInput(MiniGameInputPlantCards) {
+		t.Error("expected whack-a-zombie to allow plant cards")
+	}
+	if rule.CheckResult() != MiniGameResultNone {
+		t.Errorf("expected no result at start, got %q", rule.CheckResult())
+	}
+}
+
+// TestWhackZombieRule_HitZombie 测试锤子先砸护甲、再砸身体，并在砸死后计数
+func TestWhackZombieRule_HitZombie(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rule := NewWhackZombieRule()
+	if err := rule.Setup(&MiniGameContext{EntityManager: em}); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	zombie := em.CreateEntity()
+	ecs.AddComponent(em, zombie, &components.HealthComponent{CurrentHealth: 270, MaxHealth: 270})
+	ecs.AddComponent(em, zombie, &components.AccessoryComponent{
+		Pieces: []*components.AccessoryPiece{{Tag: types.AccessoryCone, Tier: 1, Durability: 370, MaxDurability: 370}},
+	})
+
+	// 第一锤只砸掉路障，溢出伤害不计入身体
+	rule.handleClick(zombie, 0, 0)
+	health, _ := ecs.GetComponent[*components.HealthComponent](em, zombie)
+	if health.CurrentHealth != 270 || rule.kills != 0 {
+		t.Fatalf("expected armor to absorb first hit, health=%d kills=%d", health.CurrentHealth, rule.kills)
+	}
+
+	// 模拟 BehaviorSystem 移除破碎的护甲
+	accessory, _ := ecs.GetComponent[*components.AccessoryComponent](em, zombie)
+	accessory.Remove(types.AccessoryCone)
+
+	rule.handleClick(zombie, 0, 0)
+	if rule.kills != 1 {
+		t.Fatalf("expected second hit to kill, kills=%d", rule.kills)
+	}
+
+	// 已死亡的僵尸不会重复计数，空挥也不计数
+	rule.handleClick(zombie, 0, 0)
+	rule.handleClick(0, 0, 0)
+	if rule.kills != 1 {
+		t.Errorf("expected kills to stay 1, got %d", rule.kills)
+	}
+}
+
+// TestWhackZombieRule_CheckResult 测试达到击杀数后获胜
+func TestWhackZombieRule_CheckResult(t *testing.T) {
+	rule := NewWhackZombieRule()
+	rule.kills = config.WhackZombieKillQuota - 1
+	if rule.CheckResult() != MiniGameResultNone {
+		t.Errorf("expected no result below quota, got %q", rule.CheckResult())
+	}
+	rule.kills = config.WhackZombieKillQuota
+	if rule.CheckResult() != MiniGameResultWin {
+		t.Errorf("expected win at quota, got %q", rule.CheckResult())
+	}
+}
+
+// TestWhackZombieRule_RiseInterval 测试爬出间隔随击杀数缩短且有下限
+func TestWhackZombieRule_RiseInterval(t *testing.T) {
+	rule := NewWhackZombieRule()
+	if got := rule.riseInterval(); got != config.WhackZombieRiseInterval {
+		t.Errorf("expected initial interval %.2f, got %.2f", config.WhackZombieRiseInterval, got)
+	}
+	rule.kills = 10
+	if got := rule.riseInterval(); got >= config.WhackZombieRiseInterval {
+		t.Errorf("expected interval to shrink, got %.2f", got)
+	}
+	rule.kills = 1000
+	if got := rule.riseInterval(); got != config.WhackZombieRiseIntervalMin {
+		t.Errorf("expected minimum interval %.2f, got %.2f", config.WhackZombieRiseIntervalMin, got)
+	}
+}
+
+// TestWhackZombieRule_SaveRestore 测试击杀数和爬出计时的存档往返
+func TestWhackZombieRule_SaveRestore(t *testing.T) {
+	rule := NewWhackZombieRule()
+	rule.kills = 12
+	rule.riseTimer = 1.25
+
+	saveData := game.NewBattleSaveData()
+	rule.SaveState(saveData)
+	if saveData.MiniGame == nil || saveData.MiniGame.RuleID != WhackZombieRuleID {
+		t.Fatalf("expected mini-game save data for %s, got %+v", WhackZombieRuleID, saveData.MiniGame)
+	}
+
+	restored := NewWhackZombieRule()
+	restored.RestoreState(saveData)
+	if restored.kills != 12 || restored.riseTimer != 1.25 {
+		t.Errorf("expected kills=12 riseTimer=1.25, got kills=%d riseTimer=%.2f", restored.kills, restored.riseTimer)
+	}
+
+	// 其他规则的存档数据不会被恢复
+	saveData.MiniGame.RuleID = BowlingRuleID
+	other := NewWhackZombieRule()
+	other.RestoreState(saveData)
+	if other.kills != 0 {
+		t.Errorf("expected foreign save data to be ignored, got kills=%d", other.kills)
+	}
+}
+
+// TestVasebreakerRule 测试砸罐子规则的注册和钩子
+func TestVasebreakerRule(t *testing.T) {
+	rule := NewMiniGameRule(VasebreakerRuleID)
+	if _, ok := rule.(*VasebreakerRule); !ok {
+		t.Fatalf("expected *VasebreakerRule, got %T", rule)
+	}
+	for _, info := range RegisteredMiniGames() {
+		if info.ID == VasebreakerRuleID {
+			t.Error("expected vasebreaker to stay out of the mini-game menu")
+		}
+	}
+	if rule.AllowsInput(MiniGameInputPlantCards) {
+		t.Error("expected vasebreaker to disallow plant cards")
+	}
+	if info, _ := LookupMiniGame(VasebreakerRuleID); !info.HUD.HideSeedBank || !info.HUD.HideSunCounter {
+		t.Errorf("expected seed bank and sun counter to be hidden, got %+v", info.HUD)
+	}
+}
+
+// TestAssignVaseContents 测试罐子内容分配：固定种子结果固定，带标记的罐子装对应的内容
+func TestAssignVaseContents(t *testing.T) {
+	vases := []config.VasePosition{
+		{Row: 1, Col: 7, Type: config.VaseTypePlant},
+		{Row: 2, Col: 8, Type: config.VaseTypeZombie},
+		{Row: 3, Col: 9, Type: config.VaseTypeRegular},
+		{Row: 4, Col: 9, Type: config.VaseTypeRegular},
+	}
+	contents := []config.VaseContent{
+		{Plant: "peashooter", Count: 2},
+		{Zombie: "basic", Count: 2},
+	}
+
+	first, err := assignVaseContents(vases, contents, 42)
+	if err != nil {
+		t.Fatalf("assignVaseContents failed: %v", err)
+	}
+	second, _ := assignVaseContents(vases, contents, 42)
+	for i := range first {
+		if first[i] != second[i] {
+			t.Errorf("expected same seed to give same contents, vase %d: %+v vs %+v", i, first[i], second[i])
+		}
+	}
+	if first[0].Plant == "" {
+		t.Errorf("expected plant vase to hold a plant, got %+v", first[0])
+	}
+	if first[1].Zombie == "" {
+		t.Errorf("expected zombie vase to hold a zombie, got %+v", first[1])
+	}
+
+	plants, zombies := 0, 0
+	for _, content := range first {
+		if content.Plant != "" {
+			plants++
+		} else {
+			zombies++
+		}
+	}
+	if plants != 2 || zombies != 2 {
+		t.Errorf("expected 2 plants and 2 zombies, got %d and %d", plants, zombies)
+	}
+
+	// 植物罐子比植物内容多时报错
+	short := []config.VaseContent{{Zombie: "basic", Count: 4}}
+	if _, err := assignVaseContents(vases, short, 42); err == nil {
+		t.Error("expected error when plant vases outnumber plant contents")
+	}
+}
+
+// TestVasebreakerRule_CheckResult 测试罐子全部打碎且僵尸全部消灭后获胜
+func TestVasebreakerRule_CheckResult(t *testing.T) {
+	em := ecs.NewEntityManager()
+	gs := &game.GameState{}
+	rule := NewVasebreakerRule()
+	if err := rule.Setup(&MiniGameContext{EntityManager: em, GameState: gs, LawnLayout: config.GetLawnLayout("day")}); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+	if rule.CheckResult() != MiniGameResultNone {
+		t.Errorf("expected no result before vases are placed, got %q", rule.CheckResult())
+	}
+
+	rule.vasesPlaced = true
+	vase := em.CreateEntity()
+	ecs.AddComponent(em, vase, &components.VaseComponent{Col: 5, Row: 0})
+	if rule.CheckResult() != MiniGameResultNone {
+		t.Errorf("expected no result while a vase remains, got %q", rule.CheckResult())
+	}
+
+	em.DestroyEntity(vase)
+	em.RemoveMarkedEntities()
+	gs.ZombiesSummoned = 2
+	gs.ZombiesKilled = 1
+	if rule.CheckResult() != MiniGameResultNone {
+		t.Errorf("expected no result while zombies remain, got %q", rule.CheckResult())
+	}
+
+	gs.ZombiesKilled = 2
+	if rule.CheckResult() != MiniGameResultWin {
+		t.Errorf("expected win, got %q", rule.CheckResult())
+	}
+}
+
+// TestVasebreakerRule_SeedPacket 测试种子包弹出后落地，取消种植时放回原地
+func TestVasebreakerRule_SeedPacket(t *testing.T) {
+	em := ecs.NewEntityManager()
+	gs := &game.GameState{}
+	rule := NewVasebreakerRule()
+	if err := rule.Setup(&MiniGameContext{EntityManager: em, GameState: gs, LawnLayout: config.GetLawnLayout("day")}); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+	rule.vasesPlaced = true
+
+	packetID := em.CreateEntity()
+	ecs.AddComponent(em, packetID, &components.PositionComponent{X: 500, Y: 300})
+	ecs.AddComponent(em, packetID, &components.SeedPacketComponent{
+		PlantType: components.PlantPeashooter,
+		VelocityY: config.SeedPacketPopSpeed,
+		TargetY:   315,
+	})
+
+	for i := 0; i < 120; i++ {
+		rule.Update(1.0 / 60)
+	}
+	packet, _ := ecs.GetComponent[*components.SeedPacketComponent](em, packetID)
+	pos, _ := ecs.GetComponent[*components.PositionComponent](em, packetID)
+	if !packet.Landed || pos.Y != 315 {
+		t.Fatalf("expected packet to land at y=315, landed=%v y=%.1f", packet.Landed, pos.Y)
+	}
+
+	// 拾起后仍在种植模式时保持拾起状态，退出种植模式后放下
+	packet.Held = true
+	rule.heldPacket = packetID
+	gs.IsPlantingMode = true
+	rule.Update(1.0 / 60)
+	if !packet.Held {
+		t.Error("expected packet to stay held while planting")
+	}
+	gs.IsPlantingMode = false
+	rule.Update(1.0 / 60)
+	if packet.Held || rule.heldPacket != 0 {
+		t.Errorf("expected packet to be released after planting is cancelled, held=%v heldPacket=%d", packet.Held, rule.heldPacket)
+	}
+}
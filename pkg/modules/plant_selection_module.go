@@ -112,37 +112,54 @@ func NewPlantSelectionModule(
 	return module, nil
 }
 
+// plantTypeByID 植物ID到植物类型的映射（关卡配置中的植物ID，如 "peashooter"）
+// 注意：只包含当前已实现的植物类型
+var plantTypeByID = map[string]components.PlantType{
+	"sunflower":    components.PlantSunflower,
+	"peashooter":   components.PlantPeashooter,
+	"wallnut":      components.PlantWallnut,
+	"cherrybomb":   components.PlantCherryBomb,
+	"magnetshroom": components.PlantMagnetshroom,
+	"hypnoshroom":  components.PlantHypnoshroom,
+	"kernelpult":   components.PlantKernelpult,
+	"cobcannon":    components.PlantCobCannon,
+	"splitpea":     components.PlantSplitPea,
+	"starfruit":    components.PlantStarfruit,
+	"cattail":      components.PlantCattail,
+	"tallnut":      components.PlantTallnut,
+	"gravebuster":  components.PlantGraveBuster,
+	"lilypad":      components.PlantLilyPad,
+	"tanglekelp":   components.PlantTangleKelp,
+	"seashroom":    components.PlantSeaShroom,
+	"plantern":     components.PlantPlantern,
+	"blover":       components.PlantBlover,
+	"flowerpot":    components.PlantFlowerPot,
+	"jalapeno":     components.PlantJalapeno,
+	"iceshroom":    components.PlantIceShroom,
+	"spikeweed":    components.PlantSpikeweed,
+	"chomper":      components.PlantChomper,
+	"umbrellaleaf": components.PlantUmbrellaLeaf,
+	// TODO: 未来添加更多植物类型（Epic 8+）
+	// "potatomine":    components.PlantPotatoMine,
+	// "snowpea":       components.PlantSnowPea,
+	// "repeater":      components.PlantRepeater,
+	// "puffshroom":    components.PlantPuffShroom,
+	// "sunshroom":     components.PlantSunShroom,
+	// "fumeshroom":    components.PlantFumeShroom,
+	// "hypnoshroom":   components.PlantHypnoShroom,
+	// "scaredyshroom": components.PlantScaredyshroom,
+	// "doomshroom":    components.PlantDoomShroom,
+}
+
 // createPlantCards 根据关卡配置创建植物卡片实体
 // 内部方法，由 NewPlantSelectionModule 调用
 func (m *PlantSelectionModule) createPlantCards(levelConfig *config.LevelConfig, seedBankX, seedBankY float64) error {
-	// 植物类型映射（从字符串ID到组件类型）
-	// 注意：只包含当前已实现的植物类型
-	plantTypeMap := map[string]components.PlantType{
-		"sunflower":  components.PlantSunflower,
-		"peashooter": components.PlantPeashooter,
-		"wallnut":    components.PlantWallnut,
-		"cherrybomb": components.PlantCherryBomb,
-		// TODO: 未来添加更多植物类型（Epic 8+）
-		// "potatomine":    components.PlantPotatoMine,
-		// "snowpea":       components.PlantSnowPea,
-		// "chomper":       components.PlantChomper,
-		// "repeater":      components.PlantRepeater,
-		// "puffshroom":    components.PlantPuffShroom,
-		// "sunshroom":     components.PlantSunShroom,
-		// "fumeshroom":    components.PlantFumeShroom,
-		// "gravebuster":   components.PlantGraveBuster,
-		// "hypnoshroom":   components.PlantHypnoShroom,
-		// "scaredyshroom": components.PlantScaredyshroom,
-		// "iceshroom":     components.PlantIceShroom,
-		// "doomshroom":    components.PlantDoomShroom,
-	}
-
 	// 获取本关可用植物列表
 	availablePlants := levelConfig.AvailablePlants
 
-	// Story 19.5: 保龄球模式（initialSun == 0）使用传送带，不创建植物卡片
-	if levelConfig.InitialSun == 0 && len(availablePlants) == 0 {
-		log.Printf("[PlantSelectionModule] Bowling mode: skipping plant card creation (uses conveyor belt)")
+	// Story 19.5: 隐藏植物选择栏的小游戏规则（如保龄球使用传送带）不创建植物卡片
+	if len(availablePlants) == 0 && miniGameHidesSeedBank(levelConfig.SpecialRules) {
+		log.Printf("[PlantSelectionModule] Mini-game %q hides seed bank: skipping plant card creation", levelConfig.SpecialRules)
 		return nil
 	}
 
@@ -165,7 +182,7 @@ func (m *PlantSelectionModule) createPlantCards(levelConfig *config.LevelConfig,
 
 	// 创建所有可用植物的卡片
 	for i, plantName := range availablePlants {
-		plantType, ok := plantTypeMap[plantName]
+		plantType, ok := plantTypeByID[plantName]
 		if !ok {
 			log.Printf("[PlantSelectionModule] Warning: Unknown plant type '%s', skipping", plantName)
 			continue
@@ -283,6 +300,17 @@ func (m *PlantSelectionModule) ConfirmSelection() error {
 	return m.selectionSystem.ConfirmSelection()
 }
 
+// ReplacePlantCards 用新的植物列表替换选择栏中的卡片
+// 用于生存模式每组旗帜之间重新选择植物，新卡片没有冷却
+//
+// 参数:
+//   - plants: 新的植物ID列表
+//   - seedBankX, seedBankY: 植物选择栏位置
+func (m *PlantSelectionModule) ReplacePlantCards(plants []string, seedBankX, seedBankY float64) error {
+	m.Cleanup()
+	return m.createPlantCards(&config.LevelConfig{AvailablePlants: plants}, seedBankX, seedBankY)
+}
+
 // Cleanup 清理模块资源
 // 用途：
 //   - 场景切换时清理所有卡片实体
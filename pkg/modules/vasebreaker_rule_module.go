@@ -0,0 +1,459 @@
+package modules
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"log"
+	"math/rand"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+	"github.com/gonewx/pvz/pkg/utils"
+)
+
+// VasebreakerRuleID 砸罐子的规则名
+const VasebreakerRuleID = "vasebreaker"
+
+func init() {
+	// 砸罐子从主菜单的解谜模式按钮进入，不出现在小游戏菜单中
+	// 隐藏植物选择栏和阳光，铲子放在菜单按钮左侧
+	RegisterMiniGame(MiniGameInfo{
+		ID:            VasebreakerRuleID,
+		Name:          "砸罐子",
+		SpawnsZombies: true,
+		HUD: MiniGameHUD{
+			HideSeedBank:   true,
+			HideSunCounter: true,
+			DisableSkySun:  true,
+		},
+	}, func() MiniGameRule {
+		return NewVasebreakerRule()
+	})
+}
+
+// vaseShatterEffects 罐子破碎时的粒子效果（按罐子类型）
+var vaseShatterEffects = map[string]string{
+	config.VaseTypeRegular: "VaseShatter",
+	config.VaseTypePlant:   "VaseShatterLeaf",
+	config.VaseTypeZombie:  "VaseShatterZombie",
+}
+
+// VasebreakerRule 砸罐子规则
+//
+// 职责：
+//   - 进入关卡时按关卡配置摆放罐子，罐子的内容由内容表随机分配（可以用 vaseSeed 固定）
+//   - 点击罐子使其破碎：僵尸立即开始行走，种子包弹出后落在草坪上
+//   - 点击种子包拾起后进入种植模式，种植不消耗阳光，种下后种子包消失
+//   - 所有罐子都被打碎、所有僵尸都被消灭后获胜
+//
+// 没有植物选择栏、阳光和天空阳光，植物只能来自罐子里的种子包
+type VasebreakerRule struct {
+	BaseMiniGameRule
+
+	entityManager    *ecs.EntityManager
+	gameState        *game.GameState
+	resourceManager  *game.ResourceManager
+	reanimSystem     *systems.ReanimSystem
+	inputSystem      *systems.InputSystem
+	lawnGridSystem   *systems.LawnGridSystem
+	lawnGridEntityID ecs.EntityID
+	lawnLayout       config.LawnLayout
+	levelConfig      *config.LevelConfig
+
+	vasesPlaced bool         // 罐子是否已摆放（新关卡在第一帧摆放，读档时从存档恢复）
+	heldPacket  ecs.EntityID // 已拾起、正在种植的种子包（0 表示没有）
+}
+
+// vasebreakerState 砸罐子规则的存档状态
+type vasebreakerState struct {
+	Vases   []vaseState
+	Packets []seedPacketState
+}
+
+// vaseState 未打碎的罐子
+type vaseState struct {
+	Col        int
+	Row        int
+	Type       string
+	PlantType  components.PlantType
+	ZombieType string
+}
+
+// seedPacketState 草坪上的种子包（读档后直接落在地面上）
+type seedPacketState struct {
+	PlantType components.PlantType
+	X         float64
+	Y         float64
+}
+
+// NewVasebreakerRule 创建砸罐子规则
+func NewVasebreakerRule() *VasebreakerRule {
+	return &VasebreakerRule{}
+}
+
+// ID 规则名
+func (r *VasebreakerRule) ID() string {
+	return VasebreakerRuleID
+}
+
+// Setup 接入草坪点击和免费种植
+func (r *VasebreakerRule) Setup(ctx *MiniGameContext) error {
+	r.entityManager = ctx.EntityManager
+	r.gameState = ctx.GameState
+	r.resourceManager = ctx.ResourceManager
+	r.reanimSystem = ctx.ReanimSystem
+	r.inputSystem = ctx.InputSystem
+	r.lawnGridSystem = ctx.LawnGridSystem
+	r.lawnGridEntityID = ctx.LawnGridEntityID
+	r.lawnLayout = ctx.LawnLayout
+	r.levelConfig = ctx.LevelConfig
+
+	if r.inputSystem != nil {
+		r.inputSystem.SetLawnClickHandler(r.handleClick)
+		r.inputSystem.SetFreePlanting(true)
+		r.inputSystem.SetPlantedHandler(r.onPlanted)
+	}
+
+	// 没有植物选择栏，铲子放在菜单按钮左侧
+	placeShovelByMenu(ctx)
+
+	log.Printf("[VasebreakerRule] Initialized")
+	return nil
+}
+
+// Update 摆放罐子、让弹出的种子包落地，并在取消种植时放下拾起的种子包
+func (r *VasebreakerRule) Update(deltaTime float64) {
+	if !r.vasesPlaced {
+		r.placeVases()
+	}
+
+	for _, entityID := range ecs.GetEntitiesWith2[*components.SeedPacketComponent, *components.PositionComponent](r.entityManager) {
+		packet, _ := ecs.GetComponent[*components.SeedPacketComponent](r.entityManager, entityID)
+		if packet.Landed {
+			continue
+		}
+		pos, _ := ecs.GetComponent[*components.PositionComponent](r.entityManager, entityID)
+		pos.Y += packet.VelocityY * deltaTime
+		packet.VelocityY += config.SeedPacketGravity * deltaTime
+		if packet.VelocityY > 0 && pos.Y >= packet.TargetY {
+			pos.Y = packet.TargetY
+			packet.VelocityY = 0
+			packet.Landed = true
+		}
+	}
+
+	// 右键取消种植后，种子包留在原地
+	if r.heldPacket != 0 && (r.gameState == nil || !r.gameState.IsPlantingMode) {
+		if packet, ok := ecs.GetComponent[*components.SeedPacketComponent](r.entityManager, r.heldPacket); ok {
+			packet.Held = false
+		}
+		r.heldPacket = 0
+	}
+}
+
+// CheckResult 所有罐子都被打碎、所有僵尸都被消灭后获胜（失败由 LevelSystem 的默认判定处理）
+func (r *VasebreakerRule) CheckResult() string {
+	if !r.vasesPlaced || r.gameState == nil {
+		return MiniGameResultNone
+	}
+	if len(ecs.GetEntitiesWith1[*components.VaseComponent](r.entityManager)) > 0 {
+		return MiniGameResultNone
+	}
+	if r.gameState.ZombiesKilled < r.gameState.ZombiesSummoned {
+		return MiniGameResultNone
+	}
+	return MiniGameResultWin
+}
+
+// AllowsInput 没有植物选择栏
+func (r *VasebreakerRule) AllowsInput(input MiniGameInput) bool {
+	return input != MiniGameInputPlantCards
+}
+
+// SaveState 保存未打碎的罐子和草坪上的种子包
+func (r *VasebreakerRule) SaveState(saveData *game.BattleSaveData) {
+	var state vasebreakerState
+	for _, entityID := range ecs.GetEntitiesWith1[*components.VaseComponent](r.entityManager) {
+		vase, _ := ecs.GetComponent[*components.VaseComponent](r.entityManager, entityID)
+		state.Vases = append(state.Vases, vaseState{
+			Col:        vase.Col,
+			Row:        vase.Row,
+			Type:       vase.Type,
+			PlantType:  vase.PlantType,
+			ZombieType: vase.ZombieType,
+		})
+	}
+	for _, entityID := range ecs.GetEntitiesWith2[*components.SeedPacketComponent, *components.PositionComponent](r.entityManager) {
+		packet, _ := ecs.GetComponent[*components.SeedPacketComponent](r.entityManager, entityID)
+		pos, _ := ecs.GetComponent[*components.PositionComponent](r.entityManager, entityID)
+		state.Packets = append(state.Packets, seedPacketState{PlantType: packet.PlantType, X: pos.X, Y: packet.TargetY})
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(state); err != nil {
+		log.Printf("[VasebreakerRule] ERROR: Failed to encode state: %v", err)
+		return
+	}
+	saveData.MiniGame = &game.MiniGameSaveData{RuleID: VasebreakerRuleID, State: buffer.Bytes()}
+}
+
+// RestoreState 恢复未打碎的罐子和草坪上的种子包
+func (r *VasebreakerRule) RestoreState(saveData *game.BattleSaveData) {
+	if saveData.MiniGame == nil || saveData.MiniGame.RuleID != VasebreakerRuleID {
+		return
+	}
+	var state vasebreakerState
+	if err := gob.NewDecoder(bytes.NewReader(saveData.MiniGame.State)).Decode(&state); err != nil {
+		log.Printf("[VasebreakerRule] ERROR: Failed to decode state: %v", err)
+		return
+	}
+
+	r.vasesPlaced = true
+	for _, vase := range state.Vases {
+		if err := r.placeVase(vase.Col, vase.Row, vase.Type, vase.PlantType, vase.ZombieType); err != nil {
+			log.Printf("[VasebreakerRule] ERROR: Failed to restore vase at (%d, %d): %v", vase.Col, vase.Row, err)
+		}
+	}
+	for _, saved := range state.Packets {
+		entityID, err := r.spawnSeedPacket(saved.PlantType, saved.X, saved.Y, saved.Y)
+		if err != nil {
+			log.Printf("[VasebreakerRule] ERROR: Failed to restore seed packet: %v", err)
+			continue
+		}
+		packet, _ := ecs.GetComponent[*components.SeedPacketComponent](r.entityManager, entityID)
+		packet.VelocityY = 0
+		packet.Landed = true
+	}
+	log.Printf("[VasebreakerRule] Restored %d vases, %d seed packets", len(state.Vases), len(state.Packets))
+}
+
+// placeVases 按关卡配置摆放罐子并分配内容
+// vaseSeed 为 0 时每次进入关卡随机分配
+func (r *VasebreakerRule) placeVases() {
+	r.vasesPlaced = true
+	if r.levelConfig == nil || len(r.levelConfig.Vases) == 0 {
+		return
+	}
+
+	seed := r.levelConfig.VaseSeed
+	if seed == 0 {
+		seed = rand.Int63()
+	}
+	contents, err := assignVaseContents(r.levelConfig.Vases, r.levelConfig.VaseContents, seed)
+	if err != nil {
+		log.Printf("[VasebreakerRule] ERROR: Failed to assign vase contents: %v", err)
+		return
+	}
+
+	for i, vase := range r.levelConfig.Vases {
+		content := contents[i]
+		var plantType components.PlantType
+		if content.Plant != "" {
+			var ok bool
+			if plantType, ok = plantTypeByID[content.Plant]; !ok {
+				log.Printf("[VasebreakerRule] Warning: Unknown plant type '%s', skipping vase", content.Plant)
+				continue
+			}
+		}
+		if err := r.placeVase(vase.Col-1, vase.Row-1, vase.Type, plantType, content.Zombie); err != nil {
+			log.Printf("[VasebreakerRule] ERROR: Failed to place vase at (%d, %d): %v", vase.Col, vase.Row, err)
+		}
+	}
+	log.Printf("[VasebreakerRule] Placed %d vases (seed=%d)", len(r.levelConfig.Vases), seed)
+}
+
+// placeVase 在指定格子（0-based）创建罐子并占据格子
+func (r *VasebreakerRule) placeVase(col, row int, vaseType string, plantType components.PlantType, zombieType string) error {
+	entityID, err := entities.NewVaseEntity(r.entityManager, r.resourceManager, r.lawnLayout, col, row, vaseType, plantType, zombieType)
+	if err != nil {
+		return err
+	}
+	if r.lawnGridSystem != nil {
+		if err := r.lawnGridSystem.OccupyCell(r.lawnGridEntityID, col, row, entityID); err != nil {
+			r.entityManager.DestroyEntity(entityID)
+			return err
+		}
+	}
+	return nil
+}
+
+// handleClick 点击草坪（由 InputSystem 在非种植模式下调用）：优先拾起种子包，其次打碎罐子
+func (r *VasebreakerRule) handleClick(worldX, worldY float64) {
+	if r.gameState != nil && r.gameState.IsGameOver {
+		return
+	}
+
+	if packetID := r.findSeedPacketAt(worldX, worldY); packetID != 0 {
+		r.pickUpSeedPacket(packetID, worldX, worldY)
+		return
+	}
+
+	col, row, ok := utils.WorldToGridCoords(worldX, worldY, r.lawnLayout)
+	if !ok {
+		return
+	}
+	for _, entityID := range ecs.GetEntitiesWith1[*components.VaseComponent](r.entityManager) {
+		vase, _ := ecs.GetComponent[*components.VaseComponent](r.entityManager, entityID)
+		if vase.Col == col && vase.Row == row {
+			r.breakVase(entityID)
+			return
+		}
+	}
+}
+
+// findSeedPacketAt 查找世界坐标处已落地、未被拾起的种子包（多个重叠时返回最后一个）
+func (r *VasebreakerRule) findSeedPacketAt(worldX, worldY float64) ecs.EntityID {
+	var found ecs.EntityID
+	for _, entityID := range ecs.GetEntitiesWith2[*components.SeedPacketComponent, *components.PositionComponent](r.entityManager) {
+		packet, _ := ecs.GetComponent[*components.SeedPacketComponent](r.entityManager, entityID)
+		if !packet.Landed || packet.Held || packet.Card == nil || packet.Card.BackgroundImage == nil {
+			continue
+		}
+		pos, _ := ecs.GetComponent[*components.PositionComponent](r.entityManager, entityID)
+		bounds := packet.Card.BackgroundImage.Bounds()
+		halfW := float64(bounds.Dx()) * packet.Card.CardScale / 2
+		halfH := float64(bounds.Dy()) * packet.Card.CardScale / 2
+		if worldX >= pos.X-halfW && worldX <= pos.X+halfW && worldY >= pos.Y-halfH && worldY <= pos.Y+halfH {
+			found = entityID
+		}
+	}
+	return found
+}
+
+// pickUpSeedPacket 拾起种子包并进入种植模式
+func (r *VasebreakerRule) pickUpSeedPacket(packetID ecs.EntityID, worldX, worldY float64) {
+	packet, ok := ecs.GetComponent[*components.SeedPacketComponent](r.entityManager, packetID)
+	if !ok {
+		return
+	}
+	packet.Held = true
+	r.heldPacket = packetID
+	if r.inputSystem != nil {
+		r.inputSystem.BeginPlanting(packet.PlantType, worldX, worldY)
+	}
+	log.Printf("[VasebreakerRule] Picked up seed packet %d (plant type %v)", packetID, packet.PlantType)
+}
+
+// onPlanted 种下拾起的种子包后，种子包消失
+func (r *VasebreakerRule) onPlanted(plantType components.PlantType, col, row int) {
+	if r.heldPacket == 0 {
+		return
+	}
+	r.entityManager.DestroyEntity(r.heldPacket)
+	log.Printf("[VasebreakerRule] Seed packet %d planted at (%d, %d)", r.heldPacket, col, row)
+	r.heldPacket = 0
+}
+
+// breakVase 打碎罐子：释放格子，播放破碎效果，放出僵尸或种子包
+func (r *VasebreakerRule) breakVase(vaseID ecs.EntityID) {
+	vase, ok := ecs.GetComponent[*components.VaseComponent](r.entityManager, vaseID)
+	if !ok {
+		return
+	}
+	pos, _ := ecs.GetComponent[*components.PositionComponent](r.entityManager, vaseID)
+	x, y := pos.X, pos.Y
+	col, row := vase.Col, vase.Row
+	plantType, zombieType := vase.PlantType, vase.ZombieType
+
+	if r.lawnGridSystem != nil {
+		if err := r.lawnGridSystem.ReleaseCell(r.lawnGridEntityID, col, row); err != nil {
+			log.Printf("[VasebreakerRule] Warning: Failed to release vase cell: %v", err)
+		}
+	}
+	r.entityManager.DestroyEntity(vaseID)
+
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_VASE_BREAKING")
+	}
+	if r.resourceManager != nil {
+		// 碎片从罐子范围内飞出，落在罐子底部附近
+		bottomY := y + r.lawnLayout.CellHeight/2 + config.VaseOffsetY
+		if _, err := entities.CreateParticleEffect(r.entityManager, r.resourceManager, vaseShatterEffects[vase.Type], x-15, bottomY-70); err != nil {
+			log.Printf("[VasebreakerRule] Warning: Failed to create vase shatter effect: %v", err)
+		}
+	}
+
+	if zombieType != "" {
+		zombieID, err := systems.SpawnZombieAt(r.entityManager, r.resourceManager, r.gameState, r.lawnLayout, zombieType, col, row, 0)
+		if err != nil {
+			log.Printf("[VasebreakerRule] ERROR: Failed to spawn %s zombie from vase: %v", zombieType, err)
+			return
+		}
+		log.Printf("[VasebreakerRule] Vase %d at (%d, %d) released %s zombie %d", vaseID, col, row, zombieType, zombieID)
+		return
+	}
+
+	if _, err := r.spawnSeedPacket(plantType, x, y, y+config.SeedPacketLandOffsetY); err != nil {
+		log.Printf("[VasebreakerRule] ERROR: Failed to spawn seed packet from vase: %v", err)
+		return
+	}
+	log.Printf("[VasebreakerRule] Vase %d at (%d, %d) released seed packet (plant type %v)", vaseID, col, row, plantType)
+}
+
+// spawnSeedPacket 创建种子包实体
+func (r *VasebreakerRule) spawnSeedPacket(plantType components.PlantType, x, y, targetY float64) (ecs.EntityID, error) {
+	if r.resourceManager == nil || r.reanimSystem == nil {
+		return 0, fmt.Errorf("resource manager or reanim system not available")
+	}
+	return entities.NewSeedPacketEntity(r.entityManager, r.resourceManager, r.reanimSystem, plantType, x, y, targetY)
+}
+
+// assignVaseContents 按内容表为每个罐子分配内容（每项 Count 为 1）
+//
+// 植物罐子和僵尸罐子先分到对应的内容，普通罐子分到剩下的内容；
+// 相同的种子分配结果相同
+//
+// 返回：
+//   - 与 vases 一一对应的内容
+//   - 内容不足时返回错误
+func assignVaseContents(vases []config.VasePosition, contents []config.VaseContent, seed int64) ([]config.VaseContent, error) {
+	rng := rand.New(rand.NewSource(seed))
+
+	var plants, zombies []config.VaseContent
+	for _, content := range contents {
+		item := config.VaseContent{Plant: content.Plant, Zombie: content.Zombie, Count: 1}
+		for i := 0; i < content.Count; i++ {
+			if item.Plant != "" {
+				plants = append(plants, item)
+			} else {
+				zombies = append(zombies, item)
+			}
+		}
+	}
+	rng.Shuffle(len(plants), func(i, j int) { plants[i], plants[j] = plants[j], plants[i] })
+	rng.Shuffle(len(zombies), func(i, j int) { zombies[i], zombies[j] = zombies[j], zombies[i] })
+
+	assigned := make([]config.VaseContent, len(vases))
+	for i, vase := range vases {
+		switch vase.Type {
+		case config.VaseTypePlant:
+			if len(plants) == 0 {
+				return nil, fmt.Errorf("not enough seed packets for plant vase %d", i)
+			}
+			assigned[i], plants = plants[0], plants[1:]
+		case config.VaseTypeZombie:
+			if len(zombies) == 0 {
+				return nil, fmt.Errorf("not enough zombies for zombie vase %d", i)
+			}
+			assigned[i], zombies = zombies[0], zombies[1:]
+		}
+	}
+
+	rest := append(plants, zombies...)
+	rng.Shuffle(len(rest), func(i, j int) { rest[i], rest[j] = rest[j], rest[i] })
+	for i, vase := range vases {
+		if vase.Type == config.VaseTypePlant || vase.Type == config.VaseTypeZombie {
+			continue
+		}
+		if len(rest) == 0 {
+			return nil, fmt.Errorf("not enough contents for vase %d", i)
+		}
+		assigned[i], rest = rest[0], rest[1:]
+	}
+	return assigned, nil
+}
@@ -0,0 +1,335 @@
+package modules
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"image/color"
+	"log"
+	"math/rand"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+	"github.com/gonewx/pvz/pkg/utils"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// WhackZombieRuleID 锤僵尸的规则名
+const WhackZombieRuleID = "whack_zombie"
+
+func init() {
+	RegisterMiniGame(MiniGameInfo{
+		ID:            WhackZombieRuleID,
+		Name:          "锤僵尸",
+		LevelID:       config.WhackZombieLevelID,
+		SpawnsZombies: true,
+	}, func() MiniGameRule {
+		return NewWhackZombieRule()
+	})
+}
+
+// hammerTracks 锤子的可见轨道（隐藏锤子时全部隐藏）
+var hammerTracks = []string{"hammer_3", "hammer_2", "hammer1"}
+
+// WhackZombieRule 锤僵尸规则
+//
+// 职责：
+//   - 僵尸不断从随机墓碑下快速爬出（间隔随击杀数缩短）
+//   - 鼠标显示为锤子，点击僵尸造成伤害（普通僵尸一锤毙命，护甲僵尸需要多锤）
+//   - 天空阳光在夜晚也会掉落，且掉落更快
+//   - 用锤子砸死 WhackZombieKillQuota 只僵尸后获胜
+//
+// 锤子取代铲子，植物只能从关卡配置的固定卡片中种植
+type WhackZombieRule struct {
+	BaseMiniGameRule
+
+	entityManager   *ecs.EntityManager
+	gameState       *game.GameState
+	resourceManager *game.ResourceManager
+	lawnLayout      config.LawnLayout
+
+	hammerID      ecs.EntityID     // 锤子光标实体（0 表示尚未创建）
+	hammerVisible bool             // 锤子是否可见（暂停、种植模式时隐藏）
+	counterFont   *text.GoTextFace // 击杀计数字体
+	rng           *rand.Rand       // 墓碑和僵尸类型的随机数
+	riseTimer     float64          // 距离下一只僵尸爬出的时间（秒）
+	kills         int              // 用锤子砸死的僵尸数
+}
+
+// whackZombieState 锤僵尸规则的存档状态
+type whackZombieState struct {
+	Kills     int
+	RiseTimer float64
+}
+
+// NewWhackZombieRule 创建锤僵尸规则
+func NewWhackZombieRule() *WhackZombieRule {
+	return &WhackZombieRule{
+		rng:       rand.New(rand.NewSource(rand.Int63())),
+		riseTimer: config.WhackZombieFirstRiseDelay,
+	}
+}
+
+// ID 规则名
+func (r *WhackZombieRule) ID() string {
+	return WhackZombieRuleID
+}
+
+// Setup 接入锤子点击、开启加速的天空阳光并加载计数字体
+func (r *WhackZombieRule) Setup(ctx *MiniGameContext) error {
+	r.entityManager = ctx.EntityManager
+	r.gameState = ctx.GameState
+	r.resourceManager = ctx.ResourceManager
+	r.lawnLayout = ctx.LawnLayout
+
+	if inputSystem := ctx.InputSystem; inputSystem != nil {
+		inputSystem.SetLawnClickHandler(func(worldX, worldY float64) {
+			r.handleClick(inputSystem.FindZombieAt(worldX, worldY), worldX, worldY)
+		})
+	}
+
+	// 夜晚关卡默认没有天空阳光，锤僵尸模式的阳光掉落更快
+	if ctx.SunSpawnSystem != nil {
+		ctx.SunSpawnSystem.SetIntervalScale(config.WhackZombieSunIntervalScale)
+		ctx.SunSpawnSystem.Enable()
+	}
+
+	if ctx.ResourceManager != nil {
+		font, err := ctx.ResourceManager.LoadFont("assets/fonts/SimHei.ttf", config.WhackZombieCounterFontSize)
+		if err != nil {
+			log.Printf("[WhackZombieRule] Warning: Failed to load counter font: %v", err)
+		} else {
+			r.counterFont = font
+		}
+	}
+
+	log.Printf("[WhackZombieRule] Initialized, kill quota=%d", config.WhackZombieKillQuota)
+	return nil
+}
+
+// Update 更新锤子位置并让僵尸从墓碑下爬出
+func (r *WhackZombieRule) Update(deltaTime float64) {
+	r.updateHammer()
+
+	r.riseTimer -= deltaTime
+	if r.riseTimer > 0 {
+		return
+	}
+	r.riseTimer = r.riseInterval()
+	r.spawnZombie()
+}
+
+// CheckResult 砸死足够的僵尸后获胜（失败由 LevelSystem 的默认判定处理）
+func (r *WhackZombieRule) CheckResult() string {
+	if r.kills >= config.WhackZombieKillQuota {
+		return MiniGameResultWin
+	}
+	return MiniGameResultNone
+}
+
+// AllowsInput 锤子取代铲子
+func (r *WhackZombieRule) AllowsInput(input MiniGameInput) bool {
+	return input != MiniGameInputShovel
+}
+
+// DrawHUD 绘制击杀计数，并根据暂停和种植状态切换锤子与系统光标
+//
+// 暂停时 Update 不会被调用，因此光标状态在每帧绘制时同步
+func (r *WhackZombieRule) DrawHUD(screen *ebiten.Image) {
+	r.setHammerVisible(r.shouldShowHammer())
+
+	if r.counterFont == nil {
+		return
+	}
+	str := fmt.Sprintf("已砸僵尸 %d/%d", r.kills, config.WhackZombieKillQuota)
+	x := config.WhackZombieCounterX - text.Advance(str, r.counterFont)/2
+
+	shadow := &text.DrawOptions{}
+	shadow.GeoM.Translate(x+2, config.WhackZombieCounterY+2)
+	shadow.ColorScale.ScaleWithColor(color.Black)
+	text.Draw(screen, str, r.counterFont, shadow)
+
+	op := &text.DrawOptions{}
+	op.GeoM.Translate(x, config.WhackZombieCounterY)
+	op.ColorScale.ScaleWithColor(color.RGBA{R: 255, G: 220, B: 80, A: 255})
+	text.Draw(screen, str, r.counterFont, op)
+}
+
+// SaveState 保存击杀数和爬出计时
+func (r *WhackZombieRule) SaveState(saveData *game.BattleSaveData) {
+	var buffer bytes.Buffer
+	state := whackZombieState{Kills: r.kills, RiseTimer: r.riseTimer}
+	if err := gob.NewEncoder(&buffer).Encode(state); err != nil {
+		log.Printf("[WhackZombieRule] ERROR: Failed to encode state: %v", err)
+		return
+	}
+	saveData.MiniGame = &game.MiniGameSaveData{RuleID: WhackZombieRuleID, State: buffer.Bytes()}
+}
+
+// RestoreState 恢复击杀数和爬出计时
+func (r *WhackZombieRule) RestoreState(saveData *game.BattleSaveData) {
+	if saveData.MiniGame == nil || saveData.MiniGame.RuleID != WhackZombieRuleID {
+		return
+	}
+	var state whackZombieState
+	if err := gob.NewDecoder(bytes.NewReader(saveData.MiniGame.State)).Decode(&state); err != nil {
+		log.Printf("[WhackZombieRule] ERROR: Failed to decode state: %v", err)
+		return
+	}
+	r.kills = state.Kills
+	r.riseTimer = state.RiseTimer
+	log.Printf("[WhackZombieRule] Restored kills=%d, riseTimer=%.2f", r.kills, r.riseTimer)
+}
+
+// riseInterval 下一只僵尸爬出的间隔（随击杀数缩短，不低于 WhackZombieRiseIntervalMin）
+func (r *WhackZombieRule) riseInterval() float64 {
+	interval := config.WhackZombieRiseInterval - float64(r.kills)*config.WhackZombieRiseIntervalStep
+	if interval < config.WhackZombieRiseIntervalMin {
+		interval = config.WhackZombieRiseIntervalMin
+	}
+	return interval
+}
+
+// spawnZombie 从随机墓碑下爬出一只僵尸
+func (r *WhackZombieRule) spawnZombie() {
+	graves := ecs.GetEntitiesWith1[*components.GraveComponent](r.entityManager)
+	if len(graves) == 0 {
+		return
+	}
+	graveID := graves[r.rng.Intn(len(graves))]
+
+	zombieType := "basic"
+	switch roll := r.rng.Float64(); {
+	case roll < config.WhackZombieBucketheadChance:
+		zombieType = "buckethead"
+	case roll < config.WhackZombieBucketheadChance+config.WhackZombieConeheadChance:
+		zombieType = "conehead"
+	}
+
+	entityID, err := systems.SpawnGraveZombie(r.entityManager, r.resourceManager, r.gameState, r.lawnLayout, graveID, zombieType, 0, config.WhackZombieRiseDuration)
+	if err != nil {
+		log.Printf("[WhackZombieRule] ERROR: Failed to spawn grave zombie: %v", err)
+		return
+	}
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_DIRT_RISE")
+	}
+	log.Printf("[WhackZombieRule] %s zombie %d rises from grave %d", zombieType, entityID, graveID)
+}
+
+// handleClick 锤子敲击草坪（由 InputSystem 在非种植模式下点击草坪时调用）
+func (r *WhackZombieRule) handleClick(zombieID ecs.EntityID, worldX, worldY float64) {
+	if r.gameState != nil && r.gameState.IsGameOver {
+		return
+	}
+
+	if r.hammerID != 0 {
+		ecs.AddComponent(r.entityManager, r.hammerID, &components.AnimationCommandComponent{
+			UnitID:        "hammer",
+			AnimationName: "anim_whack_zombie",
+			Processed:     false,
+		})
+	}
+
+	audioManager := game.GetGameState().GetAudioManager()
+	if audioManager != nil {
+		audioManager.PlaySound("SOUND_SWING")
+	}
+	if zombieID == 0 {
+		return
+	}
+
+	if r.hitZombie(zombieID) {
+		r.kills++
+		log.Printf("[WhackZombieRule] Zombie %d whacked (%d/%d)", zombieID, r.kills, config.WhackZombieKillQuota)
+	}
+	if audioManager != nil {
+		audioManager.PlaySound("SOUND_BONK")
+	}
+}
+
+// hitZombie 锤子击中僵尸，优先扣除护甲（护甲破碎时溢出的伤害不计入身体）
+//
+// 返回：
+//   - 僵尸是否被这一锤砸死
+func (r *WhackZombieRule) hitZombie(zombieID ecs.EntityID) bool {
+	health, ok := ecs.GetComponent[*components.HealthComponent](r.entityManager, zombieID)
+	if !ok || health.CurrentHealth <= 0 {
+		return false
+	}
+	systems.AddFlashEffect(r.entityManager, zombieID)
+
+	if helmet := systems.ZombieHelmet(r.entityManager, zombieID); helmet != nil {
+		// 护甲可以降到负数，BehaviorSystem 会检查 <= 0 的情况并处理护甲破坏
+		helmet.Durability -= config.WhackZombieHammerDamage
+		return false
+	}
+
+	// 生命值可以降到负数，BehaviorSystem 会检查 <= 0 的情况并播放死亡动画
+	health.CurrentHealth -= config.WhackZombieHammerDamage
+	return health.CurrentHealth <= 0
+}
+
+// updateHammer 创建锤子实体并让它跟随鼠标
+func (r *WhackZombieRule) updateHammer() {
+	if r.hammerID == 0 {
+		if r.resourceManager == nil {
+			return
+		}
+		mouseX, mouseY := utils.GetPointerPosition()
+		hammerID, err := entities.NewHammerEntity(r.entityManager, r.resourceManager,
+			float64(mouseX)+config.WhackZombieHammerOffsetX, float64(mouseY)+config.WhackZombieHammerOffsetY)
+		if err != nil {
+			log.Printf("[WhackZombieRule] Warning: Failed to create hammer: %v", err)
+			r.resourceManager = nil // 资源缺失，不再重试
+			return
+		}
+		r.hammerID = hammerID
+		r.setHammerVisible(r.shouldShowHammer())
+	}
+
+	if pos, ok := ecs.GetComponent[*components.PositionComponent](r.entityManager, r.hammerID); ok {
+		mouseX, mouseY := utils.GetPointerPosition()
+		pos.X = float64(mouseX) + config.WhackZombieHammerOffsetX
+		pos.Y = float64(mouseY) + config.WhackZombieHammerOffsetY
+	}
+}
+
+// shouldShowHammer 锤子只在战斗进行中且不在种植模式时显示
+func (r *WhackZombieRule) shouldShowHammer() bool {
+	if r.gameState == nil {
+		return true
+	}
+	return !r.gameState.IsPaused && !r.gameState.IsGameOver && !r.gameState.IsPlantingMode
+}
+
+// setHammerVisible 切换锤子和系统光标（锤子可见时隐藏系统光标）
+func (r *WhackZombieRule) setHammerVisible(visible bool) {
+	if r.hammerID == 0 || visible == r.hammerVisible {
+		return
+	}
+	r.hammerVisible = visible
+
+	if reanim, ok := ecs.GetComponent[*components.ReanimComponent](r.entityManager, r.hammerID); ok {
+		if visible {
+			reanim.HiddenTracks = nil
+		} else {
+			reanim.HiddenTracks = make(map[string]bool, len(hammerTracks))
+			for _, track := range hammerTracks {
+				reanim.HiddenTracks[track] = true
+			}
+		}
+		reanim.LastRenderFrame = -1
+	}
+
+	if visible {
+		ebiten.SetCursorMode(ebiten.CursorModeHidden)
+	} else {
+		ebiten.SetCursorMode(ebiten.CursorModeVisible)
+	}
+}
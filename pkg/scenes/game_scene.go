@@ -38,9 +38,6 @@ type GameScene struct {
 	flagMeterProg *ebiten.Image // Flag meter progress bar (进度条填充)
 	flagMeterFlag *ebiten.Image // Flag meter flags/parts (进度条标志，3列切片)
 
-	// Story 19.4: Bowling red line image (保龄球红线图片)
-	bowlingRedLine *ebiten.Image // Red line between column 3 and 4 (保龄球关卡红线)
-
 	// Story 19.5: Conveyor belt images (传送带图片)
 	conveyorBeltBackdrop *ebiten.Image // Conveyor belt backdrop (传送带背景)
 	conveyorBelt         *ebiten.Image // Conveyor belt animation (传送带传动动画，6行纹理)
@@ -113,6 +110,7 @@ type GameScene struct {
 	// Story 3.3: Lawn Grid System
 	lawnGridSystem   *systems.LawnGridSystem // 草坪网格管理系统
 	lawnGridEntityID ecs.EntityID            // 草坪网格实体ID
+	lawnLayout       config.LawnLayout       // 当前场景的草坪行布局
 
 	// Story 3.4: Behavior System
 	behaviorSystem *behavior.BehaviorSystem // 植物行为系统（向日葵生产阳光等）
@@ -134,9 +132,8 @@ type GameScene struct {
 	flashEffectSystem *systems.FlashEffectSystem // 闪烁效果系统（僵尸受击闪烁）
 
 	// Story 8.2: Tutorial System
-	tutorialSystem      *systems.TutorialSystem // 教学系统（关卡 1-1 教学引导）
-	tutorialFont        interface{}             // 教学文本字体（*utils.BitmapFont 或 *text.GoTextFace）
-	bowlingTutorialFont interface{}             // Level 1-5 保龄球教学字体（42px）
+	tutorialSystem *systems.TutorialSystem // 教学系统（关卡 1-1 教学引导）
+	tutorialFont   interface{}             // 教学文本字体（*utils.BitmapFont 或 *text.GoTextFace）
 
 	// Story 8.2 QA改进：完整的铺草皮动画系统
 	soddingSystem *systems.SoddingSystem // 铺草皮动画系统（SodRoll 滚动动画）
@@ -196,6 +193,11 @@ type GameScene struct {
 	shovelSelected          bool                             // 铲子是否被选中
 	shovelInteractionSystem *systems.ShovelInteractionSystem // 铲子交互系统
 
+	// 小游戏规则固定的铲子卡槽位置（屏幕坐标，未固定时紧挨植物选择栏）
+	shovelSlotFixed bool
+	shovelSlotX     float64
+	shovelSlotY     float64
+
 	// Story 19.3: 强引导教学系统
 	guidedTutorialSystem *systems.GuidedTutorialSystem // 强引导教学系统（Level 1-5 铲子教学）
 
@@ -213,14 +215,23 @@ type GameScene struct {
 	// 铲子拖拽状态（移动端触摸拖拽支持）
 	isDragShovel bool // 是否处于铲子拖拽模式
 
-	// Story 19.6: 保龄球坚果滚动系统
-	bowlingNutSystem *systems.BowlingNutSystem // 保龄球坚果滚动系统
+	// 小游戏规则（关卡 specialRules 对应的规则模块，普通关卡为 nil）
+	miniGameRule modules.MiniGameRule
 
 	// Story 19.1: 疯狂戴夫对话系统
 	daveDialogueSystem *systems.DaveDialogueSystem // 疯狂戴夫对话系统
 
 	// 僵尸呻吟音效系统（环境音效，增强游戏氛围）
 	zombieGroanSystem *systems.ZombieGroanSystem
+
+	// 雾系统（雾夜关卡，没有雾的关卡为 nil）
+	fogSystem *systems.FogSystem
+
+	// 屋顶系统（屋顶关卡，没有斜坡的关卡为 nil）
+	roofSystem *systems.RoofSystem
+
+	// 生存模式重新选卡面板（每组旗帜结束后显示，其余时间为 nil）
+	survivalReselectionCards []*survivalReselectionCard
 }
 
 // NewGameScene creates and returns a new GameScene instance.
@@ -321,12 +332,29 @@ func NewGameScene(rm *game.ResourceManager, sm *game.SceneManager, levelID strin
 		}
 	}
 
+	// 按场景设置草坪行布局（后院泳池 6 行、中间两行为水路）
+	// CRITICAL: 必须在创建任何依赖网格坐标的实体和系统之前确定
+	sceneType := ""
+	if scene.gameState.CurrentLevel != nil {
+		sceneType = scene.gameState.CurrentLevel.SceneType
+	}
+	scene.lawnLayout = config.GetLawnLayout(sceneType)
+
+	// 根据关卡的 specialRules 创建小游戏规则（在 Setup 之前就需要读取 HUD 配置）
+	if scene.gameState.CurrentLevel != nil && scene.gameState.CurrentLevel.SpecialRules != "" {
+		scene.miniGameRule = modules.NewMiniGameRule(scene.gameState.CurrentLevel.SpecialRules)
+		if scene.miniGameRule != nil {
+			log.Printf("[GameScene] Mini-game rule: %s", scene.miniGameRule.ID())
+		}
+	}
+
 	// Load all UI resources
 	// CRITICAL: 必须在关卡配置加载之后调用，因为需要读取 BackgroundImage 配置
 	scene.loadResources()
 
 	// Initialize systems
 	scene.renderSystem = systems.NewRenderSystem(scene.entityManager)
+	scene.renderSystem.SetLawnLayout(scene.lawnLayout)
 	scene.sunMovementSystem = systems.NewSunMovementSystem(scene.entityManager)
 	scene.lifetimeSystem = systems.NewLifetimeSystem(scene.entityManager)
 	// TODO(Story 6.3): 迁移到 ReanimSystem
@@ -346,7 +374,7 @@ func NewGameScene(rm *game.ResourceManager, sm *game.SceneManager, levelID strin
 	if len(enabledLanes) == 0 {
 		enabledLanes = []int{1, 2, 3, 4, 5} // 默认所有行启用
 	}
-	scene.lawnGridSystem = systems.NewLawnGridSystem(scene.entityManager, enabledLanes)
+	scene.lawnGridSystem = systems.NewLawnGridSystem(scene.entityManager, scene.lawnLayout, enabledLanes)
 	scene.lawnGridEntityID = scene.entityManager.CreateEntity()
 	scene.entityManager.AddComponent(scene.lawnGridEntityID, &components.LawnGridComponent{})
 	log.Printf("[GameScene] Initialized lawn grid system (Entity ID: %d) with enabled lanes: %v", scene.lawnGridEntityID, enabledLanes)
@@ -411,7 +439,7 @@ func NewGameScene(rm *game.ResourceManager, sm *game.SceneManager, levelID strin
 	// Story 3.2: Initialize plant preview systems
 	// PlantPreviewRenderSystem 需要引用 PlantPreviewSystem 来获取两个渲染位置
 	// Story 8.1: PlantPreviewSystem 需要 LawnGridSystem 来检查行是否启用
-	scene.plantPreviewSystem = systems.NewPlantPreviewSystem(scene.entityManager, scene.gameState, scene.lawnGridSystem)
+	scene.plantPreviewSystem = systems.NewPlantPreviewSystem(scene.entityManager, scene.gameState, scene.lawnLayout, scene.lawnGridSystem)
 	// 修复: 使用静态图像预览,不需要 ReanimSystem
 	scene.plantPreviewRenderSystem = systems.NewPlantPreviewRenderSystem(scene.entityManager, scene.plantPreviewSystem)
 
@@ -441,12 +469,15 @@ func NewGameScene(rm *game.ResourceManager, sm *game.SceneManager, levelID strin
 		log.Printf("[GameScene] Warning: Failed to load spawn rules: %v (constraint checking disabled)", err)
 		spawnRules = nil
 	}
+	scene.behaviorSystem.SetSpawnRules(spawnRules)
 	// Story 17.9: Load zombie physics config (optional, nil means use default coordinates)
 	zombiePhysics, err := config.LoadZombiePhysicsConfig("data/zombie_physics.yaml")
 	if err != nil {
 		log.Printf("[GameScene] Warning: Failed to load zombie physics config: %v (using default coordinates)", err)
 		zombiePhysics = nil
 	}
+	// ExtraPoints 波次和省略 waves 的关卡：在 WaveSpawnSystem 提取允许的僵尸类型之前生成波次
+	scene.generateLevelWaves(spawnRules)
 	scene.waveSpawnSystem = systems.NewWaveSpawnSystem(scene.entityManager, rm, scene.gameState.CurrentLevel, scene.gameState, spawnRules, zombiePhysics)
 	log.Printf("[GameScene] Initialized wave spawn system (spawn rules enabled: %v, physics config enabled: %v)", spawnRules != nil, zombiePhysics != nil)
 
@@ -467,7 +498,7 @@ func NewGameScene(rm *game.ResourceManager, sm *game.SceneManager, levelID strin
 
 	// Story 8.3 + 8.4重构: Create RewardAnimationSystem (完全封装，无需单独创建面板渲染系统)
 	// RewardAnimationSystem内部自动创建和管理RewardPanelRenderSystem
-	scene.rewardSystem = systems.NewRewardAnimationSystem(scene.entityManager, scene.gameState, rm, scene.sceneManager, scene.reanimSystem, scene.particleSystem, scene.renderSystem)
+	scene.rewardSystem = systems.NewRewardAnimationSystem(scene.entityManager, scene.gameState, rm, scene.sceneManager, scene.reanimSystem, scene.particleSystem, scene.renderSystem, scene.lawnLayout)
 	log.Printf("[GameScene] Initialized reward animation system (fully encapsulated)")
 
 	// Story 8.3: Create OpeningAnimationSystem (conditionally, may return nil)
@@ -487,7 +518,7 @@ func NewGameScene(rm *game.ResourceManager, sm *game.SceneManager, levelID strin
 
 	// Story 10.2: Create LawnmowerSystem (除草车系统 - 最后防线)
 	// Story 10.3: 传递 ReanimSystem 用于播放僵尸死亡动画
-	scene.lawnmowerSystem = systems.NewLawnmowerSystem(scene.entityManager, rm, scene.gameState)
+	scene.lawnmowerSystem = systems.NewLawnmowerSystem(scene.entityManager, rm, scene.gameState, scene.lawnLayout)
 	log.Printf("[GameScene] Initialized lawnmower system")
 
 	// Story 10.2: 除草车实体将在铺草皮动画完成后创建（见铺草皮回调）
@@ -495,7 +526,7 @@ func NewGameScene(rm *game.ResourceManager, sm *game.SceneManager, levelID strin
 
 	// 2. Create LevelSystem (需要 RewardAnimationSystem 和 LawnmowerSystem)
 	// Story 14.3: Epic 14 - Removed ReanimSystem dependency
-	scene.levelSystem = systems.NewLevelSystem(scene.entityManager, scene.gameState, scene.waveSpawnSystem, rm, scene.rewardSystem, scene.lawnmowerSystem)
+	scene.levelSystem = systems.NewLevelSystem(scene.entityManager, scene.gameState, scene.waveSpawnSystem, rm, scene.rewardSystem, scene.lawnmowerSystem, scene.lawnLayout)
 	// Story 17.9: 设置僵尸物理配置（用于类型化进家判定）
 	if zombiePhysics != nil {
 		scene.levelSystem.SetZombiePhysicsConfig(zombiePhysics)
@@ -521,7 +552,7 @@ func NewGameScene(rm *game.ResourceManager, sm *game.SceneManager, levelID strin
 	})
 
 	// 3. Create ZombieLaneTransitionSystem (僵尸行转换系统)
-	scene.zombieLaneTransitionSystem = systems.NewZombieLaneTransitionSystem(scene.entityManager)
+	scene.zombieLaneTransitionSystem = systems.NewZombieLaneTransitionSystem(scene.entityManager, scene.lawnLayout)
 	log.Printf("[GameScene] Initialized zombie lane transition system")
 
 	// 方案A+：Initialize flash effect system
@@ -552,19 +583,20 @@ func NewGameScene(rm *game.ResourceManager, sm *game.SceneManager, levelID strin
 		}
 	}
 
-	// Story 19.10: 保龄球关卡（initialSun == 0）禁用阳光生成
-	if scene.gameState.CurrentLevel != nil && scene.gameState.CurrentLevel.InitialSun == 0 {
+	// 夜晚关卡没有天空掉落的阳光
+	if scene.gameState.CurrentLevel != nil && scene.gameState.CurrentLevel.IsNight() {
 		scene.sunSpawnSystem.Disable()
-		log.Printf("[GameScene] Bowling level (initialSun=0): sun spawn system DISABLED")
+		log.Printf("[GameScene] Night level: sun spawn system DISABLED")
+	}
 
-		// 加载保龄球关卡专用的大字体（42px）
-		bowlingFont, err := scene.resourceManager.LoadFont("assets/fonts/SimHei.ttf", config.BowlingTutorialTextFontSize)
-		if err != nil {
-			log.Printf("WARNING: Failed to load bowling tutorial font: %v", err)
-		} else {
-			scene.bowlingTutorialFont = bowlingFont
-			log.Printf("[GameScene] Loaded bowling tutorial font: SimHei.ttf (%.0fpx)", config.BowlingTutorialTextFontSize)
-		}
+	// 播放关卡的背景音乐和环境粒子（夜晚关卡使用夜晚场景的配置）
+	scene.startLevelAmbience()
+
+	// Story 19.10: 小游戏规则（如保龄球）禁用阳光生成
+	hud := scene.miniGameHUD()
+	if hud.DisableSkySun {
+		scene.sunSpawnSystem.Disable()
+		log.Printf("[GameScene] Mini-game level: sun spawn system DISABLED")
 	}
 
 	// Story 8.2 QA改进：初始化铺草皮动画系统
@@ -627,13 +659,9 @@ func NewGameScene(rm *game.ResourceManager, sm *game.SceneManager, levelID strin
 	log.Printf("[GameScene] Initialized level phase system")
 
 	// Story 19.5: 初始化传送带系统
-	scene.conveyorBeltSystem = systems.NewConveyorBeltSystem(scene.entityManager, scene.gameState, rm)
+	scene.conveyorBeltSystem = systems.NewConveyorBeltSystem(scene.entityManager, scene.gameState, rm, scene.lawnLayout)
 	log.Printf("[GameScene] Initialized conveyor belt system")
 
-	// Story 19.6: 初始化保龄球坚果滚动系统
-	scene.bowlingNutSystem = systems.NewBowlingNutSystem(scene.entityManager, rm)
-	log.Printf("[GameScene] Initialized bowling nut system")
-
 	// Story 19.1: 初始化疯狂戴夫对话系统
 	scene.daveDialogueSystem = systems.NewDaveDialogueSystem(scene.entityManager, scene.gameState, rm)
 	log.Printf("[GameScene] Initialized Dave dialogue system")
@@ -642,6 +670,23 @@ func NewGameScene(rm *game.ResourceManager, sm *game.SceneManager, levelID strin
 	scene.zombieGroanSystem = systems.NewZombieGroanSystem(scene.entityManager, scene.gameState)
 	log.Printf("[GameScene] Initialized zombie groan system")
 
+	// 雾夜关卡：创建雾实体和雾系统
+	if scene.gameState.CurrentLevel != nil && scene.gameState.CurrentLevel.FogColumns > 0 {
+		level := scene.gameState.CurrentLevel
+		if _, err := entities.NewFogEntity(scene.entityManager, rm, level.FogColumns, level.Storm); err != nil {
+			log.Printf("[GameScene] Warning: Failed to create fog: %v", err)
+		} else {
+			scene.fogSystem = systems.NewFogSystem(scene.entityManager, scene.lawnLayout)
+			log.Printf("[GameScene] Initialized fog system (columns=%d, storm=%v)", level.FogColumns, level.Storm)
+		}
+	}
+
+	// 屋顶关卡：创建屋顶系统（斜坡跟随和子弹撞顶）
+	if scene.lawnLayout.HasSlope() {
+		scene.roofSystem = systems.NewRoofSystem(scene.entityManager, scene.lawnLayout)
+		log.Printf("[GameScene] Initialized roof system")
+	}
+
 	// Story 19.5: 根据关卡配置初始化传送带参数
 	if scene.gameState.CurrentLevel != nil && scene.gameState.CurrentLevel.ConveyorBelt != nil {
 		conveyorConfig := scene.gameState.CurrentLevel.ConveyorBelt
@@ -699,32 +744,15 @@ func NewGameScene(rm *game.ResourceManager, sm *game.SceneManager, levelID strin
 		}
 	})
 
-	scene.levelPhaseSystem.SetOnActivateBowling(func() {
-		log.Printf("[GameScene] Activating bowling phase")
-		// Story 19.5: 激活传送带系统
-		if scene.conveyorBeltSystem != nil {
-			scene.conveyorBeltSystem.Activate()
-		}
-		// 启用红线限制（网格预览在红线右侧不显示）
-		if scene.plantPreviewRenderSystem != nil {
-			scene.plantPreviewRenderSystem.SetRedLineEnabled(true)
-		}
-		// Story 19.9: 恢复波次计时系统
-		// 特殊关卡在 LevelSystem 初始化时暂停了波次计时，现在需要初始化并恢复
-		if scene.levelSystem != nil {
-			scene.levelSystem.ResumeWaveTiming()
-			log.Printf("[GameScene] Wave timing resumed for bowling phase")
-		}
-		// Story 19.x QA: 保龄球阶段开始时创建除草车
-		// 除草车应该在铲子教学完成后才出现
-		scene.initLawnmowers()
-		log.Printf("[GameScene] Lawnmowers initialized for bowling phase")
-	})
+	// 初始化小游戏规则（所有系统就绪后，保龄球阶段的激活由规则接入转场完成回调）
+	scene.setupMiniGameRule()
 
 	// Story 19.4: 生成预设植物
 	// 必须在 GuidedTutorialSystem 初始化之后调用，这样系统才能正确追踪植物数量
 	// Bug Fix: 如果有战斗存档，跳过预设植物生成（会从存档恢复）
+	// 夜晚关卡的墓碑与预设植物一样，有战斗存档时从存档恢复
 	if !scene.hasBattleSave {
+		scene.spawnGraves()
 		scene.spawnPresetPlants()
 	} else {
 		log.Printf("[GameScene] Skipping preset plants spawn (will restore from battle save)")
@@ -1116,6 +1144,16 @@ func (s *GameScene) Update(deltaTime float64) {
 		return // 停止其他游戏系统（僵尸移动、植物攻击等）
 	}
 
+	// 生存模式：一组旗帜结束后暂停战斗，等待玩家重新选择植物
+	if s.levelSystem != nil && s.levelSystem.IsAwaitingPlantReselection() {
+		if s.buttonSystem != nil {
+			s.buttonSystem.Update(deltaTime)
+		}
+		s.updateSurvivalReselection()
+		s.updateMouseCursor()
+		return
+	}
+
 	// Update all ECS systems in order (order matters for correct game logic)
 	s.levelSystem.Update(deltaTime)                       // 0. Update level system (Story 5.5: wave spawning, victory/defeat)
 	s.waveSpawnSystem.UpdatePendingActivations(deltaTime) // 0.05. Update pending zombie activations (散落入场效果)
@@ -1195,9 +1233,9 @@ func (s *GameScene) Update(deltaTime float64) {
 	if s.conveyorBeltSystem != nil {
 		s.conveyorBeltSystem.Update(deltaTime) // 9.8. Update conveyor belt
 	}
-	// Story 19.6: Bowling nut system (rolling movement)
-	if s.bowlingNutSystem != nil {
-		s.bowlingNutSystem.Update(deltaTime) // 9.9. Update bowling nut rolling
+	// 小游戏规则（保龄球坚果滚动等）
+	if s.miniGameRule != nil {
+		s.miniGameRule.Update(deltaTime) // 9.9. Update mini-game rule
 	}
 	// Story 19.1: Dave dialogue system (dialogue progression)
 	if s.daveDialogueSystem != nil {
@@ -1207,9 +1245,17 @@ func (s *GameScene) Update(deltaTime float64) {
 	if s.zombieGroanSystem != nil {
 		s.zombieGroanSystem.Update(deltaTime)
 	}
+	// 雾系统 - 雾的推进、植物灯笼照明和雷雨闪电
+	if s.fogSystem != nil {
+		s.fogSystem.Update(deltaTime)
+	}
+	// 屋顶系统 - 斜坡跟随和子弹撞顶
+	if s.roofSystem != nil {
+		s.roofSystem.Update(deltaTime)
+	}
 	// Story 3.2: 植物预览系统 - 更新预览位置（双图像支持）
 	s.plantPreviewSystem.Update(deltaTime) // 10. Update plant preview position (dual-image support)
-	s.lawnGridSystem.Update(deltaTime)     // 10.5. Update lawn flash animation (Story 8.2)
+	s.lawnGridSystem.Update(deltaTime)     // 10.5. Update lawn flash animation (Story 8.2) and ice trail
 	// ECS 按钮系统更新（交互检测）
 	if s.buttonSystem != nil {
 		s.buttonSystem.Update(deltaTime) // 10.7. Update button interactions (hover, click)
@@ -1252,9 +1298,11 @@ func (s *GameScene) Draw(screen *ebiten.Image) {
 	// Layer 1: Draw lawn background
 	s.drawBackground(screen)
 
-	// Story 19.4: Draw bowling red line (保龄球红线)
-	// 红线在背景之上、植物之下渲染
-	s.drawBowlingRedLine(screen)
+	// 小游戏规则的草坪元素（如保龄球红线）
+	// 在背景之上、植物之下渲染
+	if s.miniGameRule != nil {
+		s.miniGameRule.DrawLawn(screen, s.cameraX)
+	}
 
 	// Story 8.3.1: 开场动画或铺草皮动画期间隐藏 UI 元素
 	// 注意：需要检查 soddingAnimStarted 来避免开场动画完成和铺草皮动画开始之间的闪现
@@ -1287,10 +1335,10 @@ func (s *GameScene) Draw(screen *ebiten.Image) {
 
 		// Layer 3: Draw plant cards (Story 3.1 架构优化)
 		// 在植物和僵尸下方渲染，符合原版PVZ设计
-		// Story 19.5: 保龄球模式不显示植物选择模块（使用传送带）
+		// Story 19.5: 小游戏规则可以隐藏植物选择模块（如保龄球使用传送带）
 		// 滑入动画：计算 Y 偏移量，与植物选择栏同步滑入
 		if s.plantSelectionModule != nil {
-			if s.gameState.CurrentLevel == nil || s.gameState.CurrentLevel.InitialSun != 0 {
+			if !s.miniGameHUD().HideSeedBank {
 				// 计算滑入动画 Y 偏移
 				yOffset := s.getSeedBankCurrentY() - float64(config.SeedBankY)
 				s.plantSelectionModule.DrawWithOffset(screen, yOffset)
@@ -1343,6 +1391,10 @@ func (s *GameScene) Draw(screen *ebiten.Image) {
 	// 传送带卡片拖拽预览
 	s.drawConveyorCardPreview(screen)
 
+	// Layer 7.8: Draw seed packets (砸罐子掉落的种子包)
+	// 种子包与阳光一样是可拾取物，在游戏世界之上、阳光之下
+	s.renderSystem.DrawSeedPackets(screen, s.cameraX)
+
 	// Layer 8: Draw suns (阳光) - 最顶层
 	// 阳光在最顶层以确保始终可点击
 	s.renderSystem.DrawSuns(screen, s.cameraX)
@@ -1350,13 +1402,11 @@ func (s *GameScene) Draw(screen *ebiten.Image) {
 	// Layer 8.5: Draw tutorial text (Story 8.2 + Story 19.x QA)
 	// 教学文本在阳光之下、UI之上
 	// 渲染所有 TutorialTextComponent 实体（包括临时提示文本）
-	// 优先使用 bowlingTutorialFont，其次 tutorialFont，最后 sunCounterFont
-	if s.bowlingTutorialFont != nil {
-		s.renderSystem.DrawTutorialText(screen, s.bowlingTutorialFont, s.bowlingTutorialFont)
-	} else if s.tutorialFont != nil {
-		s.renderSystem.DrawTutorialText(screen, s.tutorialFont, s.bowlingTutorialFont)
+	// 优先使用 tutorialFont，其次 sunCounterFont（小游戏规则设置的大字体由 RenderSystem 优先使用）
+	if s.tutorialFont != nil {
+		s.renderSystem.DrawTutorialText(screen, s.tutorialFont)
 	} else if s.sunCounterFont != nil {
-		s.renderSystem.DrawTutorialText(screen, s.sunCounterFont, nil)
+		s.renderSystem.DrawTutorialText(screen, s.sunCounterFont)
 	}
 
 	// Layer 8.6: Draw UI particles (教学箭头、奖励粒子等)
@@ -1368,6 +1418,11 @@ func (s *GameScene) Draw(screen *ebiten.Image) {
 	// Story 8.3.1: 开场动画或铺草皮动画期间隐藏进度条
 	if !hideUI {
 		s.drawProgressBar(screen)
+
+		// 小游戏规则的 HUD 元素
+		if s.miniGameRule != nil {
+			s.miniGameRule.DrawHUD(screen)
+		}
 	}
 
 	// Layer 10: Draw last wave warning (Story 5.5) - DISABLED for production
@@ -1415,6 +1470,9 @@ func (s *GameScene) Draw(screen *ebiten.Image) {
 	// Tooltip 在对话框之后、暂停菜单之前渲染
 	s.drawTooltip(screen)
 
+	// 生存模式重新选卡面板（暂停菜单之下）
+	s.drawSurvivalReselection(screen)
+
 	// Story 10.1: Draw pause menu (最顶层 - 在所有其他元素之上)
 	if s.pauseMenuModule != nil {
 		s.pauseMenuModule.Draw(screen)
@@ -1493,19 +1551,16 @@ func (s *GameScene) SetShovelSelected(selected bool) {
 
 // GetShovelSlotBounds 获取铲子槽位边界（屏幕坐标）
 // 实现 systems.ShovelStateProvider 接口
-// Story 19.5: 保龄球模式使用相对于菜单按钮的位置
-// Story 19.x QA: 铲子位置相对于菜单按钮偏左 10px
+// 小游戏规则可以固定铲子位置（如保龄球放在菜单按钮左侧）
 func (s *GameScene) GetShovelSlotBounds() image.Rectangle {
+	if s.shovelSlotFixed {
+		shovelX, shovelY := int(s.shovelSlotX), int(s.shovelSlotY)
+		return image.Rect(shovelX, shovelY, shovelX+config.ShovelWidth, shovelY+config.ShovelHeight)
+	}
+
 	// 计算铲子位置
 	var shovelX int
-	// Story 19.5: 保龄球模式（initialSun == 0）使用相对于菜单按钮的位置
-	if s.gameState.CurrentLevel != nil && s.gameState.CurrentLevel.InitialSun == 0 {
-		// 菜单按钮 X 位置
-		menuButtonX := WindowWidth - int(config.MenuButtonOffsetFromRight)
-		// 铲子右边缘到菜单按钮左边缘的距离为 BowlingShovelGapFromMenuButton
-		// 铲子 X = 菜单按钮 X - 间距 - 铲子宽度
-		shovelX = menuButtonX - config.BowlingShovelGapFromMenuButton - config.ShovelWidth
-	} else if s.seedBank != nil {
+	if s.seedBank != nil {
 		// 普通模式根据选择栏图片宽度动态计算
 		seedBankWidth := s.seedBank.Bounds().Dx()
 		shovelX = config.SeedBankX + seedBankWidth + config.ShovelGapFromSeedBank
@@ -1513,8 +1568,8 @@ func (s *GameScene) GetShovelSlotBounds() image.Rectangle {
 		shovelX = config.ShovelX // 默认值
 	}
 
-	// 铲子 Y 位置
-	shovelY := config.BowlingShovelY
+	// 铲子 Y 位置（与植物选择栏顶部对齐）
+	shovelY := config.SeedBankY
 
 	return image.Rect(
 		shovelX,
@@ -1600,6 +1655,11 @@ func (s *GameScene) updateShovelSlotClick() {
 		return
 	}
 
+	// 小游戏规则可以禁用铲子
+	if !s.allowsMiniGameInput(modules.MiniGameInputShovel) {
+		return
+	}
+
 	// 检查铲子是否可用
 	// Story 19.x QA: 铲子教学关卡（有预设植物）强制启用铲子
 	isShovelTutorialLevel := s.gameState.CurrentLevel != nil && len(s.gameState.CurrentLevel.PresetPlants) > 0
@@ -1800,6 +1860,9 @@ func (s *GameScene) detectPlantAtPosition(worldX, worldY float64) ecs.EntityID {
 		// 计算植物边界（与 ShovelInteractionSystem 保持一致）
 		plantWidth := 60.0
 		plantHeight := 80.0
+		if plantComp, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, entity); ok {
+			plantWidth += float64(components.PlantFootprintWidth(plantComp.PlantType)-1) * config.CellWidth
+		}
 
 		plantLeft := posComp.X - plantWidth/2
 		plantRight := posComp.X + plantWidth/2
@@ -1831,13 +1894,18 @@ func (s *GameScene) removePlantWithShovel(entityID ecs.EntityID) {
 			plantComp.PlantType, posComp.X, posComp.Y, plantComp.GridRow, plantComp.GridCol)
 
 		// 更新草坪网格，释放该格子
-		lawnGridEntities := ecs.GetEntitiesWith1[*components.LawnGridComponent](s.entityManager)
-		if len(lawnGridEntities) > 0 {
-			gridComp, ok := ecs.GetComponent[*components.LawnGridComponent](s.entityManager, lawnGridEntities[0])
-			if ok && plantComp.GridRow >= 0 && plantComp.GridRow < 5 &&
-				plantComp.GridCol >= 0 && plantComp.GridCol < 9 {
-				gridComp.Occupancy[plantComp.GridRow][plantComp.GridCol] = 0 // 0 表示空格子
-				log.Printf("[GameScene] 释放网格 (%d, %d)", plantComp.GridRow, plantComp.GridCol)
+		if s.lawnGridSystem != nil && s.lawnGridEntityID != 0 {
+			if plantComp.PlantType.IsPlatform() {
+				// 睡莲、花盆记录在种植平台层，不占用格子
+				s.lawnGridSystem.RemovePlatform(s.lawnGridEntityID, plantComp.GridCol, plantComp.GridRow)
+			} else {
+				// 多格植物（如玉米加农炮）需要释放占用的所有格子
+				width := components.PlantFootprintWidth(plantComp.PlantType)
+				if err := s.lawnGridSystem.ReleaseCells(s.lawnGridEntityID, plantComp.GridCol, plantComp.GridRow, width); err != nil {
+					log.Printf("[GameScene] 警告：释放网格占用失败: %v", err)
+				} else {
+					log.Printf("[GameScene] 释放网格 (%d, %d)", plantComp.GridRow, plantComp.GridCol)
+				}
 			}
 		}
 	}
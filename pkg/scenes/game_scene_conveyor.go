@@ -51,10 +51,9 @@ func (s *GameScene) calculateConveyorX() float64 {
 	// 计算铲子 X 位置（与 drawShovel 保持一致）
 	var shovelX float64
 
-	// 保龄球模式（initialSun == 0）使用相对于菜单按钮的位置
-	if s.gameState.CurrentLevel != nil && s.gameState.CurrentLevel.InitialSun == 0 {
-		menuButtonX := float64(WindowWidth) - config.MenuButtonOffsetFromRight
-		shovelX = menuButtonX - float64(config.BowlingShovelGapFromMenuButton) - float64(config.ShovelWidth)
+	// 小游戏规则（如保龄球）固定的位置
+	if s.shovelSlotFixed {
+		shovelX = s.shovelSlotX
 	} else if s.seedBank != nil {
 		// 普通模式根据选择栏图片宽度动态计算
 		seedBankWidth := float64(s.seedBank.Bounds().Dx())
@@ -690,10 +689,10 @@ func (s *GameScene) handleConveyorCardPlacement(worldX, worldY float64) bool {
 
 	// 计算放置的行列
 	col := int((worldX - config.GridWorldStartX) / config.CellWidth)
-	row := int((worldY - config.GridWorldStartY) / config.CellHeight)
+	row := int((worldY - s.lawnLayout.StartY) / s.lawnLayout.CellHeight)
 
 	// 验证行列有效性
-	if row < 0 || row >= config.GridRows || col < 0 || col >= config.GridColumns {
+	if row < 0 || row >= s.lawnLayout.Rows || col < 0 || col >= config.GridColumns {
 		s.conveyorBeltSystem.DeselectCard()
 		s.destroyConveyorCardPreview()
 		return false
@@ -903,7 +902,7 @@ func (s *GameScene) updateConveyorBeltClick() {
 		worldY := float64(mouseY)
 
 		// 检查是否点击在草坪区域
-		if worldY >= config.GridWorldStartY && worldY < config.GridWorldStartY+float64(config.GridRows)*config.CellHeight {
+		if worldY >= s.lawnLayout.StartY && worldY < s.lawnLayout.StartY+float64(s.lawnLayout.Rows)*s.lawnLayout.CellHeight {
 			// 尝试放置，只有成功时才销毁预览
 			if s.handleConveyorCardPlacement(worldX, worldY) {
 				// 放置成功，销毁预览
@@ -1076,7 +1075,7 @@ func (s *GameScene) handleConveyorDragEnd(dragInfo utils.DragInfo) {
 	worldY := float64(dragInfo.CurrentY)
 
 	// 检查是否在草坪区域
-	if worldY < config.GridWorldStartY || worldY >= config.GridWorldStartY+float64(config.GridRows)*config.CellHeight {
+	if worldY < s.lawnLayout.StartY || worldY >= s.lawnLayout.StartY+float64(s.lawnLayout.Rows)*s.lawnLayout.CellHeight {
 		log.Printf("[GameScene] 传送带卡片拖拽结束: 位置在草坪外，取消种植")
 		return
 	}
@@ -1090,10 +1089,10 @@ func (s *GameScene) handleConveyorDragEnd(dragInfo utils.DragInfo) {
 
 	// 计算放置的行列
 	col := int((worldX - config.GridWorldStartX) / config.CellWidth)
-	row := int((worldY - config.GridWorldStartY) / config.CellHeight)
+	row := int((worldY - s.lawnLayout.StartY) / s.lawnLayout.CellHeight)
 
 	// 验证行列有效性
-	if row < 0 || row >= config.GridRows || col < 0 || col >= config.GridColumns {
+	if row < 0 || row >= s.lawnLayout.Rows || col < 0 || col >= config.GridColumns {
 		log.Printf("[GameScene] 传送带卡片拖拽结束: 行列无效 row=%d, col=%d", row, col)
 		return
 	}
@@ -31,14 +31,14 @@ func (s *GameScene) drawGridDebug(screen *ebiten.Image) {
 		return
 	}
 
-	// 使用统一的网格参数（从 config.layout_config.go）
+	// 使用当前场景的网格参数（列来自 config.layout_config.go，行来自草坪布局）
 	// 注意：这里使用的是世界坐标，需要转换为屏幕坐标
 	gridWorldStartX := config.GridWorldStartX
-	gridWorldStartY := config.GridWorldStartY
+	gridWorldStartY := s.lawnLayout.StartY
 	gridColumns := config.GridColumns
-	gridRows := config.GridRows
+	gridRows := s.lawnLayout.Rows
 	cellWidth := config.CellWidth
-	cellHeight := config.CellHeight
+	cellHeight := s.lawnLayout.CellHeight
 
 	// 将网格世界坐标转换为屏幕坐标
 	gridScreenStartX := gridWorldStartX - s.cameraX
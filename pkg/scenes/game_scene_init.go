@@ -1,7 +1,9 @@
 package scenes
 
 import (
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/gonewx/pvz/pkg/components"
 	"github.com/gonewx/pvz/pkg/config"
@@ -10,6 +12,7 @@ import (
 	"github.com/gonewx/pvz/pkg/game"
 	"github.com/gonewx/pvz/pkg/modules"
 	"github.com/gonewx/pvz/pkg/systems"
+	"github.com/gonewx/pvz/pkg/types"
 )
 
 // initPlantCardSystems initializes the plant selection module.
@@ -35,6 +38,21 @@ func (s *GameScene) initPlantCardSystems(rm *game.ResourceManager) {
 		}
 	}
 
+	// 生存模式：种子栏只放已选的植物（默认取植物池前 SurvivalSeedSlots 种），
+	// 每组旗帜结束后可在重新选卡面板中更换
+	if levelConfig.Survival {
+		if len(s.gameState.SurvivalPlants) == 0 {
+			seedCount := len(levelConfig.AvailablePlants)
+			if seedCount > config.SurvivalSeedSlots {
+				seedCount = config.SurvivalSeedSlots
+			}
+			s.gameState.SurvivalPlants = append([]string(nil), levelConfig.AvailablePlants[:seedCount]...)
+		}
+		survivalLevel := *levelConfig
+		survivalLevel.AvailablePlants = s.gameState.SurvivalPlants
+		levelConfig = &survivalLevel
+	}
+
 	// 创建植物选择栏模块
 	var err error
 	s.plantSelectionModule, err = modules.NewPlantSelectionModule(
@@ -56,6 +74,25 @@ func (s *GameScene) initPlantCardSystems(rm *game.ResourceManager) {
 	log.Printf("[GameScene] Plant selection module initialized successfully")
 }
 
+// generateLevelWaves 为 ExtraPoints 波次和省略 waves 的关卡程序生成波次
+//
+// 生存模式的波次由 LevelSystem 按旗帜组生成，这里跳过；
+// 读档时 restoreBattleState 会用存档中的波次替换这里生成的波次
+func (s *GameScene) generateLevelWaves(spawnRules *config.SpawnRulesConfig) {
+	levelConfig := s.gameState.CurrentLevel
+	if levelConfig == nil || levelConfig.Survival || !systems.NeedsWaveGeneration(levelConfig) {
+		return
+	}
+
+	zombieStats, err := config.LoadZombieStats("data/zombie_stats.yaml")
+	if err != nil {
+		log.Printf("[GameScene] Warning: Failed to load zombie stats, waves not generated: %v", err)
+		return
+	}
+	generator := systems.NewWaveGenerator(zombieStats, spawnRules, levelConfig, s.gameState.WavesPerRound, time.Now().UnixNano())
+	s.gameState.ReplaceLevelWaves(generator.GenerateWaves(levelConfig, s.gameState.GetCurrentRoundNumber()))
+}
+
 // initMenuButton 初始化菜单按钮（ECS 架构）
 // 创建可复用的三段式按钮实体，文字自动居中
 func (s *GameScene) initMenuButton(rm *game.ResourceManager) {
@@ -212,13 +249,9 @@ func (s *GameScene) initLawnmowers() {
 		enabledLanes = []int{1, 2, 3, 4, 5}
 	}
 
-	// 为每个启用的行创建除草车
+	// 为每个启用的行创建除草车（水路行为泳池清洁车）
 	for _, lane := range enabledLanes {
-		lawnmowerID, err := entities.NewLawnmowerEntity(
-			s.entityManager,
-			s.resourceManager,
-			lane,
-		)
+		lawnmowerID, err := s.newLawnmowerForLane(lane)
 
 		if err != nil {
 			log.Printf("[GameScene] ERROR: Failed to create lawnmower for lane %d: %v", lane, err)
@@ -231,6 +264,17 @@ func (s *GameScene) initLawnmowers() {
 	log.Printf("[GameScene] Initialized %d lawnmowers for enabled lanes: %v", len(enabledLanes), enabledLanes)
 }
 
+// newLawnmowerForLane 为指定行（1-based）创建除草车，水路行创建泳池清洁车，屋顶创建屋顶清洁车
+func (s *GameScene) newLawnmowerForLane(lane int) (ecs.EntityID, error) {
+	if s.lawnLayout.IsWaterRow(lane - 1) {
+		return entities.NewPoolCleanerEntity(s.entityManager, s.resourceManager, s.lawnLayout, lane)
+	}
+	if s.lawnLayout.HasSlope() {
+		return entities.NewRoofCleanerEntity(s.entityManager, s.resourceManager, s.lawnLayout, lane)
+	}
+	return entities.NewLawnmowerEntity(s.entityManager, s.resourceManager, s.lawnLayout, lane)
+}
+
 // spawnPresetPlants 生成预设植物
 // Story 19.4: 在关卡加载时根据配置生成预设植物
 //
@@ -264,7 +308,7 @@ func (s *GameScene) spawnPresetPlants() {
 		row := preset.Row - 1
 
 		// 验证坐标范围
-		if row < 0 || row >= config.GridRows || col < 0 || col >= config.GridColumns {
+		if row < 0 || row >= s.lawnLayout.Rows || col < 0 || col >= config.GridColumns {
 			log.Printf("[GameScene] ERROR: Invalid preset plant position at index %d: row=%d, col=%d",
 				i, preset.Row, preset.Col)
 			continue
@@ -282,6 +326,7 @@ func (s *GameScene) spawnPresetPlants() {
 				s.gameState,
 				s.reanimSystem,
 				components.PlantPeashooter,
+				s.lawnLayout,
 				col, row,
 			)
 		case "sunflower":
@@ -291,6 +336,7 @@ func (s *GameScene) spawnPresetPlants() {
 				s.gameState,
 				s.reanimSystem,
 				components.PlantSunflower,
+				s.lawnLayout,
 				col, row,
 			)
 		case "wallnut":
@@ -299,6 +345,7 @@ func (s *GameScene) spawnPresetPlants() {
 				s.resourceManager,
 				s.gameState,
 				s.reanimSystem,
+				s.lawnLayout,
 				col, row,
 			)
 		case "cherrybomb":
@@ -306,6 +353,15 @@ func (s *GameScene) spawnPresetPlants() {
 				s.entityManager,
 				s.resourceManager,
 				s.gameState,
+				s.lawnLayout,
+				col, row,
+			)
+		case "flowerpot":
+			entityID, err = entities.NewFlowerPotEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
 				col, row,
 			)
 		default:
@@ -319,8 +375,12 @@ func (s *GameScene) spawnPresetPlants() {
 			continue
 		}
 
-		// 更新草坪网格占用状态
-		if s.lawnGridSystem != nil && s.lawnGridEntityID != 0 {
+		// 更新草坪网格占用状态（花盆记录在种植平台层，不占用格子）
+		if s.lawnGridSystem != nil && s.lawnGridEntityID != 0 && preset.Type == "flowerpot" {
+			if err := s.lawnGridSystem.PlaceFlowerPot(s.lawnGridEntityID, col, row, entityID); err != nil {
+				log.Printf("[GameScene] Warning: Failed to place flower pot at (%d,%d): %v", col, row, err)
+			}
+		} else if s.lawnGridSystem != nil && s.lawnGridEntityID != 0 {
 			if err := s.lawnGridSystem.OccupyCell(s.lawnGridEntityID, col, row, entityID); err != nil {
 				log.Printf("[GameScene] Warning: Failed to occupy grid cell (%d,%d): %v", col, row, err)
 			}
@@ -334,6 +394,58 @@ func (s *GameScene) spawnPresetPlants() {
 	log.Printf("[GameScene] Preset plants spawned: %d/%d", spawnedCount, len(presetPlants))
 }
 
+// startLevelAmbience 播放关卡的背景音乐并生成环境粒子
+// 未配置时保持当前音乐，资源缺失时只记录警告
+func (s *GameScene) startLevelAmbience() {
+	level := s.gameState.CurrentLevel
+	if level == nil {
+		return
+	}
+
+	if level.Music != "" {
+		if audioManager := s.gameState.GetAudioManager(); audioManager != nil && !audioManager.PlayMusic(level.Music) {
+			log.Printf("[GameScene] Warning: Failed to play level music %s", level.Music)
+		}
+	}
+
+	if level.AmbientParticles != "" {
+		centerX := config.GridWorldStartX + float64(config.GridColumns)*config.CellWidth/2
+		centerY := s.lawnLayout.StartY + float64(s.lawnLayout.Rows)*s.lawnLayout.CellHeight/2
+		if _, err := entities.CreateParticleEffect(s.entityManager, s.resourceManager, level.AmbientParticles, centerX, centerY); err != nil {
+			log.Printf("[GameScene] Warning: Failed to create ambient particles %s: %v", level.AmbientParticles, err)
+		}
+	}
+}
+
+// spawnGraves 生成关卡配置的墓碑（夜晚关卡）
+//
+// 墓碑占据格子，只能种植墓碑吞噬者；最后一波时每块墓碑下爬出一只僵尸
+func (s *GameScene) spawnGraves() {
+	if s.gameState.CurrentLevel == nil || len(s.gameState.CurrentLevel.Graves) == 0 {
+		return
+	}
+
+	for _, grave := range s.gameState.CurrentLevel.Graves {
+		// 将 1-based 配置坐标转换为 0-based 代码坐标
+		s.placeGrave(grave.Col-1, grave.Row-1)
+	}
+	log.Printf("[GameScene] Graves spawned: %d", len(s.gameState.CurrentLevel.Graves))
+}
+
+// placeGrave 在指定格子（0-based）创建墓碑并登记到草坪网格
+func (s *GameScene) placeGrave(col, row int) {
+	entityID, err := entities.NewGraveEntity(s.entityManager, s.resourceManager, s.lawnLayout, col, row)
+	if err != nil {
+		log.Printf("[GameScene] ERROR: Failed to create grave at (%d,%d): %v", col, row, err)
+		return
+	}
+	if s.lawnGridSystem != nil && s.lawnGridEntityID != 0 {
+		if err := s.lawnGridSystem.PlaceGrave(s.lawnGridEntityID, col, row, entityID); err != nil {
+			log.Printf("[GameScene] Warning: Failed to place grave at (%d,%d): %v", col, row, err)
+		}
+	}
+}
+
 // saveBattleState 保存当前战斗状态
 //
 // Story 18.2: 战斗存档保存触发
@@ -363,6 +475,9 @@ func (s *GameScene) saveBattleState() {
 
 	// 创建序列化器并保存
 	serializer := game.NewBattleSerializer(gdataManager)
+	if s.miniGameRule != nil {
+		serializer.SetSaveHook(s.miniGameRule.SaveState)
+	}
 	if err := serializer.SaveBattle(s.entityManager, s.gameState, currentUser); err != nil {
 		log.Printf("[GameScene] ERROR: Failed to save battle state: %v", err)
 		return
@@ -622,11 +737,14 @@ func (s *GameScene) skipOpeningAnimation() {
 	}
 
 	// 阳光生成：从存档恢复时根据关卡配置决定是否启用
-	// Story 19.10: 保龄球关卡（initialSun == 0）禁用阳光生成
+	// Story 19.10: 小游戏规则（如保龄球）禁用阳光生成
 	if s.sunSpawnSystem != nil {
-		if s.gameState.CurrentLevel != nil && s.gameState.CurrentLevel.InitialSun == 0 {
-			// 保龄球关卡不使用阳光，保持禁用状态
-			log.Printf("[GameScene] 恢复存档: 保龄球关卡，阳光生成保持禁用")
+		if s.miniGameHUD().DisableSkySun {
+			// 小游戏规则不使用天空阳光，保持禁用状态
+			log.Printf("[GameScene] 恢复存档: 小游戏关卡，阳光生成保持禁用")
+		} else if s.gameState.CurrentLevel != nil && s.gameState.CurrentLevel.IsNight() {
+			// 夜晚关卡没有天空掉落的阳光
+			log.Printf("[GameScene] 恢复存档: 夜晚关卡，阳光生成保持禁用")
 		} else {
 			// 普通关卡启用阳光生成
 			s.sunSpawnSystem.Enable()
@@ -699,6 +817,19 @@ func (s *GameScene) restoreBattleState() {
 		return
 	}
 
+	// 程序生成的波次：恢复存档时的波次，下面的波次进度才能对应上
+	if len(saveData.GeneratedWaves) > 0 {
+		s.gameState.ReplaceLevelWaves(saveData.GeneratedWaves)
+		if s.waveSpawnSystem != nil {
+			s.waveSpawnSystem.ResetSpawnConstraints()
+		}
+	}
+
+	// 生存模式：先恢复本组旗帜的波次和种子栏，下面的波次进度才能对应上
+	if saveData.Survival != nil {
+		s.restoreSurvivalState(saveData.Survival)
+	}
+
 	// 恢复游戏状态
 	s.gameState.Sun = saveData.Sun
 	s.gameState.LevelTime = saveData.LevelTime
@@ -709,6 +840,8 @@ func (s *GameScene) restoreBattleState() {
 	}
 	s.gameState.TotalZombiesSpawned = saveData.TotalZombiesSpawned
 	s.gameState.ZombiesKilled = saveData.ZombiesKilled
+	s.gameState.ZombiesSummoned = saveData.ZombiesSummoned
+	s.gameState.BossSpawned = saveData.BossSpawned
 
 	log.Printf("[GameScene] 游戏状态已恢复: Sun=%d, Wave=%d, Time=%.1f, TotalZombiesInLevel=%d, ZombiesKilled=%d, TotalZombiesSpawned=%d, SpawnedWaves=%v",
 		s.gameState.Sun, s.gameState.CurrentWaveIndex, s.gameState.LevelTime,
@@ -716,11 +849,15 @@ func (s *GameScene) restoreBattleState() {
 		s.gameState.TotalZombiesSpawned, s.gameState.SpawnedWaves)
 
 	// Story 18.3: 恢复所有实体
+	// 墓碑先于植物恢复，墓碑吞噬者需要关联所在格子的墓碑
+	s.restoreGraves(saveData.Graves)
 	s.restorePlants(saveData.Plants)
 	s.restoreZombies(saveData.Zombies)
 	s.restoreProjectiles(saveData.Projectiles)
 	s.restoreSuns(saveData.Suns)
 	s.restoreLawnmowers(saveData.Lawnmowers)
+	s.restoreIceTrail(saveData.IceTrail)
+	s.restoreZombossBalls(saveData.ZombossBalls)
 
 	log.Printf("[GameScene] 实体恢复完成: Plants=%d, Zombies=%d, Projectiles=%d, Suns=%d, Lawnmowers=%d",
 		len(saveData.Plants), len(saveData.Zombies), len(saveData.Projectiles),
@@ -757,15 +894,24 @@ func (s *GameScene) restoreBattleState() {
 		if waveTimingSystem != nil {
 			waveTimingSystem.RestoreState(saveData.CurrentWaveIndex, saveData.LevelTime)
 		}
+
+		// 生存模式在两组旗帜之间存档：回到重新选择植物的面板，下一组旗帜的计时保持暂停
+		if saveData.Survival != nil && saveData.Survival.AwaitingReselection {
+			s.levelSystem.RestorePlantReselection()
+		}
 	}
 
-	// Story 19.x: 恢复保龄球模式数据（Level 1-5）
-	s.restoreBowlingNuts(saveData.BowlingNuts)
+	// Story 19.x: 恢复传送带和关卡阶段数据（Level 1-5）
 	s.restoreConveyorBelt(saveData.ConveyorBelt)
 	s.restoreLevelPhase(saveData.LevelPhase)
 	s.restoreDaveDialogue(saveData.DaveDialogue)
 	s.restoreGuidedTutorial(saveData.GuidedTutorial)
 
+	// 恢复小游戏规则状态（保龄球坚果、保龄球阶段等，需要在关卡阶段恢复之后）
+	if s.miniGameRule != nil {
+		s.miniGameRule.RestoreState(saveData)
+	}
+
 	// Bug Fix: 不再在恢复后立即删除存档
 	// 存档删除应该在用户确认"继续"后才执行，这样：
 	// - 用户选择"取消"返回主菜单时，存档仍然保留
@@ -774,6 +920,33 @@ func (s *GameScene) restoreBattleState() {
 	log.Printf("[GameScene] 战斗状态恢复完成! (存档将在用户确认后删除)")
 }
 
+// restoreMagnetshroomState 恢复冷却中的磁力菇：冷却时间、吸附的饰品显示和冷却动画
+func (s *GameScene) restoreMagnetshroomState(entityID ecs.EntityID, magnet *components.MagnetshroomComponent, plantData game.PlantData) {
+	magnet.RechargeTimer = plantData.MagnetRechargeTimer
+	magnet.HeldAccessory = types.AccessoryTag(plantData.MagnetHeldAccessory)
+
+	if position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID); ok && magnet.HeldAccessory != types.AccessoryNone {
+		heldID, err := entities.NewMagnetHeldAccessoryEntity(
+			s.entityManager,
+			s.resourceManager,
+			magnet.HeldAccessory,
+			position.X+config.MagnetshroomHeldOffsetX,
+			position.Y+config.MagnetshroomHeldOffsetY,
+		)
+		if err != nil {
+			log.Printf("[GameScene] Warning: Failed to restore magnet-shroom held accessory %s: %v", magnet.HeldAccessory, err)
+		} else {
+			magnet.HeldEntity = heldID
+		}
+	}
+
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    "magnetshroom",
+		ComboName: "nonactive",
+		Processed: false,
+	})
+}
+
 // restorePlants 恢复植物实体
 //
 // Story 18.3: 从存档数据重建植物实体
@@ -808,6 +981,7 @@ func (s *GameScene) restorePlants(plants []game.PlantData) {
 				s.gameState,
 				s.reanimSystem,
 				plantType,
+				s.lawnLayout,
 				plantData.GridCol,
 				plantData.GridRow,
 			)
@@ -817,6 +991,7 @@ func (s *GameScene) restorePlants(plants []game.PlantData) {
 				s.resourceManager,
 				s.gameState,
 				s.reanimSystem,
+				s.lawnLayout,
 				plantData.GridCol,
 				plantData.GridRow,
 			)
@@ -825,6 +1000,179 @@ func (s *GameScene) restorePlants(plants []game.PlantData) {
 				s.entityManager,
 				s.resourceManager,
 				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+			)
+		case components.PlantMagnetshroom:
+			entityID, err = entities.NewMagnetshroomEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+			)
+		case components.PlantHypnoshroom:
+			entityID, err = entities.NewHypnoshroomEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+			)
+		case components.PlantKernelpult:
+			entityID, err = entities.NewKernelpultEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+			)
+		case components.PlantCobCannon:
+			entityID, err = entities.NewCobCannonEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+			)
+		case components.PlantSplitPea:
+			entityID, err = entities.NewSplitPeaEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+			)
+		case components.PlantStarfruit:
+			entityID, err = entities.NewStarfruitEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+			)
+		case components.PlantCattail:
+			entityID, err = entities.NewCattailEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+			)
+		case components.PlantTallnut:
+			entityID, err = entities.NewTallnutEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+			)
+		case components.PlantGraveBuster:
+			entityID, err = entities.NewGraveBusterEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+				s.lawnGridSystem.GetGrave(s.lawnGridEntityID, plantData.GridCol, plantData.GridRow),
+			)
+		case components.PlantLilyPad:
+			entityID, err = entities.NewLilyPadEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+			)
+		case components.PlantTangleKelp:
+			entityID, err = entities.NewTangleKelpEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+			)
+		case components.PlantSeaShroom:
+			entityID, err = entities.NewSeaShroomEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+			)
+		case components.PlantPlantern:
+			entityID, err = entities.NewPlanternEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+			)
+		case components.PlantFlowerPot:
+			entityID, err = entities.NewFlowerPotEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+			)
+		case components.PlantJalapeno:
+			entityID, err = entities.NewJalapenoEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+			)
+		case components.PlantIceShroom:
+			entityID, err = entities.NewIceShroomEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+			)
+		case components.PlantSpikeweed:
+			entityID, err = entities.NewSpikeweedEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+			)
+		case components.PlantChomper:
+			entityID, err = entities.NewChomperEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
+				plantData.GridCol,
+				plantData.GridRow,
+			)
+		case components.PlantUmbrellaLeaf:
+			entityID, err = entities.NewUmbrellaLeafEntity(
+				s.entityManager,
+				s.resourceManager,
+				s.gameState,
+				s.lawnLayout,
 				plantData.GridCol,
 				plantData.GridRow,
 			)
@@ -866,9 +1214,39 @@ func (s *GameScene) restorePlants(plants []game.PlantData) {
 				plantData.PlantType, timerComp.CurrentTime, timerComp.TargetTime, timerComp.IsReady)
 		}
 
-		// 更新草坪网格占用状态
-		if s.lawnGridSystem != nil && s.lawnGridEntityID != 0 {
-			if err := s.lawnGridSystem.OccupyCell(s.lawnGridEntityID, plantData.GridCol, plantData.GridRow, entityID); err != nil {
+		// 恢复磁力菇冷却和吸附的饰品
+		if magnet, ok := ecs.GetComponent[*components.MagnetshroomComponent](s.entityManager, entityID); ok && plantData.MagnetRechargeTimer > 0 {
+			s.restoreMagnetshroomState(entityID, magnet, plantData)
+		}
+
+		// 恢复玉米加农炮装填进度（装填完成的加农炮由 BehaviorSystem 重新进入已装填状态）
+		if cannon, ok := ecs.GetComponent[*components.CobCannonComponent](s.entityManager, entityID); ok {
+			cannon.ReloadTimer = plantData.ReloadTimer
+		}
+
+		// 恢复植物上架着的梯子
+		if plantData.HasLadder {
+			if _, err := entities.NewPlacedLadderEntity(s.entityManager, s.resourceManager, entityID); err != nil {
+				log.Printf("[GameScene] Warning: Failed to restore ladder on plant %s at (%d,%d): %v",
+					plantData.PlantType, plantData.GridRow, plantData.GridCol, err)
+				ecs.AddComponent(s.entityManager, entityID, &components.LadderedComponent{})
+			}
+		}
+
+		// 更新草坪网格占用状态（睡莲、花盆单独记录在种植平台层，多格植物占用所有格子）
+		if s.lawnGridSystem != nil && s.lawnGridEntityID != 0 && plantType == components.PlantLilyPad {
+			if err := s.lawnGridSystem.PlaceLilyPad(s.lawnGridEntityID, plantData.GridCol, plantData.GridRow, entityID); err != nil {
+				log.Printf("[GameScene] Warning: Failed to place lily pad at (%d,%d): %v",
+					plantData.GridCol, plantData.GridRow, err)
+			}
+		} else if s.lawnGridSystem != nil && s.lawnGridEntityID != 0 && plantType == components.PlantFlowerPot {
+			if err := s.lawnGridSystem.PlaceFlowerPot(s.lawnGridEntityID, plantData.GridCol, plantData.GridRow, entityID); err != nil {
+				log.Printf("[GameScene] Warning: Failed to place flower pot at (%d,%d): %v",
+					plantData.GridCol, plantData.GridRow, err)
+			}
+		} else if s.lawnGridSystem != nil && s.lawnGridEntityID != 0 {
+			width := components.PlantFootprintWidth(plantType)
+			if err := s.lawnGridSystem.OccupyCells(s.lawnGridEntityID, plantData.GridCol, plantData.GridRow, width, entityID); err != nil {
 				log.Printf("[GameScene] Warning: Failed to occupy grid cell (%d,%d): %v",
 					plantData.GridCol, plantData.GridRow, err)
 			}
@@ -905,39 +1283,40 @@ func (s *GameScene) restoreZombies(zombies []game.ZombieData) {
 		// 跳过正在死亡的僵尸
 		// 这些僵尸在存档时还在播放死亡动画，尚未被计入 ZombiesKilled
 		// 跳过恢复时需要增加击杀计数，否则会导致胜利条件计算错误
+		// 被魅惑的僵尸在被魅惑时已计入，不重复计数
 		if zombieData.BehaviorType == "dying" || zombieData.BehaviorType == "dying_explosion" {
 			log.Printf("[GameScene] Skipping dying zombie at (%.1f, %.1f), incrementing ZombiesKilled", zombieData.X, zombieData.Y)
-			s.gameState.ZombiesKilled++
+			if !zombieData.IsCharmed {
+				s.gameState.ZombiesKilled++
+			}
+			continue
+		}
+
+		// 僵王博士不走路，按攻击循环状态单独恢复
+		if zombieData.Zomboss != nil {
+			s.restoreZomboss(zombieData)
 			continue
 		}
 
 		// 计算行号（从 Y 坐标推算，如果 Lane 未设置）
 		lane := zombieData.Lane
 		if lane == 0 {
-			// 从 Y 坐标推算行号
-			lane = int((zombieData.Y-config.GridWorldStartY)/config.CellHeight) + 1
+			// 从 Y 坐标推算行号（屋顶斜坡上需减去斜坡偏移）
+			lane = s.lawnLayout.RowAt(zombieData.X, zombieData.Y) + 1
 			if lane < 1 {
 				lane = 1
 			}
-			if lane > 5 {
-				lane = 5
+			if lane > s.lawnLayout.Rows {
+				lane = s.lawnLayout.Rows
 			}
 		}
 
 		// 根据僵尸类型创建实体
-		var entityID ecs.EntityID
-		var err error
-
-		switch zombieData.ZombieType {
-		case "basic":
-			entityID, err = entities.NewZombieEntity(s.entityManager, s.resourceManager, lane-1, zombieData.X)
-		case "conehead":
-			entityID, err = entities.NewConeheadZombieEntity(s.entityManager, s.resourceManager, lane-1, zombieData.X)
-		case "buckethead":
-			entityID, err = entities.NewBucketheadZombieEntity(s.entityManager, s.resourceManager, lane-1, zombieData.X)
-		default:
-			// 默认创建普通僵尸
-			entityID, err = entities.NewZombieEntity(s.entityManager, s.resourceManager, lane-1, zombieData.X)
+		entityID, err := entities.NewZombieEntityByType(s.entityManager, s.resourceManager, zombieData.ZombieType, s.lawnLayout, lane-1, zombieData.X)
+		if err != nil && types.ZombieTypeFromString(zombieData.ZombieType) == types.ZombieUnknown {
+			// 未知类型默认创建普通僵尸
+			zombieData.ZombieType = "basic"
+			entityID, err = entities.NewZombieEntity(s.entityManager, s.resourceManager, s.lawnLayout, lane-1, zombieData.X)
 		}
 
 		if err != nil {
@@ -947,9 +1326,10 @@ func (s *GameScene) restoreZombies(zombies []game.ZombieData) {
 		}
 
 		// 恢复位置（Y 坐标可能需要调整）
+		// 屋顶斜坡偏移由 RoofSystem 重新叠加，这里恢复为不含斜坡偏移的坐标
 		if posComp, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID); ok {
 			posComp.X = zombieData.X
-			posComp.Y = zombieData.Y
+			posComp.Y = zombieData.Y - s.lawnLayout.SlopeOffsetY(zombieData.X)
 		}
 
 		// 恢复生命值
@@ -958,14 +1338,90 @@ func (s *GameScene) restoreZombies(zombies []game.ZombieData) {
 			healthComp.MaxHealth = zombieData.MaxHealth
 		}
 
-		// 恢复护甲值（如果有）
+		// 恢复头部防具耐久（如果有）
 		if zombieData.ArmorHealth > 0 {
-			if armorComp, ok := ecs.GetComponent[*components.ArmorComponent](s.entityManager, entityID); ok {
-				armorComp.CurrentArmor = zombieData.ArmorHealth
-				armorComp.MaxArmor = zombieData.ArmorMax
+			if accessoryComp, ok := ecs.GetComponent[*components.AccessoryComponent](s.entityManager, entityID); ok {
+				if helmet := accessoryComp.Helmet(); helmet != nil {
+					helmet.Durability = zombieData.ArmorHealth
+					helmet.MaxDurability = zombieData.ArmorMax
+				}
 			}
 		}
 
+		// 恢复饰品（移除存档中已不存在的饰品，如被磁力菇吸走的铁桶）
+		if zombieData.Accessories != nil {
+			s.restoreZombieAccessories(entityID, zombieData.Accessories)
+		}
+
+		// 恢复手持防具耐久
+		if zombieData.ShieldHealth > 0 {
+			if accessoryComp, ok := ecs.GetComponent[*components.AccessoryComponent](s.entityManager, entityID); ok {
+				if shield := accessoryComp.Shield(); shield != nil {
+					shield.Durability = zombieData.ShieldHealth
+				}
+			}
+		}
+
+		// 恢复跳跃僵尸的道具状态（跳跳杆已由饰品恢复处理）
+		vaultComp, hasVault := ecs.GetComponent[*components.VaultComponent](s.entityManager, entityID)
+		if hasVault && zombieData.PropLost {
+			vaultComp.State = components.VaultStateDone
+			if vaultComp.Kind == components.VaultKindPole {
+				if reanimComp, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok {
+					if reanimComp.HiddenTracks == nil {
+						reanimComp.HiddenTracks = make(map[string]bool)
+					}
+					reanimComp.HiddenTracks["Zombie_polevaulter_pole"] = true
+					reanimComp.HiddenTracks["Zombie_polevaulter_pole2"] = true
+				}
+			}
+		}
+		hasVaultProp := hasVault && vaultComp.HasProp()
+
+		// 恢复气球僵尸的气球状态（坠落中的气球僵尸直接落地）
+		balloonComp, hasBalloon := ecs.GetComponent[*components.BalloonComponent](s.entityManager, entityID)
+		if hasBalloon && zombieData.PropLost {
+			if collComp, ok := ecs.GetComponent[*components.CollisionComponent](s.entityManager, entityID); ok {
+				collComp.Altitude = 0
+			}
+			ecs.RemoveComponent[*components.BalloonComponent](s.entityManager, entityID)
+			hasBalloon = false
+		}
+		flying := hasBalloon && balloonComp.IsFlying()
+
+		// 恢复巨人背上的小鬼（已扔出时隐藏）
+		if gargantuarComp, ok := ecs.GetComponent[*components.GargantuarComponent](s.entityManager, entityID); ok && zombieData.PropLost {
+			gargantuarComp.HasImp = false
+			entities.HideGargantuarImp(s.entityManager, entityID)
+		}
+
+		// 恢复矿工僵尸的挖掘状态（出土中的矿工僵尸直接行走）
+		diggerComp, hasDigger := ecs.GetComponent[*components.DiggerComponent](s.entityManager, entityID)
+		tunneling := false
+		if hasDigger {
+			diggerComp.Burrowed = true
+			if zombieData.DiggerSurfaced {
+				diggerComp.State = components.DiggerStateWalking
+				diggerComp.Backward = zombieData.DiggerBackward
+				if diggerComp.Backward {
+					entities.FaceZombieRight(s.entityManager, entityID)
+				}
+			} else {
+				tunneling = true
+			}
+		}
+
+		// 恢复雪橇队僵尸是否仍在雪橇上（仍在雪橇上但冰道已消失的，由 BehaviorSystem 让其跳下）
+		// 队员已各自存档，读档后不再召集队员，仍在雪橇上的各自滑行
+		bobsledComp, hasBobsled := ecs.GetComponent[*components.BobsledComponent](s.entityManager, entityID)
+		if hasBobsled {
+			bobsledComp.TeamSummoned = true
+		}
+		if hasBobsled && zombieData.PropLost {
+			bobsledComp.State = components.BobsledStateWalking
+		}
+		riding := hasBobsled && bobsledComp.IsRiding()
+
 		// 恢复速度并激活僵尸
 		if velComp, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, entityID); ok {
 			if zombieData.VelocityX != 0 {
@@ -976,6 +1432,14 @@ func (s *GameScene) restoreZombies(zombies []game.ZombieData) {
 			}
 		}
 
+		// 恢复投篮车剩余的篮球（停车投篮中的投篮车读档后重新行驶，到达停车位置后继续投篮）
+		if catapultComp, ok := ecs.GetComponent[*components.CatapultComponent](s.entityManager, entityID); ok {
+			catapultComp.Basketballs = zombieData.Basketballs
+			if velComp, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, entityID); ok {
+				velComp.VX = config.CatapultDriveSpeed
+			}
+		}
+
 		// 设置行为状态为 walking（让 BehaviorSystem 重新判断是否需要切换到 eating）
 		if behaviorComp, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID); ok {
 			if zombieData.IsEating {
@@ -985,18 +1449,36 @@ func (s *GameScene) restoreZombies(zombies []game.ZombieData) {
 			}
 		}
 
+		// 恢复魅惑状态（击杀计数已随 GameState 保存，这里只恢复组件和移动方向）
+		if zombieData.IsCharmed {
+			entities.CharmZombie(s.entityManager, entityID)
+		}
+
 		// 触发走路动画（僵尸工厂默认创建的是 idle 动画）
 		// 根据僵尸类型选择正确的 unit ID
-		unitID := "zombie"
+		unitID := types.ZombieTypeToUnitID(zombieData.ZombieType)
 		comboName := "walk"
 		if zombieData.IsEating {
 			comboName = "eat" // Bug Fix: 配置中的啃食动画 combo 名称是 "eat"，不是 "eating"
+		} else if hasVaultProp {
+			comboName = vaultComp.MoveCombo
+		} else if flying {
+			comboName = "fly"
+		} else if tunneling {
+			comboName = "dig"
+		} else if riding {
+			comboName = "ride"
 		}
-		switch zombieData.ZombieType {
-		case "conehead":
-			unitID = "zombie_conehead"
-		case "buckethead":
-			unitID = "zombie_buckethead"
+		// 扛着梯子的扶梯僵尸使用扛梯行走/啃食动画
+		if systems.IsCarryingLadder(s.entityManager, entityID) {
+			if zombieData.IsEating {
+				comboName = "laddereat"
+			} else {
+				comboName = "ladderwalk"
+			}
+		}
+		if enrageComp, ok := ecs.GetComponent[*components.EnrageComponent](s.entityManager, entityID); ok && enrageComp.IsEnraged() {
+			comboName += enrageComp.ComboSuffix
 		}
 		ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
 			UnitID:    unitID,
@@ -1014,6 +1496,110 @@ func (s *GameScene) restoreZombies(zombies []game.ZombieData) {
 	}
 }
 
+// restoreZomboss 恢复僵王博士的生命值和攻击循环状态
+// 存档时正在播放的动画从头重新播放，攻击仍在动画结束时生效
+func (s *GameScene) restoreZomboss(zombieData game.ZombieData) {
+	entityID, err := entities.NewZombossEntity(s.entityManager, s.resourceManager)
+	if err != nil {
+		log.Printf("[GameScene] ERROR: Failed to restore Dr. Zomboss: %v", err)
+		return
+	}
+
+	if healthComp, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, entityID); ok {
+		healthComp.CurrentHealth = zombieData.Health
+		healthComp.MaxHealth = zombieData.MaxHealth
+	}
+
+	zomboss, ok := ecs.GetComponent[*components.ZombossComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	zomboss.State = components.ZombossState(zombieData.Zomboss.State)
+	zomboss.Timer = zombieData.Zomboss.Timer
+	zomboss.AttackCount = zombieData.Zomboss.AttackCount
+	zomboss.TargetRow = zombieData.Zomboss.TargetRow
+	zomboss.TargetCol = zombieData.Zomboss.TargetCol
+	zomboss.BallElement = components.ZombossElement(zombieData.Zomboss.BallElement)
+
+	if animCmd, ok := ecs.GetComponent[*components.AnimationCommandComponent](s.entityManager, entityID); ok {
+		animCmd.ComboName = zombossStateCombo(zomboss)
+		animCmd.Processed = false
+	}
+
+	log.Printf("[GameScene] 恢复僵王博士: 状态=%d, 生命值=%d/%d", zomboss.State, zombieData.Health, zombieData.MaxHealth)
+}
+
+// zombossStateCombo 返回僵王博士在某个状态下播放的动画组合
+func zombossStateCombo(zomboss *components.ZombossComponent) string {
+	switch zomboss.State {
+	case components.ZombossStateIdle:
+		return "idle"
+	case components.ZombossStateStomping:
+		return fmt.Sprintf("stomp_%d", zomboss.TargetRow+1)
+	case components.ZombossStateBungeeEnter:
+		return "bungee_enter"
+	case components.ZombossStateBungeeLeave:
+		return "bungee_leave"
+	case components.ZombossStateSummoning:
+		return fmt.Sprintf("spawn_%d", zomboss.TargetRow+1)
+	case components.ZombossStateRV:
+		return "rv"
+	case components.ZombossStateHeadEnter:
+		return "head_enter"
+	case components.ZombossStateHeadIdle:
+		return "head_idle"
+	case components.ZombossStateHeadAttack:
+		return fmt.Sprintf("head_attack_%d", zomboss.TargetRow+1)
+	case components.ZombossStateHeadLeave:
+		return "head_leave"
+	case components.ZombossStateDying:
+		return "death"
+	default:
+		return "enter"
+	}
+}
+
+// restoreZombieAccessories 根据存档恢复僵尸饰品
+//
+// 僵尸工厂会创建带有完整饰品的僵尸，这里将存档中已不存在的饰品
+// （例如被磁力菇吸走的铁桶）标记为已移除，渲染时隐藏对应的 Reanim 轨道
+func (s *GameScene) restoreZombieAccessories(entityID ecs.EntityID, saved []string) {
+	accessoryComp, ok := ecs.GetComponent[*components.AccessoryComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	keep := make(map[string]bool, len(saved))
+	for _, tag := range saved {
+		keep[tag] = true
+	}
+
+	for _, piece := range accessoryComp.Pieces {
+		if piece.Removed || keep[string(piece.Tag)] {
+			continue
+		}
+		accessoryComp.Remove(piece.Tag)
+
+		// 小丑僵尸失去八音盒后不再爆炸
+		if piece.Tag == types.AccessoryJackBox {
+			if reanimComp, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok {
+				if reanimComp.HiddenTracks == nil {
+					reanimComp.HiddenTracks = make(map[string]bool)
+				}
+				reanimComp.HiddenTracks["Zombie_jackbox_handle"] = true
+			}
+			ecs.RemoveComponent[*components.JackComponent](s.entityManager, entityID)
+		}
+
+		// 读报僵尸失去报纸后保持狂暴（存档时正在惊愕的也直接恢复为狂暴）
+		if piece.Tier == 2 {
+			if enrageComp, ok := ecs.GetComponent[*components.EnrageComponent](s.entityManager, entityID); ok {
+				enrageComp.State = components.EnrageStateEnraged
+			}
+		}
+	}
+}
+
 // restoreProjectiles 恢复子弹实体
 //
 // Story 18.3: 从存档数据重建子弹实体
@@ -1024,29 +1610,31 @@ func (s *GameScene) restoreZombies(zombies []game.ZombieData) {
 //   - 伤害值
 //
 // 简化处理：
-//   - 只恢复豌豆子弹
+//   - 恢复豌豆、星星和香蒲尖刺（尖刺的追踪目标在下一帧重新锁定）
 func (s *GameScene) restoreProjectiles(projectiles []game.ProjectileData) {
 	for _, projData := range projectiles {
-		// 目前只支持豌豆子弹
-		if projData.Type != "pea" {
+		var err error
+		switch projData.Type {
+		case "pea":
+			// 旧存档没有保存速度时使用默认的向右速度
+			vx := projData.VelocityX
+			if vx == 0 && projData.VelocityY == 0 {
+				vx = config.PeaBulletSpeed
+			}
+			_, err = entities.NewPeaProjectileWithVelocity(s.entityManager, s.resourceManager, projData.X, projData.Y, vx, projData.VelocityY)
+		case "star":
+			_, err = entities.NewStarProjectile(s.entityManager, s.resourceManager, projData.X, projData.Y, projData.VelocityX, projData.VelocityY)
+		case "cattail_spike":
+			_, err = entities.NewCattailSpike(s.entityManager, s.resourceManager, projData.X, projData.Y, 0, projData.VelocityX, projData.VelocityY)
+		default:
 			log.Printf("[GameScene] Warning: Unsupported projectile type '%s', skipping", projData.Type)
 			continue
 		}
-
-		// 使用工厂函数创建子弹实体
-		entityID, err := entities.NewPeaProjectile(s.entityManager, s.resourceManager, projData.X, projData.Y)
 		if err != nil {
 			log.Printf("[GameScene] ERROR: Failed to restore projectile at (%.1f, %.1f): %v", projData.X, projData.Y, err)
 			continue
 		}
 
-		// 恢复速度（如果保存了不同的速度）
-		if projData.VelocityX != 0 {
-			if velComp, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, entityID); ok {
-				velComp.VX = projData.VelocityX
-			}
-		}
-
 		log.Printf("[GameScene] Restored projectile at (%.1f, %.1f)", projData.X, projData.Y)
 	}
 }
@@ -1105,6 +1693,53 @@ func (s *GameScene) restoreSuns(suns []game.SunData) {
 	}
 }
 
+// restoreGraves 恢复草坪上的墓碑
+func (s *GameScene) restoreGraves(graves []game.GraveData) {
+	for _, grave := range graves {
+		if grave.Row < 0 || grave.Row >= s.lawnLayout.Rows || grave.Col < 0 || grave.Col >= config.GridColumns {
+			continue
+		}
+		s.placeGrave(grave.Col, grave.Row)
+	}
+	if len(graves) > 0 {
+		log.Printf("[GameScene] 恢复墓碑: %d", len(graves))
+	}
+}
+
+// restoreIceTrail 恢复草坪上的冰道
+func (s *GameScene) restoreIceTrail(cells []game.IceCellData) {
+	gridComp, ok := ecs.GetComponent[*components.LawnGridComponent](s.entityManager, s.lawnGridEntityID)
+	if !ok {
+		return
+	}
+	for _, cell := range cells {
+		if cell.Row < 0 || cell.Row >= s.lawnLayout.Rows || cell.Col < 0 || cell.Col >= config.GridColumns {
+			continue
+		}
+		gridComp.Ice[cell.Row][cell.Col] = cell.Remaining
+	}
+	if len(cells) > 0 {
+		log.Printf("[GameScene] 恢复冰道格子: %d", len(cells))
+	}
+}
+
+// restoreZombossBalls 恢复僵王博士吐出的火球和冰球
+func (s *GameScene) restoreZombossBalls(balls []game.ZombossBallData) {
+	for _, ballData := range balls {
+		ballID, err := entities.NewZombossBallEntity(s.entityManager, s.resourceManager, components.ZombossElement(ballData.Element), s.lawnLayout, ballData.Row)
+		if err != nil {
+			log.Printf("[GameScene] ERROR: Failed to restore Dr. Zomboss ball: %v", err)
+			continue
+		}
+		if posComp, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, ballID); ok {
+			posComp.X = ballData.X
+		}
+	}
+	if len(balls) > 0 {
+		log.Printf("[GameScene] 恢复僵王博士的球: %d", len(balls))
+	}
+}
+
 // restoreLawnmowers 恢复除草车实体
 //
 // Story 18.3: 从存档数据重建除草车实体
@@ -1134,7 +1769,7 @@ func (s *GameScene) restoreLawnmowers(lawnmowers []game.LawnmowerData) {
 		}
 
 		// 创建除草车实体
-		entityID, err := entities.NewLawnmowerEntity(s.entityManager, s.resourceManager, lmData.Lane)
+		entityID, err := s.newLawnmowerForLane(lmData.Lane)
 		if err != nil {
 			log.Printf("[GameScene] ERROR: Failed to restore lawnmower on lane %d: %v", lmData.Lane, err)
 			continue
@@ -1193,6 +1828,46 @@ func stringToPlantType(s string) components.PlantType {
 		return components.PlantWallnut
 	case "CherryBomb", "cherrybomb":
 		return components.PlantCherryBomb
+	case "Magnetshroom", "magnetshroom":
+		return components.PlantMagnetshroom
+	case "Hypnoshroom", "hypnoshroom":
+		return components.PlantHypnoshroom
+	case "Kernelpult", "kernelpult":
+		return components.PlantKernelpult
+	case "CobCannon", "cobcannon":
+		return components.PlantCobCannon
+	case "SplitPea", "splitpea":
+		return components.PlantSplitPea
+	case "Starfruit", "starfruit":
+		return components.PlantStarfruit
+	case "Cattail", "cattail":
+		return components.PlantCattail
+	case "Tallnut", "tallnut":
+		return components.PlantTallnut
+	case "GraveBuster", "gravebuster":
+		return components.PlantGraveBuster
+	case "LilyPad", "lilypad":
+		return components.PlantLilyPad
+	case "TangleKelp", "tanglekelp":
+		return components.PlantTangleKelp
+	case "SeaShroom", "seashroom":
+		return components.PlantSeaShroom
+	case "Plantern", "plantern":
+		return components.PlantPlantern
+	case "Blover", "blover":
+		return components.PlantBlover
+	case "FlowerPot", "flowerpot":
+		return components.PlantFlowerPot
+	case "Jalapeno", "jalapeno":
+		return components.PlantJalapeno
+	case "IceShroom", "iceshroom":
+		return components.PlantIceShroom
+	case "Spikeweed", "spikeweed":
+		return components.PlantSpikeweed
+	case "Chomper", "chomper":
+		return components.PlantChomper
+	case "UmbrellaLeaf", "umbrellaleaf":
+		return components.PlantUmbrellaLeaf
 	default:
 		return components.PlantUnknown
 	}
@@ -1308,65 +1983,9 @@ func (s *GameScene) restoreTutorialState(tutorialData *game.TutorialSaveData) {
 }
 
 // =============================================================================
-// 保龄球模式数据恢复方法（Level 1-5）
+// 传送带和关卡阶段数据恢复方法（Level 1-5）
 // =============================================================================
 
-// restoreBowlingNuts 恢复保龄球坚果实体
-//
-// Story 19.x: 从存档数据重建保龄球坚果实体
-//
-// 恢复内容：
-//   - 位置和速度（X, Y, VelocityX, VelocityY）
-//   - 行号和弹射状态
-//   - 是否为爆炸坚果
-//   - 弹射次数和方向
-func (s *GameScene) restoreBowlingNuts(bowlingNuts []game.BowlingNutData) {
-	if len(bowlingNuts) == 0 {
-		return
-	}
-
-	log.Printf("[GameScene] 恢复 %d 个保龄球坚果...", len(bowlingNuts))
-
-	for _, nutData := range bowlingNuts {
-		// 使用工厂函数创建（col=0 作为临时值，后续会覆盖位置）
-		entityID, err := entities.NewBowlingNutEntity(
-			s.entityManager,
-			s.resourceManager,
-			nutData.Row,
-			0, // 临时 col，位置会被覆盖
-			nutData.IsExplosive,
-		)
-
-		if err != nil {
-			log.Printf("[GameScene] ERROR: Failed to restore bowling nut at (%.1f, %.1f): %v",
-				nutData.X, nutData.Y, err)
-			continue
-		}
-
-		// 恢复位置（覆盖工厂函数计算的默认位置）
-		if posComp, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID); ok {
-			posComp.X = nutData.X
-			posComp.Y = nutData.Y
-		}
-
-		// 恢复保龄球坚果组件状态
-		if bowlingComp, ok := ecs.GetComponent[*components.BowlingNutComponent](s.entityManager, entityID); ok {
-			bowlingComp.VelocityX = nutData.VelocityX
-			bowlingComp.VelocityY = nutData.VelocityY
-			bowlingComp.Row = nutData.Row
-			bowlingComp.IsRolling = nutData.IsRolling
-			bowlingComp.IsBouncing = nutData.IsBouncing
-			bowlingComp.TargetRow = nutData.TargetRow
-			bowlingComp.BounceCount = nutData.BounceCount
-			bowlingComp.CollisionCooldown = nutData.CollisionCooldown
-			bowlingComp.BounceDirection = nutData.BounceDirection
-		}
-
-		log.Printf("[GameScene] Restored bowling nut at (%.1f, %.1f), row=%d, explosive=%v, bouncing=%v",
-			nutData.X, nutData.Y, nutData.Row, nutData.IsExplosive, nutData.IsBouncing)
-	}
-}
-
 // restoreConveyorBelt 恢复传送带状态
 //
 // Story 19.x: 从存档数据恢复传送带状态
@@ -1464,28 +2083,7 @@ func (s *GameScene) restoreLevelPhase(phaseData *game.LevelPhaseData) {
 		phaseComp.CurrentPhase, phaseComp.PhaseState,
 		phaseComp.ConveyorBeltVisible, phaseComp.ShowRedLine)
 
-	// Bug Fix: 如果恢复的是 Phase 2（保龄球阶段）且状态为 active，
-	// 需要激活相关系统（这些操作在正常转场完成时由回调执行）
-	if phaseComp.CurrentPhase == 2 && phaseComp.PhaseState == components.PhaseStateActive {
-		log.Printf("[GameScene] 恢复 Phase 2 激活状态，启动相关系统...")
-
-		// 激活传送带系统
-		if s.conveyorBeltSystem != nil {
-			s.conveyorBeltSystem.Activate()
-			log.Printf("[GameScene] 传送带系统已激活")
-		}
-
-		// 启用红线限制
-		if s.plantPreviewRenderSystem != nil {
-			s.plantPreviewRenderSystem.SetRedLineEnabled(true)
-		}
-
-		// 恢复波次计时系统
-		if s.levelSystem != nil {
-			s.levelSystem.ResumeWaveTiming()
-			log.Printf("[GameScene] 波次计时系统已恢复")
-		}
-	}
+	// 恢复到已激活的下一阶段时，由小游戏规则的 RestoreState 重新启动该阶段的系统（如保龄球的传送带）
 }
 
 // restoreDaveDialogue 恢复 Dave 对话状态
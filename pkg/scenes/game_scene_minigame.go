@@ -0,0 +1,79 @@
+package scenes
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/modules"
+)
+
+// setupMiniGameRule 初始化小游戏规则，并把规则的钩子接入各系统
+//
+// 必须在所有系统初始化之后调用：
+//   - 规则的胜负判定接入 LevelSystem
+//   - 规则禁用的植物卡片和阳光收集输入同步到 InputSystem
+func (s *GameScene) setupMiniGameRule() {
+	if s.miniGameRule == nil {
+		return
+	}
+
+	ctx := &modules.MiniGameContext{
+		EntityManager:   s.entityManager,
+		GameState:       s.gameState,
+		ResourceManager: s.resourceManager,
+		LevelConfig:     s.gameState.CurrentLevel,
+		InputSystem:     s.inputSystem,
+		SunSpawnSystem:  s.sunSpawnSystem,
+		ReanimSystem:    s.reanimSystem,
+
+		LawnGridSystem:   s.lawnGridSystem,
+		LawnGridEntityID: s.lawnGridEntityID,
+		LawnLayout:       s.lawnLayout,
+
+		RenderSystem:             s.renderSystem,
+		LevelSystem:              s.levelSystem,
+		LevelPhaseSystem:         s.levelPhaseSystem,
+		ConveyorBeltSystem:       s.conveyorBeltSystem,
+		PlantPreviewRenderSystem: s.plantPreviewRenderSystem,
+
+		InitLawnmowers: s.initLawnmowers,
+		SetShovelSlot:  s.setShovelSlot,
+	}
+	if err := s.miniGameRule.Setup(ctx); err != nil {
+		log.Printf("[GameScene] ERROR: Failed to set up mini-game rule '%s': %v", s.miniGameRule.ID(), err)
+		s.miniGameRule = nil
+		return
+	}
+
+	if s.levelSystem != nil {
+		// 自行生成僵尸的规则没有波次，胜利只由规则判定
+		info, _ := modules.LookupMiniGame(s.miniGameRule.ID())
+		s.levelSystem.SetResultOverride(s.miniGameRule.CheckResult, info.SpawnsZombies)
+	}
+	if s.inputSystem != nil {
+		s.inputSystem.SetPlantCardsEnabled(s.miniGameRule.AllowsInput(modules.MiniGameInputPlantCards))
+		s.inputSystem.SetSunCollectionEnabled(s.miniGameRule.AllowsInput(modules.MiniGameInputCollectSun))
+	}
+
+	log.Printf("[GameScene] Mini-game rule '%s' set up", s.miniGameRule.ID())
+}
+
+// setShovelSlot 将铲子卡槽固定在指定的屏幕位置（不再跟随植物选择栏滑入）
+func (s *GameScene) setShovelSlot(x, y float64) {
+	s.shovelSlotFixed = true
+	s.shovelSlotX = x
+	s.shovelSlotY = y
+}
+
+// miniGameHUD 返回小游戏规则对 HUD 的调整（普通关卡为零值）
+func (s *GameScene) miniGameHUD() modules.MiniGameHUD {
+	if s.miniGameRule == nil {
+		return modules.MiniGameHUD{}
+	}
+	info, _ := modules.LookupMiniGame(s.miniGameRule.ID())
+	return info.HUD
+}
+
+// allowsMiniGameInput 检查小游戏规则是否允许某种玩家输入（普通关卡总是允许）
+func (s *GameScene) allowsMiniGameInput(input modules.MiniGameInput) bool {
+	return s.miniGameRule == nil || s.miniGameRule.AllowsInput(input)
+}
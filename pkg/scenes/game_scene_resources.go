@@ -114,15 +114,6 @@ func (s *GameScene) loadResources() {
 		s.flagMeterFlag = flagMeterFlag
 	}
 
-	// Story 19.4: Load bowling red line image (保龄球红线图片)
-	bowlingRedLine, err := s.resourceManager.LoadImage("assets/images/Wallnut_bowlingstripe.png")
-	if err != nil {
-		log.Printf("Warning: Failed to load bowling red line image: %v", err)
-	} else {
-		s.bowlingRedLine = bowlingRedLine
-		log.Printf("[GameScene] Loaded bowling red line image")
-	}
-
 	// Story 19.5: Load conveyor belt images (传送带图片)
 	conveyorBackdrop, err := s.resourceManager.LoadImageByID("IMAGE_CONVEYORBELT_BACKDROP")
 	if err != nil {
@@ -365,7 +356,7 @@ func (s *GameScene) loadTwoStageSoddingImages(enabledLanes []int) {
 	// 两阶段渲染：使用中间行的中心位置
 	// Story 8.2.1 修复：sodOverlayY应该使用实际的草皮位置（居中+偏移）
 	middleLane := enabledLanes[len(enabledLanes)/2]
-	rowCenterY := config.GridWorldStartY + float64(middleLane-1)*config.CellHeight + config.CellHeight/2.0
+	rowCenterY := s.lawnLayout.StartY + float64(middleLane-1)*s.lawnLayout.CellHeight + s.lawnLayout.CellHeight/2.0
 	sodHeight := float64(s.sodHeight)
 	sodOverlayY := rowCenterY - sodHeight/2.0 + config.SodOverlayOffsetY
 
@@ -399,7 +390,7 @@ func (s *GameScene) loadConsecutive3RowsSodding(enabledLanes []int) {
 	// 连续3行：使用中间行的中心位置
 	// Story 8.2.1 修复：sodOverlayY应该使用实际的草皮位置（居中+偏移）
 	middleLane := enabledLanes[len(enabledLanes)/2]
-	rowCenterY := config.GridWorldStartY + float64(middleLane-1)*config.CellHeight + config.CellHeight/2.0
+	rowCenterY := s.lawnLayout.StartY + float64(middleLane-1)*s.lawnLayout.CellHeight + s.lawnLayout.CellHeight/2.0
 	sodHeight := float64(s.sodHeight)
 	sodOverlayY := rowCenterY - sodHeight/2.0 + config.SodOverlayOffsetY
 
@@ -433,7 +424,7 @@ func (s *GameScene) loadSingleRowSodding(animLanes []int) {
 	// 单行模式下，使用第一个动画行的位置
 	// Story 8.2.1 修复：sodOverlayY应该使用实际的草皮位置（居中+偏移）
 	firstAnimLane := animLanes[0]
-	rowCenterY := config.GridWorldStartY + float64(firstAnimLane-1)*config.CellHeight + config.CellHeight/2.0
+	rowCenterY := s.lawnLayout.StartY + float64(firstAnimLane-1)*s.lawnLayout.CellHeight + s.lawnLayout.CellHeight/2.0
 	sodHeight := float64(s.sodHeight)
 	sodOverlayY := rowCenterY - sodHeight/2.0 + config.SodOverlayOffsetY
 
@@ -530,7 +521,7 @@ func (s *GameScene) renderConsecutive3RowsToBackground(sodRowRGB *ebiten.Image,
 
 	// 计算中间行（第3行）的中心Y坐标
 	middleLane := lanes[1] // 第3行（索引1）
-	middleRowCenterY := config.GridWorldStartY + float64(middleLane-1)*config.CellHeight + config.CellHeight/2.0
+	middleRowCenterY := s.lawnLayout.StartY + float64(middleLane-1)*s.lawnLayout.CellHeight + s.lawnLayout.CellHeight/2.0
 
 	// 草皮Y坐标 = 中间行中心 - 草皮高度的一半 + 偏移
 	// 这样图片的中心对齐到第3行的中心，覆盖第2,3,4行
@@ -556,7 +547,7 @@ func (s *GameScene) renderSingleRowsToBackground(sodRowRGB *ebiten.Image, lanes
 		sodHeight := float64(sodBounds.Dy())
 
 		// 计算目标行的中心Y坐标
-		rowCenterY := config.GridWorldStartY + float64(lane-1)*config.CellHeight + config.CellHeight/2.0
+		rowCenterY := s.lawnLayout.StartY + float64(lane-1)*s.lawnLayout.CellHeight + s.lawnLayout.CellHeight/2.0
 
 		// 草皮Y坐标 = 行中心 - 草皮高度的一半 + 偏移
 		dstY := rowCenterY - sodHeight/2.0 + config.SodOverlayOffsetY
@@ -636,7 +627,7 @@ func (s *GameScene) renderTwoStageOverlay(newBackground *ebiten.Image, lanesToPr
 
 	// 计算中间行的中心Y坐标
 	middleLane := lanesToPreRender[1] // 中间行
-	rowCenterY := config.GridWorldStartY + float64(middleLane-1)*config.CellHeight + config.CellHeight/2.0
+	rowCenterY := s.lawnLayout.StartY + float64(middleLane-1)*s.lawnLayout.CellHeight + s.lawnLayout.CellHeight/2.0
 
 	// 3行草皮图片的高度
 	sodBounds := sod3RowRGB.Bounds()
@@ -669,7 +660,7 @@ func (s *GameScene) renderConsecutive3RowsOverlay(newBackground *ebiten.Image, l
 	}
 
 	middleLane := lanesToPreRender[1]
-	rowCenterY := config.GridWorldStartY + float64(middleLane-1)*config.CellHeight + config.CellHeight/2.0
+	rowCenterY := s.lawnLayout.StartY + float64(middleLane-1)*s.lawnLayout.CellHeight + s.lawnLayout.CellHeight/2.0
 	sodBounds := sod3RowRGB.Bounds()
 	sodHeight := float64(sodBounds.Dy())
 	dstY := rowCenterY - sodHeight/2.0 + config.SodOverlayOffsetY
@@ -698,7 +689,7 @@ func (s *GameScene) renderSingleRowsOverlay(newBackground *ebiten.Image, lanesTo
 	for _, lane := range lanesToPreRender {
 		sodBounds := sod1RowRGB.Bounds()
 		sodHeight := float64(sodBounds.Dy())
-		rowCenterY := config.GridWorldStartY + float64(lane-1)*config.CellHeight + config.CellHeight/2.0
+		rowCenterY := s.lawnLayout.StartY + float64(lane-1)*s.lawnLayout.CellHeight + s.lawnLayout.CellHeight/2.0
 		dstY := rowCenterY - sodHeight/2.0 + config.SodOverlayOffsetY
 		dstX := config.GridWorldStartX + config.SodOverlayOffsetX
 
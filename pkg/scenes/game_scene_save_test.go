@@ -216,6 +216,31 @@ func TestRestoreZombies_SkipDyingZombies(t *testing.T) {
 	}
 }
 
+// TestZombossStateCombo 测试读档后僵王博士按保存的状态重新播放对应动画
+func TestZombossStateCombo(t *testing.T) {
+	tests := []struct {
+		state     components.ZombossState
+		targetRow int
+		expected  string
+	}{
+		{components.ZombossStateEntering, 0, "enter"},
+		{components.ZombossStateIdle, 0, "idle"},
+		{components.ZombossStateStomping, 2, "stomp_3"},
+		{components.ZombossStateSummoning, 4, "spawn_5"},
+		{components.ZombossStateRV, 1, "rv"},
+		{components.ZombossStateHeadIdle, 0, "head_idle"},
+		{components.ZombossStateHeadAttack, 0, "head_attack_1"},
+		{components.ZombossStateDying, 0, "death"},
+	}
+
+	for _, tt := range tests {
+		zomboss := &components.ZombossComponent{State: tt.state, TargetRow: tt.targetRow}
+		if got := zombossStateCombo(zomboss); got != tt.expected {
+			t.Errorf("zombossStateCombo(state=%d, row=%d) = %q, expected %q", tt.state, tt.targetRow, got, tt.expected)
+		}
+	}
+}
+
 // TestRestoreProjectiles_EmptyList 测试空子弹列表恢复
 // Story 18.3: 验证空列表处理
 func TestRestoreProjectiles_EmptyList(t *testing.T) {
@@ -0,0 +1,216 @@
+package scenes
+
+import (
+	"fmt"
+	"image/color"
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/utils"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// 生存模式重新选卡面板布局
+const (
+	survivalPanelCardSpacing   = 60.0  // 卡片间距
+	survivalPanelCardsY        = 250.0 // 卡片顶部 Y 坐标
+	survivalPanelCardWidth     = 50.0  // 卡片宽度（100 × PlantCardScale）
+	survivalPanelCardHeight    = 70.0  // 卡片高度（140 × PlantCardScale）
+	survivalPanelButtonWidth   = 160.0 // 开始按钮宽度
+	survivalPanelButtonHeight  = 40.0  // 开始按钮高度
+	survivalPanelButtonY       = 360.0 // 开始按钮顶部 Y 坐标
+	survivalPanelUnselectAlpha = 0.35  // 未选中卡片的透明度
+)
+
+// survivalReselectionCard 重新选卡面板中的一张卡片
+type survivalReselectionCard struct {
+	plantID  string
+	card     *components.PlantCardComponent
+	selected bool
+}
+
+// restoreSurvivalState 从战斗存档恢复生存模式进度
+//
+// 恢复已坚持的旗帜数、本组旗帜的波次和种子栏，
+// 必须在恢复波次进度和僵尸计数之前调用（StartSurvivalFlagSet 会重置它们）
+func (s *GameScene) restoreSurvivalState(survival *game.SurvivalSaveData) {
+	s.gameState.SurvivalFlags = survival.Flags
+	if len(survival.Waves) > 0 {
+		s.gameState.StartSurvivalFlagSet(survival.Waves)
+		if s.waveSpawnSystem != nil {
+			s.waveSpawnSystem.ResetSpawnConstraints()
+		}
+	}
+
+	if len(survival.Plants) > 0 {
+		s.gameState.SurvivalPlants = append([]string(nil), survival.Plants...)
+		if s.plantSelectionModule != nil {
+			if err := s.plantSelectionModule.ReplacePlantCards(s.gameState.SurvivalPlants, config.SeedBankX, config.SeedBankY); err != nil {
+				log.Printf("[GameScene] Warning: Failed to restore survival seed bank: %v", err)
+			}
+		}
+	}
+
+	log.Printf("[GameScene] Restored survival progress: %d flags, plants %v", survival.Flags, s.gameState.SurvivalPlants)
+}
+
+// updateSurvivalReselection 更新生存模式重新选卡面板
+//
+// 每组旗帜结束后波次计时暂停，玩家点击卡片切换是否携带（最多 SurvivalSeedSlots 种），
+// 点击"继续"后替换种子栏并开始下一组旗帜
+func (s *GameScene) updateSurvivalReselection() {
+	if s.survivalReselectionCards == nil {
+		s.initSurvivalReselectionCards()
+	}
+
+	pressed, mouseX, mouseY := utils.IsPointerJustPressed()
+	if !pressed {
+		return
+	}
+	x, y := float64(mouseX), float64(mouseY)
+
+	// 点击卡片：切换选中状态
+	startX := s.survivalPanelCardsStartX()
+	for i, entry := range s.survivalReselectionCards {
+		cardX := startX + float64(i)*survivalPanelCardSpacing
+		if x < cardX || x > cardX+survivalPanelCardWidth || y < survivalPanelCardsY || y > survivalPanelCardsY+survivalPanelCardHeight {
+			continue
+		}
+		if !entry.selected && s.survivalSelectedCount() >= config.SurvivalSeedSlots {
+			return
+		}
+		entry.selected = !entry.selected
+		return
+	}
+
+	// 点击"继续"按钮：至少选择一种植物
+	buttonX := (float64(WindowWidth) - survivalPanelButtonWidth) / 2
+	if x < buttonX || x > buttonX+survivalPanelButtonWidth || y < survivalPanelButtonY || y > survivalPanelButtonY+survivalPanelButtonHeight {
+		return
+	}
+	if s.survivalSelectedCount() == 0 {
+		return
+	}
+	s.finishSurvivalReselection()
+}
+
+// initSurvivalReselectionCards 为植物池中的每种植物创建面板卡片，当前种子栏中的植物默认选中
+func (s *GameScene) initSurvivalReselectionCards() {
+	s.survivalReselectionCards = []*survivalReselectionCard{}
+	if s.gameState.CurrentLevel == nil {
+		return
+	}
+
+	current := make(map[string]bool, len(s.gameState.SurvivalPlants))
+	for _, plantID := range s.gameState.SurvivalPlants {
+		current[plantID] = true
+	}
+
+	for _, plantID := range s.gameState.CurrentLevel.AvailablePlants {
+		plantType := stringToPlantType(plantID)
+		if plantType == components.PlantUnknown {
+			continue
+		}
+		card, err := entities.NewPlantCardComponent(s.entityManager, s.resourceManager, s.reanimSystem, plantType, config.PlantCardScale)
+		if err != nil {
+			log.Printf("[GameScene] Warning: Failed to create survival panel card '%s': %v", plantID, err)
+			continue
+		}
+		s.survivalReselectionCards = append(s.survivalReselectionCards, &survivalReselectionCard{
+			plantID:  plantID,
+			card:     card,
+			selected: current[plantID],
+		})
+	}
+}
+
+// finishSurvivalReselection 用选中的植物替换种子栏，并开始下一组旗帜的波次计时
+func (s *GameScene) finishSurvivalReselection() {
+	plants := make([]string, 0, config.SurvivalSeedSlots)
+	for _, entry := range s.survivalReselectionCards {
+		if entry.selected {
+			plants = append(plants, entry.plantID)
+		}
+	}
+
+	s.gameState.SurvivalPlants = plants
+	if s.plantSelectionModule != nil {
+		if err := s.plantSelectionModule.ReplacePlantCards(plants, config.SeedBankX, config.SeedBankY); err != nil {
+			log.Printf("[GameScene] Warning: Failed to replace survival seed bank: %v", err)
+		}
+	}
+	s.survivalReselectionCards = nil
+	s.levelSystem.FinishPlantReselection()
+
+	log.Printf("[GameScene] Survival plants reselected: %v", plants)
+}
+
+// survivalSelectedCount 返回面板中已选中的卡片数量
+func (s *GameScene) survivalSelectedCount() int {
+	count := 0
+	for _, entry := range s.survivalReselectionCards {
+		if entry.selected {
+			count++
+		}
+	}
+	return count
+}
+
+// survivalPanelCardsStartX 返回面板第一张卡片的 X 坐标（整排卡片水平居中）
+func (s *GameScene) survivalPanelCardsStartX() float64 {
+	rowWidth := float64(len(s.survivalReselectionCards)-1)*survivalPanelCardSpacing + survivalPanelCardWidth
+	return (float64(WindowWidth) - rowWidth) / 2
+}
+
+// drawSurvivalReselection 绘制生存模式重新选卡面板
+func (s *GameScene) drawSurvivalReselection(screen *ebiten.Image) {
+	if s.levelSystem == nil || !s.levelSystem.IsAwaitingPlantReselection() || s.survivalReselectionCards == nil {
+		return
+	}
+
+	ebitenutil.DrawRect(screen, 0, 0, float64(WindowWidth), float64(WindowHeight), color.RGBA{A: 160})
+
+	var fontSource *text.GoTextFaceSource
+	if s.plantCardFont != nil {
+		fontSource = s.plantCardFont.Source
+	}
+	startX := s.survivalPanelCardsStartX()
+	for i, entry := range s.survivalReselectionCards {
+		entry.card.Alpha = 1.0
+		if !entry.selected {
+			entry.card.Alpha = survivalPanelUnselectAlpha
+		}
+		entities.RenderPlantCard(screen, entry.card, startX+float64(i)*survivalPanelCardSpacing, survivalPanelCardsY, fontSource, config.PlantCardSunCostFontSize)
+	}
+
+	buttonX := (float64(WindowWidth) - survivalPanelButtonWidth) / 2
+	buttonColor := color.RGBA{R: 0, G: 150, B: 0, A: 255}
+	if s.survivalSelectedCount() == 0 {
+		buttonColor = color.RGBA{R: 90, G: 90, B: 90, A: 255}
+	}
+	ebitenutil.DrawRect(screen, buttonX, survivalPanelButtonY, survivalPanelButtonWidth, survivalPanelButtonHeight, buttonColor)
+
+	if s.sunCounterFont == nil {
+		return
+	}
+	best := s.gameState.GetSaveManager().GetSurvivalBestFlags()
+	lines := []struct {
+		text string
+		y    float64
+	}{
+		{fmt.Sprintf("已坚持 %d 面旗帜（最佳纪录 %d）", s.gameState.SurvivalFlags, best), 160},
+		{fmt.Sprintf("选择最多 %d 种植物 (%d/%d)", config.SurvivalSeedSlots, s.survivalSelectedCount(), config.SurvivalSeedSlots), 200},
+		{"继续", survivalPanelButtonY + 8},
+	}
+	for _, line := range lines {
+		op := &text.DrawOptions{}
+		op.GeoM.Translate((float64(WindowWidth)-text.Advance(line.text, s.sunCounterFont))/2, line.y)
+		op.ColorScale.ScaleWithColor(color.White)
+		text.Draw(screen, line.text, s.sunCounterFont, op)
+	}
+}
@@ -3,8 +3,11 @@ package scenes
 import (
 	"testing"
 
+	"github.com/gonewx/pvz/pkg/components"
 	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
 	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
 	"github.com/hajimehoshi/ebiten/v2/audio"
 )
 
@@ -236,3 +239,25 @@ func TestGameSceneMaxCameraXCalculation(t *testing.T) {
 		t.Errorf("maxCameraX should be 0 when background fails to load, got %f", scene.maxCameraX)
 	}
 }
+
+// TestRemovePlantWithShovelReleasesPoolRow 测试铲除泳池第 6 行的植物后格子可以重新种植
+func TestRemovePlantWithShovelReleasesPoolRow(t *testing.T) {
+	em := ecs.NewEntityManager()
+	lawnGridSystem := systems.NewLawnGridSystem(em, config.GetLawnLayout("pool"), nil)
+	lawnGridEntityID := em.CreateEntity()
+	em.AddComponent(lawnGridEntityID, &components.LawnGridComponent{})
+	scene := &GameScene{entityManager: em, lawnGridSystem: lawnGridSystem, lawnGridEntityID: lawnGridEntityID}
+
+	plantID := em.CreateEntity()
+	em.AddComponent(plantID, &components.PlantComponent{PlantType: components.PlantPeashooter, GridCol: 8, GridRow: 5})
+	em.AddComponent(plantID, &components.PositionComponent{})
+	if err := lawnGridSystem.OccupyCell(lawnGridEntityID, 8, 5, plantID); err != nil {
+		t.Fatalf("OccupyCell failed: %v", err)
+	}
+
+	scene.removePlantWithShovel(plantID)
+
+	if lawnGridSystem.IsOccupied(lawnGridEntityID, 8, 5) {
+		t.Error("Expected the cell in row 6 released after shoveling")
+	}
+}
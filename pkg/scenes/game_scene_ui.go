@@ -8,6 +8,7 @@ import (
 	"github.com/gonewx/pvz/pkg/components"
 	"github.com/gonewx/pvz/pkg/config"
 	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/modules"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
@@ -38,8 +39,8 @@ func getSunFlashColor(timer float64, cycle float64) color.Color {
 // Story 19.5: 保龄球模式使用传送带，不显示植物选择栏
 // 滑入动画：从上向下滑入，类似传送带入场效果
 func (s *GameScene) drawSeedBank(screen *ebiten.Image) {
-	// Story 19.5: 保龄球模式（initialSun == 0）不显示植物选择栏
-	if s.gameState.CurrentLevel != nil && s.gameState.CurrentLevel.InitialSun == 0 {
+	// Story 19.5: 小游戏规则（如保龄球）隐藏植物选择栏
+	if s.miniGameHUD().HideSeedBank {
 		return
 	}
 
@@ -74,8 +75,8 @@ func (s *GameScene) drawSeedBank(screen *ebiten.Image) {
 // Story 19.10: 保龄球关卡（initialSun == 0）不显示阳光槽
 // 滑入动画：与植物选择栏同步滑入
 func (s *GameScene) drawSunCounter(screen *ebiten.Image) {
-	// Story 19.10: 保龄球关卡（initialSun == 0）不显示阳光槽
-	if s.gameState.CurrentLevel != nil && s.gameState.CurrentLevel.InitialSun == 0 {
+	// Story 19.10: 小游戏规则（如保龄球）隐藏阳光槽
+	if s.miniGameHUD().HideSunCounter {
 		return
 	}
 
@@ -141,6 +142,11 @@ func (s *GameScene) drawSunCounter(screen *ebiten.Image) {
 // 铲子位置紧挨选择栏右侧，与选择栏上对齐
 // 滑入动画：与植物选择栏同步滑入（非保龄球模式）
 func (s *GameScene) drawShovel(screen *ebiten.Image) {
+	// 小游戏规则禁用铲子时不显示
+	if !s.allowsMiniGameInput(modules.MiniGameInputShovel) {
+		return
+	}
+
 	// 教学关卡不显示铲子（玩家还不需要学习移除植物）
 	// 但是：铲子教学关卡（Level 1-5，有预设植物）需要显示铲子
 	if s.gameState.CurrentLevel != nil && s.gameState.CurrentLevel.OpeningType == "tutorial" {
@@ -161,16 +167,9 @@ func (s *GameScene) drawShovel(screen *ebiten.Image) {
 	// 计算铲子位置
 	var shovelX float64
 	var shovelY float64
-	// Story 19.5: 保龄球模式（initialSun == 0）使用相对于菜单按钮的位置
-	// Story 19.x QA: 铲子位置相对于菜单按钮偏左 10px
-	if s.gameState.CurrentLevel != nil && s.gameState.CurrentLevel.InitialSun == 0 {
-		// 菜单按钮 X 位置
-		menuButtonX := float64(WindowWidth) - config.MenuButtonOffsetFromRight
-		// 铲子右边缘到菜单按钮左边缘的距离为 BowlingShovelGapFromMenuButton
-		// 铲子 X = 菜单按钮 X - 间距 - 铲子宽度
-		shovelX = menuButtonX - float64(config.BowlingShovelGapFromMenuButton) - float64(config.ShovelWidth)
-		// 保龄球模式使用固定 Y 位置
-		shovelY = float64(config.BowlingShovelY)
+	// 小游戏规则（如保龄球）固定的位置
+	if s.shovelSlotFixed {
+		shovelX, shovelY = s.shovelSlotX, s.shovelSlotY
 	} else if s.seedBank != nil {
 		// 普通模式根据选择栏图片宽度动态计算
 		seedBankWidth := float64(s.seedBank.Bounds().Dx())
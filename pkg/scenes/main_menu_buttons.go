@@ -1,7 +1,6 @@
 package scenes
 
 import (
-	"fmt"
 	"log"
 
 	"github.com/gonewx/pvz/pkg/components"
@@ -438,22 +437,24 @@ func (m *MainMenuScene) onMenuButtonClicked(buttonType config.MenuButtonType) {
 	}
 
 	// Route to appropriate handler based on button type
+	//
+	// buttonType 来自 config.MenuButtonHitboxes，按墓碑上实际绘制的文字划分，而不是轨道名称。
+	// SelectorScreen.reanim 的轨道名称与墓碑文字错开了一位：
+	//   - SelectorScreen_Survival_button   → 玩玩小游戏（MenuButtonChallenges）
+	//   - SelectorScreen_Challenges_button → 解谜模式（MenuButtonVasebreaker）
+	//   - SelectorScreen_ZenGarden_button  → 生存模式（MenuButtonSurvival）
 	switch buttonType {
 	case config.MenuButtonAdventure:
-		// 检查下一关是否超出已实现的关卡范围（1-5）
+		// 通关已实现的白天关卡（1-1 ~ 1-5）后进入僵王博士最终关
 		saveManager := game.GetGameState().GetSaveManager()
-		nextLevel := saveManager.GetNextLevelToPlay()
+		nextLevel := config.ResolveAdventureLevel(saveManager.GetNextLevelToPlay())
 		log.Printf("[MainMenuScene] Adventure button clicked - next level: %s", nextLevel)
 
-		// 解析关卡ID，检查是否超过 1-5
-		var chapter, level int
-		if _, err := fmt.Sscanf(nextLevel, "%d-%d", &chapter, &level); err == nil {
-			if chapter == 1 && level > 5 {
-				// 关卡超出已实现范围，弹出"敬请期待"对话框
-				log.Printf("[MainMenuScene] Level %s not implemented yet, showing coming soon dialog", nextLevel)
-				m.showComingSoonDialog()
-				return
-			}
+		// 已击败僵王博士，后续关卡超出已实现范围，弹出"敬请期待"对话框
+		if saveManager.GetHighestLevel() == config.BossLevelID {
+			log.Printf("[MainMenuScene] Final level %s already completed, showing coming soon dialog", nextLevel)
+			m.showComingSoonDialog()
+			return
 		}
 
 		// Story 12.6: Trigger zombie hand animation before starting adventure
@@ -461,16 +462,25 @@ func (m *MainMenuScene) onMenuButtonClicked(buttonType config.MenuButtonType) {
 		m.triggerZombieHandAnimation()
 
 	case config.MenuButtonChallenges:
-		// TODO: Implement challenges/mini-games mode
-		log.Printf("[MainMenuScene] Challenges mode - Not yet implemented")
+		// 玩玩小游戏：写着"玩玩小游戏"的墓碑是 SelectorScreen_Survival_button 轨道，
+		// SelectorScreen_Challenges_button 轨道画的是解谜模式
+		// 列出已注册的小游戏规则
+		log.Printf("[MainMenuScene] Opening mini-games menu")
+		m.sceneManager.SwitchTo(NewMiniGameSelectScene(m.resourceManager, m.sceneManager))
 
 	case config.MenuButtonVasebreaker:
-		// TODO: Implement vasebreaker/puzzle mode
-		log.Printf("[MainMenuScene] Vasebreaker mode - Not yet implemented")
+		// 解谜模式：砸罐子，罐子的摆放和内容表由关卡配置定义
+		log.Printf("[MainMenuScene] Starting vasebreaker: %s", config.VasebreakerLevelID)
+		gameScene := NewGameScene(m.resourceManager, m.sceneManager, config.VasebreakerLevelID)
+		m.sceneManager.SwitchTo(gameScene)
 
 	case config.MenuButtonSurvival:
-		// TODO: Implement survival mode
-		log.Printf("[MainMenuScene] Survival mode - Not yet implemented")
+		// 生存模式：写着"生存模式"的墓碑是 SelectorScreen_ZenGarden_button 轨道
+		// 波次由 SurvivalWaveGenerator 按旗帜组生成
+		// 战斗存档只有一个槽位，关卡不匹配的存档会在 GameScene 中被丢弃
+		log.Printf("[MainMenuScene] Starting survival mode: %s", config.SurvivalLevelID)
+		gameScene := NewGameScene(m.resourceManager, m.sceneManager, config.SurvivalLevelID)
+		m.sceneManager.SwitchTo(gameScene)
 
 	default:
 		log.Printf("[MainMenuScene] Warning: Unknown button type: %v", buttonType)
@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 
+	"github.com/gonewx/pvz/pkg/config"
 	"github.com/gonewx/pvz/pkg/entities"
 	"github.com/gonewx/pvz/pkg/game"
 )
@@ -93,7 +94,7 @@ func (m *MainMenuScene) showErrorDialog(title, message string) {
 }
 
 // showComingSoonDialog 显示"敬请期待"对话框
-// 当玩家通关 1-5 后点击冒险模式按钮时显示此对话框
+// 当玩家击败僵王博士（通关最终关）后点击冒险模式按钮时显示此对话框
 // 按钮功能：
 //   - 确定：将当前关卡重置为 1-1，重新加载主菜单界面
 //   - 取消：关闭对话框
@@ -207,7 +208,8 @@ func (m *MainMenuScene) onStartAdventureClicked() {
 	levelToLoad := ""
 	currentUser := saveManager.GetCurrentUser()
 	if currentUser != "" && saveManager.HasBattleSave(currentUser) {
-		if battleInfo, err := saveManager.GetBattleSaveInfo(currentUser); err == nil && battleInfo != nil {
+		// 生存模式的存档只能从生存模式按钮继续
+		if battleInfo, err := saveManager.GetBattleSaveInfo(currentUser); err == nil && battleInfo != nil && battleInfo.LevelID != config.SurvivalLevelID {
 			levelToLoad = battleInfo.LevelID
 			log.Printf("[MainMenu] Found battle save for level %s, using it", levelToLoad)
 		}
@@ -215,7 +217,7 @@ func (m *MainMenuScene) onStartAdventureClicked() {
 
 	// 如果没有战斗存档，使用 GetNextLevelToPlay
 	if levelToLoad == "" {
-		levelToLoad = saveManager.GetNextLevelToPlay()
+		levelToLoad = config.ResolveAdventureLevel(saveManager.GetNextLevelToPlay())
 		log.Printf("[MainMenu] No battle save, loading next level: %s (highest completed: %s)",
 			levelToLoad, saveManager.GetHighestLevel())
 	}
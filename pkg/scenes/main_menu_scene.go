@@ -189,7 +189,8 @@ func NewMainMenuScene(rm *game.ResourceManager, sm *game.SceneManager) *MainMenu
 			scene.currentLevel = ""
 			currentUser := saveManager.GetCurrentUser()
 			if currentUser != "" && saveManager.HasBattleSave(currentUser) {
-				if battleInfo, err := saveManager.GetBattleSaveInfo(currentUser); err == nil && battleInfo != nil {
+				// 生存模式的存档不代表冒险模式进度
+				if battleInfo, err := saveManager.GetBattleSaveInfo(currentUser); err == nil && battleInfo != nil && battleInfo.LevelID != config.SurvivalLevelID {
 					scene.currentLevel = battleInfo.LevelID
 					log.Printf("[MainMenuScene] Found battle save for level %s, using it for display", scene.currentLevel)
 				}
@@ -197,7 +198,7 @@ func NewMainMenuScene(rm *game.ResourceManager, sm *game.SceneManager) *MainMenu
 
 			// 如果没有战斗存档，使用 GetNextLevelToPlay
 			if scene.currentLevel == "" {
-				scene.currentLevel = saveManager.GetNextLevelToPlay()
+				scene.currentLevel = config.ResolveAdventureLevel(saveManager.GetNextLevelToPlay())
 				log.Printf("[MainMenuScene] No battle save, using next level: %s (highest completed: %s)",
 					scene.currentLevel, saveManager.GetHighestLevel())
 			}
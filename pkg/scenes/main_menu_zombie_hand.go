@@ -4,6 +4,7 @@ import (
 	"log"
 
 	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
 	"github.com/gonewx/pvz/pkg/ecs"
 	"github.com/gonewx/pvz/pkg/game"
 )
@@ -129,7 +130,8 @@ func (m *MainMenuScene) checkZombieHandAnimationFinished() {
 	levelToLoad := ""
 	currentUser := saveManager.GetCurrentUser()
 	if currentUser != "" && saveManager.HasBattleSave(currentUser) {
-		if battleInfo, err := saveManager.GetBattleSaveInfo(currentUser); err == nil && battleInfo != nil {
+		// 生存模式的存档只能从生存模式按钮继续
+		if battleInfo, err := saveManager.GetBattleSaveInfo(currentUser); err == nil && battleInfo != nil && battleInfo.LevelID != config.SurvivalLevelID {
 			levelToLoad = battleInfo.LevelID
 			log.Printf("[MainMenu] Found battle save for level %s, using it", levelToLoad)
 		}
@@ -137,7 +139,7 @@ func (m *MainMenuScene) checkZombieHandAnimationFinished() {
 
 	// 如果没有战斗存档，使用 GetNextLevelToPlay
 	if levelToLoad == "" {
-		levelToLoad = saveManager.GetNextLevelToPlay()
+		levelToLoad = config.ResolveAdventureLevel(saveManager.GetNextLevelToPlay())
 		log.Printf("[MainMenu] No battle save, loading next level: %s (highest completed: %s)",
 			levelToLoad, saveManager.GetHighestLevel())
 	}
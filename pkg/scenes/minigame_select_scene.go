@@ -0,0 +1,182 @@
+package scenes
+
+import (
+	"image/color"
+	"log"
+
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/modules"
+	"github.com/gonewx/pvz/pkg/utils"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// 小游戏选择界面布局
+const (
+	miniGameSelectColumns      = 5     // 每行窗口数
+	miniGameSelectWindowWidth  = 118.0 // 窗口宽度（Challenge_Window 尺寸）
+	miniGameSelectWindowHeight = 120.0 // 窗口高度
+	miniGameSelectSpacingX     = 150.0 // 窗口水平间距
+	miniGameSelectSpacingY     = 150.0 // 窗口垂直间距
+	miniGameSelectStartY       = 110.0 // 第一行窗口顶部 Y 坐标
+	miniGameSelectNameOffsetY  = 84.0  // 名称文字相对窗口顶部的 Y 偏移
+	miniGameSelectBackX        = 20.0  // 返回按钮 X 坐标
+	miniGameSelectBackY        = 550.0 // 返回按钮 Y 坐标
+	miniGameSelectBackWidth    = 140.0 // 返回按钮宽度
+	miniGameSelectBackHeight   = 34.0  // 返回按钮高度
+)
+
+// MiniGameSelectScene 小游戏选择界面
+//
+// 列出所有已注册的小游戏规则（modules.RegisteredMiniGames），
+// 点击窗口进入对应的关卡，点击返回按钮或按 ESC 回到主菜单
+type MiniGameSelectScene struct {
+	resourceManager *game.ResourceManager
+	sceneManager    *game.SceneManager
+
+	games        []modules.MiniGameInfo
+	hoveredIndex int // 鼠标悬停的窗口索引（-1 表示没有）
+
+	background      *ebiten.Image
+	window          *ebiten.Image
+	windowHighlight *ebiten.Image
+	titleFont       *text.GoTextFace
+	nameFont        *text.GoTextFace
+}
+
+// NewMiniGameSelectScene 创建小游戏选择界面
+func NewMiniGameSelectScene(rm *game.ResourceManager, sm *game.SceneManager) *MiniGameSelectScene {
+	scene := &MiniGameSelectScene{
+		resourceManager: rm,
+		sceneManager:    sm,
+		games:           modules.RegisteredMiniGames(),
+		hoveredIndex:    -1,
+	}
+
+	if err := rm.LoadResourceGroup("DelayLoad_ChallengeScreen"); err != nil {
+		log.Printf("[MiniGameSelectScene] Warning: Failed to load challenge screen resources: %v", err)
+	}
+	if img, err := rm.LoadImageByID("IMAGE_CHALLENGE_BACKGROUND"); err == nil {
+		scene.background = img
+	}
+	if img, err := rm.LoadImageByID("IMAGE_CHALLENGE_WINDOW"); err == nil {
+		scene.window = img
+	}
+	if img, err := rm.LoadImageByID("IMAGE_CHALLENGE_WINDOW_HIGHLIGHT"); err == nil {
+		scene.windowHighlight = img
+	}
+	if font, err := rm.LoadFont("assets/fonts/SimHei.ttf", 28); err == nil {
+		scene.titleFont = font
+	}
+	if font, err := rm.LoadFont("assets/fonts/SimHei.ttf", 16); err == nil {
+		scene.nameFont = font
+	}
+
+	log.Printf("[MiniGameSelectScene] Created with %d mini-games", len(scene.games))
+	return scene
+}
+
+// Update 处理悬停、窗口点击和返回
+func (s *MiniGameSelectScene) Update(deltaTime float64) {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		s.backToMainMenu()
+		return
+	}
+
+	mouseX, mouseY := utils.GetPointerPosition()
+	s.hoveredIndex = s.windowAt(float64(mouseX), float64(mouseY))
+
+	pressed, x, y := utils.IsPointerJustPressed()
+	if !pressed {
+		return
+	}
+
+	if index := s.windowAt(float64(x), float64(y)); index >= 0 {
+		s.startMiniGame(s.games[index])
+		return
+	}
+
+	if float64(x) >= miniGameSelectBackX && float64(x) <= miniGameSelectBackX+miniGameSelectBackWidth &&
+		float64(y) >= miniGameSelectBackY && float64(y) <= miniGameSelectBackY+miniGameSelectBackHeight {
+		s.backToMainMenu()
+	}
+}
+
+// Draw 绘制背景、小游戏窗口和返回按钮
+func (s *MiniGameSelectScene) Draw(screen *ebiten.Image) {
+	if s.background != nil {
+		screen.DrawImage(s.background, &ebiten.DrawImageOptions{})
+	} else {
+		screen.Fill(color.RGBA{R: 30, G: 60, B: 30, A: 255})
+	}
+
+	s.drawCenteredText(screen, "玩玩小游戏", s.titleFont, float64(WindowWidth)/2, 40, color.RGBA{R: 255, G: 220, B: 80, A: 255})
+
+	for i, info := range s.games {
+		x, y := s.windowPosition(i)
+		window := s.window
+		if i == s.hoveredIndex && s.windowHighlight != nil {
+			window = s.windowHighlight
+		}
+		if window != nil {
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Translate(x, y)
+			screen.DrawImage(window, op)
+		} else {
+			ebitenutil.DrawRect(screen, x, y, miniGameSelectWindowWidth, miniGameSelectWindowHeight, color.RGBA{R: 90, G: 70, B: 40, A: 255})
+		}
+		s.drawCenteredText(screen, info.Name, s.nameFont, x+miniGameSelectWindowWidth/2, y+miniGameSelectNameOffsetY, color.RGBA{R: 40, G: 30, B: 10, A: 255})
+	}
+
+	ebitenutil.DrawRect(screen, miniGameSelectBackX, miniGameSelectBackY, miniGameSelectBackWidth, miniGameSelectBackHeight, color.RGBA{R: 60, G: 60, B: 60, A: 220})
+	s.drawCenteredText(screen, "返回主菜单", s.nameFont, miniGameSelectBackX+miniGameSelectBackWidth/2, miniGameSelectBackY+8, color.White)
+}
+
+// windowPosition 返回第 index 个窗口左上角的屏幕坐标（每行 miniGameSelectColumns 个，水平居中）
+func (s *MiniGameSelectScene) windowPosition(index int) (float64, float64) {
+	rowWidth := float64(miniGameSelectColumns-1)*miniGameSelectSpacingX + miniGameSelectWindowWidth
+	startX := (float64(WindowWidth) - rowWidth) / 2
+	col := index % miniGameSelectColumns
+	row := index / miniGameSelectColumns
+	return startX + float64(col)*miniGameSelectSpacingX, miniGameSelectStartY + float64(row)*miniGameSelectSpacingY
+}
+
+// windowAt 返回坐标所在的窗口索引，不在任何窗口上时返回 -1
+func (s *MiniGameSelectScene) windowAt(x, y float64) int {
+	for i := range s.games {
+		wx, wy := s.windowPosition(i)
+		if x >= wx && x <= wx+miniGameSelectWindowWidth && y >= wy && y <= wy+miniGameSelectWindowHeight {
+			return i
+		}
+	}
+	return -1
+}
+
+// startMiniGame 进入小游戏关卡
+// 战斗存档只有一个槽位，关卡不匹配的存档会在 GameScene 中被丢弃
+func (s *MiniGameSelectScene) startMiniGame(info modules.MiniGameInfo) {
+	log.Printf("[MiniGameSelectScene] Starting mini-game %s (level %s)", info.ID, info.LevelID)
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_TAP")
+	}
+	s.sceneManager.SwitchTo(NewGameScene(s.resourceManager, s.sceneManager, info.LevelID))
+}
+
+// backToMainMenu 返回主菜单
+func (s *MiniGameSelectScene) backToMainMenu() {
+	log.Printf("[MiniGameSelectScene] Back to main menu")
+	s.sceneManager.SwitchTo(NewMainMenuScene(s.resourceManager, s.sceneManager))
+}
+
+// drawCenteredText 以 centerX 为中心绘制一行文字
+func (s *MiniGameSelectScene) drawCenteredText(screen *ebiten.Image, str string, face *text.GoTextFace, centerX, y float64, clr color.Color) {
+	if face == nil {
+		return
+	}
+	op := &text.DrawOptions{}
+	op.GeoM.Translate(centerX-text.Advance(str, face)/2, y)
+	op.ColorScale.ScaleWithColor(clr)
+	text.Draw(screen, str, face, op)
+}
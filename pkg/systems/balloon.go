@@ -0,0 +1,26 @@
+package systems
+
+import (
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/ecs"
+)
+
+// PopZombieBalloon 击破气球僵尸的气球（如被对空子弹击中）
+//
+// 僵尸立即落回地面高度（之后可被普通子弹击中），
+// 坠落动画和恢复行走由 BehaviorSystem 处理
+//
+// 返回:
+//   - bool: 是否击破了气球（僵尸没有正在飞行的气球时返回 false）
+func PopZombieBalloon(em *ecs.EntityManager, zombieID ecs.EntityID) bool {
+	balloon, ok := ecs.GetComponent[*components.BalloonComponent](em, zombieID)
+	if !ok || !balloon.IsFlying() {
+		return false
+	}
+	balloon.State = components.BalloonStatePopped
+
+	if collision, ok := ecs.GetComponent[*components.CollisionComponent](em, zombieID); ok {
+		collision.Altitude = 0
+	}
+	return true
+}
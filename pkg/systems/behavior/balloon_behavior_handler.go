@@ -0,0 +1,84 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// updateBalloonZombies 更新所有气球僵尸的气球状态
+//
+// 气球被击破（见 systems.PopZombieBalloon）后，僵尸原地播放坠落动画，
+// 落地后移除气球组件，之后按普通僵尸行走和啃食
+func (s *BehaviorSystem) updateBalloonZombies() {
+	for _, entityID := range ecs.GetEntitiesWith1[*components.BalloonComponent](s.entityManager) {
+		balloon, _ := ecs.GetComponent[*components.BalloonComponent](s.entityManager, entityID)
+		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+		if !ok {
+			continue
+		}
+
+		// 在空中被炸死等情况：直接落地，播放普通死亡动画
+		if behavior.Type != components.BehaviorZombieBasic && behavior.Type != components.BehaviorZombieEating {
+			if s.isZombieBehaviorType(behavior.Type) {
+				s.landBalloonZombie(entityID)
+			}
+			continue
+		}
+
+		switch balloon.State {
+		case components.BalloonStatePopped:
+			s.startBalloonFall(entityID, balloon, behavior)
+		case components.BalloonStateFalling:
+			reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID)
+			if !ok || reanim.IsFinished {
+				s.landBalloonZombie(entityID)
+				s.stopEatingAndResume(entityID)
+			}
+		}
+	}
+}
+
+// startBalloonFall 气球被击破：僵尸原地播放坠落动画
+func (s *BehaviorSystem) startBalloonFall(entityID ecs.EntityID, balloon *components.BalloonComponent, behavior *components.BehaviorComponent) {
+	log.Printf("[BehaviorSystem] 气球僵尸 %d 的气球被击破，开始坠落", entityID)
+	balloon.State = components.BalloonStateFalling
+
+	if velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, entityID); ok {
+		velocity.VX = 0
+	}
+
+	behavior.ZombieAnimState = components.ZombieAnimIdle
+	s.resetZombieRootMotion(entityID)
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    behavior.UnitID,
+		ComboName: "pop",
+		Processed: false,
+	})
+
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_BALLOON_POP")
+	}
+}
+
+// landBalloonZombie 气球僵尸落地：回到地面高度并移除气球组件
+func (s *BehaviorSystem) landBalloonZombie(entityID ecs.EntityID) {
+	if collision, ok := ecs.GetComponent[*components.CollisionComponent](s.entityManager, entityID); ok {
+		collision.Altitude = 0
+	}
+	ecs.RemoveComponent[*components.BalloonComponent](s.entityManager, entityID)
+}
+
+// isBalloonFalling 气球僵尸是否正在坠落（原地不动，不检测碰撞）
+func (s *BehaviorSystem) isBalloonFalling(entityID ecs.EntityID) bool {
+	balloon, ok := ecs.GetComponent[*components.BalloonComponent](s.entityManager, entityID)
+	return ok && !balloon.IsFlying()
+}
+
+// isAirborneZombie 僵尸是否在空中（地面植物无法啃食、普通子弹无法击中）
+func (s *BehaviorSystem) isAirborneZombie(entityID ecs.EntityID) bool {
+	collision, ok := ecs.GetComponent[*components.CollisionComponent](s.entityManager, entityID)
+	return ok && collision.IsAirborne()
+}
@@ -0,0 +1,86 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// createTestBalloonZombie 创建测试用的飞行中的气球僵尸
+func createTestBalloonZombie(em *ecs.EntityManager, x, y float64) ecs.EntityID {
+	id := createTestWalkingZombie(em, x, y)
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, id)
+	behavior.UnitID = types.UnitIDZombieBalloon
+	velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, id)
+	velocity.VX = config.BalloonFlySpeed
+	collision, _ := ecs.GetComponent[*components.CollisionComponent](em, id)
+	collision.Altitude = config.BalloonAltitude
+	ecs.AddComponent(em, id, &components.BalloonComponent{State: components.BalloonStateFlying})
+	return id
+}
+
+// TestFlyingBalloonPassesOverPlants 测试飞行中的气球僵尸从植物上方飞过而不啃食
+func TestFlyingBalloonPassesOverPlants(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	createTestGridPlant(em, components.PlantPeashooter, 5, 2)
+	balloonID := createTestBalloonZombie(em, config.GridWorldStartX+5*config.CellWidth+config.CellWidth/2, zombieYForRow(2))
+	position, _ := ecs.GetComponent[*components.PositionComponent](em, balloonID)
+	startX := position.X
+
+	bs.handleZombieBasicBehavior(balloonID, 0.1)
+
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, balloonID)
+	if behavior.Type != components.BehaviorZombieBasic {
+		t.Fatalf("飞行中的气球僵尸不应啃食植物，实际行为 %v", behavior.Type)
+	}
+	if position.X >= startX {
+		t.Errorf("气球僵尸应继续向左飞行，X 从 %.1f 变为 %.1f", startX, position.X)
+	}
+}
+
+// TestPoppedBalloonFallsThenWalks 测试气球被击破后原地坠落，坠落动画结束后按普通僵尸行走
+func TestPoppedBalloonFallsThenWalks(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	balloonID := createTestBalloonZombie(em, 500, zombieYForRow(2))
+	balloon, _ := ecs.GetComponent[*components.BalloonComponent](em, balloonID)
+	balloon.State = components.BalloonStatePopped
+
+	bs.updateBalloonZombies()
+
+	if balloon.State != components.BalloonStateFalling {
+		t.Fatalf("气球被击破后应开始坠落，实际 %v", balloon.State)
+	}
+	if cmd, _ := ecs.GetComponent[*components.AnimationCommandComponent](em, balloonID); cmd == nil || cmd.ComboName != "pop" {
+		t.Errorf("期望播放 pop 动画，实际 %+v", cmd)
+	}
+	position, _ := ecs.GetComponent[*components.PositionComponent](em, balloonID)
+	startX := position.X
+	bs.handleZombieBasicBehavior(balloonID, 0.1)
+	if position.X != startX {
+		t.Errorf("坠落期间应原地不动，X 从 %.1f 变为 %.1f", startX, position.X)
+	}
+
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, balloonID)
+	reanim.IsFinished = true
+	bs.updateBalloonZombies()
+
+	if _, ok := ecs.GetComponent[*components.BalloonComponent](em, balloonID); ok {
+		t.Error("落地后应移除气球组件")
+	}
+	if velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, balloonID); velocity.VX != config.ZombieWalkSpeed {
+		t.Errorf("落地后应以普通速度行走，实际 %.1f", velocity.VX)
+	}
+	if cmd, _ := ecs.GetComponent[*components.AnimationCommandComponent](em, balloonID); cmd == nil || (cmd.ComboName != "walk" && cmd.ComboName != "walk2") {
+		t.Errorf("落地后应播放行走动画，实际 %+v", cmd)
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"log"
 
 	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
 	"github.com/gonewx/pvz/pkg/ecs"
 	"github.com/gonewx/pvz/pkg/game"
 	"github.com/gonewx/pvz/pkg/systems"
+	"github.com/hajimehoshi/ebiten/v2/audio"
 )
 
 // BehaviorSystem 处理实体的行为逻辑
@@ -14,10 +16,13 @@ import (
 type BehaviorSystem struct {
 	entityManager    *ecs.EntityManager
 	resourceManager  *game.ResourceManager
-	gameState        *game.GameState         // 用于僵尸死亡计数
-	logFrameCounter  int                     // 日志输出计数器（避免全局变量）
-	lawnGridSystem   *systems.LawnGridSystem // 用于植物死亡时释放网格占用
-	lawnGridEntityID ecs.EntityID            // 草坪网格实体ID
+	gameState        *game.GameState          // 用于僵尸死亡计数
+	logFrameCounter  int                      // 日志输出计数器（避免全局变量）
+	lawnGridSystem   *systems.LawnGridSystem  // 用于植物死亡时释放网格占用
+	lawnGridEntityID ecs.EntityID             // 草坪网格实体ID
+	lawnLayout       config.LawnLayout        // 当前场景的草坪行布局（来自 LawnGridSystem）
+	spawnRules       *config.SpawnRulesConfig // 生成规则（召唤僵尸时检查场景限制，nil 表示不检查）
+	jackMusicPlayer  *audio.Player            // 正在播放的八音盒音乐（小丑僵尸行走时循环播放）
 }
 
 // 日志输出间隔常量
@@ -28,7 +33,7 @@ const LogOutputFrameInterval = 100 // 日志输出间隔（每N帧输出一次
 //   - em: EntityManager 实例
 //   - rm: ResourceManager 实例
 //   - gs: GameState 实例 (用于僵尸死亡计数)
-//   - lgs: LawnGridSystem 实例 (用于植物死亡时释放网格占用)
+//   - lgs: LawnGridSystem 实例 (用于植物死亡时释放网格占用，并提供当前场景的草坪行布局)
 //   - lawnGridID: 草坪网格实体ID
 func NewBehaviorSystem(em *ecs.EntityManager, rm *game.ResourceManager, gs *game.GameState, lgs *systems.LawnGridSystem, lawnGridID ecs.EntityID) *BehaviorSystem {
 	log.Printf("[BehaviorSystem] NewBehaviorSystem: lawnGridSystem=%v, lawnGridEntityID=%d", lgs != nil, lawnGridID)
@@ -38,15 +43,23 @@ func NewBehaviorSystem(em *ecs.EntityManager, rm *game.ResourceManager, gs *game
 		gameState:        gs,
 		lawnGridSystem:   lgs,
 		lawnGridEntityID: lawnGridID,
+		lawnLayout:       lgs.Layout(),
 	}
 }
 
+// SetSpawnRules 设置生成规则配置
+// 舞王召唤伴舞等关卡配置之外的僵尸时，使用该配置检查场景类型限制
+func (s *BehaviorSystem) SetSpawnRules(rules *config.SpawnRulesConfig) {
+	s.spawnRules = rules
+}
+
 // Update 更新所有拥有行为组件的实体
 func (s *BehaviorSystem) Update(deltaTime float64) {
 	// 检查游戏是否胜利（奖励动画阶段）
 	// 胜利后植物应停止所有行为（包括向日葵生产阳光）
 	if s.gameState != nil && s.gameState.IsGameOver && s.gameState.GameResult == "win" {
 		// 游戏胜利时，不再更新任何行为
+		s.stopJackMusic()
 		return
 	}
 
@@ -70,6 +83,7 @@ func (s *BehaviorSystem) Update(deltaTime float64) {
 				s.updateTriggerZombieMovement(triggerZombieID, deltaTime)
 			}
 		}
+		s.stopJackMusic()
 		return
 	}
 
@@ -91,10 +105,31 @@ func (s *BehaviorSystem) Update(deltaTime float64) {
 	dyingZombieEntityList := s.queryDyingZombies()
 
 	// 合并所有活动僵尸列表（移动中 + 啃食中），用于豌豆射手检测目标
-	allZombieEntityList := append([]ecs.EntityID{}, zombieEntityList...)
-	allZombieEntityList = append(allZombieEntityList, eatingZombieEntityList...)
+	// 被魅惑的僵尸属于植物阵营，不作为植物的攻击目标
+	allZombieEntityList := make([]ecs.EntityID, 0, len(zombieEntityList)+len(eatingZombieEntityList))
+	for _, list := range [][]ecs.EntityID{zombieEntityList, eatingZombieEntityList} {
+		for _, entityID := range list {
+			// 地下的僵尸（矿工僵尸）、潜在水下的僵尸（潜水僵尸）无法被任何植物攻击
+			if !s.isCharmedZombie(entityID) && !s.isUndergroundZombie(entityID) && !s.isSubmergedZombie(entityID) {
+				allZombieEntityList = append(allZombieEntityList, entityID)
+			}
+		}
+	}
+
+	// 僵王博士低头露出弱点时可以被植物攻击
+	if bossID, _, ok := systems.GetZomboss(s.entityManager); ok && systems.IsZombossHeadExposed(s.entityManager, bossID) {
+		allZombieEntityList = append(allZombieEntityList, bossID)
+	}
+
+	// 地面僵尸列表：空中的僵尸（气球僵尸）只能被对空植物（香蒲）攻击
+	groundZombieEntityList := make([]ecs.EntityID, 0, len(allZombieEntityList))
+	for _, entityID := range allZombieEntityList {
+		if !s.isAirborneZombie(entityID) {
+			groundZombieEntityList = append(groundZombieEntityList, entityID)
+		}
+	}
 
-	// 查询所有豌豆子弹实体
+	// 查询所有直线/追踪子弹实体（豌豆、星星、尖刺）
 	projectileEntityList := s.queryProjectiles()
 
 	// 日志输出（避免每帧都打印）
@@ -121,11 +156,49 @@ func (s *BehaviorSystem) Update(deltaTime float64) {
 		case components.BehaviorSunflower:
 			s.handleSunflowerBehavior(entityID, deltaTime)
 		case components.BehaviorPeashooter:
-			s.handlePeashooterBehavior(entityID, deltaTime, allZombieEntityList)
+			s.handlePeashooterBehavior(entityID, deltaTime, groundZombieEntityList)
 		case components.BehaviorWallnut:
 			s.handleWallnutBehavior(entityID, deltaTime)
 		case components.BehaviorCherryBomb:
 			s.handleCherryBombBehavior(entityID, deltaTime)
+		case components.BehaviorMagnetshroom:
+			s.handleMagnetshroomBehavior(entityID, deltaTime)
+		case components.BehaviorHypnoshroom:
+			// 催眠菇没有主动行为，被啃食时由 handleZombieEatingBehavior 处理魅惑
+		case components.BehaviorKernelpult:
+			s.handleKernelpultBehavior(entityID, deltaTime, groundZombieEntityList)
+		case components.BehaviorCobCannon:
+			s.handleCobCannonBehavior(entityID, deltaTime)
+		case components.BehaviorSplitPea:
+			s.handleSplitPeaBehavior(entityID, deltaTime, groundZombieEntityList)
+		case components.BehaviorStarfruit:
+			s.handleStarfruitBehavior(entityID, deltaTime, groundZombieEntityList)
+		case components.BehaviorCattail:
+			s.handleCattailBehavior(entityID, deltaTime, allZombieEntityList)
+		case components.BehaviorGraveBuster:
+			s.handleGraveBusterBehavior(entityID, deltaTime)
+		case components.BehaviorLilyPad:
+			// 睡莲没有主动行为，只为陆生植物提供种植平台
+		case components.BehaviorTangleKelp:
+			s.handleTangleKelpBehavior(entityID, deltaTime, zombieEntityList)
+		case components.BehaviorSeaShroom:
+			s.handleSeaShroomBehavior(entityID, deltaTime, groundZombieEntityList)
+		case components.BehaviorPlantern:
+			// 植物灯笼没有主动行为，照明范围由 FogSystem 计算
+		case components.BehaviorBlover:
+			s.handleBloverBehavior(entityID, deltaTime)
+		case components.BehaviorFlowerPot:
+			// 花盆没有主动行为，只为屋顶上的植物提供种植平台
+		case components.BehaviorJalapeno:
+			s.handleJalapenoBehavior(entityID, deltaTime)
+		case components.BehaviorIceShroom:
+			s.handleIceShroomBehavior(entityID, deltaTime)
+		case components.BehaviorSpikeweed:
+			s.handleSpikeweedBehavior(entityID, deltaTime, groundZombieEntityList)
+		case components.BehaviorChomper:
+			s.handleChomperBehavior(entityID, deltaTime, groundZombieEntityList)
+		case components.BehaviorUmbrellaLeaf:
+			s.handleUmbrellaLeafBehavior(entityID)
 		default:
 			// 未知行为类型，记录警告
 			if s.logFrameCounter%LogOutputFrameInterval == 1 {
@@ -145,8 +218,53 @@ func (s *BehaviorSystem) Update(deltaTime float64) {
 	// 更新坚果墙被啃食发光效果（渐变衰减）
 	s.updateWallnutHitGlowEffects(deltaTime)
 
+	// 更新舞团（舞王僵尸召唤伴舞、统一舞步），需在僵尸移动之前设置好舞者速度
+	s.updateDanceGroups(deltaTime)
+
+	// 更新小丑僵尸（引信、弹出、爆炸和八音盒音乐），需在僵尸移动之前停下打开盒子的小丑
+	s.updateJackZombies(deltaTime)
+
+	// 更新气球僵尸（气球被击破后坠落、落地），需在僵尸移动之前停下坠落中的僵尸
+	s.updateBalloonZombies()
+
+	// 更新矿工僵尸（挖掘、出土、头晕），需在僵尸移动之前停下出土中的矿工僵尸
+	s.updateDiggerZombies(deltaTime)
+
+	// 更新巨人僵尸（砸击、扔小鬼）和被扔出的小鬼（飞行、落地），需在僵尸移动之前停下
+	s.updateGargantuars()
+	s.updateThrownImps(deltaTime)
+
+	// 更新蹦极僵尸（瞄准、下落、抓取植物、上升），蹦极僵尸不走路
+	s.updateBungeeZombies(deltaTime)
+
+	// 更新扶梯僵尸架梯、僵尸翻越梯子，以及植物消失后残留的梯子
+	s.updateLadders(deltaTime)
+
+	// 更新投篮车僵尸（停车、投篮、篮球用完后继续前进），需在僵尸移动之前停下投篮中的投篮车
+	s.updateCatapults(deltaTime)
+
+	// 雪橇车铺设冰道；雪橇队僵尸在冰道上滑行、冰道到头时跳下雪橇
+	s.updateZombonis()
+	s.updateBobsleds(deltaTime)
+
+	// 僵王博士的攻击循环；火球和冰球向左滚动碾碎植物
+	s.updateZomboss(deltaTime)
+	s.updateZombossBalls(deltaTime)
+
+	// 从墓碑下爬出的僵尸逐渐升到地面，爬出前原地不动
+	s.updateRisingZombies(deltaTime)
+
+	// 水路僵尸到达水池边缘时跳入水中，入水期间原地不动
+	s.updateSwimmingZombies()
+
+	// 被寒冰菇冻结的僵尸解冻计时，冻结期间不移动、不啃食
+	s.updateFrozenZombies(deltaTime)
+
 	// 遍历所有移动中的僵尸实体，根据行为类型分发处理
 	for _, entityID := range zombieEntityList {
+		if s.isFrozenZombie(entityID) {
+			continue
+		}
 		behaviorComp, _ := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
 
 		// 根据行为类型分发
@@ -166,6 +284,9 @@ func (s *BehaviorSystem) Update(deltaTime float64) {
 
 	// 遍历所有啃食中的僵尸实体
 	for _, entityID := range eatingZombieEntityList {
+		if s.isFrozenZombie(entityID) {
+			continue
+		}
 		behaviorComp, _ := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
 
 		// 只处理啃食状态的僵尸
@@ -182,11 +303,35 @@ func (s *BehaviorSystem) Update(deltaTime float64) {
 		switch behaviorComp.Type {
 		case components.BehaviorPeaProjectile:
 			s.handlePeaProjectileBehavior(entityID, deltaTime)
+		case components.BehaviorStarProjectile:
+			s.handleStarProjectileBehavior(entityID, deltaTime)
+		case components.BehaviorCattailSpike:
+			s.handleCattailSpikeBehavior(entityID, deltaTime, allZombieEntityList)
+		case components.BehaviorPuffProjectile:
+			s.handlePuffProjectileBehavior(entityID, deltaTime)
 		default:
 			// 忽略非子弹类型（如僵尸）
 		}
 	}
 
+	// 遍历所有抛物线子弹实体（玉米粒、玉米炮弹、篮球），它们不使用速度组件
+	lobbedProjectileEntityList := ecs.GetEntitiesWith1[*components.LobbedProjectileComponent](s.entityManager)
+	for _, entityID := range lobbedProjectileEntityList {
+		behaviorComp, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+		if !ok {
+			continue
+		}
+
+		switch behaviorComp.Type {
+		case components.BehaviorKernelProjectile:
+			s.handleKernelProjectileBehavior(entityID, deltaTime)
+		case components.BehaviorCobProjectile:
+			s.handleCobProjectileBehavior(entityID, deltaTime)
+		case components.BehaviorBasketballProjectile:
+			s.handleBasketballProjectileBehavior(entityID, deltaTime)
+		}
+	}
+
 	// 遍历所有死亡中的僵尸实体（处理死亡动画完成后的删除）
 	for _, entityID := range dyingZombieEntityList {
 		behaviorComp, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
@@ -353,9 +498,9 @@ func (s *BehaviorSystem) queryExplosionDyingZombies() []ecs.EntityID {
 	return explosionDyingZombies
 }
 
-// queryProjectiles 查询所有豌豆子弹实体
+// queryProjectiles 查询所有直线/追踪子弹实体
 //
-// 返回所有 BehaviorType 为 BehaviorPeaProjectile 的实体
+// 返回所有 BehaviorType 满足 components.IsDirectProjectile 的实体
 func (s *BehaviorSystem) queryProjectiles() []ecs.EntityID {
 	// 查询所有拥有 BehaviorComponent, PositionComponent, VelocityComponent 的实体
 	// 注意：子弹和移动中的僵尸组件组合相同，需要通过 BehaviorType 区分
@@ -381,9 +526,9 @@ func (s *BehaviorSystem) queryProjectiles() []ecs.EntityID {
 
 		// DEBUG: 记录每个候选实体的行为类型
 		log.Printf("[BehaviorSystem] queryProjectiles: 实体 %d 的行为类型 = %v（是子弹: %v）",
-			entityID, behaviorComp.Type, behaviorComp.Type == components.BehaviorPeaProjectile)
+			entityID, behaviorComp.Type, components.IsDirectProjectile(behaviorComp.Type))
 
-		if behaviorComp.Type == components.BehaviorPeaProjectile {
+		if components.IsDirectProjectile(behaviorComp.Type) {
 			projectiles = append(projectiles, entityID)
 		}
 	}
@@ -17,7 +17,7 @@ func TestPlantDeathReleasesGrid(t *testing.T) {
 	em := ecs.NewEntityManager()
 	rm := game.NewResourceManager(getTestAudioContext())
 	gs := game.GetGameState()
-	lgs := systems.NewLawnGridSystem(em, []int{1, 2, 3, 4, 5})
+	lgs := systems.NewLawnGridSystem(em, config.GetLawnLayout("day"), []int{1, 2, 3, 4, 5})
 
 	// 创建草坪网格实体
 	gridID := em.CreateEntity()
@@ -89,7 +89,7 @@ func TestMultiplePlantsDeathReleasesGrid(t *testing.T) {
 	em := ecs.NewEntityManager()
 	rm := game.NewResourceManager(getTestAudioContext())
 	gs := game.GetGameState()
-	lgs := systems.NewLawnGridSystem(em, []int{1, 2, 3, 4, 5})
+	lgs := systems.NewLawnGridSystem(em, config.GetLawnLayout("day"), []int{1, 2, 3, 4, 5})
 
 	gridID := em.CreateEntity()
 	ecs.AddComponent(em, gridID, &components.LawnGridComponent{})
@@ -173,7 +173,7 @@ func TestCherryBombExplosionReleasesGrid(t *testing.T) {
 	em := ecs.NewEntityManager()
 	rm := game.NewResourceManager(getTestAudioContext())
 	gs := game.GetGameState()
-	lgs := systems.NewLawnGridSystem(em, []int{1, 2, 3, 4, 5})
+	lgs := systems.NewLawnGridSystem(em, config.GetLawnLayout("day"), []int{1, 2, 3, 4, 5})
 
 	// 创建草坪网格实体
 	gridID := em.CreateEntity()
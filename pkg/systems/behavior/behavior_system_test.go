@@ -8,6 +8,7 @@ import (
 
 	"github.com/gonewx/pvz/internal/particle"
 	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
 	"github.com/gonewx/pvz/pkg/ecs"
 	"github.com/gonewx/pvz/pkg/game"
 	"github.com/gonewx/pvz/pkg/systems"
@@ -44,8 +45,13 @@ func init() {
 // Bug Fix: 所有测试需要传入 LawnGridSystem 和 lawnGridEntityID
 // Story 14.3: Epic 14 - Removed ReanimSystem dependency
 func createTestBehaviorSystem(em *ecs.EntityManager, rm *game.ResourceManager, gs *game.GameState) *BehaviorSystem {
+	return createTestBehaviorSystemWithLayout(em, rm, gs, config.GetLawnLayout("day"))
+}
+
+// createTestBehaviorSystemWithLayout 创建使用指定草坪行布局的测试 BehaviorSystem（泳池、屋顶等场景）
+func createTestBehaviorSystemWithLayout(em *ecs.EntityManager, rm *game.ResourceManager, gs *game.GameState, layout config.LawnLayout) *BehaviorSystem {
 	// 创建测试用的 LawnGridSystem
-	lgs := systems.NewLawnGridSystem(em, []int{1, 2, 3, 4, 5})
+	lgs := systems.NewLawnGridSystem(em, layout, nil)
 
 	// 创建草坪网格实体
 	gridID := em.CreateEntity()
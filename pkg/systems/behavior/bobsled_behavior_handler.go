@@ -0,0 +1,199 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/systems"
+)
+
+// updateBobsleds 更新雪橇队僵尸的滑行和跳下雪橇
+//
+// 队长乘雪橇入场，激活时召集其余队员，全队共乘一架雪橇（见 updateBobsledSleds）；
+// 所在格子有冰道时以 BobsledSlideSpeed 滑行（草坪右侧外沿用第 9 列的冰道），
+// 冰道到头时停下播放 jump 动画，动画结束后像普通僵尸一样行走
+func (s *BehaviorSystem) updateBobsleds(deltaTime float64) {
+	for _, entityID := range ecs.GetEntitiesWith1[*components.BobsledComponent](s.entityManager) {
+		bobsled, _ := ecs.GetComponent[*components.BobsledComponent](s.entityManager, entityID)
+		if !bobsled.IsRiding() {
+			continue
+		}
+		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+		if !ok || behavior.Type != components.BehaviorZombieBasic {
+			continue
+		}
+		if waveState, ok := ecs.GetComponent[*components.ZombieWaveStateComponent](s.entityManager, entityID); ok && !waveState.IsActivated {
+			continue
+		}
+		position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+		if !ok {
+			continue
+		}
+
+		if !bobsled.TeamSummoned && bobsled.State == components.BobsledStateRiding {
+			s.summonBobsledTeam(entityID, bobsled, position)
+		}
+
+		switch bobsled.State {
+		case components.BobsledStateRiding:
+			if bobsled.Sled != 0 {
+				continue // 由雪橇带着滑行
+			}
+			if !s.isOnIce(entityID, position) {
+				log.Printf("[BehaviorSystem] 雪橇队僵尸 %d 冰道到头，跳下雪橇", entityID)
+				s.jumpOffBobsled(entityID, bobsled)
+				continue
+			}
+			position.X += config.BobsledSlideSpeed * deltaTime
+		case components.BobsledStateJumping:
+			if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok && !reanim.IsFinished {
+				continue
+			}
+			bobsled.State = components.BobsledStateWalking
+			if velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, entityID); ok {
+				velocity.VX = s.zombieWalkSpeed(entityID)
+			}
+			s.resetZombieRootMotion(entityID)
+			s.changeZombieAnimation(entityID, components.ZombieAnimWalking)
+		}
+	}
+
+	s.updateBobsledSleds(deltaTime)
+}
+
+// summonBobsledTeam 队长召集其余队员，按座位排在队长身后（右侧），全队共乘一架雪橇
+func (s *BehaviorSystem) summonBobsledTeam(leaderID ecs.EntityID, bobsled *components.BobsledComponent, position *components.PositionComponent) {
+	bobsled.TeamSummoned = true
+
+	var riders [components.BobsledTeamSize]ecs.EntityID
+	riders[0] = leaderID
+
+	row := s.zombieRowOf(position)
+	waveIndex := systems.SummonerWaveIndex(s.entityManager, s.gameState, leaderID)
+	for seat := 1; seat < components.BobsledTeamSize; seat++ {
+		x := position.X + float64(seat)*config.BobsledRiderSpacing
+		memberID, err := entities.NewBobsledZombieEntity(s.entityManager, s.resourceManager, s.lawnLayout, row, x)
+		if err != nil {
+			log.Printf("[BehaviorSystem] 警告：召集雪橇队员失败: %v", err)
+			continue
+		}
+		member, _ := ecs.GetComponent[*components.BobsledComponent](s.entityManager, memberID)
+		member.TeamSummoned = true
+		if memberPos, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, memberID); ok {
+			memberPos.Y = position.Y
+		}
+
+		// 队员不在关卡配置中，归入队长所在波次并计入胜利条件
+		systems.RegisterSummonedZombie(s.entityManager, s.gameState, memberID, waveIndex)
+		entities.ActivateZombie(s.entityManager, memberID)
+		riders[seat] = memberID
+	}
+
+	sledY := s.lawnLayout.StartY + float64(row)*s.lawnLayout.CellHeight + s.lawnLayout.CellHeight/2
+	sledID := entities.NewBobsledSledEntity(s.entityManager, riders, position.X, sledY)
+	log.Printf("[BehaviorSystem] 雪橇队队长 %d 召集队员 %v，共乘雪橇 %d", leaderID, riders[1:], sledID)
+}
+
+// updateBobsledSleds 雪橇带着全队滑行
+//
+// 队员按座位跟随雪橇；死亡或跳下的队员离开雪橇。
+// 最前方的队员所在格子没有冰道时，全队一起跳下雪橇，雪橇消失
+func (s *BehaviorSystem) updateBobsledSleds(deltaTime float64) {
+	for _, sledID := range ecs.GetEntitiesWith2[*components.BobsledSledComponent, *components.PositionComponent](s.entityManager) {
+		sled, _ := ecs.GetComponent[*components.BobsledSledComponent](s.entityManager, sledID)
+		sledPos, _ := ecs.GetComponent[*components.PositionComponent](s.entityManager, sledID)
+
+		var frontID ecs.EntityID
+		for seat, riderID := range sled.Riders {
+			if !s.isSledRider(riderID, sledID) {
+				sled.Riders[seat] = 0
+				continue
+			}
+			if frontID == 0 {
+				frontID = riderID
+			}
+		}
+		if frontID == 0 {
+			s.entityManager.DestroyEntity(sledID)
+			continue
+		}
+
+		sledPos.X += config.BobsledSlideSpeed * deltaTime
+		for seat, riderID := range sled.Riders {
+			if position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, riderID); ok {
+				position.X = sledPos.X + float64(seat)*config.BobsledRiderSpacing
+			}
+		}
+
+		frontPos, _ := ecs.GetComponent[*components.PositionComponent](s.entityManager, frontID)
+		if s.isOnIce(frontID, frontPos) {
+			continue
+		}
+		log.Printf("[BehaviorSystem] 雪橇 %d 冰道到头，全队跳下雪橇", sledID)
+		for _, riderID := range sled.Riders {
+			if bobsled, ok := ecs.GetComponent[*components.BobsledComponent](s.entityManager, riderID); ok {
+				s.jumpOffBobsled(riderID, bobsled)
+			}
+		}
+		s.entityManager.DestroyEntity(sledID)
+	}
+}
+
+// isSledRider 僵尸是否仍坐在指定雪橇上滑行
+func (s *BehaviorSystem) isSledRider(entityID, sledID ecs.EntityID) bool {
+	if entityID == 0 {
+		return false
+	}
+	bobsled, ok := ecs.GetComponent[*components.BobsledComponent](s.entityManager, entityID)
+	if !ok || bobsled.Sled != sledID || bobsled.State != components.BobsledStateRiding {
+		return false
+	}
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+	return ok && behavior.Type == components.BehaviorZombieBasic
+}
+
+// jumpOffBobsled 雪橇队僵尸离开雪橇，原地播放 jump 动画
+func (s *BehaviorSystem) jumpOffBobsled(entityID ecs.EntityID, bobsled *components.BobsledComponent) {
+	bobsled.State = components.BobsledStateJumping
+	bobsled.Sled = 0
+	s.playBobsledCombo(entityID, "jump")
+}
+
+// isOnIce 僵尸碰撞盒中心所在格子是否有冰道
+// 草坪右侧外（僵尸入场途中）以同一行最右侧格子为准
+func (s *BehaviorSystem) isOnIce(entityID ecs.EntityID, position *components.PositionComponent) bool {
+	if s.lawnGridSystem == nil {
+		return false
+	}
+	col := iceColumnAt(s.zombieCenterX(entityID, position))
+	if col >= config.GridColumns {
+		col = config.GridColumns - 1
+	}
+	return s.lawnGridSystem.HasIce(s.lawnGridEntityID, col, s.zombieRowOf(position))
+}
+
+// playBobsledCombo 原地播放雪橇队僵尸的动画
+func (s *BehaviorSystem) playBobsledCombo(entityID ecs.EntityID, comboName string) {
+	if velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, entityID); ok {
+		velocity.VX = 0
+	}
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	behavior.ZombieAnimState = components.ZombieAnimIdle
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    behavior.UnitID,
+		ComboName: comboName,
+		Processed: false,
+	})
+}
+
+// isBobsledRiding 雪橇队僵尸是否仍在雪橇上（滑行、跳下期间由 updateBobsleds 控制）
+func (s *BehaviorSystem) isBobsledRiding(entityID ecs.EntityID) bool {
+	bobsled, ok := ecs.GetComponent[*components.BobsledComponent](s.entityManager, entityID)
+	return ok && bobsled.IsRiding()
+}
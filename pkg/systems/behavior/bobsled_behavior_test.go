@@ -0,0 +1,149 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// TestBobsledRidesIceTrailThenWalks 测试独自滑行的雪橇队僵尸沿冰道滑行，冰道到头后跳下雪橇步行
+func TestBobsledRidesIceTrailThenWalks(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	for col := 4; col < config.GridColumns; col++ {
+		bs.lawnGridSystem.LayIce(bs.lawnGridEntityID, col, 2)
+	}
+
+	// 草坪右侧外沿用最右侧格子的冰道；读档恢复的队员没有雪橇，独自滑行
+	bobsledID, bobsled := createTestBobsledRider(em, config.GridWorldEndX+50, zombieYForRow(2))
+	position, _ := ecs.GetComponent[*components.PositionComponent](em, bobsledID)
+
+	startX := position.X
+	bs.updateBobsleds(1.0)
+	if bobsled.State != components.BobsledStateRiding || position.X != startX+config.BobsledSlideSpeed {
+		t.Fatalf("冰道上应滑行，实际状态 %v，X %.1f → %.1f", bobsled.State, startX, position.X)
+	}
+
+	// 滑行期间不由普通行走逻辑移动
+	x := position.X
+	bs.handleZombieBasicBehavior(bobsledID, 0.1)
+	if position.X != x {
+		t.Errorf("滑行中的雪橇队僵尸不应按行走速度移动，X %.1f → %.1f", x, position.X)
+	}
+
+	position.X = cellCenterX(3)
+	bs.updateBobsleds(0.1)
+	if bobsled.State != components.BobsledStateJumping {
+		t.Fatalf("冰道到头应跳下雪橇，实际 %v", bobsled.State)
+	}
+
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, bobsledID)
+	reanim.IsFinished = true
+	bs.updateBobsleds(0.1)
+	if bobsled.State != components.BobsledStateWalking {
+		t.Fatalf("跳下雪橇后应步行，实际 %v", bobsled.State)
+	}
+	if velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, bobsledID); velocity.VX != config.ZombieWalkSpeed {
+		t.Errorf("步行速度应为 %.1f，实际 %.1f", config.ZombieWalkSpeed, velocity.VX)
+	}
+}
+
+// createTestBobsledRider 创建测试用的雪橇队僵尸（已召集过队员）
+func createTestBobsledRider(em *ecs.EntityManager, x, y float64) (ecs.EntityID, *components.BobsledComponent) {
+	id := createTestWalkingZombie(em, x, y)
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, id)
+	behavior.UnitID = types.UnitIDZombieBobsled
+	bobsled := &components.BobsledComponent{State: components.BobsledStateRiding, TeamSummoned: true}
+	ecs.AddComponent(em, id, bobsled)
+	return id, bobsled
+}
+
+// TestBobsledTeamRidesAndJumpsOffTogether 测试雪橇队共乘一架雪橇滑行，冰道到头时全队一起跳下
+func TestBobsledTeamRidesAndJumpsOffTogether(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	for col := 4; col < config.GridColumns; col++ {
+		bs.lawnGridSystem.LayIce(bs.lawnGridEntityID, col, 2)
+	}
+
+	var riders [components.BobsledTeamSize]ecs.EntityID
+	bobsleds := make([]*components.BobsledComponent, components.BobsledTeamSize)
+	for seat := range riders {
+		riders[seat], bobsleds[seat] = createTestBobsledRider(em, cellCenterX(6)+float64(seat)*config.BobsledRiderSpacing, zombieYForRow(2))
+	}
+	sledID := entities.NewBobsledSledEntity(em, riders, cellCenterX(6), plantYForRow(2))
+	for seat, bobsled := range bobsleds {
+		if bobsled.Sled != sledID {
+			t.Fatalf("座位 %d 的队员应坐在雪橇 %d 上，实际 %d", seat, sledID, bobsled.Sled)
+		}
+	}
+
+	// 最前方的队长死亡后，其余队员仍随雪橇滑行
+	leaderBehavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, riders[0])
+	leaderBehavior.Type = components.BehaviorZombieDying
+
+	bs.updateBobsleds(1.0)
+	sledPos, _ := ecs.GetComponent[*components.PositionComponent](em, sledID)
+	if sledPos.X != cellCenterX(6)+config.BobsledSlideSpeed {
+		t.Fatalf("雪橇应滑行到 %.1f，实际 %.1f", cellCenterX(6)+config.BobsledSlideSpeed, sledPos.X)
+	}
+	for seat := 1; seat < components.BobsledTeamSize; seat++ {
+		position, _ := ecs.GetComponent[*components.PositionComponent](em, riders[seat])
+		if want := sledPos.X + float64(seat)*config.BobsledRiderSpacing; position.X != want {
+			t.Errorf("座位 %d 的队员应在 X=%.1f，实际 %.1f", seat, want, position.X)
+		}
+	}
+	if leaderPos, _ := ecs.GetComponent[*components.PositionComponent](em, riders[0]); leaderPos.X != cellCenterX(6) {
+		t.Error("死亡的队长不应再随雪橇移动")
+	}
+
+	// 最前方的队员离开冰道，全队一起跳下
+	sledPos.X = cellCenterX(3) - config.BobsledRiderSpacing
+	bs.updateBobsleds(0.1)
+	for seat := 1; seat < components.BobsledTeamSize; seat++ {
+		if bobsleds[seat].State != components.BobsledStateJumping || bobsleds[seat].Sled != 0 {
+			t.Errorf("座位 %d 的队员应跳下雪橇，实际状态 %v，雪橇 %d", seat, bobsleds[seat].State, bobsleds[seat].Sled)
+		}
+	}
+	em.RemoveMarkedEntities()
+	if ecs.HasComponent[*components.BobsledSledComponent](em, sledID) {
+		t.Error("全队跳下后雪橇应消失")
+	}
+}
+
+// TestBobsledLeaderSummonsTeamOnce 测试队长激活时召集队员并乘上雪橇，之后不再召集
+func TestBobsledLeaderSummonsTeamOnce(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	for col := 0; col < config.GridColumns; col++ {
+		bs.lawnGridSystem.LayIce(bs.lawnGridEntityID, col, 2)
+	}
+
+	leaderID, bobsled := createTestBobsledRider(em, config.GridWorldEndX+50, zombieYForRow(2))
+	bobsled.TeamSummoned = false
+
+	bs.updateBobsleds(0.1)
+	if !bobsled.TeamSummoned || bobsled.Sled == 0 {
+		t.Fatalf("队长激活后应召集队员并乘上雪橇，实际 %+v", bobsled)
+	}
+	sled, _ := ecs.GetComponent[*components.BobsledSledComponent](em, bobsled.Sled)
+	if sled.Riders[0] != leaderID {
+		t.Errorf("队长应坐在最前方的座位，实际 %v", sled.Riders)
+	}
+
+	bs.updateBobsleds(0.1)
+	if sleds := ecs.GetEntitiesWith1[*components.BobsledSledComponent](em); len(sleds) != 1 {
+		t.Errorf("只应召集一次，实际 %d 架雪橇", len(sleds))
+	}
+}
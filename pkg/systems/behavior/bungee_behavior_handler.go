@@ -0,0 +1,332 @@
+package behavior
+
+import (
+	"log"
+	"math/rand"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+)
+
+// updateBungeeZombies 更新所有蹦极僵尸的偷取状态
+//
+// 蹦极僵尸不走路，整个过程由此方法控制：
+//   - 瞄准：激活后选定随机一株植物所在的格子，显示准星，等待一段时间
+//   - 下落：沿蹦极绳从高空落到目标格子（在空中，普通子弹无法击中）
+//   - 抓取：落地播放 grab 动画，动画结束时若未受到伤害则抓起植物并释放格子
+//     （目标格子受叶子保护伞保护时不会落地，被挡开后直接上升）
+//   - 上升：拎着植物（或空手）升回高空，离开后计入消灭数量并删除
+func (s *BehaviorSystem) updateBungeeZombies(deltaTime float64) {
+	for _, entityID := range ecs.GetEntitiesWith1[*components.BungeeComponent](s.entityManager) {
+		bungee, _ := ecs.GetComponent[*components.BungeeComponent](s.entityManager, entityID)
+		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+		if !ok {
+			continue
+		}
+
+		// 被消灭：放弃偷取，在原地播放普通死亡动画
+		if behavior.Type != components.BehaviorZombieBasic {
+			if s.isZombieBehaviorType(behavior.Type) {
+				s.finishBungee(entityID, bungee)
+			}
+			continue
+		}
+		if waveState, ok := ecs.GetComponent[*components.ZombieWaveStateComponent](s.entityManager, entityID); ok && !waveState.IsActivated {
+			continue
+		}
+
+		switch bungee.State {
+		case components.BungeeStateTargeting:
+			if !bungee.HasTarget {
+				s.startBungeeTargeting(entityID, bungee)
+			}
+			bungee.Timer -= deltaTime
+			if bungee.Timer <= 0 {
+				s.startBungeeDrop(entityID, bungee)
+			}
+		case components.BungeeStateDropping:
+			bungee.Timer += deltaTime
+			progress := bungee.Timer / config.BungeeDropDuration
+			if progress >= 1 {
+				if umbrellaID := s.findUmbrellaLeafCovering(bungee.TargetCol, bungee.TargetRow); umbrellaID != 0 {
+					s.blockBungee(entityID, bungee, umbrellaID)
+					continue
+				}
+				s.landBungee(entityID, bungee)
+				continue
+			}
+			s.setBungeeHeight(entityID, bungee, config.BungeeDropHeight*(1-progress))
+		case components.BungeeStateGrabbing:
+			reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID)
+			if !ok || reanim.IsFinished {
+				s.finishBungeeGrab(entityID, bungee)
+			}
+		case components.BungeeStateRaising:
+			bungee.Timer += deltaTime
+			progress := bungee.Timer / config.BungeeRaiseDuration
+			if progress >= 1 {
+				s.removeBungee(entityID, bungee)
+				continue
+			}
+			s.setBungeeHeight(entityID, bungee, config.BungeeDropHeight*progress)
+		}
+	}
+}
+
+// startBungeeTargeting 选定目标格子并显示准星
+// 优先选择其他蹦极僵尸未瞄准的植物，草坪上没有植物时随机选择一个格子
+func (s *BehaviorSystem) startBungeeTargeting(entityID ecs.EntityID, bungee *components.BungeeComponent) {
+	col, row, ok := s.pickBungeeTargetPlant(entityID)
+	if !ok {
+		col = rand.Intn(config.GridColumns)
+		row = rand.Intn(s.lawnLayout.Rows)
+	}
+	bungee.HasTarget = true
+	bungee.TargetCol = col
+	bungee.TargetRow = row
+	bungee.Timer = config.BungeeTargetDelay
+	bungee.GroundY = s.lawnLayout.StartY + float64(row)*s.lawnLayout.CellHeight + s.lawnLayout.CellHeight/2.0 + config.ZombieVerticalOffset +
+		s.lawnLayout.CellSlopeOffsetY(col)
+
+	// 蹦极僵尸在目标格子正上方等待，不属于生成时的行
+	if position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID); ok {
+		collisionOffsetX := 0.0
+		if collision, ok := ecs.GetComponent[*components.CollisionComponent](s.entityManager, entityID); ok {
+			collisionOffsetX = collision.OffsetX
+		}
+		position.X = config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2 - collisionOffsetX
+	}
+	if lane, ok := ecs.GetComponent[*components.ZombieTargetLaneComponent](s.entityManager, entityID); ok {
+		lane.TargetRow = row
+		lane.HasReachedTargetLane = true
+	}
+	if velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, entityID); ok {
+		velocity.VX = 0
+	}
+	s.setBungeeHeight(entityID, bungee, config.BungeeDropHeight)
+	s.setZombieShadowVisible(entityID, false)
+
+	cellX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+	cellY := s.lawnLayout.StartY + float64(row)*s.lawnLayout.CellHeight + s.lawnLayout.CellHeight/2 + s.lawnLayout.CellSlopeOffsetY(col)
+	targetID, err := entities.NewBungeeTargetEntity(s.entityManager, s.resourceManager, cellX, cellY)
+	if err != nil {
+		log.Printf("[BehaviorSystem] 警告：创建蹦极僵尸准星失败: %v", err)
+	} else {
+		bungee.TargetEntityID = targetID
+	}
+	log.Printf("[BehaviorSystem] 蹦极僵尸 %d 瞄准格子 (%d, %d)", entityID, col, row)
+}
+
+// pickBungeeTargetPlant 随机选择一株未被其他蹦极僵尸瞄准的植物，返回其所在格子
+func (s *BehaviorSystem) pickBungeeTargetPlant(entityID ecs.EntityID) (col, row int, ok bool) {
+	targeted := make(map[[2]int]bool)
+	for _, otherID := range ecs.GetEntitiesWith1[*components.BungeeComponent](s.entityManager) {
+		other, _ := ecs.GetComponent[*components.BungeeComponent](s.entityManager, otherID)
+		if otherID != entityID && other.HasTarget {
+			targeted[[2]int{other.TargetCol, other.TargetRow}] = true
+		}
+	}
+
+	var candidates [][2]int
+	for _, plantID := range ecs.GetEntitiesWith1[*components.PlantComponent](s.entityManager) {
+		plant, _ := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID)
+		cell := [2]int{plant.GridCol, plant.GridRow}
+		if !targeted[cell] {
+			candidates = append(candidates, cell)
+		}
+	}
+	if len(candidates) == 0 {
+		return 0, 0, false
+	}
+	cell := candidates[rand.Intn(len(candidates))]
+	return cell[0], cell[1], true
+}
+
+// startBungeeDrop 准星等待结束，沿蹦极绳开始下落
+func (s *BehaviorSystem) startBungeeDrop(entityID ecs.EntityID, bungee *components.BungeeComponent) {
+	bungee.State = components.BungeeStateDropping
+	bungee.Timer = 0
+	s.playBungeeCombo(entityID, "drop")
+
+	if position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID); ok {
+		cordID, err := entities.NewBungeeCordEntity(s.entityManager, s.resourceManager, position.X, position.Y)
+		if err != nil {
+			log.Printf("[BehaviorSystem] 警告：创建蹦极绳失败: %v", err)
+		} else {
+			bungee.CordEntityID = cordID
+			s.setBungeeHeight(entityID, bungee, config.BungeeDropHeight)
+		}
+	}
+
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_BUNGEE_SCREAM")
+	}
+}
+
+// landBungee 落到目标格子：回到地面，记录生命值后开始抓取
+func (s *BehaviorSystem) landBungee(entityID ecs.EntityID, bungee *components.BungeeComponent) {
+	bungee.State = components.BungeeStateGrabbing
+	s.setBungeeHeight(entityID, bungee, 0)
+	s.setZombieShadowVisible(entityID, true)
+	s.playBungeeCombo(entityID, "grab")
+
+	if health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, entityID); ok {
+		bungee.LandedHealth = health.CurrentHealth
+	}
+	log.Printf("[BehaviorSystem] 蹦极僵尸 %d 落在格子 (%d, %d)", entityID, bungee.TargetCol, bungee.TargetRow)
+}
+
+// finishBungeeGrab 抓取动画结束：未受到伤害时抓起目标格子上的植物，然后升回高空
+func (s *BehaviorSystem) finishBungeeGrab(entityID ecs.EntityID, bungee *components.BungeeComponent) {
+	// 抓取期间种下的叶子保护伞同样挡开蹦极僵尸
+	if umbrellaID := s.findUmbrellaLeafCovering(bungee.TargetCol, bungee.TargetRow); umbrellaID != 0 {
+		s.blockBungee(entityID, bungee, umbrellaID)
+		return
+	}
+	s.removeBungeeTarget(bungee)
+
+	damaged := false
+	if health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, entityID); ok {
+		damaged = health.CurrentHealth < bungee.LandedHealth
+	}
+
+	if damaged {
+		log.Printf("[BehaviorSystem] 蹦极僵尸 %d 在抓取时受到伤害，放弃偷取植物", entityID)
+	} else if plantID := s.findPlantAtCell(bungee.TargetCol, bungee.TargetRow); plantID != 0 {
+		s.liftPlant(plantID)
+		bungee.PlantID = plantID
+		log.Printf("[BehaviorSystem] 蹦极僵尸 %d 抓起植物 %d", entityID, plantID)
+	}
+
+	bungee.State = components.BungeeStateRaising
+	bungee.Timer = 0
+	s.setZombieShadowVisible(entityID, false)
+	if bungee.PlantID != 0 {
+		s.playBungeeCombo(entityID, "raise")
+	} else {
+		s.playBungeeCombo(entityID, "idle")
+	}
+}
+
+// findPlantAtCell 查找占据指定格子的植物
+// 睡莲、花盆上种着植物时返回上面的植物，平台上没有植物时才返回平台
+func (s *BehaviorSystem) findPlantAtCell(col, row int) ecs.EntityID {
+	var platformID ecs.EntityID
+	for _, plantID := range ecs.GetEntitiesWith1[*components.PlantComponent](s.entityManager) {
+		plant, _ := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID)
+		if plant.GridRow != row || col < plant.GridCol || col >= plant.GridCol+components.PlantFootprintWidth(plant.PlantType) {
+			continue
+		}
+		if !plant.PlantType.IsPlatform() {
+			return plantID
+		}
+		platformID = plantID
+	}
+	return platformID
+}
+
+// liftPlant 植物被蹦极僵尸抓起：释放占用的格子，植物不再作为植物参与战斗
+// 植物实体保留到蹦极僵尸离开草坪，期间跟随蹦极僵尸升起
+func (s *BehaviorSystem) liftPlant(plantID ecs.EntityID) {
+	s.releasePlantCells(plantID)
+	ecs.RemoveComponent[*components.PlantComponent](s.entityManager, plantID)
+	ecs.RemoveComponent[*components.BehaviorComponent](s.entityManager, plantID)
+	ecs.RemoveComponent[*components.CollisionComponent](s.entityManager, plantID)
+}
+
+// setBungeeHeight 设置蹦极僵尸离地的高度，同步碰撞盒高度、被拎起的植物和蹦极绳
+func (s *BehaviorSystem) setBungeeHeight(entityID ecs.EntityID, bungee *components.BungeeComponent, height float64) {
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	position.Y = bungee.GroundY - height
+
+	if collision, ok := ecs.GetComponent[*components.CollisionComponent](s.entityManager, entityID); ok {
+		collision.Altitude = height
+	}
+
+	if bungee.PlantID != 0 {
+		if plantPos, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, bungee.PlantID); ok {
+			plantPos.Y = s.lawnLayout.StartY + float64(bungee.TargetRow)*s.lawnLayout.CellHeight + s.lawnLayout.CellHeight/2 +
+				s.lawnLayout.CellSlopeOffsetY(bungee.TargetCol) - height
+		}
+	}
+
+	// 蹦极绳从高空垂到僵尸头顶，图片以中点定位，按绳长纵向拉伸
+	if bungee.CordEntityID != 0 {
+		topY := bungee.GroundY - config.BungeeDropHeight
+		if cordPos, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, bungee.CordEntityID); ok {
+			cordPos.X = position.X
+			cordPos.Y = (topY + position.Y) / 2
+		}
+		if scale, ok := ecs.GetComponent[*components.ScaleComponent](s.entityManager, bungee.CordEntityID); ok {
+			scale.ScaleY = (position.Y - topY) / config.BungeeCordImageHeight
+		}
+	}
+}
+
+// removeBungee 蹦极僵尸升回高空离开草坪：计入消灭数量，删除被拎走的植物和蹦极僵尸
+func (s *BehaviorSystem) removeBungee(entityID ecs.EntityID, bungee *components.BungeeComponent) {
+	log.Printf("[BehaviorSystem] 蹦极僵尸 %d 离开草坪（拎走植物 %d）", entityID, bungee.PlantID)
+	if bungee.PlantID != 0 {
+		s.entityManager.DestroyEntity(bungee.PlantID)
+	}
+	s.removeBungeeTarget(bungee)
+	s.removeBungeeCord(bungee)
+	s.recordZombieKilled(entityID)
+	s.entityManager.DestroyEntity(entityID)
+}
+
+// finishBungee 蹦极僵尸被消灭：放弃偷取，已抓起的植物掉落消失，移除准星、蹦极绳和蹦极组件
+// 之后由普通死亡流程播放死亡动画并计入消灭数量
+func (s *BehaviorSystem) finishBungee(entityID ecs.EntityID, bungee *components.BungeeComponent) {
+	if bungee.PlantID != 0 {
+		s.entityManager.DestroyEntity(bungee.PlantID)
+	}
+	s.removeBungeeTarget(bungee)
+	s.removeBungeeCord(bungee)
+	if collision, ok := ecs.GetComponent[*components.CollisionComponent](s.entityManager, entityID); ok {
+		collision.Altitude = 0
+	}
+	ecs.RemoveComponent[*components.BungeeComponent](s.entityManager, entityID)
+}
+
+// removeBungeeTarget 删除蹦极僵尸的准星
+func (s *BehaviorSystem) removeBungeeTarget(bungee *components.BungeeComponent) {
+	if bungee.TargetEntityID != 0 {
+		s.entityManager.DestroyEntity(bungee.TargetEntityID)
+		bungee.TargetEntityID = 0
+	}
+}
+
+// removeBungeeCord 删除蹦极绳
+func (s *BehaviorSystem) removeBungeeCord(bungee *components.BungeeComponent) {
+	if bungee.CordEntityID != 0 {
+		s.entityManager.DestroyEntity(bungee.CordEntityID)
+		bungee.CordEntityID = 0
+	}
+}
+
+// isBungeeZombie 是否为蹦极僵尸（不走路，由 updateBungeeZombies 控制）
+func (s *BehaviorSystem) isBungeeZombie(entityID ecs.EntityID) bool {
+	return systems.IsBungeeZombie(s.entityManager, entityID)
+}
+
+// playBungeeCombo 原地播放蹦极僵尸的动画
+func (s *BehaviorSystem) playBungeeCombo(entityID ecs.EntityID, comboName string) {
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	behavior.ZombieAnimState = components.ZombieAnimIdle
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    behavior.UnitID,
+		ComboName: comboName,
+		Processed: false,
+	})
+}
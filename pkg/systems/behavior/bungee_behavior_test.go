@@ -0,0 +1,196 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// createTestBungeeZombie 创建测试用的尚未选定目标的蹦极僵尸
+func createTestBungeeZombie(em *ecs.EntityManager) (ecs.EntityID, *components.BungeeComponent) {
+	id := createTestWalkingZombie(em, config.GridWorldEndX+100, zombieYForRow(0))
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, id)
+	behavior.UnitID = types.UnitIDZombieBungee
+	health, _ := ecs.GetComponent[*components.HealthComponent](em, id)
+	health.CurrentHealth = config.BungeeZombieDefaultHealth
+	health.MaxHealth = config.BungeeZombieDefaultHealth
+	velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, id)
+	velocity.VX = 0
+	bungee := &components.BungeeComponent{State: components.BungeeStateTargeting}
+	ecs.AddComponent(em, id, bungee)
+	return id, bungee
+}
+
+// dropTestBungee 推进蹦极僵尸直到落地开始抓取
+func dropTestBungee(t *testing.T, bs *BehaviorSystem, bungee *components.BungeeComponent) {
+	t.Helper()
+	bs.updateBungeeZombies(0.1)
+	bs.updateBungeeZombies(config.BungeeTargetDelay)
+	if bungee.State != components.BungeeStateDropping {
+		t.Fatalf("准星等待结束后应开始下落，实际 %v", bungee.State)
+	}
+	bs.updateBungeeZombies(config.BungeeDropDuration)
+	if bungee.State != components.BungeeStateGrabbing {
+		t.Fatalf("下落结束后应落地抓取，实际 %v", bungee.State)
+	}
+}
+
+// TestBungeeStealsTargetedPlant 测试蹦极僵尸瞄准植物所在格子，落下抓起植物并释放格子，离开后计入消灭数量
+func TestBungeeStealsTargetedPlant(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	gs := game.GetGameState()
+	bs := createTestBehaviorSystem(em, rm, gs)
+
+	plantID := createTestGridPlant(em, components.PlantPeashooter, 4, 2)
+	if err := bs.lawnGridSystem.OccupyCell(bs.lawnGridEntityID, 4, 2, plantID); err != nil {
+		t.Fatalf("Failed to occupy cell: %v", err)
+	}
+	bungeeID, bungee := createTestBungeeZombie(em)
+
+	bs.updateBungeeZombies(0.1)
+	if !bungee.HasTarget || bungee.TargetCol != 4 || bungee.TargetRow != 2 {
+		t.Fatalf("应瞄准植物所在格子 (4, 2)，实际 (%d, %d)", bungee.TargetCol, bungee.TargetRow)
+	}
+	collision, _ := ecs.GetComponent[*components.CollisionComponent](em, bungeeID)
+	if !collision.IsAirborne() {
+		t.Error("等待下落时蹦极僵尸应在空中")
+	}
+	if !systems.IsBungeeZombie(em, bungeeID) {
+		t.Error("蹦极僵尸不应参与按行判定的进家和除草车")
+	}
+
+	dropTestBungee(t, bs, bungee)
+	position, _ := ecs.GetComponent[*components.PositionComponent](em, bungeeID)
+	if position.Y != zombieYForRow(2) || collision.IsAirborne() {
+		t.Fatalf("应落在第 2 行地面上，实际 Y %.1f，离地 %.1f", position.Y, collision.Altitude)
+	}
+
+	// 蹦极僵尸不走路
+	bs.handleZombieBasicBehavior(bungeeID, 0.1)
+	if velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, bungeeID); velocity.VX != 0 {
+		t.Errorf("蹦极僵尸不应行走，实际速度 %.1f", velocity.VX)
+	}
+
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, bungeeID)
+	reanim.IsFinished = true
+	bs.updateBungeeZombies(0.1)
+	if bungee.State != components.BungeeStateRaising || bungee.PlantID != plantID {
+		t.Fatalf("抓取结束后应拎着植物上升，实际状态 %v，植物 %d", bungee.State, bungee.PlantID)
+	}
+	if bs.lawnGridSystem.IsOccupied(bs.lawnGridEntityID, 4, 2) {
+		t.Error("植物被抓起后格子应被释放")
+	}
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, plantID); ok {
+		t.Error("被抓起的植物不应再参与战斗")
+	}
+
+	killedBefore := gs.ZombiesKilled
+	bs.updateBungeeZombies(config.BungeeRaiseDuration)
+	em.RemoveMarkedEntities()
+
+	if _, ok := ecs.GetComponent[*components.BehaviorComponent](em, bungeeID); ok {
+		t.Error("升回高空后蹦极僵尸应离开")
+	}
+	if _, ok := ecs.GetComponent[*components.PositionComponent](em, plantID); ok {
+		t.Error("被拎走的植物应被删除")
+	}
+	if gs.ZombiesKilled != killedBefore+1 {
+		t.Errorf("蹦极僵尸离开应计入消灭数量，实际 %d → %d", killedBefore, gs.ZombiesKilled)
+	}
+}
+
+// TestDamagedBungeeCancelsTheft 测试蹦极僵尸在抓起植物前受到伤害时放弃偷取
+func TestDamagedBungeeCancelsTheft(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	plantID := createTestGridPlant(em, components.PlantWallnut, 6, 1)
+	bungeeID, bungee := createTestBungeeZombie(em)
+
+	dropTestBungee(t, bs, bungee)
+
+	health, _ := ecs.GetComponent[*components.HealthComponent](em, bungeeID)
+	health.CurrentHealth -= 20
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, bungeeID)
+	reanim.IsFinished = true
+	bs.updateBungeeZombies(0.1)
+
+	if bungee.State != components.BungeeStateRaising || bungee.PlantID != 0 {
+		t.Fatalf("受到伤害后应空手上升，实际状态 %v，植物 %d", bungee.State, bungee.PlantID)
+	}
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, plantID); !ok {
+		t.Error("植物不应被抓走")
+	}
+}
+
+// TestUmbrellaLeafBlocksBungee 测试叶子保护伞挡开落向周围 3x3 格子的蹦极僵尸，蹦极僵尸空手弹回高空
+func TestUmbrellaLeafBlocksBungee(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	umbrellaID := createTestGridPlant(em, components.PlantUmbrellaLeaf, 4, 2)
+	ecs.AddComponent(em, umbrellaID, &components.BehaviorComponent{Type: components.BehaviorUmbrellaLeaf})
+	ecs.AddComponent(em, umbrellaID, &components.ReanimComponent{})
+	plantID := createTestGridPlant(em, components.PlantWallnut, 5, 3)
+	_, bungee := createTestBungeeZombie(em)
+
+	bs.updateBungeeZombies(0.1)
+	bs.updateBungeeZombies(config.BungeeTargetDelay)
+	bs.updateBungeeZombies(config.BungeeDropDuration)
+
+	if bungee.State != components.BungeeStateRaising || bungee.PlantID != 0 {
+		t.Fatalf("被叶子保护伞挡开后应空手弹回高空，实际状态 %v，植物 %d", bungee.State, bungee.PlantID)
+	}
+	for _, id := range []ecs.EntityID{umbrellaID, plantID} {
+		if _, ok := ecs.GetComponent[*components.PlantComponent](em, id); !ok {
+			t.Errorf("受保护的植物 %d 不应被抓走", id)
+		}
+	}
+	if cmd, _ := ecs.GetComponent[*components.AnimationCommandComponent](em, umbrellaID); cmd == nil || cmd.ComboName != "block" {
+		t.Errorf("期望叶子保护伞播放 block 动画，实际 %+v", cmd)
+	}
+
+	if bs.findUmbrellaLeafCovering(6, 2) != 0 {
+		t.Error("叶子保护伞不应保护 3x3 范围以外的格子")
+	}
+}
+
+// TestBungeeStealsPottedPlantOnRoof 测试屋顶上蹦极僵尸抓走花盆上的植物，花盆留在原地
+func TestBungeeStealsPottedPlantOnRoof(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystemWithLayout(em, rm, game.GetGameState(), config.GetLawnLayout("roof"))
+
+	potID := createTestGridPlant(em, components.PlantFlowerPot, 3, 1)
+	if err := bs.lawnGridSystem.PlaceFlowerPot(bs.lawnGridEntityID, 3, 1, potID); err != nil {
+		t.Fatalf("Failed to place flower pot: %v", err)
+	}
+	plantID := createTestGridPlant(em, components.PlantPeashooter, 3, 1)
+	if err := bs.lawnGridSystem.OccupyCell(bs.lawnGridEntityID, 3, 1, plantID); err != nil {
+		t.Fatalf("Failed to occupy cell: %v", err)
+	}
+	bungeeID, bungee := createTestBungeeZombie(em)
+
+	dropTestBungee(t, bs, bungee)
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, bungeeID)
+	reanim.IsFinished = true
+	bs.updateBungeeZombies(0.1)
+
+	if bungee.PlantID != plantID {
+		t.Fatalf("应抓走花盆上的植物 %d，实际 %d", plantID, bungee.PlantID)
+	}
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, potID); !ok {
+		t.Error("花盆应留在原地")
+	}
+	if bs.lawnGridSystem.IsOccupied(bs.lawnGridEntityID, 3, 1) || bs.lawnGridSystem.GetPlatform(bs.lawnGridEntityID, 3, 1) != potID {
+		t.Error("植物被抓走后格子应被释放，花盆仍在格子上")
+	}
+}
@@ -0,0 +1,203 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// updateCatapults 更新所有投篮车僵尸的投篮状态
+//
+// 投篮车行驶到 CatapultStopX 后，只要还有篮球且同行有植物就停车投篮：
+//   - 停车：等待投篮间隔
+//   - 投篮：播放 shoot 动画，动画结束时向同行最靠后（最左侧）的植物抛出篮球
+//
+// 篮球用完或同行没有植物时继续前进，碾压挡路的植物（见 tryCrushPlant）
+func (s *BehaviorSystem) updateCatapults(deltaTime float64) {
+	for _, entityID := range ecs.GetEntitiesWith1[*components.CatapultComponent](s.entityManager) {
+		catapult, _ := ecs.GetComponent[*components.CatapultComponent](s.entityManager, entityID)
+		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+		if !ok || behavior.Type != components.BehaviorZombieBasic {
+			continue
+		}
+		if waveState, ok := ecs.GetComponent[*components.ZombieWaveStateComponent](s.entityManager, entityID); ok && !waveState.IsActivated {
+			continue
+		}
+		if health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, entityID); ok && health.CurrentHealth <= 0 {
+			continue
+		}
+		position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+		if !ok {
+			continue
+		}
+
+		switch catapult.State {
+		case components.CatapultStateDriving:
+			if catapult.Basketballs > 0 && !s.isCharmedZombie(entityID) &&
+				s.zombieCenterX(entityID, position) <= config.CatapultStopX &&
+				s.findRearmostPlantInRow(s.zombieRowOf(position)) != 0 {
+				log.Printf("[BehaviorSystem] 投篮车 %d 停车准备投篮", entityID)
+				catapult.State = components.CatapultStateAiming
+				catapult.FireTimer = 0
+				s.playCatapultCombo(entityID, "idle")
+			}
+		case components.CatapultStateAiming:
+			catapult.FireTimer -= deltaTime
+			if catapult.FireTimer > 0 {
+				continue
+			}
+			targetID := s.findRearmostPlantInRow(s.zombieRowOf(position))
+			if targetID == 0 {
+				s.resumeCatapultDriving(entityID, catapult)
+				continue
+			}
+			catapult.State = components.CatapultStateShooting
+			catapult.TargetPlantID = targetID
+			s.playCatapultCombo(entityID, "shoot")
+		case components.CatapultStateShooting:
+			if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok && !reanim.IsFinished {
+				continue
+			}
+			s.throwBasketball(entityID, catapult, position)
+			if catapult.Basketballs <= 0 {
+				log.Printf("[BehaviorSystem] 投篮车 %d 篮球用完，继续前进", entityID)
+				s.resumeCatapultDriving(entityID, catapult)
+				continue
+			}
+			catapult.State = components.CatapultStateAiming
+			catapult.FireTimer = config.CatapultFireInterval
+			s.playCatapultCombo(entityID, "idle")
+		}
+	}
+}
+
+// findRearmostPlantInRow 查找指定行最靠后（最左侧）的植物
+//
+// 返回:
+//   - ecs.EntityID: 植物实体ID，该行没有植物时返回 0
+func (s *BehaviorSystem) findRearmostPlantInRow(row int) ecs.EntityID {
+	var targetID ecs.EntityID
+	targetCol := 0
+	for _, plantID := range ecs.GetEntitiesWith1[*components.PlantComponent](s.entityManager) {
+		plant, _ := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID)
+		if plant.GridRow != row {
+			continue
+		}
+		if targetID == 0 || plant.GridCol < targetCol {
+			targetID = plantID
+			targetCol = plant.GridCol
+		}
+	}
+	return targetID
+}
+
+// throwBasketball 投篮动画结束：篮球从投篮车上沿抛物线飞向目标植物
+// 目标植物在投篮期间消失时改投同行剩余最靠后的植物，同行没有植物时不消耗篮球
+func (s *BehaviorSystem) throwBasketball(entityID ecs.EntityID, catapult *components.CatapultComponent, position *components.PositionComponent) {
+	targetID := catapult.TargetPlantID
+	catapult.TargetPlantID = 0
+	if _, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, targetID); !ok {
+		targetID = s.findRearmostPlantInRow(s.zombieRowOf(position))
+	}
+	targetPos, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, targetID)
+	if targetID == 0 || !ok {
+		return
+	}
+
+	catapult.Basketballs--
+	startX := s.zombieCenterX(entityID, position) + config.BasketballReleaseOffsetX
+	startY := position.Y + config.BasketballReleaseOffsetY
+	if _, err := entities.NewBasketballProjectile(s.entityManager, s.resourceManager, startX, startY, targetID, targetPos.X, targetPos.Y); err != nil {
+		log.Printf("[BehaviorSystem] 警告：投篮车抛出篮球失败: %v", err)
+		return
+	}
+
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_BASKETBALL")
+	}
+	log.Printf("[BehaviorSystem] 投篮车 %d 向植物 %d 抛出篮球（剩余 %d）", entityID, targetID, catapult.Basketballs)
+}
+
+// handleBasketballProjectileBehavior 处理篮球的飞行和命中
+// 篮球落地时对目标植物造成伤害，植物生命值归零时被摧毁
+func (s *BehaviorSystem) handleBasketballProjectileBehavior(entityID ecs.EntityID, deltaTime float64) {
+	lob, landed := s.updateLobbedProjectile(entityID, deltaTime)
+	if lob == nil || !landed {
+		return
+	}
+
+	if lob.TargetEntity != 0 {
+		if health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, lob.TargetEntity); ok {
+			health.CurrentHealth -= lob.Damage
+			log.Printf("[BehaviorSystem] 篮球命中植物 %d，剩余生命值 %d", lob.TargetEntity, health.CurrentHealth)
+			if health.CurrentHealth <= 0 {
+				s.destroyPlant(lob.TargetEntity)
+			}
+		}
+	}
+
+	s.entityManager.DestroyEntity(entityID)
+}
+
+// tryCrushPlant 车辆僵尸（投篮车、雪橇车）遇到挡路的植物时直接碾过
+//
+// 返回:
+//   - bool: 是否碾压了植物（非车辆僵尸返回 false，按普通僵尸处理）
+func (s *BehaviorSystem) tryCrushPlant(entityID, plantID ecs.EntityID) bool {
+	if !s.isVehicleZombie(entityID) {
+		return false
+	}
+
+	log.Printf("[BehaviorSystem] 车辆僵尸 %d 碾过植物 %d", entityID, plantID)
+	s.destroyPlant(plantID)
+	return true
+}
+
+// resumeCatapultDriving 投篮车恢复行驶
+func (s *BehaviorSystem) resumeCatapultDriving(entityID ecs.EntityID, catapult *components.CatapultComponent) {
+	catapult.State = components.CatapultStateDriving
+	catapult.TargetPlantID = 0
+	if velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, entityID); ok {
+		velocity.VX = config.CatapultDriveSpeed
+	}
+	s.changeZombieAnimation(entityID, components.ZombieAnimWalking)
+}
+
+// wreckCatapult 投篮车被地刺扎破报废，播放投篮车专用的烧焦动画
+func (s *BehaviorSystem) wreckCatapult(entityID ecs.EntityID) {
+	if health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, entityID); ok && health.CurrentHealth > 0 {
+		health.CurrentHealth = 0
+	}
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_EXPLOSION")
+	}
+	log.Printf("[BehaviorSystem] 投篮车 %d 报废", entityID)
+	s.triggerZombieExplosionDeath(entityID)
+}
+
+// isCatapultShooting 投篮车是否正在停车投篮
+func (s *BehaviorSystem) isCatapultShooting(entityID ecs.EntityID) bool {
+	catapult, ok := ecs.GetComponent[*components.CatapultComponent](s.entityManager, entityID)
+	return ok && catapult.State != components.CatapultStateDriving
+}
+
+// playCatapultCombo 停车播放投篮车的动画
+func (s *BehaviorSystem) playCatapultCombo(entityID ecs.EntityID, comboName string) {
+	if velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, entityID); ok {
+		velocity.VX = 0
+	}
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	behavior.ZombieAnimState = components.ZombieAnimIdle
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    behavior.UnitID,
+		ComboName: comboName,
+		Processed: false,
+	})
+}
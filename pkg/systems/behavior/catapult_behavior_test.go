@@ -0,0 +1,126 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// createTestCatapult 创建测试用的投篮车僵尸
+func createTestCatapult(em *ecs.EntityManager, x, y float64, basketballs int) (ecs.EntityID, *components.CatapultComponent) {
+	id := createTestWalkingZombie(em, x, y)
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, id)
+	behavior.UnitID = types.UnitIDZombieCatapult
+	health, _ := ecs.GetComponent[*components.HealthComponent](em, id)
+	health.CurrentHealth = config.CatapultZombieDefaultHealth
+	health.MaxHealth = config.CatapultZombieDefaultHealth
+	velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, id)
+	velocity.VX = config.CatapultDriveSpeed
+	catapult := &components.CatapultComponent{Basketballs: basketballs}
+	ecs.AddComponent(em, id, catapult)
+	return id, catapult
+}
+
+// TestCatapultLobsBasketballAtRearmostPlant 测试投篮车到达停车位置后停下，向同行最靠后的植物投篮
+func TestCatapultLobsBasketballAtRearmostPlant(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	rearID := createTestGridPlant(em, components.PlantSunflower, 1, 2)
+	createTestGridPlant(em, components.PlantPeashooter, 4, 2)
+	createTestGridPlant(em, components.PlantSunflower, 0, 3)
+
+	catapultID, catapult := createTestCatapult(em, config.CatapultStopX+100, zombieYForRow(2), 2)
+
+	// 未到停车位置时继续行驶
+	bs.updateCatapults(0.1)
+	if catapult.State != components.CatapultStateDriving {
+		t.Fatalf("未到停车位置时应继续行驶，实际 %v", catapult.State)
+	}
+
+	position, _ := ecs.GetComponent[*components.PositionComponent](em, catapultID)
+	position.X = config.CatapultStopX
+	bs.updateCatapults(0.1)
+	if catapult.State != components.CatapultStateAiming {
+		t.Fatalf("到达停车位置后应停车，实际 %v", catapult.State)
+	}
+	if velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, catapultID); velocity.VX != 0 {
+		t.Errorf("停车后速度应为 0，实际 %.1f", velocity.VX)
+	}
+
+	bs.updateCatapults(0.1)
+	if catapult.State != components.CatapultStateShooting || catapult.TargetPlantID != rearID {
+		t.Fatalf("应瞄准同行最靠后的植物 %d，实际状态 %v，目标 %d", rearID, catapult.State, catapult.TargetPlantID)
+	}
+
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, catapultID)
+	reanim.IsFinished = true
+	bs.updateCatapults(0.1)
+	if catapult.Basketballs != 1 || catapult.State != components.CatapultStateAiming {
+		t.Fatalf("投篮后应剩余 1 个篮球并等待下一次投篮，实际 %d 个，状态 %v", catapult.Basketballs, catapult.State)
+	}
+
+	balls := ecs.GetEntitiesWith1[*components.LobbedProjectileComponent](em)
+	if len(balls) != 1 {
+		t.Fatalf("应抛出 1 个篮球，实际 %d", len(balls))
+	}
+	lob, _ := ecs.GetComponent[*components.LobbedProjectileComponent](em, balls[0])
+	if lob.TargetEntity != rearID {
+		t.Fatalf("篮球应飞向植物 %d，实际 %d", rearID, lob.TargetEntity)
+	}
+
+	bs.handleBasketballProjectileBehavior(balls[0], config.BasketballFlightDuration/2)
+	if lob.TargetEntity != rearID {
+		t.Error("飞行途中不应丢失目标植物")
+	}
+	bs.handleBasketballProjectileBehavior(balls[0], config.BasketballFlightDuration)
+	health, _ := ecs.GetComponent[*components.HealthComponent](em, rearID)
+	if health.CurrentHealth != 300-config.BasketballDamage {
+		t.Errorf("篮球应造成 %d 点伤害，植物剩余生命值 %d", config.BasketballDamage, health.CurrentHealth)
+	}
+}
+
+// TestCatapultCrushesPlantsWhenOutOfBasketballs 测试篮球用完的投篮车继续前进，碾过挡路的植物
+func TestCatapultCrushesPlantsWhenOutOfBasketballs(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	plantID := createTestGridPlant(em, components.PlantWallnut, 3, 1)
+	catapultID, catapult := createTestCatapult(em, cellCenterX(3), zombieYForRow(1), 0)
+
+	bs.updateCatapults(0.1)
+	if catapult.State != components.CatapultStateDriving {
+		t.Fatalf("没有篮球时不应停车，实际 %v", catapult.State)
+	}
+
+	bs.handleZombieBasicBehavior(catapultID, 0.1)
+	em.RemoveMarkedEntities()
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, plantID); ok {
+		t.Error("投篮车应碾过挡路的植物")
+	}
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, catapultID)
+	if behavior.Type != components.BehaviorZombieBasic {
+		t.Errorf("投篮车不应啃食植物，实际行为 %v", behavior.Type)
+	}
+}
+
+// TestCatapultExplosionDeathUsesCharredCatapult 测试投篮车被炸死时播放投篮车专用的烧焦动画
+func TestCatapultExplosionDeathUsesCharredCatapult(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	catapultID, _ := createTestCatapult(em, cellCenterX(6), zombieYForRow(0), config.CatapultBasketballCount)
+	bs.triggerZombieExplosionDeath(catapultID)
+
+	cmd, ok := ecs.GetComponent[*components.AnimationCommandComponent](em, catapultID)
+	if !ok || cmd.UnitID != types.UnitIDZombieCharredCatapult || cmd.ComboName != "death" {
+		t.Errorf("期望播放 %s/death，实际 %+v", types.UnitIDZombieCharredCatapult, cmd)
+	}
+}
@@ -0,0 +1,174 @@
+package behavior
+
+import (
+	"log"
+	"math"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+)
+
+// handleCattailBehavior 处理香蒲的行为逻辑
+// 计时器就绪且草坪上有僵尸时，向最近的僵尸发射追踪尖刺（不限于所在行）
+func (s *BehaviorSystem) handleCattailBehavior(entityID ecs.EntityID, deltaTime float64, zombieEntityList []ecs.EntityID) {
+	plant, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	timer, ok := ecs.GetComponent[*components.TimerComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	// 射击动画播放完毕后切换回待机动画
+	if plant.AttackAnimState == components.AttackAnimAttacking {
+		if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok && reanim.IsFinished {
+			ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+				UnitID:    "cattail",
+				ComboName: "idle",
+				Processed: false,
+			})
+			plant.AttackAnimState = components.AttackAnimIdle
+		}
+	}
+
+	timer.CurrentTime += deltaTime
+	if timer.CurrentTime < timer.TargetTime {
+		return
+	}
+
+	startX := position.X
+	startY := position.Y + config.CattailLaunchOffsetY
+
+	targetID, ok := s.findNearestZombie(startX, startY, zombieEntityList)
+	if !ok {
+		return // 没有目标时保持就绪
+	}
+
+	timer.CurrentTime = 0
+
+	// 初始速度直接朝向目标，之后由 handleCattailSpikeBehavior 持续修正
+	targetX, targetY := s.lobTargetPoint(targetID)
+	angle := math.Atan2(targetY-startY, targetX-startX)
+	vx := math.Cos(angle) * config.CattailSpikeSpeed
+	vy := math.Sin(angle) * config.CattailSpikeSpeed
+
+	if _, err := entities.NewCattailSpike(s.entityManager, s.resourceManager, startX, startY, targetID, vx, vy); err != nil {
+		log.Printf("[BehaviorSystem] 香蒲 %d 创建尖刺失败: %v", entityID, err)
+		return
+	}
+
+	s.playShootSound()
+
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    "cattail",
+		ComboName: "attack",
+		Processed: false,
+	})
+	plant.AttackAnimState = components.AttackAnimAttacking
+
+	log.Printf("[BehaviorSystem] 香蒲 %d 向僵尸 %d 发射尖刺", entityID, targetID)
+}
+
+// findNearestZombie 查找草坪上距离指定点最近的僵尸（任意行）
+// 只考虑已进入屏幕的僵尸，距离按僵尸碰撞盒中心计算
+// 空中的僵尸（气球僵尸）只有对空植物能攻击，因此优先锁定
+func (s *BehaviorSystem) findNearestZombie(x, y float64, zombieEntityList []ecs.EntityID) (ecs.EntityID, bool) {
+	screenRightBoundary := config.GridWorldEndX + 50.0
+
+	var targetID ecs.EntityID
+	targetAirborne := false
+	nearestDistSq := 0.0
+	for _, zombieID := range zombieEntityList {
+		if !s.isHomingTargetValid(zombieID) {
+			continue
+		}
+		zombieX, zombieY := s.lobTargetPoint(zombieID)
+		if zombieX >= screenRightBoundary {
+			continue
+		}
+		airborne := s.isAirborneZombie(zombieID)
+		if targetID != 0 && targetAirborne && !airborne {
+			continue
+		}
+		distSq := (zombieX-x)*(zombieX-x) + (zombieY-y)*(zombieY-y)
+		if targetID == 0 || (airborne && !targetAirborne) || distSq < nearestDistSq {
+			targetID = zombieID
+			targetAirborne = airborne
+			nearestDistSq = distSq
+		}
+	}
+
+	return targetID, targetID != 0
+}
+
+// isHomingTargetValid 判断僵尸是否仍可作为追踪目标（存活、未被魅惑，且不在地下或水下）
+// 飞行途中钻入地下、潜入水下的目标不会被子弹碰撞，尖刺需要重新锁定
+func (s *BehaviorSystem) isHomingTargetValid(zombieID ecs.EntityID) bool {
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, zombieID)
+	if !ok || !s.isZombieBehaviorType(behavior.Type) || behavior.Type == components.BehaviorZombieDying {
+		return false
+	}
+	return !s.isCharmedZombie(zombieID) && !s.isUndergroundZombie(zombieID) && !s.isSubmergedZombie(zombieID)
+}
+
+// handleCattailSpikeBehavior 处理香蒲尖刺的追踪飞行
+// 目标失效时重新锁定最近的僵尸；没有僵尸时沿当前方向直线飞出屏幕
+func (s *BehaviorSystem) handleCattailSpikeBehavior(entityID ecs.EntityID, deltaTime float64, zombieEntityList []ecs.EntityID) {
+	homing, ok := ecs.GetComponent[*components.HomingComponent](s.entityManager, entityID)
+	if !ok {
+		s.moveDirectProjectile(entityID, deltaTime, "香蒲尖刺")
+		return
+	}
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	if homing.TargetEntity == 0 || !s.isHomingTargetValid(homing.TargetEntity) {
+		homing.TargetEntity, _ = s.findNearestZombie(position.X, position.Y, zombieEntityList)
+	}
+
+	if homing.TargetEntity != 0 {
+		targetX, targetY := s.lobTargetPoint(homing.TargetEntity)
+		velocity.VX, velocity.VY = steerTowards(velocity.VX, velocity.VY, targetX-position.X, targetY-position.Y, homing.Speed, homing.TurnRate*deltaTime)
+	}
+
+	s.moveDirectProjectile(entityID, deltaTime, "香蒲尖刺")
+}
+
+// steerTowards 将速度方向向期望方向旋转，单次旋转角度不超过 maxTurn（弧度）
+//
+// 参数:
+//   - vx, vy: 当前速度
+//   - dx, dy: 期望方向（无需归一化）
+//   - speed: 旋转后的速度大小
+//   - maxTurn: 本次最多旋转的角度（弧度）
+//
+// 返回:
+//   - float64, float64: 旋转后的速度
+func steerTowards(vx, vy, dx, dy, speed, maxTurn float64) (float64, float64) {
+	current := math.Atan2(vy, vx)
+	desired := math.Atan2(dy, dx)
+
+	// 将角度差归一化到 [-π, π]，选择较短的旋转方向
+	diff := math.Remainder(desired-current, 2*math.Pi)
+	if diff > maxTurn {
+		diff = maxTurn
+	} else if diff < -maxTurn {
+		diff = -maxTurn
+	}
+
+	angle := current + diff
+	return math.Cos(angle) * speed, math.Sin(angle) * speed
+}
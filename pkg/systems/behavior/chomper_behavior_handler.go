@@ -0,0 +1,149 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+)
+
+// handleChomperBehavior 处理大嘴花的行为
+//
+// 僵尸进入咬合范围时播放 bite 动画，动画结束时：
+//   - 能吞下的僵尸（见 systems.CanBeSwallowed）被整个吞下，之后咀嚼 ChomperChewDuration 秒
+//   - 巨人僵尸无法吞下，只受到 ChomperBiteDamage 点伤害，大嘴花立即恢复待机
+func (s *BehaviorSystem) handleChomperBehavior(entityID ecs.EntityID, deltaTime float64, zombieEntityList []ecs.EntityID) {
+	chomper, ok := ecs.GetComponent[*components.ChomperComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	plant, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	switch chomper.State {
+	case components.ChomperStateReady:
+		targetID, ok := s.findChomperTarget(plant, position, zombieEntityList)
+		if !ok {
+			return
+		}
+		log.Printf("[BehaviorSystem] 大嘴花 %d 咬向僵尸 %d", entityID, targetID)
+		chomper.State = components.ChomperStateBiting
+		chomper.TargetID = targetID
+		s.playChomperCombo(entityID, "bite")
+	case components.ChomperStateBiting:
+		if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok && !reanim.IsFinished {
+			return
+		}
+		s.finishChomperBite(entityID, chomper, plant, position, zombieEntityList)
+	case components.ChomperStateChewing:
+		chomper.ChewTimer -= deltaTime
+		if chomper.ChewTimer > 0 {
+			return
+		}
+		chomper.State = components.ChomperStateSwallowing
+		s.playChomperCombo(entityID, "swallow")
+	case components.ChomperStateSwallowing:
+		if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok && !reanim.IsFinished {
+			return
+		}
+		chomper.State = components.ChomperStateReady
+		s.playChomperCombo(entityID, "idle")
+	}
+}
+
+// finishChomperBite bite 动画结束：吞下或咬伤目标
+// 目标在咬下之前离开咬合范围或已经死亡时扑空，恢复待机
+func (s *BehaviorSystem) finishChomperBite(entityID ecs.EntityID, chomper *components.ChomperComponent, plant *components.PlantComponent, position *components.PositionComponent, zombieEntityList []ecs.EntityID) {
+	targetID := chomper.TargetID
+	chomper.TargetID = 0
+
+	if !s.isChomperTarget(targetID, plant, position, zombieEntityList) {
+		log.Printf("[BehaviorSystem] 大嘴花 %d 扑空", entityID)
+		chomper.State = components.ChomperStateReady
+		s.playChomperCombo(entityID, "idle")
+		return
+	}
+
+	if !systems.CanBeSwallowed(s.entityManager, targetID) {
+		log.Printf("[BehaviorSystem] 大嘴花 %d 吞不下僵尸 %d，只咬伤它", entityID, targetID)
+		s.applyLobDamage(targetID, config.ChomperBiteDamage)
+		if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+			audioManager.PlaySound("SOUND_CHOMP")
+		}
+		chomper.State = components.ChomperStateReady
+		s.playChomperCombo(entityID, "idle")
+		return
+	}
+
+	log.Printf("[BehaviorSystem] 大嘴花 %d 吞下僵尸 %d", entityID, targetID)
+	s.recordZombieKilled(targetID)
+	s.entityManager.DestroyEntity(targetID)
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_BIGCHOMP")
+	}
+
+	chomper.State = components.ChomperStateChewing
+	chomper.ChewTimer = config.ChomperChewDuration
+	s.playChomperCombo(entityID, "chew")
+}
+
+// findChomperTarget 查找咬合范围内最靠近大嘴花的僵尸
+func (s *BehaviorSystem) findChomperTarget(plant *components.PlantComponent, position *components.PositionComponent, zombieEntityList []ecs.EntityID) (ecs.EntityID, bool) {
+	var targetID ecs.EntityID
+	minDistance := 0.0
+	for _, zombieID := range zombieEntityList {
+		if !s.isChomperTarget(zombieID, plant, position, zombieEntityList) {
+			continue
+		}
+		zombiePos, _ := ecs.GetComponent[*components.PositionComponent](s.entityManager, zombieID)
+		distance := s.zombieCenterX(zombieID, zombiePos) - position.X
+		if targetID == 0 || distance < minDistance {
+			targetID = zombieID
+			minDistance = distance
+		}
+	}
+	return targetID, targetID != 0
+}
+
+// isChomperTarget 僵尸是否在大嘴花的咬合范围内：同一行，碰撞盒中心在大嘴花所在格子到前方 ChomperBiteRange 之间
+// 空中、地下、潜水和被魅惑的僵尸不在 zombieEntityList 中；僵王博士不会被咬
+func (s *BehaviorSystem) isChomperTarget(zombieID ecs.EntityID, plant *components.PlantComponent, position *components.PositionComponent, zombieEntityList []ecs.EntityID) bool {
+	found := false
+	for _, id := range zombieEntityList {
+		if id == zombieID {
+			found = true
+			break
+		}
+	}
+	if !found || ecs.HasComponent[*components.ZombossComponent](s.entityManager, zombieID) {
+		return false
+	}
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, zombieID)
+	if !ok || behavior.Type == components.BehaviorZombieDying || behavior.Type == components.BehaviorZombieDyingExplosion {
+		return false
+	}
+	zombiePos, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, zombieID)
+	if !ok || s.zombieRowOf(zombiePos) != plant.GridRow {
+		return false
+	}
+	distance := s.zombieCenterX(zombieID, zombiePos) - position.X
+	return distance >= -config.CellWidth/2 && distance <= config.ChomperBiteRange
+}
+
+// playChomperCombo 播放大嘴花的动画
+func (s *BehaviorSystem) playChomperCombo(entityID ecs.EntityID, comboName string) {
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    "chomper",
+		ComboName: comboName,
+		Processed: false,
+	})
+}
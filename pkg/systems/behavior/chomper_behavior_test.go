@@ -0,0 +1,119 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// createTestChomper 创建测试用的大嘴花
+func createTestChomper(em *ecs.EntityManager, col, row int) ecs.EntityID {
+	id := createTestGridPlant(em, components.PlantChomper, col, row)
+	ecs.AddComponent(em, id, &components.BehaviorComponent{Type: components.BehaviorChomper})
+	ecs.AddComponent(em, id, &components.ChomperComponent{})
+	ecs.AddComponent(em, id, &components.ReanimComponent{})
+	return id
+}
+
+// finishChomperAnimation 模拟大嘴花当前动画播放完毕
+func finishChomperAnimation(em *ecs.EntityManager, chomperID ecs.EntityID) {
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, chomperID)
+	reanim.IsFinished = true
+}
+
+// TestChomperSwallowsZombie 测试大嘴花吞下面前的僵尸，咀嚼结束后才能再次攻击
+func TestChomperSwallowsZombie(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	chomperID := createTestChomper(em, 3, 2)
+	zombieID := createTestWalkingZombie(em, cellCenterX(4), zombieYForRow(2))
+	farID := createTestWalkingZombie(em, cellCenterX(7), zombieYForRow(2))
+
+	bs.Update(0.01)
+
+	chomper, _ := ecs.GetComponent[*components.ChomperComponent](em, chomperID)
+	if chomper.State != components.ChomperStateBiting || chomper.TargetID != zombieID {
+		t.Fatalf("大嘴花应咬向面前的僵尸 %d，实际状态 %v，目标 %d", zombieID, chomper.State, chomper.TargetID)
+	}
+
+	finishChomperAnimation(em, chomperID)
+	bs.Update(0.01)
+	em.RemoveMarkedEntities()
+
+	if ecs.HasComponent[*components.HealthComponent](em, zombieID) {
+		t.Error("僵尸应被整个吞下")
+	}
+	if chomper.State != components.ChomperStateChewing {
+		t.Fatalf("吞下僵尸后应开始咀嚼，实际 %v", chomper.State)
+	}
+	if cmd, _ := ecs.GetComponent[*components.AnimationCommandComponent](em, chomperID); cmd == nil || cmd.ComboName != "chew" {
+		t.Errorf("期望播放 chew 动画，实际 %+v", cmd)
+	}
+
+	// 咀嚼期间不攻击走近的僵尸
+	farPos, _ := ecs.GetComponent[*components.PositionComponent](em, farID)
+	farPos.X = cellCenterX(4)
+	bs.Update(config.ChomperChewDuration / 2)
+	if chomper.State != components.ChomperStateChewing {
+		t.Fatalf("咀嚼期间不应攻击，实际 %v", chomper.State)
+	}
+
+	bs.Update(config.ChomperChewDuration / 2)
+	if chomper.State != components.ChomperStateSwallowing {
+		t.Fatalf("咀嚼结束后应播放吞咽动画，实际 %v", chomper.State)
+	}
+	finishChomperAnimation(em, chomperID)
+	bs.Update(0.01)
+	if chomper.State != components.ChomperStateReady {
+		t.Errorf("吞咽结束后应恢复待机，实际 %v", chomper.State)
+	}
+}
+
+// TestChomperCannotSwallowGargantuar 测试大嘴花吞不下巨人，只能咬伤它并立即恢复待机
+func TestChomperCannotSwallowGargantuar(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	chomperID := createTestChomper(em, 3, 2)
+	gargID := createTestGargantuar(em, cellCenterX(4), zombieYForRow(2))
+
+	bs.handleChomperBehavior(chomperID, 0.01, []ecs.EntityID{gargID})
+	finishChomperAnimation(em, chomperID)
+	bs.handleChomperBehavior(chomperID, 0.01, []ecs.EntityID{gargID})
+	em.RemoveMarkedEntities()
+
+	health, ok := ecs.GetComponent[*components.HealthComponent](em, gargID)
+	if !ok {
+		t.Fatal("巨人不应被吞下")
+	}
+	if lost := health.MaxHealth - health.CurrentHealth; lost != config.ChomperBiteDamage {
+		t.Errorf("巨人应受到 %d 点咬伤，实际 %d", config.ChomperBiteDamage, lost)
+	}
+	chomper, _ := ecs.GetComponent[*components.ChomperComponent](em, chomperID)
+	if chomper.State != components.ChomperStateReady {
+		t.Errorf("咬伤巨人后应立即恢复待机，实际 %v", chomper.State)
+	}
+}
+
+// TestGargantuarSparesSpikeweed 测试巨人从地刺上走过时不会砸它
+func TestGargantuarSparesSpikeweed(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	spikeweedID := createTestSpikeweed(em, 5, 2)
+	gargID := createTestGargantuar(em, cellCenterX(5), zombieYForRow(2))
+
+	bs.handleZombieBasicBehavior(gargID, 0.1)
+
+	gargantuar, _ := ecs.GetComponent[*components.GargantuarComponent](em, gargID)
+	if gargantuar.State == components.GargantuarStateSmashing {
+		t.Errorf("巨人不应砸地刺 %d", spikeweedID)
+	}
+}
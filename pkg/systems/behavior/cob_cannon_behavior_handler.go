@@ -0,0 +1,162 @@
+package behavior
+
+import (
+	"log"
+	"math"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// handleCobCannonBehavior 处理玉米加农炮的行为逻辑
+//
+// 状态流转:
+//   - 装填中：ReloadTimer 递减，播放 unarmed_idle
+//   - 装填完成：播放 charge 后切换为 idle，等待玩家瞄准
+//   - 玩家选择落点后（FireRequested）：发射玉米炮弹，重新开始装填
+func (s *BehaviorSystem) handleCobCannonBehavior(entityID ecs.EntityID, deltaTime float64) {
+	cannon, ok := ecs.GetComponent[*components.CobCannonComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	reanim, hasReanim := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID)
+
+	if !cannon.Armed {
+		// 发射动画播放完毕后切换为未装填待机
+		plant, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, entityID)
+		if ok && plant.AttackAnimState == components.AttackAnimAttacking && hasReanim && reanim.IsFinished {
+			s.playCobCannonAnimation(entityID, "unarmed_idle")
+			plant.AttackAnimState = components.AttackAnimIdle
+		}
+
+		cannon.ReloadTimer -= deltaTime
+		if cannon.ReloadTimer <= 0 {
+			cannon.ReloadTimer = 0
+			cannon.Armed = true
+			s.playCobCannonAnimation(entityID, "charge")
+			log.Printf("[BehaviorSystem] 玉米加农炮 %d 装填完成", entityID)
+		}
+		return
+	}
+
+	// 装填完成动画播放完毕后切换为已装填待机
+	if hasReanim && reanim.IsFinished && !reanim.IsLooping {
+		s.playCobCannonAnimation(entityID, "idle")
+	}
+
+	if cannon.FireRequested {
+		s.fireCobCannon(entityID, cannon)
+	}
+}
+
+// fireCobCannon 发射玉米炮弹并重新开始装填
+func (s *BehaviorSystem) fireCobCannon(entityID ecs.EntityID, cannon *components.CobCannonComponent) {
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	startY := position.Y + config.CobLaunchOffsetY
+	if _, err := entities.NewCobProjectile(s.entityManager, s.resourceManager, position.X, startY, cannon.TargetX, cannon.TargetY); err != nil {
+		log.Printf("[BehaviorSystem] 玉米加农炮 %d 创建玉米炮弹失败: %v", entityID, err)
+		return
+	}
+
+	log.Printf("[BehaviorSystem] 玉米加农炮 %d 发射，落点 (%.1f, %.1f)", entityID, cannon.TargetX, cannon.TargetY)
+
+	cannon.FireRequested = false
+	cannon.Armed = false
+	cannon.ReloadTimer = config.CobCannonReloadTime
+
+	if plant, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, entityID); ok {
+		plant.AttackAnimState = components.AttackAnimAttacking
+	}
+	s.playCobCannonAnimation(entityID, "shooting")
+
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_COBLAUNCH")
+	}
+}
+
+// playCobCannonAnimation 播放玉米加农炮的动画组合
+func (s *BehaviorSystem) playCobCannonAnimation(entityID ecs.EntityID, comboName string) {
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    "cobcannon",
+		ComboName: comboName,
+		Processed: false,
+	})
+}
+
+// handleCobProjectileBehavior 处理玉米炮弹的飞行，落地时爆炸
+func (s *BehaviorSystem) handleCobProjectileBehavior(entityID ecs.EntityID, deltaTime float64) {
+	lob, landed := s.updateLobbedProjectile(entityID, deltaTime)
+	if lob == nil || !landed {
+		return
+	}
+
+	s.explodeCob(lob.TargetX, lob.TargetY, lob.Damage)
+	s.entityManager.DestroyEntity(entityID)
+}
+
+// explodeCob 玉米炮弹在落点爆炸，对以落点为中心的 3x3 格子范围内的僵尸造成伤害
+//
+// 参数:
+//   - x, y: 落点（世界坐标）
+//   - damage: 爆炸伤害
+//
+// 返回:
+//   - int: 受到伤害的僵尸数量
+func (s *BehaviorSystem) explodeCob(x, y float64, damage int) int {
+	targetRow := s.lawnLayout.RowAt(x, y)
+
+	affected := 0
+	zombies := ecs.GetEntitiesWith2[*components.BehaviorComponent, *components.PositionComponent](s.entityManager)
+	for _, zombieID := range zombies {
+		behavior, _ := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, zombieID)
+		if !s.isZombieBehaviorType(behavior.Type) || behavior.Type == components.BehaviorZombieDying {
+			continue
+		}
+		// 被魅惑的僵尸属于植物阵营，不受玉米炮弹伤害
+		if s.isCharmedZombie(zombieID) {
+			continue
+		}
+
+		zombiePos, _ := ecs.GetComponent[*components.PositionComponent](s.entityManager, zombieID)
+		zombieRow := s.lawnLayout.RowAt(zombiePos.X, zombiePos.Y-config.ZombieVerticalOffset)
+		if zombieRow < targetRow-config.CobExplosionRowRange || zombieRow > targetRow+config.CobExplosionRowRange {
+			continue
+		}
+
+		// 僵尸碰撞盒与爆炸范围在水平方向上有重叠即受到伤害
+		centerX := zombiePos.X
+		if collision, ok := ecs.GetComponent[*components.CollisionComponent](s.entityManager, zombieID); ok {
+			centerX += collision.OffsetX
+		}
+		if math.Abs(centerX-x) > config.CobExplosionHalfWidth+config.ZombieCollisionWidth/2 {
+			continue
+		}
+
+		affected++
+		s.applyLobDamage(zombieID, damage)
+
+		// 被爆炸杀死的僵尸播放烧焦死亡动画
+		if health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, zombieID); ok && health.CurrentHealth <= 0 {
+			s.triggerZombieExplosionDeath(zombieID)
+		}
+	}
+
+	log.Printf("[BehaviorSystem] 玉米炮弹在 (%.1f, %.1f) 爆炸，影响了 %d 个僵尸", x, y, affected)
+
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_DOOMSHROOM")
+	}
+
+	if _, err := entities.CreateParticleEffect(s.entityManager, s.resourceManager, config.ExplosiveNutParticleEffect, x, y); err != nil {
+		log.Printf("[BehaviorSystem] 警告：创建玉米炮弹爆炸粒子效果失败: %v", err)
+	}
+
+	return affected
+}
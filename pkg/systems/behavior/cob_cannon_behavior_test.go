@@ -0,0 +1,134 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// zombieYForRow 返回指定行僵尸的 Y 坐标（与僵尸工厂一致，包含垂直偏移）
+func zombieYForRow(row int) float64 {
+	return config.GridWorldStartY + float64(row)*config.CellHeight + config.CellHeight/2 + config.ZombieVerticalOffset
+}
+
+// TestExplodeCobHits3x3Area 测试玉米炮弹只伤害落点周围 3x3 格子内的僵尸
+func TestExplodeCobHits3x3Area(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	gs := game.GetGameState()
+	bs := createTestBehaviorSystem(em, rm, gs)
+
+	// 落点：第 2 行第 5 列中心
+	targetX := config.GridWorldStartX + 5*config.CellWidth + config.CellWidth/2
+	targetY := config.GridWorldStartY + 2*config.CellHeight + config.CellHeight/2
+
+	inCenter := createTestWalkingZombie(em, targetX, zombieYForRow(2))
+	inUpper := createTestWalkingZombie(em, targetX+config.CellWidth, zombieYForRow(1))
+	inLower := createTestWalkingZombie(em, targetX-config.CellWidth, zombieYForRow(3))
+	tooFarRow := createTestWalkingZombie(em, targetX, zombieYForRow(4))
+	tooFarCol := createTestWalkingZombie(em, targetX+4*config.CellWidth, zombieYForRow(2))
+
+	affected := bs.explodeCob(targetX, targetY, config.CobCannonDamage)
+	if affected != 3 {
+		t.Errorf("期望 3 个僵尸受到伤害，实际 %d", affected)
+	}
+
+	for _, id := range []ecs.EntityID{inCenter, inUpper, inLower} {
+		health, _ := ecs.GetComponent[*components.HealthComponent](em, id)
+		if health.CurrentHealth > 0 {
+			t.Errorf("范围内的僵尸 %d 应被炸死，剩余生命值 %d", id, health.CurrentHealth)
+		}
+	}
+	for _, id := range []ecs.EntityID{tooFarRow, tooFarCol} {
+		health, _ := ecs.GetComponent[*components.HealthComponent](em, id)
+		if health.CurrentHealth != health.MaxHealth {
+			t.Errorf("范围外的僵尸 %d 不应受到伤害", id)
+		}
+	}
+}
+
+// TestCobCannonReloadAndFire 测试玉米加农炮装填、发射后重新装填
+func TestCobCannonReloadAndFire(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	gs := game.GetGameState()
+	bs := createTestBehaviorSystem(em, rm, gs)
+
+	cannonID := em.CreateEntity()
+	ecs.AddComponent(em, cannonID, &components.PlantComponent{PlantType: components.PlantCobCannon, GridRow: 2, GridCol: 0})
+	ecs.AddComponent(em, cannonID, &components.PositionComponent{X: 300, Y: 300})
+	cannon := &components.CobCannonComponent{ReloadTimer: 1.0}
+	ecs.AddComponent(em, cannonID, cannon)
+
+	bs.handleCobCannonBehavior(cannonID, 0.5)
+	if cannon.IsReady() {
+		t.Fatal("装填未完成时不应可以瞄准")
+	}
+
+	bs.handleCobCannonBehavior(cannonID, 0.6)
+	if !cannon.IsReady() {
+		t.Fatal("装填完成后应可以瞄准")
+	}
+
+	cannon.TargetX = 600
+	cannon.TargetY = 250
+	cannon.FireRequested = true
+	bs.handleCobCannonBehavior(cannonID, 0.016)
+
+	if cannon.Armed || cannon.FireRequested {
+		t.Error("发射后应进入装填状态")
+	}
+	if cannon.ReloadTimer != config.CobCannonReloadTime {
+		t.Errorf("发射后装填时间应重置为 %.1f，实际 %.1f", config.CobCannonReloadTime, cannon.ReloadTimer)
+	}
+
+	// 测试环境无法加载图片时不会创建炮弹实体，只在加载成功时检查落点
+	for _, id := range ecs.GetEntitiesWith1[*components.LobbedProjectileComponent](em) {
+		lob, _ := ecs.GetComponent[*components.LobbedProjectileComponent](em, id)
+		if lob.TargetX != 600 || lob.TargetY != 250 {
+			t.Errorf("炮弹落点应为 (600, 250)，实际 (%.1f, %.1f)", lob.TargetX, lob.TargetY)
+		}
+	}
+}
+
+// TestKernelProjectileDamagesTarget 测试玉米粒落地时伤害目标僵尸
+func TestKernelProjectileDamagesTarget(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	gs := game.GetGameState()
+	bs := createTestBehaviorSystem(em, rm, gs)
+
+	zombieID := createTestWalkingZombie(em, 600, zombieYForRow(2))
+
+	kernelID := em.CreateEntity()
+	ecs.AddComponent(em, kernelID, &components.PositionComponent{X: 300, Y: 280})
+	ecs.AddComponent(em, kernelID, &components.BehaviorComponent{Type: components.BehaviorKernelProjectile})
+	ecs.AddComponent(em, kernelID, &components.LobbedProjectileComponent{
+		StartX:       300,
+		StartY:       280,
+		TargetEntity: zombieID,
+		ArcHeight:    config.KernelArcHeight,
+		Duration:     config.KernelFlightTime,
+		Damage:       config.KernelDamage,
+	})
+
+	// 飞行到一半：位于抛物线上方，目标未受伤
+	bs.handleKernelProjectileBehavior(kernelID, config.KernelFlightTime/2)
+	pos, _ := ecs.GetComponent[*components.PositionComponent](em, kernelID)
+	if pos.Y >= 280 {
+		t.Errorf("飞行中的玉米粒应高于起点，实际 Y=%.1f", pos.Y)
+	}
+	health, _ := ecs.GetComponent[*components.HealthComponent](em, zombieID)
+	if health.CurrentHealth != health.MaxHealth {
+		t.Error("玉米粒落地前不应造成伤害")
+	}
+
+	// 落地
+	bs.handleKernelProjectileBehavior(kernelID, config.KernelFlightTime/2)
+	if health.CurrentHealth != health.MaxHealth-config.KernelDamage {
+		t.Errorf("玉米粒落地后应造成 %d 伤害，剩余生命值 %d", config.KernelDamage, health.CurrentHealth)
+	}
+}
@@ -0,0 +1,457 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// updateDanceGroups 更新所有舞团（舞王僵尸及其伴舞）
+//
+// 舞团的舞步由领舞统一驱动，与领舞自身是否在啃食无关，因此在逐个僵尸的行为处理之前单独更新：
+//   - 领舞太空步到达召唤位置后停下，召唤上下前后四名伴舞
+//   - 伴舞出现空缺时，领舞定时补召
+//   - 舞团交替齐步前进和原地举手，所有舞者使用相同的动画和速度
+//   - 任一舞者在啃食时，整个舞团原地跳舞等待
+//   - 领舞死亡后，剩余伴舞各自继续跳舞
+func (s *BehaviorSystem) updateDanceGroups(deltaTime float64) {
+	for _, entityID := range ecs.GetEntitiesWith1[*components.DancerComponent](s.entityManager) {
+		dancer, _ := ecs.GetComponent[*components.DancerComponent](s.entityManager, entityID)
+
+		// 被魅惑的舞者脱离舞团，之后按普通僵尸行走
+		if s.isCharmedZombie(entityID) {
+			ecs.RemoveComponent[*components.DancerComponent](s.entityManager, entityID)
+			continue
+		}
+		if !s.isActiveDancer(entityID) {
+			continue
+		}
+
+		if dancer.Role == components.DancerRoleLeader {
+			s.updateDanceLeader(entityID, dancer, deltaTime)
+			continue
+		}
+
+		if dancer.IsRising() {
+			s.updateBackupDancerRise(entityID, dancer, deltaTime)
+			continue
+		}
+
+		// 领舞死亡或被魅惑后，伴舞独自继续跳舞
+		if dancer.LeaderID != 0 && !s.isActiveDancer(dancer.LeaderID) {
+			log.Printf("[BehaviorSystem] 伴舞 %d 失去领舞 %d，独自继续跳舞", entityID, dancer.LeaderID)
+			dancer.LeaderID = 0
+		}
+		if dancer.LeaderID == 0 {
+			s.advanceDance(dancer, []ecs.EntityID{entityID}, deltaTime)
+		}
+	}
+}
+
+// updateDanceLeader 更新领舞（舞王僵尸）的舞步和召唤
+func (s *BehaviorSystem) updateDanceLeader(leaderID ecs.EntityID, dancer *components.DancerComponent, deltaTime float64) {
+	switch dancer.Phase {
+	case components.DancePhaseMoonwalk:
+		position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, leaderID)
+		if !ok {
+			return
+		}
+		if velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, leaderID); ok {
+			velocity.VX = config.DancingMoonwalkSpeed
+		}
+		if position.X <= config.DancingSummonX && s.isDancerFree(leaderID) {
+			s.startDancerSummon(leaderID, dancer)
+		}
+		return
+
+	case components.DancePhasePoint:
+		// 召唤期间整个舞团原地等待
+		s.applyDanceVelocity(dancer, s.danceGroupMembers(leaderID, dancer))
+		reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, leaderID)
+		if !ok || !reanim.IsFinished {
+			return
+		}
+		dancer.SummonTimer = config.DancingSummonInterval
+		s.setDancePhase(dancer, components.DancePhaseWalk, s.danceGroupMembers(leaderID, dancer))
+		return
+	}
+
+	dancer.SummonTimer -= deltaTime
+	if dancer.SummonTimer <= 0 {
+		dancer.SummonTimer = config.DancingSummonInterval
+		if s.isDancerFree(leaderID) && len(s.vacantDancerSlots(leaderID, dancer)) > 0 {
+			s.startDancerSummon(leaderID, dancer)
+			return
+		}
+	}
+
+	s.advanceDance(dancer, s.danceGroupMembers(leaderID, dancer), deltaTime)
+}
+
+// startDancerSummon 领舞停下并召唤空缺站位上的伴舞
+// 附近有无主的伴舞（如读档恢复的伴舞）时直接收编，不重复召唤
+func (s *BehaviorSystem) startDancerSummon(leaderID ecs.EntityID, dancer *components.DancerComponent) {
+	s.adoptOrphanDancers(leaderID, dancer)
+
+	slots := s.vacantDancerSlots(leaderID, dancer)
+	if len(slots) == 0 {
+		dancer.SummonTimer = config.DancingSummonInterval
+		s.setDancePhase(dancer, components.DancePhaseWalk, s.danceGroupMembers(leaderID, dancer))
+		return
+	}
+
+	// 领舞指向天空，已在场的伴舞原地举手
+	dancer.Phase = components.DancePhasePoint
+	members := s.danceGroupMembers(leaderID, dancer)
+	for _, memberID := range members[1:] {
+		if member, ok := ecs.GetComponent[*components.DancerComponent](s.entityManager, memberID); ok {
+			member.Phase = components.DancePhaseArmRaise
+		}
+		s.playDanceCombo(memberID, components.DancePhaseArmRaise)
+	}
+	s.applyDanceVelocity(dancer, members)
+	if behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, leaderID); ok {
+		behavior.ZombieAnimState = components.ZombieAnimIdle
+		s.resetZombieRootMotion(leaderID)
+		ecs.AddComponent(s.entityManager, leaderID, &components.AnimationCommandComponent{
+			UnitID:    behavior.UnitID,
+			ComboName: "point",
+			Processed: false,
+		})
+	}
+
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_DIRT_RISE")
+	}
+
+	for _, slot := range slots {
+		s.summonBackupDancer(leaderID, dancer, slot)
+	}
+}
+
+// summonBackupDancer 在指定站位召唤一名从地下升起的伴舞
+func (s *BehaviorSystem) summonBackupDancer(leaderID ecs.EntityID, dancer *components.DancerComponent, slot components.DancerSlot) {
+	row, x, ok := s.dancerSlotPosition(leaderID, slot)
+	if !ok {
+		return
+	}
+
+	backupID, err := entities.NewBackupDancerEntity(s.entityManager, s.resourceManager, s.lawnLayout, row, x)
+	if err != nil {
+		log.Printf("[BehaviorSystem] 警告：召唤伴舞失败: %v", err)
+		return
+	}
+
+	backup, _ := ecs.GetComponent[*components.DancerComponent](s.entityManager, backupID)
+	backup.LeaderID = leaderID
+	backup.RiseTimer = config.BackupDancerRiseDuration
+
+	// 伴舞从地下升起：先下沉到地面以下，出土过程中逐渐回到行中心
+	if position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, backupID); ok {
+		backup.RiseTargetY = position.Y
+		position.Y += config.BackupDancerRiseDepth
+		if _, err := entities.CreateParticleEffect(s.entityManager, s.resourceManager, "ZombieRise", position.X, backup.RiseTargetY); err != nil {
+			log.Printf("[BehaviorSystem] 警告：创建伴舞出土粒子失败: %v", err)
+		}
+	}
+
+	ecs.AddComponent(s.entityManager, backupID, &components.ZombieTargetLaneComponent{
+		TargetRow: row,
+	})
+
+	// 伴舞不在关卡配置中，归入领舞所在波次并计入胜利条件
+	waveIndex := systems.SummonerWaveIndex(s.entityManager, s.gameState, leaderID)
+	systems.RegisterSummonedZombie(s.entityManager, s.gameState, backupID, waveIndex)
+
+	dancer.Backups[slot] = backupID
+	log.Printf("[BehaviorSystem] 舞王 %d 召唤伴舞 %d（站位 %d，行 %d，X=%.1f）", leaderID, backupID, slot, row, x)
+}
+
+// updateBackupDancerRise 伴舞从地下升起，出土完成后加入舞团
+func (s *BehaviorSystem) updateBackupDancerRise(entityID ecs.EntityID, dancer *components.DancerComponent, deltaTime float64) {
+	dancer.RiseTimer -= deltaTime
+	if dancer.RiseTimer < 0 {
+		dancer.RiseTimer = 0
+	}
+
+	if position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID); ok {
+		position.Y = dancer.RiseTargetY + config.BackupDancerRiseDepth*dancer.RiseTimer/config.BackupDancerRiseDuration
+	}
+	if dancer.IsRising() {
+		return
+	}
+
+	// 出土完成：跟上领舞当前的舞步
+	phase := components.DancePhaseWalk
+	if leader, ok := ecs.GetComponent[*components.DancerComponent](s.entityManager, dancer.LeaderID); ok && s.isActiveDancer(dancer.LeaderID) {
+		if leader.Phase == components.DancePhaseArmRaise {
+			phase = components.DancePhaseArmRaise
+		}
+		dancer.PhaseTimer = leader.PhaseTimer
+	} else {
+		dancer.PhaseTimer = config.DancerWalkDuration
+	}
+	dancer.Phase = phase
+	s.playDanceCombo(entityID, phase)
+}
+
+// advanceDance 推进舞步计时，到时切换齐步/举手，并统一设置舞团速度
+func (s *BehaviorSystem) advanceDance(dancer *components.DancerComponent, members []ecs.EntityID, deltaTime float64) {
+	dancer.PhaseTimer -= deltaTime
+	if dancer.PhaseTimer <= 0 {
+		next := components.DancePhaseArmRaise
+		if dancer.Phase == components.DancePhaseArmRaise {
+			next = components.DancePhaseWalk
+		}
+		s.setDancePhase(dancer, next, members)
+		return
+	}
+	s.applyDanceVelocity(dancer, members)
+}
+
+// setDancePhase 切换舞步，所有舞者同时从第一帧开始播放新动画
+func (s *BehaviorSystem) setDancePhase(dancer *components.DancerComponent, phase components.DancePhase, members []ecs.EntityID) {
+	dancer.Phase = phase
+	dancer.PhaseTimer = config.DancerWalkDuration
+	if phase == components.DancePhaseArmRaise {
+		dancer.PhaseTimer = config.DancerArmRaiseDuration
+	}
+
+	for _, memberID := range members {
+		if member, ok := ecs.GetComponent[*components.DancerComponent](s.entityManager, memberID); ok && member != dancer {
+			member.Phase = dancer.Phase
+			member.PhaseTimer = dancer.PhaseTimer
+		}
+		s.playDanceCombo(memberID, phase)
+	}
+	s.applyDanceVelocity(dancer, members)
+}
+
+// applyDanceVelocity 设置舞团所有舞者的速度
+// 只有齐步阶段前进，且任一舞者在啃食时整个舞团原地等待
+func (s *BehaviorSystem) applyDanceVelocity(dancer *components.DancerComponent, members []ecs.EntityID) {
+	vx := 0.0
+	if dancer.Phase == components.DancePhaseWalk {
+		vx = config.DancerWalkSpeed
+		for _, memberID := range members {
+			if !s.isDancerFree(memberID) {
+				vx = 0
+				break
+			}
+		}
+	}
+
+	for _, memberID := range members {
+		if velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, memberID); ok {
+			velocity.VX = vx
+		}
+	}
+}
+
+// playDanceCombo 为舞者播放舞步动画（啃食中的舞者保持啃食动画）
+func (s *BehaviorSystem) playDanceCombo(entityID ecs.EntityID, phase components.DancePhase) {
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+	if !ok || behavior.Type != components.BehaviorZombieBasic {
+		return
+	}
+	behavior.ZombieAnimState = components.ZombieAnimWalking
+	s.resetZombieRootMotion(entityID)
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    behavior.UnitID,
+		ComboName: danceComboName(phase),
+		Processed: false,
+	})
+}
+
+// danceComboName 舞步对应的动画组合
+func danceComboName(phase components.DancePhase) string {
+	switch phase {
+	case components.DancePhaseMoonwalk:
+		return "moonwalk"
+	case components.DancePhasePoint:
+		return "point"
+	case components.DancePhaseArmRaise:
+		return "armraise"
+	default:
+		return "walk"
+	}
+}
+
+// isDancerBusy 舞者本帧是否不进行移动和碰撞检测（伴舞出土中、领舞召唤中）
+func (s *BehaviorSystem) isDancerBusy(entityID ecs.EntityID) bool {
+	dancer, ok := ecs.GetComponent[*components.DancerComponent](s.entityManager, entityID)
+	if !ok {
+		return false
+	}
+	return dancer.IsRising() || dancer.Phase == components.DancePhasePoint
+}
+
+// danceGroupMembers 返回舞团的所有成员（领舞和仍在场的伴舞），并清理已失去的伴舞站位
+// 正在出土的伴舞不计入，出土完成后再跟上舞步
+func (s *BehaviorSystem) danceGroupMembers(leaderID ecs.EntityID, dancer *components.DancerComponent) []ecs.EntityID {
+	members := []ecs.EntityID{leaderID}
+	for slot, backupID := range dancer.Backups {
+		if backupID == 0 {
+			continue
+		}
+		backup, ok := ecs.GetComponent[*components.DancerComponent](s.entityManager, backupID)
+		if !ok || backup.LeaderID != leaderID || !s.isActiveDancer(backupID) {
+			dancer.Backups[slot] = 0
+			continue
+		}
+		if !backup.IsRising() {
+			members = append(members, backupID)
+		}
+	}
+	return members
+}
+
+// vacantDancerSlots 返回可以召唤伴舞的空缺站位
+// 超出草坪、未启用或受场景限制（如屋顶）的站位不会召唤
+func (s *BehaviorSystem) vacantDancerSlots(leaderID ecs.EntityID, dancer *components.DancerComponent) []components.DancerSlot {
+	s.danceGroupMembers(leaderID, dancer)
+
+	var slots []components.DancerSlot
+	for slot := components.DancerSlot(0); slot < components.DancerSlotCount; slot++ {
+		if dancer.Backups[slot] != 0 {
+			continue
+		}
+		if _, _, ok := s.dancerSlotPosition(leaderID, slot); ok {
+			slots = append(slots, slot)
+		}
+	}
+	return slots
+}
+
+// adoptOrphanDancers 收编站位上没有领舞的伴舞
+func (s *BehaviorSystem) adoptOrphanDancers(leaderID ecs.EntityID, dancer *components.DancerComponent) {
+	s.danceGroupMembers(leaderID, dancer)
+
+	for _, backupID := range ecs.GetEntitiesWith1[*components.DancerComponent](s.entityManager) {
+		backup, _ := ecs.GetComponent[*components.DancerComponent](s.entityManager, backupID)
+		if backup.Role != components.DancerRoleBackup || backup.LeaderID != 0 || !s.isActiveDancer(backupID) {
+			continue
+		}
+		position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, backupID)
+		if !ok {
+			continue
+		}
+
+		for slot := components.DancerSlot(0); slot < components.DancerSlotCount; slot++ {
+			if dancer.Backups[slot] != 0 {
+				continue
+			}
+			row, x, ok := s.dancerSlotPosition(leaderID, slot)
+			if !ok || s.zombieRowOf(position) != row || position.X < x-config.CellWidth/2 || position.X > x+config.CellWidth/2 {
+				continue
+			}
+			backup.LeaderID = leaderID
+			dancer.Backups[slot] = backupID
+			log.Printf("[BehaviorSystem] 舞王 %d 收编伴舞 %d（站位 %d）", leaderID, backupID, slot)
+			break
+		}
+	}
+}
+
+// dancerSlotPosition 计算伴舞站位的行和 X 坐标
+//
+// 返回:
+//   - int: 行索引（0-based）
+//   - float64: 世界坐标 X
+//   - bool: 站位是否可以召唤伴舞
+func (s *BehaviorSystem) dancerSlotPosition(leaderID ecs.EntityID, slot components.DancerSlot) (int, float64, bool) {
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, leaderID)
+	if !ok {
+		return 0, 0, false
+	}
+
+	row := s.zombieRowOf(position)
+	x := position.X
+	switch slot {
+	case components.DancerSlotAbove:
+		row--
+	case components.DancerSlotBelow:
+		row++
+	case components.DancerSlotFront:
+		x -= config.CellWidth
+	case components.DancerSlotBehind:
+		x += config.CellWidth
+	}
+
+	return row, x, s.canSummonZombieInRow(types.ZombieBackupDancer.String(), row)
+}
+
+// canSummonZombieInRow 检查被召唤的僵尸能否出现在指定行
+// 行需在草坪范围内、已启用，并满足生成规则的场景类型限制
+func (s *BehaviorSystem) canSummonZombieInRow(zombieType string, row int) bool {
+	rowMax := 5
+	sceneType := "day"
+	var enabledLanes []int
+	if s.gameState != nil && s.gameState.CurrentLevel != nil {
+		if s.gameState.CurrentLevel.RowMax > 0 {
+			rowMax = s.gameState.CurrentLevel.RowMax
+		}
+		if s.gameState.CurrentLevel.SceneType != "" {
+			sceneType = s.gameState.CurrentLevel.SceneType
+		}
+		enabledLanes = s.gameState.CurrentLevel.EnabledLanes
+	}
+
+	if row < 0 || row >= rowMax {
+		return false
+	}
+
+	lane := row + 1
+	if len(enabledLanes) > 0 {
+		enabled := false
+		for _, l := range enabledLanes {
+			if l == lane {
+				enabled = true
+				break
+			}
+		}
+		if !enabled {
+			return false
+		}
+	}
+
+	if s.spawnRules != nil {
+		if ok, err := systems.CheckSceneTypeRestriction(zombieType, sceneType, lane, s.spawnRules); !ok {
+			log.Printf("[BehaviorSystem] 无法在第 %d 行召唤 %s: %v", lane, zombieType, err)
+			return false
+		}
+	}
+	return true
+}
+
+// isActiveDancer 舞者是否仍在舞团中（存活、已激活且未被魅惑）
+func (s *BehaviorSystem) isActiveDancer(entityID ecs.EntityID) bool {
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+	if !ok {
+		return false
+	}
+	if behavior.Type != components.BehaviorZombieBasic && behavior.Type != components.BehaviorZombieEating {
+		return false
+	}
+	if waveState, ok := ecs.GetComponent[*components.ZombieWaveStateComponent](s.entityManager, entityID); ok && !waveState.IsActivated {
+		return false
+	}
+	return !s.isCharmedZombie(entityID)
+}
+
+// isDancerFree 舞者是否没有在啃食
+func (s *BehaviorSystem) isDancerFree(entityID ecs.EntityID) bool {
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+	return ok && behavior.Type == components.BehaviorZombieBasic
+}
+
+// zombieRowOf 根据僵尸位置计算所在行（0-based）
+func (s *BehaviorSystem) zombieRowOf(position *components.PositionComponent) int {
+	return s.lawnLayout.RowAt(position.X, position.Y-config.ZombieVerticalOffset)
+}
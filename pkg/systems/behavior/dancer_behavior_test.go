@@ -0,0 +1,187 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// createTestDanceGroup 创建测试用的舞团：第 2 行的舞王和上方、前方两名伴舞，均处于齐步阶段
+func createTestDanceGroup(em *ecs.EntityManager) (ecs.EntityID, []ecs.EntityID) {
+	x := config.GridWorldStartX + 5*config.CellWidth
+	leaderID := createTestWalkingZombie(em, x, zombieYForRow(2))
+	leaderBehavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, leaderID)
+	leaderBehavior.UnitID = types.UnitIDZombieDancing
+	leader := &components.DancerComponent{
+		Role:        components.DancerRoleLeader,
+		Phase:       components.DancePhaseWalk,
+		PhaseTimer:  config.DancerWalkDuration,
+		SummonTimer: config.DancingSummonInterval,
+	}
+	ecs.AddComponent(em, leaderID, leader)
+
+	above := createTestWalkingZombie(em, x, zombieYForRow(1))
+	front := createTestWalkingZombie(em, x-config.CellWidth, zombieYForRow(2))
+	for slot, backupID := range map[components.DancerSlot]ecs.EntityID{
+		components.DancerSlotAbove: above,
+		components.DancerSlotFront: front,
+	} {
+		behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, backupID)
+		behavior.UnitID = types.UnitIDZombieBackupDancer
+		ecs.AddComponent(em, backupID, &components.DancerComponent{
+			Role:       components.DancerRoleBackup,
+			Phase:      components.DancePhaseWalk,
+			PhaseTimer: config.DancerWalkDuration,
+			LeaderID:   leaderID,
+		})
+		leader.Backups[slot] = backupID
+	}
+	return leaderID, []ecs.EntityID{above, front}
+}
+
+// TestDanceGroupMovesInLockstep 测试舞团以相同速度和动画齐步前进，任一舞者啃食时整个舞团停下
+func TestDanceGroupMovesInLockstep(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	leaderID, backups := createTestDanceGroup(em)
+	members := append([]ecs.EntityID{leaderID}, backups...)
+
+	expectVX := func(want float64) {
+		t.Helper()
+		for _, id := range members {
+			velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, id)
+			if velocity.VX != want {
+				t.Errorf("舞者 %d 期望速度 %.1f，实际 %.1f", id, want, velocity.VX)
+			}
+		}
+	}
+
+	bs.updateDanceGroups(0.1)
+	expectVX(config.DancerWalkSpeed)
+
+	// 伴舞啃食时整个舞团原地等待
+	eater, _ := ecs.GetComponent[*components.BehaviorComponent](em, backups[1])
+	eater.Type = components.BehaviorZombieEating
+	bs.updateDanceGroups(0.1)
+	expectVX(0)
+	eater.Type = components.BehaviorZombieBasic
+
+	// 齐步结束后所有舞者同时举手
+	leader, _ := ecs.GetComponent[*components.DancerComponent](em, leaderID)
+	leader.PhaseTimer = 0.05
+	bs.updateDanceGroups(0.1)
+	expectVX(0)
+	for _, id := range members {
+		dancer, _ := ecs.GetComponent[*components.DancerComponent](em, id)
+		if dancer.Phase != components.DancePhaseArmRaise {
+			t.Errorf("舞者 %d 期望举手阶段，实际 %v", id, dancer.Phase)
+		}
+		cmd, _ := ecs.GetComponent[*components.AnimationCommandComponent](em, id)
+		if cmd == nil || cmd.ComboName != "armraise" {
+			t.Errorf("舞者 %d 期望播放 armraise，实际 %+v", id, cmd)
+		}
+	}
+}
+
+// TestBackupDancerDancesAloneAfterLeaderDies 测试舞王死亡后伴舞独自继续跳舞
+func TestBackupDancerDancesAloneAfterLeaderDies(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	leaderID, backups := createTestDanceGroup(em)
+	leaderBehavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, leaderID)
+	leaderBehavior.Type = components.BehaviorZombieDying
+
+	bs.updateDanceGroups(0.1)
+
+	for _, id := range backups {
+		dancer, _ := ecs.GetComponent[*components.DancerComponent](em, id)
+		if dancer.LeaderID != 0 {
+			t.Errorf("伴舞 %d 应脱离已死亡的领舞", id)
+		}
+		velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, id)
+		if velocity.VX != config.DancerWalkSpeed {
+			t.Errorf("伴舞 %d 应继续齐步前进，实际速度 %.1f", id, velocity.VX)
+		}
+	}
+}
+
+// TestBackupDancerRisesFromGround 测试伴舞从地下升起，出土期间不移动，出土后跟上舞步
+func TestBackupDancerRisesFromGround(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	leaderID, backups := createTestDanceGroup(em)
+	leader, _ := ecs.GetComponent[*components.DancerComponent](em, leaderID)
+	leader.Phase = components.DancePhaseArmRaise
+
+	backupID := backups[0]
+	dancer, _ := ecs.GetComponent[*components.DancerComponent](em, backupID)
+	position, _ := ecs.GetComponent[*components.PositionComponent](em, backupID)
+	dancer.RiseTargetY = position.Y
+	dancer.RiseTimer = config.BackupDancerRiseDuration
+	position.Y += config.BackupDancerRiseDepth
+
+	bs.updateDanceGroups(config.BackupDancerRiseDuration / 2)
+	if !bs.isDancerBusy(backupID) {
+		t.Fatal("出土中的伴舞不应移动")
+	}
+	if position.Y <= dancer.RiseTargetY || position.Y >= dancer.RiseTargetY+config.BackupDancerRiseDepth {
+		t.Errorf("出土一半时 Y 应位于地下和行中心之间，实际 %.1f", position.Y)
+	}
+
+	bs.updateDanceGroups(config.BackupDancerRiseDuration)
+	if dancer.IsRising() || position.Y != dancer.RiseTargetY {
+		t.Fatalf("出土完成后应回到行中心，实际 Y=%.1f", position.Y)
+	}
+	if dancer.Phase != components.DancePhaseArmRaise {
+		t.Errorf("出土后应跟上领舞的举手阶段，实际 %v", dancer.Phase)
+	}
+}
+
+// TestDancerSlotsRespectLawnAndSceneRestrictions 测试伴舞站位受草坪范围和场景限制约束
+func TestDancerSlotsRespectLawnAndSceneRestrictions(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	gs := &game.GameState{CurrentLevel: &config.LevelConfig{
+		SceneType:    "day",
+		RowMax:       5,
+		EnabledLanes: []int{1, 2, 3, 4, 5},
+	}}
+	bs := createTestBehaviorSystem(em, rm, gs)
+	bs.SetSpawnRules(&config.SpawnRulesConfig{
+		SceneTypeRestrictions: config.SceneRestrictions{
+			DancingRestrictions: config.DancingRestrictions{ProhibitedScenes: []string{"roof"}},
+		},
+	})
+
+	// 第 1 行的舞王上方没有草坪
+	leaderID := createTestWalkingZombie(em, config.GridWorldStartX+5*config.CellWidth, zombieYForRow(0))
+	leader := &components.DancerComponent{Role: components.DancerRoleLeader}
+	ecs.AddComponent(em, leaderID, leader)
+
+	slots := bs.vacantDancerSlots(leaderID, leader)
+	want := []components.DancerSlot{components.DancerSlotBelow, components.DancerSlotFront, components.DancerSlotBehind}
+	if len(slots) != len(want) {
+		t.Fatalf("期望站位 %v，实际 %v", want, slots)
+	}
+	for i := range want {
+		if slots[i] != want[i] {
+			t.Errorf("期望站位 %v，实际 %v", want, slots)
+		}
+	}
+
+	// 屋顶场景禁止召唤伴舞
+	gs.CurrentLevel.SceneType = "roof"
+	if slots := bs.vacantDancerSlots(leaderID, leader); len(slots) != 0 {
+		t.Errorf("屋顶场景不应召唤伴舞，实际站位 %v", slots)
+	}
+}
@@ -0,0 +1,245 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+)
+
+// updateDiggerZombies 更新所有矿工僵尸的挖掘状态
+//
+// 矿工僵尸的出土过程与是否在啃食无关，因此在逐个僵尸的行为处理之前单独更新：
+//   - 挖掘：在地下前进，到达草坪最左列后钻出地面
+//   - 钻出 → 落地 → 头晕：原地依次播放 drill、landing、dizzy 动画
+//   - 头晕结束后转身向右行走，从背后啃食植物
+func (s *BehaviorSystem) updateDiggerZombies(deltaTime float64) {
+	for _, entityID := range ecs.GetEntitiesWith1[*components.DiggerComponent](s.entityManager) {
+		digger, _ := ecs.GetComponent[*components.DiggerComponent](s.entityManager, entityID)
+
+		// 被魅惑的矿工僵尸按普通被魅惑僵尸行走
+		if s.isCharmedZombie(entityID) {
+			s.finishDigger(entityID)
+			continue
+		}
+		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+		if !ok {
+			continue
+		}
+		// 在地下被炸死等情况：回到地面播放普通死亡动画
+		if behavior.Type != components.BehaviorZombieBasic && behavior.Type != components.BehaviorZombieEating {
+			if s.isZombieBehaviorType(behavior.Type) {
+				s.finishDigger(entityID)
+			}
+			continue
+		}
+		if waveState, ok := ecs.GetComponent[*components.ZombieWaveStateComponent](s.entityManager, entityID); ok && !waveState.IsActivated {
+			continue
+		}
+
+		switch digger.State {
+		case components.DiggerStateTunneling:
+			s.updateDiggerTunneling(entityID, digger)
+		case components.DiggerStateDrilling:
+			if s.isDiggerAnimFinished(entityID) {
+				digger.State = components.DiggerStateLanding
+				s.playDiggerCombo(entityID, "landing")
+				s.setZombieShadowVisible(entityID, true)
+			}
+		case components.DiggerStateLanding:
+			if s.isDiggerAnimFinished(entityID) {
+				digger.State = components.DiggerStateDizzy
+				digger.DizzyTimer = config.DiggerDizzyDuration
+				s.playDiggerCombo(entityID, "dizzy")
+			}
+		case components.DiggerStateDizzy:
+			digger.DizzyTimer -= deltaTime
+			if digger.DizzyTimer <= 0 {
+				digger.State = components.DiggerStateWalking
+				s.stopEatingAndResume(entityID)
+			}
+		}
+	}
+}
+
+// updateDiggerTunneling 地下挖掘：入土时播放土堆效果，到达草坪最左列后钻出地面
+func (s *BehaviorSystem) updateDiggerTunneling(entityID ecs.EntityID, digger *components.DiggerComponent) {
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	if !digger.Burrowed {
+		digger.Burrowed = true
+		s.createDiggerDirt(position)
+		if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+			audioManager.PlaySound("SOUND_DIGGER_ZOMBIE")
+		}
+	}
+	// 地下没有阴影（读档恢复的矿工僵尸也在这里隐藏）
+	s.setZombieShadowVisible(entityID, false)
+
+	collisionOffsetX := 0.0
+	if collision, ok := ecs.GetComponent[*components.CollisionComponent](s.entityManager, entityID); ok {
+		collisionOffsetX = collision.OffsetX
+	}
+	surfaceX := config.GridWorldStartX + float64(config.DiggerSurfaceCol)*config.CellWidth + config.CellWidth/2
+	if position.X+collisionOffsetX <= surfaceX {
+		s.startDiggerSurfacing(entityID, digger, true)
+	}
+}
+
+// startDiggerSurfacing 矿工僵尸原地钻出地面
+//
+// 参数:
+//   - backward: 出土后是否转身向右行走（在草坪最左列出土时为 true，
+//     矿工镐被吸走就地钻出时为 false）
+func (s *BehaviorSystem) startDiggerSurfacing(entityID ecs.EntityID, digger *components.DiggerComponent, backward bool) {
+	log.Printf("[BehaviorSystem] 矿工僵尸 %d 钻出地面（转身: %v）", entityID, backward)
+	digger.State = components.DiggerStateDrilling
+	digger.Backward = backward
+
+	if velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, entityID); ok {
+		velocity.VX = 0
+	}
+
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	s.createDiggerDirt(position)
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_DIRT_RISE")
+	}
+
+	// 在土豆雷上钻出时引爆土豆雷，矿工僵尸被炸死
+	if s.detonatePotatoMineUnder(entityID, position) {
+		return
+	}
+
+	if backward {
+		entities.FaceZombieRight(s.entityManager, entityID)
+	}
+	s.playDiggerCombo(entityID, "drill")
+}
+
+// detonatePotatoMineUnder 引爆矿工僵尸出土位置的土豆雷
+//
+// 返回:
+//   - bool: 是否引爆了土豆雷（矿工僵尸随之烧焦死亡）
+func (s *BehaviorSystem) detonatePotatoMineUnder(entityID ecs.EntityID, position *components.PositionComponent) bool {
+	collisionOffsetX := 0.0
+	if collision, ok := ecs.GetComponent[*components.CollisionComponent](s.entityManager, entityID); ok {
+		collisionOffsetX = collision.OffsetX
+	}
+	col := int((position.X + collisionOffsetX - config.GridWorldStartX) / config.CellWidth)
+	row := s.zombieRowOf(position)
+
+	for _, plantID := range ecs.GetEntitiesWith1[*components.PlantComponent](s.entityManager) {
+		plant, _ := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID)
+		if plant.PlantType != components.PlantPotatoMine || !plant.OccupiesCell(col, row) {
+			continue
+		}
+
+		log.Printf("[BehaviorSystem] 矿工僵尸 %d 在土豆雷 %d 上钻出，土豆雷爆炸", entityID, plantID)
+		plantPos, hasPlantPos := ecs.GetComponent[*components.PositionComponent](s.entityManager, plantID)
+		s.destroyPlant(plantID)
+
+		if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+			audioManager.PlaySound("SOUND_POTATO_MINE")
+		}
+		if hasPlantPos {
+			if _, err := entities.CreateParticleEffect(s.entityManager, s.resourceManager, "PotatoMine", plantPos.X, plantPos.Y); err != nil {
+				log.Printf("[BehaviorSystem] 警告：创建土豆雷爆炸粒子效果失败: %v", err)
+			}
+		}
+
+		s.finishDigger(entityID)
+		if health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, entityID); ok {
+			health.CurrentHealth = 0
+		}
+		s.triggerZombieExplosionDeath(entityID)
+		return true
+	}
+	return false
+}
+
+// disarmDigger 矿工僵尸失去矿工镐：仍在地下时就地钻出，之后朝房子方向行走
+func (s *BehaviorSystem) disarmDigger(entityID ecs.EntityID) {
+	digger, ok := ecs.GetComponent[*components.DiggerComponent](s.entityManager, entityID)
+	if !ok || digger.State != components.DiggerStateTunneling {
+		return
+	}
+	s.startDiggerSurfacing(entityID, digger, false)
+}
+
+// finishDigger 结束矿工僵尸的挖掘状态：恢复阴影并移除矿工组件
+func (s *BehaviorSystem) finishDigger(entityID ecs.EntityID) {
+	s.setZombieShadowVisible(entityID, true)
+	ecs.RemoveComponent[*components.DiggerComponent](s.entityManager, entityID)
+}
+
+// isDiggerSurfacing 矿工僵尸是否正在出土（原地不动，不检测碰撞）
+func (s *BehaviorSystem) isDiggerSurfacing(entityID ecs.EntityID) bool {
+	digger, ok := ecs.GetComponent[*components.DiggerComponent](s.entityManager, entityID)
+	return ok && digger.IsSurfacing()
+}
+
+// isDiggerWalkingBackward 矿工僵尸是否在出土后向右行走
+func (s *BehaviorSystem) isDiggerWalkingBackward(entityID ecs.EntityID) bool {
+	digger, ok := ecs.GetComponent[*components.DiggerComponent](s.entityManager, entityID)
+	return ok && digger.Backward
+}
+
+// isUndergroundZombie 僵尸是否在地下（无法被攻击，不啃食植物）
+func (s *BehaviorSystem) isUndergroundZombie(entityID ecs.EntityID) bool {
+	return systems.IsUndergroundZombie(s.entityManager, entityID)
+}
+
+// isDiggerAnimFinished 矿工僵尸当前的非循环动画是否播放完毕
+func (s *BehaviorSystem) isDiggerAnimFinished(entityID ecs.EntityID) bool {
+	reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID)
+	return !ok || reanim.IsFinished
+}
+
+// playDiggerCombo 原地播放矿工僵尸的出土动画
+func (s *BehaviorSystem) playDiggerCombo(entityID ecs.EntityID, comboName string) {
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	behavior.ZombieAnimState = components.ZombieAnimIdle
+	s.resetZombieRootMotion(entityID)
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    behavior.UnitID,
+		ComboName: comboName,
+		Processed: false,
+	})
+}
+
+// createDiggerDirt 在矿工僵尸位置播放入土、出土的土堆效果
+func (s *BehaviorSystem) createDiggerDirt(position *components.PositionComponent) {
+	if _, err := entities.NewDiggerDirtEffect(s.entityManager, s.resourceManager, position.X, position.Y); err != nil {
+		log.Printf("[BehaviorSystem] 警告：创建矿工土堆效果失败: %v", err)
+	}
+	if _, err := entities.CreateParticleEffect(s.entityManager, s.resourceManager, "DiggerRise", position.X, position.Y); err != nil {
+		log.Printf("[BehaviorSystem] 警告：创建矿工出土粒子效果失败: %v", err)
+	}
+}
+
+// setZombieShadowVisible 显示或隐藏僵尸阴影（如地下的矿工僵尸没有阴影）
+func (s *BehaviorSystem) setZombieShadowVisible(entityID ecs.EntityID, visible bool) {
+	shadow, ok := ecs.GetComponent[*components.ShadowComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	if visible {
+		shadow.Alpha = config.DefaultShadowAlpha
+	} else {
+		shadow.Alpha = 0
+	}
+}
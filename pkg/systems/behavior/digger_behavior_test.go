@@ -0,0 +1,147 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// createTestDiggerZombie 创建测试用的在地下挖掘前进的矿工僵尸
+func createTestDiggerZombie(em *ecs.EntityManager, x, y float64) ecs.EntityID {
+	id := createTestWalkingZombie(em, x, y)
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, id)
+	behavior.UnitID = types.UnitIDZombieDigger
+	velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, id)
+	velocity.VX = config.DiggerTunnelSpeed
+	ecs.AddComponent(em, id, &components.DiggerComponent{State: components.DiggerStateTunneling})
+	ecs.AddComponent(em, id, &components.AccessoryComponent{
+		Pieces: []*components.AccessoryPiece{{Tag: types.AccessoryPickaxe, Tier: 0}},
+	})
+	return id
+}
+
+// TestTunnelingDiggerPassesUnderPlants 测试地下的矿工僵尸从植物下方经过而不啃食
+func TestTunnelingDiggerPassesUnderPlants(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	createTestGridPlant(em, components.PlantPeashooter, 5, 2)
+	diggerID := createTestDiggerZombie(em, config.GridWorldStartX+5*config.CellWidth+config.CellWidth/2, zombieYForRow(2))
+	position, _ := ecs.GetComponent[*components.PositionComponent](em, diggerID)
+	startX := position.X
+
+	bs.updateDiggerZombies(0.1)
+	bs.handleZombieBasicBehavior(diggerID, 0.1)
+
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, diggerID)
+	if behavior.Type != components.BehaviorZombieBasic {
+		t.Fatalf("地下的矿工僵尸不应啃食植物，实际行为 %v", behavior.Type)
+	}
+	if position.X >= startX {
+		t.Errorf("矿工僵尸应继续向左挖掘，X 从 %.1f 变为 %.1f", startX, position.X)
+	}
+	if !bs.isUndergroundZombie(diggerID) {
+		t.Error("挖掘中的矿工僵尸应在地下")
+	}
+}
+
+// TestDiggerSurfacesAtLeftmostColumnAndTurnsAround 测试矿工僵尸在草坪最左列出土，头晕后转身向右行走
+func TestDiggerSurfacesAtLeftmostColumnAndTurnsAround(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	diggerID := createTestDiggerZombie(em, config.GridWorldStartX+config.CellWidth/2-1, zombieYForRow(2))
+
+	bs.updateDiggerZombies(0.1)
+
+	digger, _ := ecs.GetComponent[*components.DiggerComponent](em, diggerID)
+	if digger.State != components.DiggerStateDrilling || !digger.Backward {
+		t.Fatalf("到达最左列后应钻出地面并准备转身，实际状态 %v，转身 %v", digger.State, digger.Backward)
+	}
+	if velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, diggerID); velocity.VX != 0 {
+		t.Errorf("出土时应原地不动，实际速度 %.1f", velocity.VX)
+	}
+	if scale, ok := ecs.GetComponent[*components.ScaleComponent](em, diggerID); !ok || scale.ScaleX >= 0 {
+		t.Error("出土后应转身朝右")
+	}
+	if cmd, _ := ecs.GetComponent[*components.AnimationCommandComponent](em, diggerID); cmd == nil || cmd.ComboName != "drill" {
+		t.Errorf("期望播放 drill 动画，实际 %+v", cmd)
+	}
+
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, diggerID)
+	reanim.IsFinished = true
+	bs.updateDiggerZombies(0.1)
+	if digger.State != components.DiggerStateLanding {
+		t.Fatalf("drill 动画结束后应落地，实际 %v", digger.State)
+	}
+	if bs.isUndergroundZombie(diggerID) {
+		t.Error("落地后不应再在地下")
+	}
+
+	bs.updateDiggerZombies(0.1)
+	if digger.State != components.DiggerStateDizzy {
+		t.Fatalf("landing 动画结束后应头晕，实际 %v", digger.State)
+	}
+
+	bs.updateDiggerZombies(config.DiggerDizzyDuration)
+	if digger.State != components.DiggerStateWalking {
+		t.Fatalf("头晕结束后应开始行走，实际 %v", digger.State)
+	}
+	if velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, diggerID); velocity.VX <= 0 {
+		t.Errorf("出土后应向右行走，实际速度 %.1f", velocity.VX)
+	}
+}
+
+// TestDiggerSurfacingOnPotatoMine 测试矿工僵尸在土豆雷上出土时引爆土豆雷并被炸死
+func TestDiggerSurfacingOnPotatoMine(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	mineID := createTestGridPlant(em, components.PlantPotatoMine, 0, 2)
+	diggerID := createTestDiggerZombie(em, config.GridWorldStartX+config.CellWidth/2-1, zombieYForRow(2))
+
+	bs.updateDiggerZombies(0.1)
+	em.RemoveMarkedEntities()
+
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, mineID); ok {
+		t.Error("土豆雷应被引爆")
+	}
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, diggerID)
+	if behavior.Type != components.BehaviorZombieDyingExplosion {
+		t.Errorf("矿工僵尸应被炸死，实际 %v", behavior.Type)
+	}
+	if _, ok := ecs.GetComponent[*components.DiggerComponent](em, diggerID); ok {
+		t.Error("炸死后应移除矿工组件")
+	}
+}
+
+// TestMagnetshroomStealsPickaxe 测试磁力菇吸走矿工镐后矿工僵尸就地钻出，之后朝房子方向行走
+func TestMagnetshroomStealsPickaxe(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	magnetID := createTestMagnetshroom(em, 300, 300)
+	diggerID := createTestDiggerZombie(em, 400, 300)
+
+	bs.handleMagnetshroomBehavior(magnetID, 0.016)
+
+	magnet, _ := ecs.GetComponent[*components.MagnetshroomComponent](em, magnetID)
+	if magnet.HeldAccessory != types.AccessoryPickaxe {
+		t.Fatalf("磁力菇应吸附矿工镐，实际为 %s", magnet.HeldAccessory)
+	}
+	digger, _ := ecs.GetComponent[*components.DiggerComponent](em, diggerID)
+	if digger.State != components.DiggerStateDrilling {
+		t.Errorf("失去矿工镐后应就地钻出，实际 %v", digger.State)
+	}
+	if digger.Backward {
+		t.Error("就地钻出后不应转身")
+	}
+}
@@ -0,0 +1,67 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+)
+
+// handleBloverBehavior 处理三叶草的行为
+//
+// 三叶草种下后播放吹风动画，动画结束时吹散草坪上的雾和空中的气球僵尸，
+// 之后持续吹风 BloverLingerDuration 秒后消失
+func (s *BehaviorSystem) handleBloverBehavior(entityID ecs.EntityID, deltaTime float64) {
+	blover, ok := ecs.GetComponent[*components.BloverComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	if !blover.Blown {
+		if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok && !reanim.IsFinished {
+			return
+		}
+		s.blowAway(entityID, blover)
+		return
+	}
+
+	blover.Timer -= deltaTime
+	if blover.Timer > 0 {
+		return
+	}
+	log.Printf("[BehaviorSystem] 三叶草 %d 吹风结束，消失", entityID)
+	s.destroyPlant(entityID)
+}
+
+// blowAway 三叶草吹风：吹散雾，把所有飞行中的气球僵尸吹出草坪
+func (s *BehaviorSystem) blowAway(entityID ecs.EntityID, blover *components.BloverComponent) {
+	blover.Blown = true
+	blover.Timer = config.BloverLingerDuration
+
+	systems.BlowAwayFog(s.entityManager)
+
+	blownCount := 0
+	for _, zombieID := range ecs.GetEntitiesWith1[*components.BalloonComponent](s.entityManager) {
+		balloon, _ := ecs.GetComponent[*components.BalloonComponent](s.entityManager, zombieID)
+		if !balloon.IsFlying() {
+			continue
+		}
+		s.recordZombieKilled(zombieID)
+		s.entityManager.DestroyEntity(zombieID)
+		blownCount++
+	}
+
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    "blover",
+		ComboName: "loop",
+		Processed: false,
+	})
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_BLOVER")
+	}
+
+	log.Printf("[BehaviorSystem] 三叶草 %d 吹散了雾和 %d 只气球僵尸", entityID, blownCount)
+}
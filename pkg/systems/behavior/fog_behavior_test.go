@@ -0,0 +1,63 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// TestBloverBlowsAwayFogAndBalloons 测试三叶草吹风动画结束后吹散雾和飞行中的气球僵尸，之后消失
+func TestBloverBlowsAwayFogAndBalloons(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	fog := &components.FogComponent{Columns: 4, RollIn: 1}
+	ecs.AddComponent(em, em.CreateEntity(), fog)
+
+	bloverID := createTestGridPlant(em, components.PlantBlover, 2, 2)
+	blover := &components.BloverComponent{}
+	ecs.AddComponent(em, bloverID, blover)
+	reanim := &components.ReanimComponent{}
+	ecs.AddComponent(em, bloverID, reanim)
+
+	flyingID := createTestBalloonZombie(em, 600, zombieYForRow(1))
+	fallingID := createTestBalloonZombie(em, 600, zombieYForRow(3))
+	falling, _ := ecs.GetComponent[*components.BalloonComponent](em, fallingID)
+	falling.State = components.BalloonStateFalling
+	walkerID := createTestWalkingZombie(em, 600, zombieYForRow(2))
+
+	bs.handleBloverBehavior(bloverID, 0.1)
+	if blover.Blown {
+		t.Fatal("吹风动画播放期间不应吹风")
+	}
+
+	reanim.IsFinished = true
+	bs.handleBloverBehavior(bloverID, 0.1)
+	em.RemoveMarkedEntities()
+
+	if !blover.Blown {
+		t.Fatal("吹风动画结束后应吹风")
+	}
+	if fog.RollIn != 0 || fog.BlowTimer != config.FogBlowAwayDuration {
+		t.Errorf("雾应被吹散，实际 RollIn %.2f BlowTimer %.2f", fog.RollIn, fog.BlowTimer)
+	}
+	if _, ok := ecs.GetComponent[*components.BehaviorComponent](em, flyingID); ok {
+		t.Error("飞行中的气球僵尸应被吹走")
+	}
+	if _, ok := ecs.GetComponent[*components.BehaviorComponent](em, fallingID); !ok {
+		t.Error("正在坠落的气球僵尸不应被吹走")
+	}
+	if _, ok := ecs.GetComponent[*components.BehaviorComponent](em, walkerID); !ok {
+		t.Error("地面上的僵尸不应被吹走")
+	}
+
+	bs.handleBloverBehavior(bloverID, config.BloverLingerDuration)
+	em.RemoveMarkedEntities()
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, bloverID); ok {
+		t.Error("三叶草吹风结束后应消失")
+	}
+}
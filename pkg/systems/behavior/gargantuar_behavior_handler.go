@@ -0,0 +1,276 @@
+package behavior
+
+import (
+	"log"
+	"math/rand"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// updateGargantuars 更新所有巨人僵尸的砸击和扔小鬼状态
+//
+// 巨人在逐个僵尸的行为处理中遇到植物时开始砸击（见 tryStartGargantuarSmash），
+// 砸击和扔小鬼期间原地播放动画，动画结束后恢复行走
+func (s *BehaviorSystem) updateGargantuars() {
+	for _, entityID := range ecs.GetEntitiesWith1[*components.GargantuarComponent](s.entityManager) {
+		gargantuar, _ := ecs.GetComponent[*components.GargantuarComponent](s.entityManager, entityID)
+		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+		if !ok || behavior.Type != components.BehaviorZombieBasic {
+			continue
+		}
+		if waveState, ok := ecs.GetComponent[*components.ZombieWaveStateComponent](s.entityManager, entityID); ok && !waveState.IsActivated {
+			continue
+		}
+
+		switch gargantuar.State {
+		case components.GargantuarStateWalking:
+			if s.shouldThrowImp(entityID, gargantuar) {
+				s.startGargantuarThrow(entityID, gargantuar)
+			}
+		case components.GargantuarStateSmashing:
+			if s.isGargantuarAnimFinished(entityID) {
+				s.finishGargantuarSmash(entityID, gargantuar)
+			}
+		case components.GargantuarStateThrowing:
+			if s.isGargantuarAnimFinished(entityID) {
+				s.throwImp(entityID, gargantuar)
+				gargantuar.State = components.GargantuarStateWalking
+				s.stopEatingAndResume(entityID)
+			}
+		}
+	}
+}
+
+// tryStartGargantuarSmash 巨人遇到挡路的植物或敌对僵尸时举起电线杆砸下
+// 地刺不挡路（detectPlantCollision 跳过地刺类植物），因此不会被砸
+//
+// 返回:
+//   - bool: 是否开始砸击（非巨人僵尸返回 false，按普通僵尸啃食）
+func (s *BehaviorSystem) tryStartGargantuarSmash(entityID, targetID ecs.EntityID) bool {
+	gargantuar, ok := ecs.GetComponent[*components.GargantuarComponent](s.entityManager, entityID)
+	if !ok {
+		return false
+	}
+
+	log.Printf("[BehaviorSystem] 巨人 %d 举起电线杆砸向 %d", entityID, targetID)
+	gargantuar.State = components.GargantuarStateSmashing
+	gargantuar.SmashTargetID = targetID
+	s.playGargantuarCombo(entityID, "smash")
+	return true
+}
+
+// finishGargantuarSmash 砸击动画结束：一击砸扁目标，之后恢复行走
+func (s *BehaviorSystem) finishGargantuarSmash(entityID ecs.EntityID, gargantuar *components.GargantuarComponent) {
+	targetID := gargantuar.SmashTargetID
+	gargantuar.State = components.GargantuarStateWalking
+	gargantuar.SmashTargetID = 0
+
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_GARGANTUAR_THUMP")
+	}
+
+	if _, isPlant := ecs.GetComponent[*components.PlantComponent](s.entityManager, targetID); isPlant {
+		log.Printf("[BehaviorSystem] 巨人 %d 砸扁植物 %d", entityID, targetID)
+		s.destroyPlant(targetID)
+	} else if health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, targetID); ok && health.CurrentHealth > 0 {
+		log.Printf("[BehaviorSystem] 巨人 %d 砸扁僵尸 %d", entityID, targetID)
+		health.CurrentHealth = 0
+	}
+
+	s.stopEatingAndResume(entityID)
+}
+
+// shouldThrowImp 巨人是否应该扔出小鬼：背上还有小鬼、生命值降到一半以下且离房子不太近
+func (s *BehaviorSystem) shouldThrowImp(entityID ecs.EntityID, gargantuar *components.GargantuarComponent) bool {
+	if !gargantuar.HasImp || s.isCharmedZombie(entityID) {
+		return false
+	}
+	health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, entityID)
+	if !ok || health.CurrentHealth*2 > health.MaxHealth {
+		return false
+	}
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	if !ok {
+		return false
+	}
+	return int((s.zombieCenterX(entityID, position)-config.GridWorldStartX)/config.CellWidth) >= config.GargantuarThrowMinCol
+}
+
+// startGargantuarThrow 巨人原地播放扔小鬼动画
+func (s *BehaviorSystem) startGargantuarThrow(entityID ecs.EntityID, gargantuar *components.GargantuarComponent) {
+	log.Printf("[BehaviorSystem] 巨人 %d 准备扔出小鬼", entityID)
+	gargantuar.State = components.GargantuarStateThrowing
+	s.playGargantuarCombo(entityID, "throw")
+}
+
+// throwImp 扔出背上的小鬼：在巨人手的位置创建小鬼，沿抛物线飞向草坪深处
+func (s *BehaviorSystem) throwImp(entityID ecs.EntityID, gargantuar *components.GargantuarComponent) {
+	gargantuar.HasImp = false
+	entities.HideGargantuarImp(s.entityManager, entityID)
+
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	row := s.zombieRowOf(position)
+	startX := s.zombieCenterX(entityID, position)
+
+	impID, err := entities.NewImpZombieEntity(s.entityManager, s.resourceManager, s.lawnLayout, row, startX)
+	if err != nil {
+		log.Printf("[BehaviorSystem] 警告：巨人扔出小鬼失败: %v", err)
+		return
+	}
+
+	impPos, _ := ecs.GetComponent[*components.PositionComponent](s.entityManager, impID)
+	imp := &components.ImpComponent{
+		State:   components.ImpStateFlying,
+		StartX:  startX,
+		StartY:  impPos.Y - config.ImpThrowReleaseHeight,
+		TargetX: impLandingX(startX),
+		GroundY: impPos.Y,
+	}
+	impPos.Y = imp.StartY
+	ecs.AddComponent(s.entityManager, impID, imp)
+
+	// 飞行中的小鬼在空中，普通子弹无法击中
+	if collision, ok := ecs.GetComponent[*components.CollisionComponent](s.entityManager, impID); ok {
+		collision.Altitude = config.ImpThrowArcHeight
+	}
+	ecs.AddComponent(s.entityManager, impID, &components.ZombieTargetLaneComponent{
+		TargetRow: row,
+	})
+	ecs.AddComponent(s.entityManager, impID, &components.AnimationCommandComponent{
+		UnitID:    types.UnitIDZombieImp,
+		ComboName: "thrown",
+		Processed: false,
+	})
+
+	// 小鬼不在关卡配置中，归入巨人所在波次并计入胜利条件
+	waveIndex := systems.SummonerWaveIndex(s.entityManager, s.gameState, entityID)
+	systems.RegisterSummonedZombie(s.entityManager, s.gameState, impID, waveIndex)
+
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_IMP")
+	}
+	log.Printf("[BehaviorSystem] 巨人 %d 扔出小鬼 %d（行 %d，X %.1f → %.1f）", entityID, impID, row, imp.StartX, imp.TargetX)
+}
+
+// impLandingX 随机计算小鬼的落点：向左扔出若干格，但不越过草坪左侧的落地边界
+func impLandingX(startX float64) float64 {
+	distance := config.ImpThrowDistanceMin + rand.Float64()*(config.ImpThrowDistanceMax-config.ImpThrowDistanceMin)
+	minX := config.GridWorldStartX + float64(config.ImpLandMinCol)*config.CellWidth + config.CellWidth/2
+	targetX := startX - distance*config.CellWidth
+	if targetX < minX {
+		targetX = minX
+	}
+	return targetX
+}
+
+// updateThrownImps 更新被扔出的小鬼：沿抛物线飞行，落地动画结束后按普通僵尸行走
+func (s *BehaviorSystem) updateThrownImps(deltaTime float64) {
+	for _, entityID := range ecs.GetEntitiesWith1[*components.ImpComponent](s.entityManager) {
+		imp, _ := ecs.GetComponent[*components.ImpComponent](s.entityManager, entityID)
+		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+		if !ok {
+			continue
+		}
+
+		// 在空中被打死：直接落地，播放普通死亡动画
+		if behavior.Type != components.BehaviorZombieBasic {
+			if s.isZombieBehaviorType(behavior.Type) {
+				s.landImp(entityID, imp)
+			}
+			continue
+		}
+
+		switch imp.State {
+		case components.ImpStateFlying:
+			imp.Elapsed += deltaTime
+			t := imp.Elapsed / config.ImpThrowDuration
+			if t > 1 {
+				t = 1
+			}
+			if position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID); ok {
+				position.X, position.Y = imp.ArcPosition(t, config.ImpThrowArcHeight)
+			}
+			if t < 1 {
+				continue
+			}
+
+			log.Printf("[BehaviorSystem] 小鬼 %d 落地 (X=%.1f)", entityID, imp.TargetX)
+			imp.State = components.ImpStateLanding
+			if collision, ok := ecs.GetComponent[*components.CollisionComponent](s.entityManager, entityID); ok {
+				collision.Altitude = 0
+			}
+			s.playGargantuarCombo(entityID, "land")
+		case components.ImpStateLanding:
+			if s.isGargantuarAnimFinished(entityID) {
+				ecs.RemoveComponent[*components.ImpComponent](s.entityManager, entityID)
+				s.stopEatingAndResume(entityID)
+			}
+		}
+	}
+}
+
+// landImp 小鬼立即落到落点所在行的地面并移除小鬼组件
+func (s *BehaviorSystem) landImp(entityID ecs.EntityID, imp *components.ImpComponent) {
+	if imp.State == components.ImpStateFlying {
+		if position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID); ok {
+			position.Y = imp.GroundY
+		}
+	}
+	if collision, ok := ecs.GetComponent[*components.CollisionComponent](s.entityManager, entityID); ok {
+		collision.Altitude = 0
+	}
+	ecs.RemoveComponent[*components.ImpComponent](s.entityManager, entityID)
+}
+
+// isGargantuarBusy 巨人是否正在砸击或扔小鬼（原地不动，不检测碰撞）
+func (s *BehaviorSystem) isGargantuarBusy(entityID ecs.EntityID) bool {
+	gargantuar, ok := ecs.GetComponent[*components.GargantuarComponent](s.entityManager, entityID)
+	return ok && gargantuar.State != components.GargantuarStateWalking
+}
+
+// isImpThrown 小鬼是否正在被扔出（飞行或落地中）
+func (s *BehaviorSystem) isImpThrown(entityID ecs.EntityID) bool {
+	_, ok := ecs.GetComponent[*components.ImpComponent](s.entityManager, entityID)
+	return ok
+}
+
+// isGargantuarAnimFinished 巨人（或小鬼）当前的非循环动画是否播放完毕
+func (s *BehaviorSystem) isGargantuarAnimFinished(entityID ecs.EntityID) bool {
+	reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID)
+	return !ok || reanim.IsFinished
+}
+
+// playGargantuarCombo 原地播放巨人（或小鬼）的动画
+func (s *BehaviorSystem) playGargantuarCombo(entityID ecs.EntityID, comboName string) {
+	if velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, entityID); ok {
+		velocity.VX = 0
+	}
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	behavior.ZombieAnimState = components.ZombieAnimIdle
+	s.resetZombieRootMotion(entityID)
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    behavior.UnitID,
+		ComboName: comboName,
+		Processed: false,
+	})
+}
+
+// zombieCenterX 僵尸碰撞盒中心的 X 坐标
+func (s *BehaviorSystem) zombieCenterX(entityID ecs.EntityID, position *components.PositionComponent) float64 {
+	if collision, ok := ecs.GetComponent[*components.CollisionComponent](s.entityManager, entityID); ok {
+		return position.X + collision.OffsetX
+	}
+	return position.X
+}
@@ -0,0 +1,164 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// createTestGargantuar 创建测试用的背着小鬼的白眼巨人
+func createTestGargantuar(em *ecs.EntityManager, x, y float64) ecs.EntityID {
+	id := createTestWalkingZombie(em, x, y)
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, id)
+	behavior.UnitID = types.UnitIDZombieGargantuar
+	health, _ := ecs.GetComponent[*components.HealthComponent](em, id)
+	health.CurrentHealth = config.GargantuarDefaultHealth
+	health.MaxHealth = config.GargantuarDefaultHealth
+	ecs.AddComponent(em, id, &components.GargantuarComponent{HasImp: true})
+	return id
+}
+
+// TestGargantuarSmashesPlant 测试巨人遇到植物时不啃食，而是在砸击动画结束时一击砸扁植物
+func TestGargantuarSmashesPlant(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	plantID := createTestGridPlant(em, components.PlantWallnut, 5, 2)
+	gargID := createTestGargantuar(em, config.GridWorldStartX+5*config.CellWidth+config.CellWidth/2, zombieYForRow(2))
+
+	bs.handleZombieBasicBehavior(gargID, 0.1)
+
+	gargantuar, _ := ecs.GetComponent[*components.GargantuarComponent](em, gargID)
+	if gargantuar.State != components.GargantuarStateSmashing || gargantuar.SmashTargetID != plantID {
+		t.Fatalf("巨人应开始砸向植物 %d，实际状态 %v，目标 %d", plantID, gargantuar.State, gargantuar.SmashTargetID)
+	}
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, gargID)
+	if behavior.Type != components.BehaviorZombieBasic {
+		t.Errorf("巨人不应啃食植物，实际行为 %v", behavior.Type)
+	}
+	if cmd, _ := ecs.GetComponent[*components.AnimationCommandComponent](em, gargID); cmd == nil || cmd.ComboName != "smash" {
+		t.Errorf("期望播放 smash 动画，实际 %+v", cmd)
+	}
+
+	// 砸击动画播放中植物不受影响
+	bs.updateGargantuars()
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, plantID); !ok {
+		t.Fatal("砸击动画结束前植物不应被砸扁")
+	}
+
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, gargID)
+	reanim.IsFinished = true
+	bs.updateGargantuars()
+	em.RemoveMarkedEntities()
+
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, plantID); ok {
+		t.Error("砸击动画结束后植物应被砸扁")
+	}
+	if gargantuar.State != components.GargantuarStateWalking {
+		t.Errorf("砸击后应恢复行走，实际 %v", gargantuar.State)
+	}
+	if systems.CanBeSwallowed(em, gargID) {
+		t.Error("大嘴花不应能吞下巨人")
+	}
+}
+
+// TestGargantuarThrowsImpBelowHalfHealth 测试巨人生命值降到一半以下时扔出小鬼，离房子太近时不扔
+func TestGargantuarThrowsImpBelowHalfHealth(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	gargID := createTestGargantuar(em, config.GridWorldStartX+7*config.CellWidth+config.CellWidth/2, zombieYForRow(2))
+	nearID := createTestGargantuar(em, config.GridWorldStartX+2*config.CellWidth+config.CellWidth/2, zombieYForRow(3))
+	for _, id := range []ecs.EntityID{gargID, nearID} {
+		health, _ := ecs.GetComponent[*components.HealthComponent](em, id)
+		health.CurrentHealth = config.GargantuarDefaultHealth / 2
+	}
+
+	bs.updateGargantuars()
+
+	gargantuar, _ := ecs.GetComponent[*components.GargantuarComponent](em, gargID)
+	if gargantuar.State != components.GargantuarStateThrowing {
+		t.Fatalf("生命值降到一半后应扔出小鬼，实际 %v", gargantuar.State)
+	}
+	if cmd, _ := ecs.GetComponent[*components.AnimationCommandComponent](em, gargID); cmd == nil || cmd.ComboName != "throw" {
+		t.Errorf("期望播放 throw 动画，实际 %+v", cmd)
+	}
+	if near, _ := ecs.GetComponent[*components.GargantuarComponent](em, nearID); near.State != components.GargantuarStateWalking {
+		t.Error("离房子太近的巨人不应扔出小鬼")
+	}
+
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, gargID)
+	reanim.IsFinished = true
+	bs.updateGargantuars()
+
+	if gargantuar.HasImp || gargantuar.State != components.GargantuarStateWalking {
+		t.Errorf("扔出小鬼后应恢复行走且背上没有小鬼，实际状态 %v，HasImp %v", gargantuar.State, gargantuar.HasImp)
+	}
+	for _, track := range config.GargantuarImpTracks {
+		if !reanim.HiddenTracks[track] {
+			t.Errorf("轨道 %s 应被隐藏", track)
+		}
+	}
+}
+
+// TestThrownImpFollowsArcAndLands 测试被扔出的小鬼沿抛物线飞到落点，落地动画结束后快速行走
+func TestThrownImpFollowsArcAndLands(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	groundY := zombieYForRow(2)
+	startX := config.GridWorldStartX + 7*config.CellWidth
+	impID := createTestWalkingZombie(em, startX, groundY-config.ImpThrowReleaseHeight)
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, impID)
+	behavior.UnitID = types.UnitIDZombieImp
+	collision, _ := ecs.GetComponent[*components.CollisionComponent](em, impID)
+	collision.Altitude = config.ImpThrowArcHeight
+	imp := &components.ImpComponent{
+		StartX:  startX,
+		StartY:  groundY - config.ImpThrowReleaseHeight,
+		TargetX: impLandingX(startX),
+		GroundY: groundY,
+	}
+	ecs.AddComponent(em, impID, imp)
+
+	if imp.TargetX >= startX || imp.TargetX < config.GridWorldStartX+config.ImpLandMinCol*config.CellWidth {
+		t.Fatalf("落点应在出手点左侧的草坪内，实际 %.1f", imp.TargetX)
+	}
+
+	position, _ := ecs.GetComponent[*components.PositionComponent](em, impID)
+	bs.updateThrownImps(config.ImpThrowDuration / 2)
+	if position.Y >= imp.StartY || position.X >= startX || position.X <= imp.TargetX {
+		t.Errorf("飞行一半时应在出手点和落点之间、高于出手点，实际 (%.1f, %.1f)", position.X, position.Y)
+	}
+	bs.handleZombieBasicBehavior(impID, 0.1)
+	if imp.State != components.ImpStateFlying {
+		t.Fatal("飞行中的小鬼不应受普通行为影响")
+	}
+
+	bs.updateThrownImps(config.ImpThrowDuration)
+	if position.X != imp.TargetX || position.Y != groundY {
+		t.Fatalf("应落在落点 (%.1f, %.1f)，实际 (%.1f, %.1f)", imp.TargetX, groundY, position.X, position.Y)
+	}
+	if imp.State != components.ImpStateLanding || collision.IsAirborne() {
+		t.Fatalf("落地后应播放落地动画并回到地面，实际状态 %v", imp.State)
+	}
+
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, impID)
+	reanim.IsFinished = true
+	bs.updateThrownImps(0.1)
+
+	if _, ok := ecs.GetComponent[*components.ImpComponent](em, impID); ok {
+		t.Error("落地动画结束后应移除小鬼组件")
+	}
+	if velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, impID); velocity.VX != config.ImpWalkSpeed {
+		t.Errorf("小鬼应以 %.1f 的速度行走，实际 %.1f", config.ImpWalkSpeed, velocity.VX)
+	}
+}
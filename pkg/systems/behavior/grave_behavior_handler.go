@@ -0,0 +1,101 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// handleGraveBusterBehavior 处理墓碑吞噬者的行为
+//
+// 墓碑吞噬者先播放落到墓碑上的动画，落地后开始啃食墓碑；
+// 啃食 GraveBusterEatDuration 后连同墓碑一起消失，格子恢复可种植
+func (s *BehaviorSystem) handleGraveBusterBehavior(entityID ecs.EntityID, deltaTime float64) {
+	buster, ok := ecs.GetComponent[*components.GraveBusterComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	if !buster.Landed {
+		if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok && !reanim.IsFinished {
+			return
+		}
+		buster.Landed = true
+		ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+			UnitID:    "gravebuster",
+			ComboName: "idle",
+			Processed: false,
+		})
+		if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+			audioManager.PlaySound("SOUND_GRAVEBUSTERCHOMP")
+		}
+		if position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID); ok {
+			if _, err := entities.CreateParticleEffect(s.entityManager, s.resourceManager, "GraveBuster", position.X, position.Y); err != nil {
+				log.Printf("[BehaviorSystem] 警告：创建墓碑吞噬者粒子效果失败: %v", err)
+			}
+		}
+		log.Printf("[BehaviorSystem] 墓碑吞噬者 %d 开始吞噬墓碑 %d", entityID, buster.GraveID)
+		return
+	}
+
+	buster.EatTimer -= deltaTime
+	if buster.EatTimer > 0 {
+		return
+	}
+	s.finishGraveBuster(entityID, buster)
+}
+
+// finishGraveBuster 墓碑被吞掉：墓碑和墓碑吞噬者一起消失
+func (s *BehaviorSystem) finishGraveBuster(entityID ecs.EntityID, buster *components.GraveBusterComponent) {
+	if grave, ok := ecs.GetComponent[*components.GraveComponent](s.entityManager, buster.GraveID); ok {
+		if s.lawnGridSystem != nil {
+			s.lawnGridSystem.RemoveGrave(s.lawnGridEntityID, grave.Col, grave.Row)
+		}
+		s.entityManager.DestroyEntity(buster.GraveID)
+	}
+
+	if position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID); ok {
+		if _, err := entities.CreateParticleEffect(s.entityManager, s.resourceManager, "GraveBusterDie", position.X, position.Y); err != nil {
+			log.Printf("[BehaviorSystem] 警告：创建墓碑吞噬者消失粒子效果失败: %v", err)
+		}
+	}
+
+	log.Printf("[BehaviorSystem] 墓碑吞噬者 %d 吞掉了墓碑 %d", entityID, buster.GraveID)
+	s.destroyPlant(entityID)
+}
+
+// updateRisingZombies 从地下爬出的僵尸（最后一波从墓碑下爬出）逐渐升到行中心，爬出后开始行走
+func (s *BehaviorSystem) updateRisingZombies(deltaTime float64) {
+	for _, entityID := range ecs.GetEntitiesWith1[*components.ZombieRiseComponent](s.entityManager) {
+		rise, _ := ecs.GetComponent[*components.ZombieRiseComponent](s.entityManager, entityID)
+		position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+		if !ok {
+			continue
+		}
+
+		rise.Timer -= deltaTime
+		if rise.Timer < 0 {
+			rise.Timer = 0
+		}
+		duration := rise.Duration
+		if duration <= 0 {
+			duration = config.GraveZombieRiseDuration
+		}
+		position.Y = rise.TargetY + config.GraveZombieRiseDepth*rise.Timer/duration
+		if rise.Timer > 0 {
+			continue
+		}
+
+		ecs.RemoveComponent[*components.ZombieRiseComponent](s.entityManager, entityID)
+		log.Printf("[BehaviorSystem] 僵尸 %d 从地下爬出", entityID)
+	}
+}
+
+// isZombieRising 僵尸是否正从地下爬出（原地不动）
+func (s *BehaviorSystem) isZombieRising(entityID ecs.EntityID) bool {
+	return ecs.HasComponent[*components.ZombieRiseComponent](s.entityManager, entityID)
+}
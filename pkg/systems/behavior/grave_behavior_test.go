@@ -0,0 +1,102 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// TestGraveBusterEatsGrave 测试墓碑吞噬者落地后啃食墓碑，结束后连同墓碑一起消失并释放格子
+func TestGraveBusterEatsGrave(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	graveID := em.CreateEntity()
+	ecs.AddComponent(em, graveID, &components.GraveComponent{Col: 6, Row: 3})
+	if err := bs.lawnGridSystem.PlaceGrave(bs.lawnGridEntityID, 6, 3, graveID); err != nil {
+		t.Fatalf("Failed to place grave: %v", err)
+	}
+
+	busterID := createTestGridPlant(em, components.PlantGraveBuster, 6, 3)
+	buster := &components.GraveBusterComponent{GraveID: graveID, EatTimer: config.GraveBusterEatDuration}
+	ecs.AddComponent(em, busterID, buster)
+	reanim := &components.ReanimComponent{}
+	ecs.AddComponent(em, busterID, reanim)
+	if err := bs.lawnGridSystem.OccupyCell(bs.lawnGridEntityID, 6, 3, busterID); err != nil {
+		t.Fatalf("Failed to occupy cell: %v", err)
+	}
+
+	bs.handleGraveBusterBehavior(busterID, 0.1)
+	if buster.Landed {
+		t.Fatal("落地动画播放完之前不应开始吞噬")
+	}
+
+	reanim.IsFinished = true
+	bs.handleGraveBusterBehavior(busterID, 0.1)
+	if !buster.Landed {
+		t.Fatal("落地动画结束后应开始吞噬墓碑")
+	}
+
+	bs.handleGraveBusterBehavior(busterID, config.GraveBusterEatDuration-0.5)
+	if !bs.lawnGridSystem.HasGrave(bs.lawnGridEntityID, 6, 3) {
+		t.Fatal("吞噬结束前墓碑应仍在")
+	}
+
+	bs.handleGraveBusterBehavior(busterID, 1.0)
+	em.RemoveMarkedEntities()
+
+	if bs.lawnGridSystem.HasGrave(bs.lawnGridEntityID, 6, 3) {
+		t.Error("墓碑被吞掉后格子不应再有墓碑")
+	}
+	if bs.lawnGridSystem.IsOccupied(bs.lawnGridEntityID, 6, 3) {
+		t.Error("墓碑吞噬者消失后格子应被释放")
+	}
+	if _, ok := ecs.GetComponent[*components.GraveComponent](em, graveID); ok {
+		t.Error("墓碑实体应被删除")
+	}
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, busterID); ok {
+		t.Error("墓碑吞噬者应随墓碑一起消失")
+	}
+}
+
+// TestRisingZombieWalksAfterRise 测试从墓碑下爬出的僵尸在爬出前原地不动，升到地面后开始行走
+func TestRisingZombieWalksAfterRise(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	startX := config.GridWorldStartX + 7*config.CellWidth + config.CellWidth/2
+	targetY := zombieYForRow(1)
+	zombieID := createTestWalkingZombie(em, startX, targetY+config.GraveZombieRiseDepth)
+	ecs.AddComponent(em, zombieID, &components.ZombieRiseComponent{
+		Timer:   config.GraveZombieRiseDuration,
+		TargetY: targetY,
+	})
+	position, _ := ecs.GetComponent[*components.PositionComponent](em, zombieID)
+
+	bs.updateRisingZombies(config.GraveZombieRiseDuration / 2)
+	bs.handleZombieBasicBehavior(zombieID, 0.1)
+	if position.X != startX {
+		t.Errorf("爬出期间不应移动，实际 X %.1f → %.1f", startX, position.X)
+	}
+	if position.Y <= targetY || position.Y >= targetY+config.GraveZombieRiseDepth {
+		t.Errorf("爬出一半时应在地面和起点之间，实际 Y %.1f", position.Y)
+	}
+
+	bs.updateRisingZombies(config.GraveZombieRiseDuration)
+	if position.Y != targetY {
+		t.Fatalf("爬出后应站在行中心，实际 Y %.1f，期望 %.1f", position.Y, targetY)
+	}
+	if bs.isZombieRising(zombieID) {
+		t.Fatal("爬出后应移除爬出状态")
+	}
+
+	bs.handleZombieBasicBehavior(zombieID, 0.1)
+	if position.X >= startX {
+		t.Errorf("爬出后应开始向左行走，实际 X %.1f", position.X)
+	}
+}
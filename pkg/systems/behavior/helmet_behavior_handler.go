@@ -0,0 +1,63 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// updateZombieHelmet 更新头部防具（I类饰品）的状态
+//
+// 路障、铁桶僵尸由各自的行为处理器切换为普通僵尸，这里处理其余戴头盔的僵尸（如橄榄球僵尸）：
+// 头盔耐久耗尽时移除头盔并播放掉落效果，僵尸保持原有单位和动画。
+// 头盔的受损外观由渲染系统根据剩余耐久绘制
+//
+// 已被路障/铁桶处理器隐藏轨道的饰品只做移除，不重复播放掉落效果
+func (s *BehaviorSystem) updateZombieHelmet(entityID ecs.EntityID) {
+	accessory, ok := ecs.GetComponent[*components.AccessoryComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	helmet := accessory.Helmet()
+	if helmet == nil || helmet.Durability > 0 {
+		return
+	}
+
+	alreadyHidden := false
+	if cfg := config.GetAccessoryConfig(helmet.Tag); cfg != nil && cfg.TrackName != "" {
+		if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok {
+			alreadyHidden = reanim.HiddenTracks[cfg.TrackName]
+		}
+	}
+	if !alreadyHidden {
+		log.Printf("[BehaviorSystem] 僵尸 %d 的 %s 被打掉", entityID, helmet.Tag)
+		s.spawnAccessoryDropEffect(entityID, helmet.Tag)
+	}
+	s.removeZombieAccessory(entityID, helmet.Tag)
+}
+
+// spawnAccessoryDropEffect 播放饰品被打掉的掉落粒子效果
+func (s *BehaviorSystem) spawnAccessoryDropEffect(entityID ecs.EntityID, tag types.AccessoryTag) {
+	cfg := config.GetAccessoryConfig(tag)
+	if cfg == nil || cfg.DropParticle == "" {
+		return
+	}
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	// 粒子配置为向右走的僵尸设计，僵尸向左走时翻转方向
+	angleOffset := 180.0
+	if s.isCharmedZombie(entityID) {
+		angleOffset = 0
+	}
+
+	if _, err := entities.CreateParticleEffect(s.entityManager, s.resourceManager, cfg.DropParticle, position.X, position.Y, angleOffset); err != nil {
+		log.Printf("[BehaviorSystem] 警告：创建饰品掉落粒子失败 (%s): %v", cfg.DropParticle, err)
+	}
+}
@@ -0,0 +1,76 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// createTestFootballZombie 创建测试用的橄榄球僵尸
+func createTestFootballZombie(em *ecs.EntityManager) (ecs.EntityID, *components.AccessoryPiece) {
+	id := createTestWalkingZombie(em, 500, zombieYForRow(2))
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, id)
+	behavior.UnitID = types.UnitIDZombieFootball
+
+	helmet := &components.AccessoryPiece{
+		Tag:           types.AccessoryFootballHelmet,
+		Tier:          1,
+		Durability:    config.FootballHelmetHealth,
+		MaxDurability: config.FootballHelmetHealth,
+	}
+	ecs.AddComponent(em, id, &components.AccessoryComponent{
+		Pieces: []*components.AccessoryPiece{helmet},
+	})
+	return id, helmet
+}
+
+// TestFootballHelmetDestroyed 测试橄榄球头盔被打坏后移除头盔，僵尸保持橄榄球僵尸单位
+func TestFootballHelmetDestroyed(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	zombieID, helmet := createTestFootballZombie(em)
+
+	// 头盔完好时不移除
+	helmet.Durability = config.FootballHelmetHealth / 2
+	bs.updateZombieHelmet(zombieID)
+	accessory, _ := ecs.GetComponent[*components.AccessoryComponent](em, zombieID)
+	if !accessory.Has(types.AccessoryFootballHelmet) {
+		t.Fatal("头盔未被打坏时不应移除")
+	}
+
+	helmet.Durability = -20
+	bs.updateZombieHelmet(zombieID)
+
+	if accessory.Has(types.AccessoryFootballHelmet) {
+		t.Error("头盔被打坏后应移除")
+	}
+	if !helmet.Removed {
+		t.Error("头盔应标记为已移除，渲染时隐藏头盔轨道")
+	}
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, zombieID)
+	if behavior.UnitID != types.UnitIDZombieFootball {
+		t.Errorf("失去头盔后应保持橄榄球僵尸单位，实际 %s", behavior.UnitID)
+	}
+}
+
+// TestAccessoryDamageStageTable 测试路障、铁桶、橄榄球头盔都有三个受损阶段
+func TestAccessoryDamageStageTable(t *testing.T) {
+	for _, tag := range []types.AccessoryTag{
+		types.AccessoryCone,
+		types.AccessoryBucket,
+		types.AccessoryFootballHelmet,
+		types.AccessoryNewspaper,
+		types.AccessoryScreenDoor,
+	} {
+		cfg := config.GetAccessoryConfig(tag)
+		if cfg == nil || cfg.ImageKey == "" || len(cfg.DamageImages) != 3 {
+			t.Errorf("%s 缺少受损阶段配置: %+v", tag, cfg)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package behavior
+
+import (
+	"log"
+	"math"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// isCharmedZombie 判断僵尸是否已被魅惑（属于植物阵营）
+func (s *BehaviorSystem) isCharmedZombie(entityID ecs.EntityID) bool {
+	return systems.IsCharmedZombie(s.entityManager, entityID)
+}
+
+// eatHypnoshroom 僵尸咬下催眠菇：催眠菇消失，僵尸被魅惑
+//
+// 参数:
+//   - zombieID: 啃食催眠菇的僵尸实体ID
+//   - plantID: 催眠菇实体ID
+func (s *BehaviorSystem) eatHypnoshroom(zombieID, plantID ecs.EntityID) {
+	log.Printf("[BehaviorSystem] 僵尸 %d 啃食催眠菇 %d，被魅惑", zombieID, plantID)
+
+	// 释放网格占用状态，允许重新种植
+	if plantComp, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID); ok {
+		if s.lawnGridSystem != nil && s.lawnGridEntityID != 0 {
+			if err := s.lawnGridSystem.ReleaseCells(s.lawnGridEntityID, plantComp.GridCol, plantComp.GridRow, components.PlantFootprintWidth(plantComp.PlantType)); err != nil {
+				log.Printf("[BehaviorSystem] 警告：释放网格占用失败: %v", err)
+			}
+		}
+	}
+	s.entityManager.DestroyEntity(plantID)
+
+	s.charmZombie(zombieID)
+}
+
+// charmZombie 魅惑僵尸，使其倒戈为植物作战
+//
+// 处理内容:
+//   - 切换阵营、镜像显示（entities.CharmZombie）
+//   - 计入消灭数：被魅惑的僵尸不再是敌人，胜利条件不再等待它
+//   - 停止啃食，转身向右移动
+func (s *BehaviorSystem) charmZombie(zombieID ecs.EntityID) {
+	if s.isCharmedZombie(zombieID) {
+		return
+	}
+
+	entities.CharmZombie(s.entityManager, zombieID)
+	s.gameState.IncrementZombiesKilled()
+
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_MINDCONTROLLED")
+	}
+
+	// 恢复移动（stopEatingAndResume 根据阵营决定移动方向）
+	s.stopEatingAndResume(zombieID)
+}
+
+// recordZombieKilled 僵尸死亡时增加消灭计数
+// 被魅惑的僵尸在被魅惑时已经计入，死亡时不再重复计数
+func (s *BehaviorSystem) recordZombieKilled(entityID ecs.EntityID) {
+	if s.isCharmedZombie(entityID) {
+		return
+	}
+	s.gameState.IncrementZombiesKilled()
+}
+
+// zombieWalkSpeed 返回僵尸按阵营决定的行走速度
+// 在草坪最左列出土的矿工僵尸向右行走，小鬼僵尸走得更快
+func (s *BehaviorSystem) zombieWalkSpeed(entityID ecs.EntityID) float64 {
+	if s.isCharmedZombie(entityID) {
+		return config.CharmedZombieWalkSpeed
+	}
+	if s.isDiggerWalkingBackward(entityID) {
+		return -config.ZombieWalkSpeed
+	}
+	if behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID); ok && behavior.UnitID == types.UnitIDZombieImp {
+		return config.ImpWalkSpeed
+	}
+	if _, ok := ecs.GetComponent[*components.CatapultComponent](s.entityManager, entityID); ok {
+		return config.CatapultDriveSpeed
+	}
+	if s.isZomboni(entityID) {
+		return config.ZomboniDriveSpeed
+	}
+	return config.ZombieWalkSpeed
+}
+
+// detectHostileZombieCollision 检测前方是否有敌对阵营的僵尸
+//
+// 普通僵尸检测左侧的被魅惑僵尸，被魅惑僵尸检测右侧的普通僵尸
+//
+// 参数:
+//   - entityID: 检测者僵尸实体ID
+//   - centerX: 检测者碰撞盒中心 X 坐标
+//   - row: 检测者所在行
+//
+// 返回:
+//   - ecs.EntityID: 敌对僵尸ID
+//   - bool: 是否检测到
+func (s *BehaviorSystem) detectHostileZombieCollision(entityID ecs.EntityID, centerX float64, row int) (ecs.EntityID, bool) {
+	charmed := s.isCharmedZombie(entityID)
+
+	candidates := ecs.GetEntitiesWith2[
+		*components.BehaviorComponent,
+		*components.PositionComponent,
+	](s.entityManager)
+
+	var targetID ecs.EntityID
+	minDistance := math.MaxFloat64
+
+	for _, otherID := range candidates {
+		if otherID == entityID {
+			continue
+		}
+
+		behavior, _ := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, otherID)
+		if !s.isZombieBehaviorType(behavior.Type) || behavior.Type == components.BehaviorZombieDying {
+			continue
+		}
+
+		// 同阵营不互相攻击
+		if s.isCharmedZombie(otherID) == charmed {
+			continue
+		}
+
+		// 未激活的僵尸不参与战斗
+		if waveState, ok := ecs.GetComponent[*components.ZombieWaveStateComponent](s.entityManager, otherID); ok {
+			if !waveState.IsActivated {
+				continue
+			}
+		}
+
+		pos, _ := ecs.GetComponent[*components.PositionComponent](s.entityManager, otherID)
+		otherRow := s.lawnLayout.RowAt(pos.X, pos.Y-config.ZombieVerticalOffset)
+		if otherRow != row {
+			continue
+		}
+
+		otherCenterX := pos.X
+		if collision, ok := ecs.GetComponent[*components.CollisionComponent](s.entityManager, otherID); ok {
+			// 空中的僵尸（气球僵尸）无法被地面僵尸啃食
+			if collision.IsAirborne() {
+				continue
+			}
+			// 地下的僵尸（矿工僵尸）同样无法被啃食
+			if s.isUndergroundZombie(otherID) {
+				continue
+			}
+			otherCenterX += collision.OffsetX
+		}
+
+		// 只检测面朝方向上的敌人
+		distance := otherCenterX - centerX
+		if !charmed {
+			distance = -distance
+		}
+		if distance < 0 || distance > config.ZombieBiteRange {
+			continue
+		}
+
+		if distance < minDistance {
+			minDistance = distance
+			targetID = otherID
+		}
+	}
+
+	return targetID, targetID != 0
+}
+
+// biteZombie 啃食敌对僵尸，与子弹相同的顺序扣除耐久：
+// 正面的手持防具（铁栅门、报纸）→ 头部防具 → 生命值
+//
+// 被啃食僵尸的死亡和防具的移除由其自身的行为处理逻辑检测（耐久/生命值 <= 0）
+func (s *BehaviorSystem) biteZombie(entityID, targetID ecs.EntityID) {
+	dirX := 0.0
+	attackerPos, ok1 := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	targetPos, ok2 := ecs.GetComponent[*components.PositionComponent](s.entityManager, targetID)
+	if ok1 && ok2 {
+		dirX = targetPos.X - attackerPos.X
+	}
+
+	if shield := systems.ZombieShieldFacing(s.entityManager, targetID, dirX); shield != nil {
+		shield.Durability -= config.ZombieEatingDamage
+	} else if helmet := systems.ZombieHelmet(s.entityManager, targetID); helmet != nil {
+		helmet.Durability -= config.ZombieEatingDamage
+	} else if health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, targetID); ok {
+		health.CurrentHealth -= config.ZombieEatingDamage
+	}
+
+	log.Printf("[BehaviorSystem] 僵尸 %d 啃食敌对僵尸 %d，造成 %d 伤害", entityID, targetID, config.ZombieEatingDamage)
+}
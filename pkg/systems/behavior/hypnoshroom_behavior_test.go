@@ -0,0 +1,173 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// createTestWalkingZombie 创建测试用的普通僵尸实体
+func createTestWalkingZombie(em *ecs.EntityManager, x, y float64) ecs.EntityID {
+	id := em.CreateEntity()
+	ecs.AddComponent(em, id, &components.PositionComponent{X: x, Y: y})
+	ecs.AddComponent(em, id, &components.BehaviorComponent{Type: components.BehaviorZombieBasic, UnitID: types.UnitIDZombie})
+	ecs.AddComponent(em, id, &components.HealthComponent{CurrentHealth: 270, MaxHealth: 270})
+	ecs.AddComponent(em, id, &components.VelocityComponent{VX: config.ZombieWalkSpeed})
+	ecs.AddComponent(em, id, &components.CollisionComponent{
+		Width:  config.ZombieCollisionWidth,
+		Height: config.ZombieCollisionHeight,
+	})
+	ecs.AddComponent(em, id, &components.ReanimComponent{})
+	return id
+}
+
+// TestEatHypnoshroomCharmsZombie 测试僵尸啃食催眠菇后被魅惑
+func TestEatHypnoshroomCharmsZombie(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	gs := game.GetGameState()
+	bs := createTestBehaviorSystem(em, rm, gs)
+
+	plantID := em.CreateEntity()
+	ecs.AddComponent(em, plantID, &components.PlantComponent{PlantType: components.PlantHypnoshroom, GridRow: 2, GridCol: 4})
+
+	zombieID := createTestWalkingZombie(em, 500, 300)
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, zombieID)
+	behavior.Type = components.BehaviorZombieEating
+	ecs.RemoveComponent[*components.VelocityComponent](em, zombieID)
+
+	killedBefore := gs.ZombiesKilled
+	bs.eatHypnoshroom(zombieID, plantID)
+
+	if !bs.isCharmedZombie(zombieID) {
+		t.Fatal("僵尸啃食催眠菇后应被魅惑")
+	}
+	if gs.ZombiesKilled != killedBefore+1 {
+		t.Errorf("被魅惑的僵尸应计入消灭数，期望 %d，实际 %d", killedBefore+1, gs.ZombiesKilled)
+	}
+
+	velocity, ok := ecs.GetComponent[*components.VelocityComponent](em, zombieID)
+	if !ok || velocity.VX <= 0 {
+		t.Error("被魅惑的僵尸应向右移动")
+	}
+
+	scale, ok := ecs.GetComponent[*components.ScaleComponent](em, zombieID)
+	if !ok || scale.ScaleX >= 0 {
+		t.Error("被魅惑的僵尸应镜像显示")
+	}
+
+	if behavior.Type != components.BehaviorZombieBasic {
+		t.Errorf("被魅惑的僵尸应恢复行走状态，实际为 %v", behavior.Type)
+	}
+
+	// 被魅惑的僵尸死亡时不重复计数
+	bs.recordZombieKilled(zombieID)
+	if gs.ZombiesKilled != killedBefore+1 {
+		t.Errorf("被魅惑的僵尸死亡时不应重复计数，期望 %d，实际 %d", killedBefore+1, gs.ZombiesKilled)
+	}
+}
+
+// TestDetectHostileZombieCollision 测试敌对阵营僵尸的检测
+func TestDetectHostileZombieCollision(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	y := config.GridWorldStartY + 2*config.CellHeight + config.CellHeight/2 + config.ZombieVerticalOffset
+	row := int((y - config.GridWorldStartY - config.ZombieVerticalOffset - config.CellHeight/2.0) / config.CellHeight)
+
+	charmedID := createTestWalkingZombie(em, 400, y)
+	bs.charmZombie(charmedID)
+
+	enemyID := createTestWalkingZombie(em, 400+config.ZombieBiteRange/2, y)
+	behindID := createTestWalkingZombie(em, 400-config.ZombieBiteRange/2, y)
+
+	// 被魅惑僵尸只检测右侧（面朝方向）的普通僵尸
+	targetID, found := bs.detectHostileZombieCollision(charmedID, 400, row)
+	if !found || targetID != enemyID {
+		t.Errorf("被魅惑僵尸应检测到前方的敌对僵尸 %d，实际 %d (found=%v)", enemyID, targetID, found)
+	}
+
+	// 普通僵尸检测左侧的被魅惑僵尸
+	targetID, found = bs.detectHostileZombieCollision(enemyID, 400+config.ZombieBiteRange/2, row)
+	if !found || targetID != charmedID {
+		t.Errorf("普通僵尸应检测到前方的被魅惑僵尸 %d，实际 %d (found=%v)", charmedID, targetID, found)
+	}
+
+	// 被魅惑僵尸在普通僵尸的背后时，普通僵尸不会回头
+	if _, found := bs.detectHostileZombieCollision(behindID, 400-config.ZombieBiteRange/2, row); found {
+		t.Error("被魅惑僵尸位于普通僵尸背后，不应被检测到")
+	}
+}
+
+// TestBiteZombieDamagesArmorFirst 测试啃食敌对僵尸时优先扣除护甲
+func TestBiteZombieDamagesArmorFirst(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	attackerID := createTestWalkingZombie(em, 400, 300)
+	targetID := createTestWalkingZombie(em, 420, 300)
+	cone := &components.AccessoryPiece{Tag: types.AccessoryCone, Tier: 1, Durability: 370, MaxDurability: 370}
+	ecs.AddComponent(em, targetID, &components.AccessoryComponent{Pieces: []*components.AccessoryPiece{cone}})
+
+	bs.biteZombie(attackerID, targetID)
+
+	health, _ := ecs.GetComponent[*components.HealthComponent](em, targetID)
+	if cone.Durability != 370-config.ZombieEatingDamage {
+		t.Errorf("护甲应扣除 %d，实际剩余 %d", config.ZombieEatingDamage, cone.Durability)
+	}
+	if health.CurrentHealth != 270 {
+		t.Errorf("有护甲时生命值不应减少，实际 %d", health.CurrentHealth)
+	}
+}
+
+// TestBiteZombieHitsShieldFromFront 测试从正面啃食持盾僵尸时先扣除手持防具耐久
+func TestBiteZombieHitsShieldFromFront(t *testing.T) {
+	tests := []struct {
+		name      string
+		attackerX float64
+		targetX   float64
+		charmed   bool
+	}{
+		{"被魅惑僵尸啃食面朝左侧的铁栅门僵尸", 400, 420, false},
+		{"普通僵尸啃食面朝右侧的被魅惑铁栅门僵尸", 420, 400, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em := ecs.NewEntityManager()
+			rm := game.NewResourceManager(getTestAudioContext())
+			bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+			attackerID := createTestWalkingZombie(em, tt.attackerX, 300)
+			targetID := createTestWalkingZombie(em, tt.targetX, 300)
+			door := &components.AccessoryPiece{
+				Tag:           types.AccessoryScreenDoor,
+				Tier:          2,
+				Durability:    config.ScreenDoorHealth,
+				MaxDurability: config.ScreenDoorHealth,
+			}
+			ecs.AddComponent(em, targetID, &components.AccessoryComponent{Pieces: []*components.AccessoryPiece{door}})
+			if tt.charmed {
+				ecs.AddComponent(em, targetID, &components.FactionComponent{Faction: components.FactionPlant})
+			} else {
+				ecs.AddComponent(em, attackerID, &components.FactionComponent{Faction: components.FactionPlant})
+			}
+
+			bs.biteZombie(attackerID, targetID)
+
+			health, _ := ecs.GetComponent[*components.HealthComponent](em, targetID)
+			if door.Durability != config.ScreenDoorHealth-config.ZombieEatingDamage {
+				t.Errorf("铁栅门应扣除 %d，实际剩余 %d", config.ZombieEatingDamage, door.Durability)
+			}
+			if health.CurrentHealth != 270 {
+				t.Errorf("铁栅门挡住啃食时生命值不应减少，实际 %d", health.CurrentHealth)
+			}
+		})
+	}
+}
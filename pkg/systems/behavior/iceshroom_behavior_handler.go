@@ -0,0 +1,99 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+)
+
+// handleIceShroomBehavior 处理寒冰菇的行为：引信计时结束后释放寒气
+func (s *BehaviorSystem) handleIceShroomBehavior(entityID ecs.EntityID, deltaTime float64) {
+	timer, ok := ecs.GetComponent[*components.TimerComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	if !timer.IsReady {
+		timer.CurrentTime += deltaTime
+		if timer.CurrentTime >= timer.TargetTime {
+			timer.IsReady = true
+		}
+		return
+	}
+
+	s.releaseIceShroomChill(entityID)
+}
+
+// releaseIceShroomChill 寒冰菇释放寒气
+//
+// 冻结场上所有敌方僵尸，冻结僵王博士滚出的所有火球，之后寒冰菇消失
+func (s *BehaviorSystem) releaseIceShroomChill(entityID ecs.EntityID) {
+	frozen := 0
+	zombies := ecs.GetEntitiesWith2[*components.BehaviorComponent, *components.PositionComponent](s.entityManager)
+	for _, zombieID := range zombies {
+		behavior, _ := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, zombieID)
+		if !s.isZombieBehaviorType(behavior.Type) || behavior.Type == components.BehaviorZombieDying {
+			continue
+		}
+		if s.isCharmedZombie(zombieID) || s.isUndergroundZombie(zombieID) {
+			continue
+		}
+
+		s.applyLobDamage(zombieID, config.IceShroomDamage)
+		s.freezeZombie(zombieID)
+		frozen++
+	}
+
+	countered := systems.CounterZombossBalls(s.entityManager, components.ZombossElementIce, -1)
+	log.Printf("[BehaviorSystem] 寒冰菇 %d 冻结了 %d 个僵尸和 %d 个火球", entityID, frozen, countered)
+
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_FROZEN")
+	}
+
+	if position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID); ok {
+		if _, err := entities.CreateParticleEffect(s.entityManager, s.resourceManager, config.IceShroomParticleEffect, position.X, position.Y); err != nil {
+			log.Printf("[BehaviorSystem] 警告：创建寒冰菇粒子效果失败: %v", err)
+		}
+	}
+
+	s.destroyPlant(entityID)
+}
+
+// freezeZombie 冻结僵尸 IceShroomFreezeDuration 秒，冻结期间暂停动画
+// 已被冻结的僵尸重新计时
+func (s *BehaviorSystem) freezeZombie(zombieID ecs.EntityID) {
+	if frozen, ok := ecs.GetComponent[*components.FrozenComponent](s.entityManager, zombieID); ok {
+		frozen.Timer = config.IceShroomFreezeDuration
+		return
+	}
+	ecs.AddComponent(s.entityManager, zombieID, &components.FrozenComponent{Timer: config.IceShroomFreezeDuration})
+	if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, zombieID); ok {
+		reanim.IsPaused = true
+	}
+}
+
+// isFrozenZombie 判断僵尸是否被寒冰菇冻结
+func (s *BehaviorSystem) isFrozenZombie(zombieID ecs.EntityID) bool {
+	return ecs.HasComponent[*components.FrozenComponent](s.entityManager, zombieID)
+}
+
+// updateFrozenZombies 冻结计时，到时间后解冻并恢复动画
+func (s *BehaviorSystem) updateFrozenZombies(deltaTime float64) {
+	for _, zombieID := range ecs.GetEntitiesWith1[*components.FrozenComponent](s.entityManager) {
+		frozen, _ := ecs.GetComponent[*components.FrozenComponent](s.entityManager, zombieID)
+		frozen.Timer -= deltaTime
+		if frozen.Timer > 0 {
+			continue
+		}
+		ecs.RemoveComponent[*components.FrozenComponent](s.entityManager, zombieID)
+		if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, zombieID); ok {
+			reanim.IsPaused = false
+		}
+	}
+}
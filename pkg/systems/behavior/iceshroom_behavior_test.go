@@ -0,0 +1,67 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// TestIceShroomFreezesZombiesAndFireBalls 测试寒冰菇引信结束后冻结所有僵尸和火球，冻结结束后僵尸恢复行走
+func TestIceShroomFreezesZombiesAndFireBalls(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	iceShroomID := createTestFusePlant(em, components.PlantIceShroom, components.BehaviorIceShroom, config.IceShroomFuseTime, 0, 0)
+
+	zombieID := createTestWalkingZombie(em, 600, zombieYForRow(3))
+	fireBalls := []ecs.EntityID{
+		createTestZombossBall(em, components.ZombossElementFire, 1),
+		createTestZombossBall(em, components.ZombossElementFire, 4),
+	}
+	iceBall := createTestZombossBall(em, components.ZombossElementIce, 2)
+
+	bs.Update(config.IceShroomFuseTime)
+	bs.Update(0.01)
+	em.RemoveMarkedEntities()
+
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, iceShroomID); ok {
+		t.Error("寒冰菇释放寒气后应消失")
+	}
+	for _, ballID := range fireBalls {
+		if ecs.HasComponent[*components.ZombossBallComponent](em, ballID) {
+			t.Errorf("火球 %d 应被冻结消除", ballID)
+		}
+	}
+	if !ecs.HasComponent[*components.ZombossBallComponent](em, iceBall) {
+		t.Error("寒冰菇不应消除冰球")
+	}
+
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, zombieID)
+	if !bs.isFrozenZombie(zombieID) || !reanim.IsPaused {
+		t.Fatal("僵尸应被冻结并暂停动画")
+	}
+	health, _ := ecs.GetComponent[*components.HealthComponent](em, zombieID)
+	if health.CurrentHealth != health.MaxHealth-config.IceShroomDamage {
+		t.Errorf("寒冰菇应造成 %d 点伤害，剩余生命值 %d", config.IceShroomDamage, health.CurrentHealth)
+	}
+
+	position, _ := ecs.GetComponent[*components.PositionComponent](em, zombieID)
+	frozenX := position.X
+	bs.Update(config.IceShroomFreezeDuration / 2)
+	if position.X != frozenX {
+		t.Errorf("冻结期间僵尸不应移动，X 从 %.1f 变为 %.1f", frozenX, position.X)
+	}
+
+	bs.Update(config.IceShroomFreezeDuration / 2)
+	bs.Update(0.1)
+	if bs.isFrozenZombie(zombieID) || reanim.IsPaused {
+		t.Fatal("冻结结束后僵尸应解冻并恢复动画")
+	}
+	if position.X >= frozenX {
+		t.Error("解冻后僵尸应继续前进")
+	}
+}
@@ -0,0 +1,232 @@
+package behavior
+
+import (
+	"log"
+	"math/rand"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// jackBoxExtraTracks 八音盒被吸走后，除饰品轨道外还需隐藏的轨道（摇柄）
+var jackBoxExtraTracks = []string{"Zombie_jackbox_handle"}
+
+// updateJackZombies 更新所有持有八音盒的小丑僵尸
+//
+// 小丑僵尸的引信与是否在啃食无关，因此在逐个僵尸的行为处理之前单独更新：
+//   - 行走或啃食期间引信持续燃烧，耗尽后原地打开盒子
+//   - 弹出动画结束时爆炸，摧毁周围 3x3 范围内的植物，小丑僵尸随之烧焦死亡
+//   - 场上有行走中的小丑僵尸时循环播放八音盒音乐
+func (s *BehaviorSystem) updateJackZombies(deltaTime float64) {
+	musicWanted := false
+
+	for _, entityID := range ecs.GetEntitiesWith1[*components.JackComponent](s.entityManager) {
+		jack, _ := ecs.GetComponent[*components.JackComponent](s.entityManager, entityID)
+
+		// 被魅惑的小丑僵尸不再爆炸，之后按普通僵尸行走
+		if s.isCharmedZombie(entityID) {
+			ecs.RemoveComponent[*components.JackComponent](s.entityManager, entityID)
+			continue
+		}
+		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+		if !ok || (behavior.Type != components.BehaviorZombieBasic && behavior.Type != components.BehaviorZombieEating) {
+			continue
+		}
+		if waveState, ok := ecs.GetComponent[*components.ZombieWaveStateComponent](s.entityManager, entityID); ok && !waveState.IsActivated {
+			continue
+		}
+
+		if jack.Popping {
+			reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID)
+			if !ok || reanim.IsFinished {
+				s.detonateJack(entityID)
+			}
+			continue
+		}
+
+		jack.PopTimer -= deltaTime
+		if jack.PopTimer <= 0 {
+			s.startJackPop(entityID, jack)
+			continue
+		}
+
+		if behavior.Type == components.BehaviorZombieBasic {
+			musicWanted = true
+		}
+	}
+
+	s.updateJackMusic(musicWanted)
+}
+
+// startJackPop 小丑僵尸停下并打开盒子
+// 打开中的盒子不能再被磁力菇吸走（见 findMagnetTarget）
+func (s *BehaviorSystem) startJackPop(entityID ecs.EntityID, jack *components.JackComponent) {
+	log.Printf("[BehaviorSystem] 小丑僵尸 %d 打开盒子", entityID)
+	jack.Popping = true
+
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	// 啃食中打开盒子：停止啃食，原地播放弹出动画
+	if behavior.Type == components.BehaviorZombieEating {
+		behavior.Type = components.BehaviorZombieBasic
+		behavior.LastEatAnimFrame = -1
+	}
+	ecs.AddComponent(s.entityManager, entityID, &components.VelocityComponent{})
+
+	behavior.ZombieAnimState = components.ZombieAnimIdle
+	s.resetZombieRootMotion(entityID)
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    behavior.UnitID,
+		ComboName: "pop",
+		Processed: false,
+	})
+
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		if rand.Float32() < 0.5 {
+			audioManager.PlaySound("SOUND_JACK_SURPRISE")
+		} else {
+			audioManager.PlaySound("SOUND_JACK_SURPRISE2")
+		}
+	}
+}
+
+// detonateJack 小丑爆炸：摧毁周围 3x3 范围内的植物，小丑僵尸烧焦死亡
+//
+// 与樱桃炸弹相反，爆炸来源是僵尸，受害者是植物：
+// 使用相同的爆炸音效和 Powie 粒子效果，小丑僵尸自身播放烧焦死亡动画
+func (s *BehaviorSystem) detonateJack(entityID ecs.EntityID) {
+	ecs.RemoveComponent[*components.JackComponent](s.entityManager, entityID)
+
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	collisionOffsetX := 0.0
+	if collision, ok := ecs.GetComponent[*components.CollisionComponent](s.entityManager, entityID); ok {
+		collisionOffsetX = collision.OffsetX
+	}
+	col := int((position.X + collisionOffsetX - config.GridWorldStartX) / config.CellWidth)
+	row := s.zombieRowOf(position)
+
+	destroyed := s.destroyPlantsInArea(row, col, config.JackExplosionRange)
+	log.Printf("[BehaviorSystem] 小丑僵尸 %d 在 (%d, %d) 爆炸，摧毁 %d 株植物", entityID, col, row, destroyed)
+
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_CHERRYBOMB")
+	}
+
+	_, err := entities.CreateParticleEffect(
+		s.entityManager,
+		s.resourceManager,
+		config.JackExplodeParticleEffect,
+		position.X, position.Y-config.ZombieVerticalOffset,
+	)
+	if err != nil {
+		log.Printf("[BehaviorSystem] 警告：创建小丑爆炸粒子效果失败: %v", err)
+	}
+
+	if health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, entityID); ok {
+		health.CurrentHealth = 0
+	}
+	s.triggerZombieExplosionDeath(entityID)
+}
+
+// destroyPlantsInArea 摧毁以指定格子为中心、向四周扩展 rangeCells 格范围内的所有植物
+// 用于僵尸发起的范围伤害（如小丑爆炸），多格植物只要占用范围内任一格子即被摧毁
+// 先摧毁种在平台上的植物，再摧毁睡莲、花盆，避免 destroyPlant 连带删除的植物被重复摧毁
+//
+// 返回:
+//   - int: 被摧毁的植物数量
+func (s *BehaviorSystem) destroyPlantsInArea(centerRow, centerCol, rangeCells int) int {
+	var plants, platforms []ecs.EntityID
+	for _, plantID := range ecs.GetEntitiesWith1[*components.PlantComponent](s.entityManager) {
+		plant, _ := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID)
+
+		hit := false
+		for row := centerRow - rangeCells; row <= centerRow+rangeCells && !hit; row++ {
+			for col := centerCol - rangeCells; col <= centerCol+rangeCells; col++ {
+				if plant.OccupiesCell(col, row) {
+					hit = true
+					break
+				}
+			}
+		}
+		if !hit {
+			continue
+		}
+
+		if plant.PlantType.IsPlatform() {
+			platforms = append(platforms, plantID)
+		} else {
+			plants = append(plants, plantID)
+		}
+	}
+
+	for _, plantID := range append(plants, platforms...) {
+		s.destroyPlant(plantID)
+	}
+	return len(plants) + len(platforms)
+}
+
+// disarmJack 小丑僵尸失去八音盒：隐藏摇柄，之后按普通僵尸行走，不再爆炸
+func (s *BehaviorSystem) disarmJack(entityID ecs.EntityID) {
+	if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok {
+		if reanim.HiddenTracks == nil {
+			reanim.HiddenTracks = make(map[string]bool)
+		}
+		for _, track := range jackBoxExtraTracks {
+			reanim.HiddenTracks[track] = true
+		}
+	}
+	ecs.RemoveComponent[*components.JackComponent](s.entityManager, entityID)
+}
+
+// isJackPopping 小丑僵尸是否正在打开盒子（原地不动，不检测碰撞）
+func (s *BehaviorSystem) isJackPopping(entityID ecs.EntityID) bool {
+	jack, ok := ecs.GetComponent[*components.JackComponent](s.entityManager, entityID)
+	return ok && jack.Popping
+}
+
+// updateJackMusic 场上有行走中的小丑僵尸时循环播放八音盒音乐，否则暂停
+// 所有小丑僵尸共用一个播放器，避免多个八音盒同时播放
+func (s *BehaviorSystem) updateJackMusic(wanted bool) {
+	if !wanted {
+		if s.jackMusicPlayer != nil {
+			s.jackMusicPlayer.Pause()
+			s.jackMusicPlayer = nil
+		}
+		return
+	}
+
+	if s.jackMusicPlayer == nil {
+		audioManager := game.GetGameState().GetAudioManager()
+		if audioManager == nil {
+			return
+		}
+		s.jackMusicPlayer = audioManager.GetSoundPlayer("SOUND_JACKINTHEBOX")
+		if s.jackMusicPlayer == nil {
+			return
+		}
+		s.jackMusicPlayer.SetVolume(audioManager.GetSoundVolume())
+	}
+
+	// 播放结束后从头开始，实现循环
+	if !s.jackMusicPlayer.IsPlaying() {
+		if err := s.jackMusicPlayer.Rewind(); err != nil {
+			log.Printf("[BehaviorSystem] 警告：重置八音盒音乐失败: %v", err)
+		}
+		s.jackMusicPlayer.Play()
+	}
+}
+
+// stopJackMusic 停止八音盒音乐（游戏胜利或僵尸获胜冻结时调用）
+func (s *BehaviorSystem) stopJackMusic() {
+	s.updateJackMusic(false)
+}
@@ -0,0 +1,150 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// createTestJackZombie 创建测试用的小丑僵尸：持有八音盒，引信剩余 popTimer 秒
+func createTestJackZombie(em *ecs.EntityManager, x, y, popTimer float64) ecs.EntityID {
+	id := createTestWalkingZombie(em, x, y)
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, id)
+	behavior.UnitID = types.UnitIDZombieJack
+	ecs.AddComponent(em, id, &components.JackComponent{PopTimer: popTimer})
+	ecs.AddComponent(em, id, &components.AccessoryComponent{
+		Pieces: []*components.AccessoryPiece{{Tag: types.AccessoryJackBox, Tier: 0}},
+	})
+	return id
+}
+
+// TestJackPopsAndDestroysPlantsIn3x3 测试小丑僵尸引信耗尽后原地打开盒子，弹出结束时炸毁周围 3x3 的植物
+func TestJackPopsAndDestroysPlantsIn3x3(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	// 小丑僵尸位于第 2 行第 5 列
+	jackID := createTestJackZombie(em, config.GridWorldStartX+5*config.CellWidth+config.CellWidth/2, zombieYForRow(2), 0.05)
+	inRange := []ecs.EntityID{
+		createTestGridPlant(em, components.PlantPeashooter, 5, 2),
+		createTestGridPlant(em, components.PlantPeashooter, 4, 1),
+		createTestGridPlant(em, components.PlantPeashooter, 6, 3),
+	}
+	outOfRange := []ecs.EntityID{
+		createTestGridPlant(em, components.PlantPeashooter, 5, 4),
+		createTestGridPlant(em, components.PlantPeashooter, 8, 2),
+	}
+
+	bs.updateJackZombies(0.1)
+
+	jack, _ := ecs.GetComponent[*components.JackComponent](em, jackID)
+	if !jack.Popping {
+		t.Fatal("引信耗尽后应打开盒子")
+	}
+	if velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, jackID); velocity.VX != 0 {
+		t.Errorf("打开盒子时应原地不动，实际速度 %.1f", velocity.VX)
+	}
+	if cmd, _ := ecs.GetComponent[*components.AnimationCommandComponent](em, jackID); cmd == nil || cmd.ComboName != "pop" {
+		t.Errorf("期望播放 pop 动画，实际 %+v", cmd)
+	}
+	magnetID := createTestMagnetshroom(em, config.GridWorldStartX+4*config.CellWidth, zombieYForRow(2))
+	bs.handleMagnetshroomBehavior(magnetID, 0.016)
+	if accessory, _ := ecs.GetComponent[*components.AccessoryComponent](em, jackID); !accessory.Has(types.AccessoryJackBox) {
+		t.Error("打开中的盒子不应再能被磁力菇吸走")
+	}
+
+	// 弹出动画播放中不爆炸
+	bs.updateJackZombies(0.1)
+	if len(ecs.GetEntitiesWith1[*components.PlantComponent](em)) != len(inRange)+len(outOfRange) {
+		t.Fatal("弹出动画结束前不应爆炸")
+	}
+
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, jackID)
+	reanim.IsFinished = true
+	bs.updateJackZombies(0.1)
+	em.RemoveMarkedEntities()
+
+	for _, id := range inRange {
+		if _, ok := ecs.GetComponent[*components.PlantComponent](em, id); ok {
+			t.Errorf("范围内的植物 %d 应被炸毁", id)
+		}
+	}
+	for _, id := range outOfRange {
+		if _, ok := ecs.GetComponent[*components.PlantComponent](em, id); !ok {
+			t.Errorf("范围外的植物 %d 不应被炸毁", id)
+		}
+	}
+
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, jackID)
+	if behavior.Type != components.BehaviorZombieDyingExplosion {
+		t.Errorf("小丑僵尸爆炸后应烧焦死亡，实际 %v", behavior.Type)
+	}
+	if _, ok := ecs.GetComponent[*components.JackComponent](em, jackID); ok {
+		t.Error("爆炸后应移除小丑组件")
+	}
+}
+
+// TestMagnetshroomStealsJackBox 测试磁力菇吸走八音盒后小丑僵尸不再爆炸
+func TestMagnetshroomStealsJackBox(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	magnetID := createTestMagnetshroom(em, 300, 300)
+	jackID := createTestJackZombie(em, 400, 300, 5)
+
+	bs.handleMagnetshroomBehavior(magnetID, 0.016)
+
+	magnet, _ := ecs.GetComponent[*components.MagnetshroomComponent](em, magnetID)
+	if magnet.HeldAccessory != types.AccessoryJackBox {
+		t.Fatalf("磁力菇应吸附八音盒，实际为 %s", magnet.HeldAccessory)
+	}
+	if _, ok := ecs.GetComponent[*components.JackComponent](em, jackID); ok {
+		t.Error("失去八音盒后应移除小丑组件")
+	}
+
+	accessory, _ := ecs.GetComponent[*components.AccessoryComponent](em, jackID)
+	if accessory.Has(types.AccessoryJackBox) {
+		t.Error("八音盒应标记为已移除，渲染时隐藏八音盒轨道")
+	}
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, jackID)
+	if !reanim.HiddenTracks["Zombie_jackbox_handle"] {
+		t.Error("轨道 Zombie_jackbox_handle 应被隐藏")
+	}
+}
+
+// TestJackDestroysPottedPlantOnce 测试小丑爆炸摧毁花盆和种在上面的植物，每株只摧毁一次
+func TestJackDestroysPottedPlantOnce(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	// 第 2 行第 5 列：花盆上种着豌豆射手
+	plantID := createTestGridPlant(em, components.PlantPeashooter, 5, 2)
+	potID := createTestGridPlant(em, components.PlantFlowerPot, 5, 2)
+	if err := bs.lawnGridSystem.PlaceFlowerPot(bs.lawnGridEntityID, 5, 2, potID); err != nil {
+		t.Fatalf("Failed to place flower pot: %v", err)
+	}
+	if err := bs.lawnGridSystem.OccupyCell(bs.lawnGridEntityID, 5, 2, plantID); err != nil {
+		t.Fatalf("Failed to occupy cell: %v", err)
+	}
+
+	if destroyed := bs.destroyPlantsInArea(2, 5, config.JackExplosionRange); destroyed != 2 {
+		t.Errorf("应摧毁花盆和上面的植物共 2 株，实际 %d", destroyed)
+	}
+	em.RemoveMarkedEntities()
+
+	for _, id := range []ecs.EntityID{plantID, potID} {
+		if _, ok := ecs.GetComponent[*components.PlantComponent](em, id); ok {
+			t.Errorf("植物 %d 应被炸毁", id)
+		}
+	}
+	if bs.lawnGridSystem.IsOccupied(bs.lawnGridEntityID, 5, 2) || bs.lawnGridSystem.HasPlatform(bs.lawnGridEntityID, 5, 2) {
+		t.Error("炸毁后格子和平台都应被释放")
+	}
+}
@@ -0,0 +1,87 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+)
+
+// handleJalapenoBehavior 处理火爆辣椒的行为：引信计时结束后点燃所在的整行
+func (s *BehaviorSystem) handleJalapenoBehavior(entityID ecs.EntityID, deltaTime float64) {
+	timer, ok := ecs.GetComponent[*components.TimerComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	if !timer.IsReady {
+		timer.CurrentTime += deltaTime
+		if timer.CurrentTime >= timer.TargetTime {
+			timer.IsReady = true
+		}
+		return
+	}
+
+	s.igniteJalapeno(entityID)
+}
+
+// igniteJalapeno 火爆辣椒点燃所在的整行
+//
+// 烧毁该行所有敌方僵尸，融化僵王博士滚到该行的冰球，之后火爆辣椒消失
+func (s *BehaviorSystem) igniteJalapeno(entityID ecs.EntityID) {
+	plant, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	row := plant.GridRow
+
+	burned := 0
+	zombies := ecs.GetEntitiesWith2[*components.BehaviorComponent, *components.PositionComponent](s.entityManager)
+	for _, zombieID := range zombies {
+		behavior, _ := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, zombieID)
+		if !s.isZombieBehaviorType(behavior.Type) || behavior.Type == components.BehaviorZombieDying {
+			continue
+		}
+		// 被魅惑的僵尸属于植物阵营；地下的矿工僵尸不受火焰影响
+		if s.isCharmedZombie(zombieID) || s.isUndergroundZombie(zombieID) {
+			continue
+		}
+
+		zombiePos, _ := ecs.GetComponent[*components.PositionComponent](s.entityManager, zombieID)
+		zombieRow := s.lawnLayout.RowAt(zombiePos.X, zombiePos.Y-config.ZombieVerticalOffset)
+		if zombieRow != row {
+			continue
+		}
+
+		burned++
+		s.applyLobDamage(zombieID, config.JalapenoDamage)
+
+		// 被烧死的僵尸播放烧焦死亡动画
+		if health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, zombieID); ok && health.CurrentHealth <= 0 {
+			s.triggerZombieExplosionDeath(zombieID)
+		}
+	}
+
+	melted := systems.CounterZombossBalls(s.entityManager, components.ZombossElementFire, row)
+	log.Printf("[BehaviorSystem] 火爆辣椒 %d 点燃第 %d 行，烧毁 %d 个僵尸，融化 %d 个冰球", entityID, row, burned, melted)
+
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_JALAPENO")
+	}
+
+	// 火焰铺满整行
+	y := s.lawnLayout.StartY + float64(row)*s.lawnLayout.CellHeight + s.lawnLayout.CellHeight/2
+	for col := 0; col < config.GridColumns; col++ {
+		x := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+		if _, err := entities.NewJalapenoFireEffect(s.entityManager, s.resourceManager, x, y+s.lawnLayout.SlopeOffsetY(x)); err != nil {
+			log.Printf("[BehaviorSystem] 警告：创建火爆辣椒火焰效果失败: %v", err)
+			break
+		}
+	}
+
+	s.destroyPlant(entityID)
+}
@@ -0,0 +1,69 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// createTestFusePlant 创建测试用的引信植物（火爆辣椒、寒冰菇）
+func createTestFusePlant(em *ecs.EntityManager, plantType components.PlantType, behaviorType components.BehaviorType, fuseTime float64, col, row int) ecs.EntityID {
+	id := createTestGridPlant(em, plantType, col, row)
+	ecs.AddComponent(em, id, &components.BehaviorComponent{Type: behaviorType})
+	ecs.AddComponent(em, id, &components.TimerComponent{Name: "fuse_timer", TargetTime: fuseTime})
+	return id
+}
+
+// createTestZombossBall 创建测试用的僵王博士火球或冰球
+func createTestZombossBall(em *ecs.EntityManager, element components.ZombossElement, row int) ecs.EntityID {
+	id := em.CreateEntity()
+	ecs.AddComponent(em, id, &components.PositionComponent{X: cellCenterX(8), Y: plantYForRow(row)})
+	ecs.AddComponent(em, id, &components.VelocityComponent{VX: config.ZombossBallSpeed})
+	ecs.AddComponent(em, id, &components.ZombossBallComponent{Element: element, Row: row})
+	return id
+}
+
+// TestJalapenoBurnsRowAndMeltsIceBall 测试火爆辣椒引信结束后烧毁所在行的僵尸、融化该行的冰球，之后消失
+func TestJalapenoBurnsRowAndMeltsIceBall(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	jalapenoID := createTestFusePlant(em, components.PlantJalapeno, components.BehaviorJalapeno, config.JalapenoFuseTime, 0, 2)
+
+	inRow := createTestWalkingZombie(em, 600, zombieYForRow(2))
+	otherRow := createTestWalkingZombie(em, 600, zombieYForRow(3))
+	iceBall := createTestZombossBall(em, components.ZombossElementIce, 2)
+	fireBall := createTestZombossBall(em, components.ZombossElementFire, 2)
+	otherRowIceBall := createTestZombossBall(em, components.ZombossElementIce, 3)
+
+	bs.Update(config.JalapenoFuseTime)
+	if !ecs.HasComponent[*components.ZombossBallComponent](em, iceBall) {
+		t.Fatal("引信结束前不应点燃")
+	}
+
+	bs.Update(0.01)
+	em.RemoveMarkedEntities()
+
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, jalapenoID); ok {
+		t.Error("火爆辣椒点燃后应消失")
+	}
+	if health, _ := ecs.GetComponent[*components.HealthComponent](em, inRow); health.CurrentHealth > 0 {
+		t.Errorf("同一行的僵尸应被烧死，剩余生命值 %d", health.CurrentHealth)
+	}
+	if health, _ := ecs.GetComponent[*components.HealthComponent](em, otherRow); health.CurrentHealth != health.MaxHealth {
+		t.Error("其他行的僵尸不应受到伤害")
+	}
+	if ecs.HasComponent[*components.ZombossBallComponent](em, iceBall) {
+		t.Error("同一行的冰球应被融化")
+	}
+	if !ecs.HasComponent[*components.ZombossBallComponent](em, fireBall) {
+		t.Error("火爆辣椒不应消除火球")
+	}
+	if !ecs.HasComponent[*components.ZombossBallComponent](em, otherRowIceBall) {
+		t.Error("其他行的冰球不应被融化")
+	}
+}
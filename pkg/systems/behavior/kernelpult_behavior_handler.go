@@ -0,0 +1,192 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+)
+
+// handleKernelpultBehavior 处理玉米投手的行为逻辑
+// 计时器就绪且同行有僵尸时，播放投掷动画并向最近的僵尸抛投玉米粒
+func (s *BehaviorSystem) handleKernelpultBehavior(entityID ecs.EntityID, deltaTime float64, zombieEntityList []ecs.EntityID) {
+	plant, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	timer, ok := ecs.GetComponent[*components.TimerComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	// 投掷动画播放完毕后切换回待机动画
+	if plant.AttackAnimState == components.AttackAnimAttacking {
+		if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok && reanim.IsFinished {
+			ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+				UnitID:    "cornpult",
+				ComboName: "idle",
+				Processed: false,
+			})
+			plant.AttackAnimState = components.AttackAnimIdle
+		}
+	}
+
+	timer.CurrentTime += deltaTime
+	if timer.CurrentTime < timer.TargetTime {
+		return
+	}
+
+	targetID, ok := s.findLobTarget(position, zombieEntityList)
+	if !ok {
+		return // 没有目标时保持就绪，发现僵尸后立即投掷
+	}
+
+	timer.CurrentTime = 0
+
+	targetX, targetY := s.lobTargetPoint(targetID)
+	startX := position.X
+	startY := position.Y + config.KernelLaunchOffsetY
+
+	if _, err := entities.NewKernelProjectile(s.entityManager, s.resourceManager, startX, startY, targetID, targetX, targetY); err != nil {
+		log.Printf("[BehaviorSystem] 玉米投手 %d 创建玉米粒失败: %v", entityID, err)
+		return
+	}
+
+	s.playShootSound()
+
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    "cornpult",
+		ComboName: "attack",
+		Processed: false,
+	})
+	plant.AttackAnimState = components.AttackAnimAttacking
+
+	log.Printf("[BehaviorSystem] 玉米投手 %d 向僵尸 %d 投掷玉米粒", entityID, targetID)
+}
+
+// findLobTarget 查找投手类植物的目标：同行、位于植物右侧且进入屏幕的最近僵尸
+// 低垂的僵王博士头部横跨多行，任何一行的投手都可以攻击
+func (s *BehaviorSystem) findLobTarget(position *components.PositionComponent, zombieEntityList []ecs.EntityID) (ecs.EntityID, bool) {
+	row := s.lawnLayout.RowAt(position.X, position.Y)
+	screenRightBoundary := config.GridWorldEndX + 50.0
+
+	var targetID, bossID ecs.EntityID
+	nearestX := 0.0
+	for _, zombieID := range zombieEntityList {
+		zombiePos, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, zombieID)
+		if !ok {
+			continue
+		}
+		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, zombieID)
+		if !ok || behavior.Type == components.BehaviorZombieDying {
+			continue
+		}
+		if behavior.Type == components.BehaviorZombieBoss {
+			bossID = zombieID
+			continue
+		}
+		if s.lawnLayout.RowAt(zombiePos.X, zombiePos.Y) != row {
+			continue
+		}
+		if zombiePos.X <= position.X || zombiePos.X >= screenRightBoundary {
+			continue
+		}
+		if targetID == 0 || zombiePos.X < nearestX {
+			targetID = zombieID
+			nearestX = zombiePos.X
+		}
+	}
+
+	// 同行没有僵尸时攻击僵王博士
+	if targetID == 0 {
+		targetID = bossID
+	}
+	return targetID, targetID != 0
+}
+
+// lobTargetPoint 计算抛物线子弹命中僵尸的落点（僵尸碰撞盒中心）
+func (s *BehaviorSystem) lobTargetPoint(zombieID ecs.EntityID) (float64, float64) {
+	zombiePos, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, zombieID)
+	if !ok {
+		return 0, 0
+	}
+	offsetX, offsetY := 0.0, 0.0
+	if collision, ok := ecs.GetComponent[*components.CollisionComponent](s.entityManager, zombieID); ok {
+		offsetX, offsetY = collision.OffsetX, collision.OffsetY
+	}
+	return zombiePos.X + offsetX, zombiePos.Y + offsetY - config.ZombieVerticalOffset
+}
+
+// updateLobbedProjectile 推进抛物线子弹的飞行位置
+// 返回 true 表示子弹已落地
+func (s *BehaviorSystem) updateLobbedProjectile(entityID ecs.EntityID, deltaTime float64) (*components.LobbedProjectileComponent, bool) {
+	lob, ok := ecs.GetComponent[*components.LobbedProjectileComponent](s.entityManager, entityID)
+	if !ok {
+		return nil, false
+	}
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	if !ok {
+		return nil, false
+	}
+
+	// 追踪移动中的目标，目标死亡后落点固定；目标植物（篮球）不移动，只需确认仍然存在
+	if lob.TargetEntity != 0 {
+		if behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, lob.TargetEntity); ok && (s.isZombieBehaviorType(behavior.Type) || behavior.Type == components.BehaviorZombieBoss) && behavior.Type != components.BehaviorZombieDying {
+			lob.TargetX, lob.TargetY = s.lobTargetPoint(lob.TargetEntity)
+		} else if _, isPlant := ecs.GetComponent[*components.PlantComponent](s.entityManager, lob.TargetEntity); !isPlant {
+			lob.TargetEntity = 0
+		}
+	}
+
+	lob.Elapsed += deltaTime
+	progress := lob.Progress()
+
+	// 直线插值 + 抛物线高度（progress=0.5 时达到顶点）
+	position.X = lob.StartX + (lob.TargetX-lob.StartX)*progress
+	position.Y = lob.StartY + (lob.TargetY-lob.StartY)*progress - lob.ArcHeight*4*progress*(1-progress)
+
+	return lob, lob.IsLanded()
+}
+
+// handleKernelProjectileBehavior 处理玉米粒的飞行和命中
+func (s *BehaviorSystem) handleKernelProjectileBehavior(entityID ecs.EntityID, deltaTime float64) {
+	lob, landed := s.updateLobbedProjectile(entityID, deltaTime)
+	if lob == nil || !landed {
+		return
+	}
+
+	if lob.TargetEntity != 0 {
+		s.applyLobDamage(lob.TargetEntity, lob.Damage)
+		if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+			audioManager.PlaySound("SOUND_KERNELPULT")
+		}
+	}
+
+	s.entityManager.DestroyEntity(entityID)
+}
+
+// applyLobDamage 对僵尸造成伤害：先扣头部防具耐久，剩余伤害扣生命值
+// 投掷物从空中落下，手持防具（报纸、铁栅门）挡不住
+// 生命值归零后由僵尸行为处理死亡流程
+func (s *BehaviorSystem) applyLobDamage(zombieID ecs.EntityID, damage int) {
+	damage = systems.AbsorbHelmetDamage(s.entityManager, zombieID, damage)
+
+	if damage > 0 {
+		if health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, zombieID); ok {
+			health.CurrentHealth -= damage
+			if health.CurrentHealth < 0 {
+				health.CurrentHealth = 0
+			}
+		}
+	}
+
+	log.Printf("[BehaviorSystem] 玉米粒命中僵尸 %d", zombieID)
+}
@@ -0,0 +1,193 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// updateLadders 更新架梯、翻越梯子和残留的梯子
+//
+// 扶梯僵尸和翻越的僵尸在逐个僵尸的行为处理中遇到植物时开始架梯或翻越（见 tryUseLadder），
+// 架梯动画结束后梯子留在植物上，扶梯僵尸自己先翻过去；植物被吃掉或铲除后梯子随之移除
+func (s *BehaviorSystem) updateLadders(deltaTime float64) {
+	for _, entityID := range ecs.GetEntitiesWith1[*components.LadderZombieComponent](s.entityManager) {
+		ladder, _ := ecs.GetComponent[*components.LadderZombieComponent](s.entityManager, entityID)
+		if !ladder.IsPlacing() {
+			continue
+		}
+		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+		if !ok || behavior.Type != components.BehaviorZombieBasic {
+			// 架梯途中死亡，放弃架梯
+			ladder.PlacingPlantID = 0
+			continue
+		}
+		if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); !ok || reanim.IsFinished {
+			s.finishPlacingLadder(entityID, ladder)
+		}
+	}
+
+	for _, entityID := range ecs.GetEntitiesWith1[*components.ClimbComponent](s.entityManager) {
+		climb, _ := ecs.GetComponent[*components.ClimbComponent](s.entityManager, entityID)
+		position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+		if !ok {
+			continue
+		}
+		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+		if !ok || behavior.Type != components.BehaviorZombieBasic {
+			// 翻越途中死亡，落回地面播放死亡动画
+			position.Y = climb.BaseY
+			ecs.RemoveComponent[*components.ClimbComponent](s.entityManager, entityID)
+			continue
+		}
+		s.updateClimb(entityID, climb, position, deltaTime)
+	}
+
+	for _, ladderID := range ecs.GetEntitiesWith1[*components.PlacedLadderComponent](s.entityManager) {
+		placed, _ := ecs.GetComponent[*components.PlacedLadderComponent](s.entityManager, ladderID)
+		if _, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, placed.PlantID); !ok {
+			log.Printf("[BehaviorSystem] 植物 %d 已消失，移除梯子 %d", placed.PlantID, ladderID)
+			s.entityManager.DestroyEntity(ladderID)
+		}
+	}
+}
+
+// tryUseLadder 地面僵尸遇到植物时使用梯子
+//
+// 扛着梯子的扶梯僵尸遇到没有梯子的坚果墙或高坚果时开始架梯；
+// 任何地面僵尸遇到架着梯子的植物时顺着梯子翻过去（转身的矿工僵尸从背面走来，不能翻越）
+//
+// 返回:
+//   - bool: 是否已处理此次植物碰撞（开始架梯或翻越）
+func (s *BehaviorSystem) tryUseLadder(zombieID, plantID ecs.EntityID) bool {
+	_, laddered := ecs.GetComponent[*components.LadderedComponent](s.entityManager, plantID)
+
+	if ladder, ok := ecs.GetComponent[*components.LadderZombieComponent](s.entityManager, zombieID); ok && !laddered && systems.IsCarryingLadder(s.entityManager, zombieID) {
+		plant, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID)
+		if ok && (plant.PlantType == components.PlantWallnut || plant.PlantType == components.PlantTallnut) {
+			log.Printf("[BehaviorSystem] 扶梯僵尸 %d 开始在植物 %d 上架梯", zombieID, plantID)
+			ladder.PlacingPlantID = plantID
+			s.playLadderCombo(zombieID, "placeladder")
+			return true
+		}
+	}
+
+	if !laddered || s.isDiggerWalkingBackward(zombieID) {
+		return false
+	}
+	s.startClimb(zombieID, plantID)
+	return true
+}
+
+// finishPlacingLadder 架梯动画结束：梯子留在植物上，扶梯僵尸失去梯子后自己先翻过去
+// 植物在架梯期间被吃掉或铲除时，扶梯僵尸扛着梯子继续前进
+func (s *BehaviorSystem) finishPlacingLadder(zombieID ecs.EntityID, ladder *components.LadderZombieComponent) {
+	plantID := ladder.PlacingPlantID
+	ladder.PlacingPlantID = 0
+
+	if _, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID); !ok {
+		log.Printf("[BehaviorSystem] 扶梯僵尸 %d 架梯的植物 %d 已消失，继续前进", zombieID, plantID)
+		s.resumeWalkingAfterLadder(zombieID)
+		return
+	}
+
+	if _, err := entities.NewPlacedLadderEntity(s.entityManager, s.resourceManager, plantID); err != nil {
+		log.Printf("[BehaviorSystem] 警告：创建梯子失败: %v", err)
+		// 没有梯子图片时仍标记植物已架梯，保证翻越逻辑正常
+		ecs.AddComponent(s.entityManager, plantID, &components.LadderedComponent{})
+	}
+	s.removeZombieAccessory(zombieID, types.AccessoryLadder)
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_LADDER_ZOMBIE")
+	}
+	log.Printf("[BehaviorSystem] 扶梯僵尸 %d 在植物 %d 上架好梯子", zombieID, plantID)
+
+	s.startClimb(zombieID, plantID)
+}
+
+// startClimb 开始顺着梯子翻越植物
+// 落点：碰撞盒中心越过植物最左侧格子的左边缘
+func (s *BehaviorSystem) startClimb(zombieID, plantID ecs.EntityID) {
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, zombieID)
+	if !ok {
+		return
+	}
+	plant, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID)
+	if !ok {
+		return
+	}
+
+	collisionOffsetX := 0.0
+	if collision, ok := ecs.GetComponent[*components.CollisionComponent](s.entityManager, zombieID); ok {
+		collisionOffsetX = collision.OffsetX
+	}
+	landingCenterX := config.GridWorldStartX + float64(plant.GridCol)*config.CellWidth - config.LadderLandingMargin
+
+	ecs.AddComponent(s.entityManager, zombieID, &components.ClimbComponent{
+		PlantID: plantID,
+		StartX:  position.X,
+		TargetX: landingCenterX - collisionOffsetX,
+		BaseY:   position.Y,
+	})
+	log.Printf("[BehaviorSystem] 僵尸 %d 顺着梯子翻越植物 %d", zombieID, plantID)
+}
+
+// updateClimb 推进翻越中的僵尸：在 LadderClimbDuration 内沿抛物线从起点移动到落点，落地后恢复行走
+func (s *BehaviorSystem) updateClimb(zombieID ecs.EntityID, climb *components.ClimbComponent, position *components.PositionComponent, deltaTime float64) {
+	climb.Elapsed += deltaTime
+	t := climb.Elapsed / config.LadderClimbDuration
+	if t > 1 {
+		t = 1
+	}
+	position.X = climb.StartX + (climb.TargetX-climb.StartX)*t
+	position.Y = climb.BaseY - 4*config.LadderClimbHeight*t*(1-t)
+	if t < 1 {
+		return
+	}
+
+	position.Y = climb.BaseY
+	ecs.RemoveComponent[*components.ClimbComponent](s.entityManager, zombieID)
+	log.Printf("[BehaviorSystem] 僵尸 %d 翻过植物 %d: X=%.1f", zombieID, climb.PlantID, position.X)
+	s.resumeWalkingAfterLadder(zombieID)
+}
+
+// resumeWalkingAfterLadder 架梯或翻越结束后恢复行走
+func (s *BehaviorSystem) resumeWalkingAfterLadder(zombieID ecs.EntityID) {
+	if velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, zombieID); ok && velocity.VX == 0 {
+		velocity.VX = s.zombieWalkSpeed(zombieID)
+	}
+	s.resetZombieRootMotion(zombieID)
+	s.changeZombieAnimation(zombieID, components.ZombieAnimWalking)
+}
+
+// isLadderBusy 僵尸是否正在架梯或翻越梯子（原地架梯、翻越期间由 updateLadders 控制）
+func (s *BehaviorSystem) isLadderBusy(entityID ecs.EntityID) bool {
+	if ladder, ok := ecs.GetComponent[*components.LadderZombieComponent](s.entityManager, entityID); ok && ladder.IsPlacing() {
+		return true
+	}
+	return ecs.HasComponent[*components.ClimbComponent](s.entityManager, entityID)
+}
+
+// playLadderCombo 原地播放扶梯僵尸的动画
+func (s *BehaviorSystem) playLadderCombo(entityID ecs.EntityID, comboName string) {
+	if velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, entityID); ok {
+		velocity.VX = 0
+	}
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	behavior.ZombieAnimState = components.ZombieAnimIdle
+	s.resetZombieRootMotion(entityID)
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    behavior.UnitID,
+		ComboName: comboName,
+		Processed: false,
+	})
+}
@@ -0,0 +1,143 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// createTestLadderZombie 创建测试用的扛着梯子的扶梯僵尸
+func createTestLadderZombie(em *ecs.EntityManager, x, y float64) (ecs.EntityID, *components.LadderZombieComponent) {
+	id := createTestWalkingZombie(em, x, y)
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, id)
+	behavior.UnitID = types.UnitIDZombieLadder
+	ecs.AddComponent(em, id, &components.AccessoryComponent{
+		Pieces: []*components.AccessoryPiece{
+			{
+				Tag:           types.AccessoryLadder,
+				Tier:          2,
+				Durability:    config.LadderShieldHealth,
+				MaxDurability: config.LadderShieldHealth,
+			},
+		},
+	})
+	ladder := &components.LadderZombieComponent{}
+	ecs.AddComponent(em, id, ladder)
+	return id, ladder
+}
+
+// cellCenterX 返回指定列格子中心的世界坐标X
+func cellCenterX(col int) float64 {
+	return config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+}
+
+// TestLadderZombiePlacesLadderOnWallnut 测试扶梯僵尸在坚果墙上架梯后失去梯子，并自己翻过坚果墙
+func TestLadderZombiePlacesLadderOnWallnut(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	plantID := createTestGridPlant(em, components.PlantWallnut, 5, 2)
+	zombieID, ladder := createTestLadderZombie(em, cellCenterX(5), zombieYForRow(2))
+
+	bs.handleZombieBasicBehavior(zombieID, 0.1)
+	if ladder.PlacingPlantID != plantID {
+		t.Fatalf("扶梯僵尸应开始在坚果墙 %d 上架梯，实际 %d", plantID, ladder.PlacingPlantID)
+	}
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, zombieID)
+	if behavior.Type != components.BehaviorZombieBasic {
+		t.Errorf("架梯时不应啃食坚果墙，实际行为 %v", behavior.Type)
+	}
+	if cmd, _ := ecs.GetComponent[*components.AnimationCommandComponent](em, zombieID); cmd == nil || cmd.ComboName != "placeladder" {
+		t.Errorf("期望播放 placeladder 动画，实际 %+v", cmd)
+	}
+
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, zombieID)
+	reanim.IsFinished = true
+	bs.updateLadders(0)
+
+	if _, ok := ecs.GetComponent[*components.LadderedComponent](em, plantID); !ok {
+		t.Fatal("架梯结束后坚果墙应被架上梯子")
+	}
+	if systems.IsCarryingLadder(em, zombieID) {
+		t.Error("架梯后扶梯僵尸不应再扛着梯子")
+	}
+	if _, ok := ecs.GetComponent[*components.ClimbComponent](em, zombieID); !ok {
+		t.Fatal("架梯后扶梯僵尸应顺着梯子翻过坚果墙")
+	}
+
+	bs.updateLadders(config.LadderClimbDuration)
+	position, _ := ecs.GetComponent[*components.PositionComponent](em, zombieID)
+	plantLeftEdge := config.GridWorldStartX + 5*config.CellWidth
+	if position.X >= plantLeftEdge || position.Y != zombieYForRow(2) {
+		t.Errorf("翻越后应落在坚果墙左侧地面上，实际 (%.1f, %.1f)", position.X, position.Y)
+	}
+	if bs.isLadderBusy(zombieID) {
+		t.Error("落地后应恢复行走")
+	}
+}
+
+// TestZombieClimbsLadderedPlant 测试其他僵尸顺着梯子翻过植物，扶梯僵尸扛着梯子时啃食非坚果植物
+func TestZombieClimbsLadderedPlant(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	nutID := createTestGridPlant(em, components.PlantTallnut, 4, 1)
+	ecs.AddComponent(em, nutID, &components.LadderedComponent{})
+	zombieID := createTestWalkingZombie(em, cellCenterX(4), zombieYForRow(1))
+
+	bs.handleZombieBasicBehavior(zombieID, 0.1)
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, zombieID)
+	if behavior.Type != components.BehaviorZombieBasic {
+		t.Fatalf("遇到架着梯子的植物不应啃食，实际行为 %v", behavior.Type)
+	}
+	if _, ok := ecs.GetComponent[*components.ClimbComponent](em, zombieID); !ok {
+		t.Fatal("普通僵尸应顺着梯子翻越植物")
+	}
+
+	peashooterID := createTestGridPlant(em, components.PlantPeashooter, 6, 3)
+	ladderZombieID, ladder := createTestLadderZombie(em, cellCenterX(6), zombieYForRow(3))
+	bs.handleZombieBasicBehavior(ladderZombieID, 0.1)
+	if ladder.IsPlacing() {
+		t.Error("扶梯僵尸只在坚果墙和高坚果上架梯")
+	}
+	behavior, _ = ecs.GetComponent[*components.BehaviorComponent](em, ladderZombieID)
+	if behavior.Type != components.BehaviorZombieEating {
+		t.Errorf("扶梯僵尸应啃食豌豆射手 %d，实际行为 %v", peashooterID, behavior.Type)
+	}
+	if cmd, _ := ecs.GetComponent[*components.AnimationCommandComponent](em, ladderZombieID); cmd == nil || cmd.ComboName != "laddereat" {
+		t.Errorf("扛着梯子时期望播放 laddereat 动画，实际 %+v", cmd)
+	}
+}
+
+// TestPlacedLadderRemovedWithPlant 测试植物被吃掉后靠在上面的梯子随之移除
+func TestPlacedLadderRemovedWithPlant(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	plantID := createTestGridPlant(em, components.PlantWallnut, 3, 0)
+	ladderID := em.CreateEntity()
+	ecs.AddComponent(em, ladderID, &components.PlacedLadderComponent{PlantID: plantID})
+	ecs.AddComponent(em, plantID, &components.LadderedComponent{LadderEntityID: ladderID})
+
+	bs.updateLadders(0.1)
+	em.RemoveMarkedEntities()
+	if _, ok := ecs.GetComponent[*components.PlacedLadderComponent](em, ladderID); !ok {
+		t.Fatal("植物还在时梯子不应被移除")
+	}
+
+	bs.destroyPlant(plantID)
+	em.RemoveMarkedEntities()
+	bs.updateLadders(0.1)
+	em.RemoveMarkedEntities()
+	if _, ok := ecs.GetComponent[*components.PlacedLadderComponent](em, ladderID); ok {
+		t.Error("植物被吃掉后梯子应被移除")
+	}
+}
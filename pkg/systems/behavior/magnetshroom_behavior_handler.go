@@ -0,0 +1,243 @@
+package behavior
+
+import (
+	"log"
+	"math"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// handleMagnetshroomBehavior 处理磁力菇的行为逻辑
+//
+// 行为流程:
+//  1. 冷却中：递减冷却时间，缩小吸附的饰品，冷却结束后删除饰品并恢复待机动画
+//  2. 空闲时：查找范围内最近的、带有金属饰品的僵尸，吸走该饰品并进入冷却
+func (s *BehaviorSystem) handleMagnetshroomBehavior(entityID ecs.EntityID, deltaTime float64) {
+	magnet, ok := ecs.GetComponent[*components.MagnetshroomComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	// 冷却中：更新吸附饰品的显示
+	if !magnet.IsReady() {
+		magnet.RechargeTimer -= deltaTime
+		s.updateMagnetHeldAccessory(magnet)
+
+		if magnet.IsReady() {
+			s.releaseMagnetHeldAccessory(entityID, magnet)
+		}
+		return
+	}
+
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	zombieID, piece := s.findMagnetTarget(position.X, position.Y)
+	if zombieID == 0 || piece == nil {
+		return
+	}
+
+	tag := piece.Tag
+	if !s.removeZombieAccessory(zombieID, tag) {
+		return
+	}
+
+	log.Printf("[BehaviorSystem] 磁力菇 %d 吸走僵尸 %d 的金属饰品: %s", entityID, zombieID, tag)
+
+	// 进入冷却，饰品吸附在磁力菇头部
+	magnet.HeldAccessory = tag
+	magnet.RechargeTimer = config.MagnetshroomCooldown
+
+	heldID, err := entities.NewMagnetHeldAccessoryEntity(
+		s.entityManager,
+		s.resourceManager,
+		tag,
+		position.X+config.MagnetshroomHeldOffsetX,
+		position.Y+config.MagnetshroomHeldOffsetY,
+	)
+	if err != nil {
+		log.Printf("[BehaviorSystem] 警告：创建吸附饰品显示失败: %v", err)
+	} else {
+		magnet.HeldEntity = heldID
+	}
+
+	// 切换为冷却动画
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    "magnetshroom",
+		ComboName: "nonactive",
+		Processed: false,
+	})
+
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_MAGNETSHROOM")
+	}
+}
+
+// findMagnetTarget 查找磁力菇范围内最近的、带有金属饰品的僵尸
+//
+// 参数:
+//   - magnetX, magnetY: 磁力菇中心位置（世界坐标）
+//
+// 返回:
+//   - ecs.EntityID: 目标僵尸ID，未找到时返回 0
+//   - *components.AccessoryPiece: 将被吸走的金属饰品
+func (s *BehaviorSystem) findMagnetTarget(magnetX, magnetY float64) (ecs.EntityID, *components.AccessoryPiece) {
+	candidates := ecs.GetEntitiesWith3[
+		*components.AccessoryComponent,
+		*components.BehaviorComponent,
+		*components.PositionComponent,
+	](s.entityManager)
+
+	var targetID ecs.EntityID
+	var targetPiece *components.AccessoryPiece
+	minDistance := math.MaxFloat64
+
+	for _, zombieID := range candidates {
+		behavior, _ := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, zombieID)
+		if !s.isZombieBehaviorType(behavior.Type) || behavior.Type == components.BehaviorZombieDying {
+			continue
+		}
+
+		// 被魅惑的僵尸属于植物阵营
+		if s.isCharmedZombie(zombieID) {
+			continue
+		}
+
+		// 正在打开的八音盒已来不及吸走
+		if s.isJackPopping(zombieID) {
+			continue
+		}
+
+		// 未激活的僵尸（开场预览、待命中）不受磁力菇影响
+		if waveState, ok := ecs.GetComponent[*components.ZombieWaveStateComponent](s.entityManager, zombieID); ok {
+			if !waveState.IsActivated {
+				continue
+			}
+		}
+
+		accessory, _ := ecs.GetComponent[*components.AccessoryComponent](s.entityManager, zombieID)
+		piece := accessory.FirstMetal()
+		if piece == nil {
+			continue
+		}
+
+		pos, _ := ecs.GetComponent[*components.PositionComponent](s.entityManager, zombieID)
+		distance := math.Hypot(pos.X-magnetX, pos.Y-magnetY)
+		if distance > config.MagnetshroomRange {
+			continue
+		}
+
+		if distance < minDistance {
+			minDistance = distance
+			targetID = zombieID
+			targetPiece = piece
+		}
+	}
+
+	return targetID, targetPiece
+}
+
+// removeZombieAccessory 从僵尸身上移除指定饰品
+//
+// 处理内容:
+//   - 将饰品标记为已移除（渲染系统据此隐藏饰品轨道）
+//   - I 类饰品：路障/铁桶僵尸转为普通僵尸
+//   - II 类饰品：铁栅门僵尸转为普通僵尸，读报僵尸进入狂暴
+//
+// 注意：与护甲被打碎不同，此处不播放饰品掉落粒子效果（饰品被吸走而非掉落）
+//
+// 返回:
+//   - true: 成功移除
+//   - false: 僵尸没有该饰品
+func (s *BehaviorSystem) removeZombieAccessory(zombieID ecs.EntityID, tag types.AccessoryTag) bool {
+	accessory, ok := ecs.GetComponent[*components.AccessoryComponent](s.entityManager, zombieID)
+	if !ok {
+		return false
+	}
+
+	piece := accessory.Remove(tag)
+	if piece == nil {
+		return false
+	}
+
+	if piece.Tier == 1 {
+		// 路障/铁桶僵尸失去头部饰品后变为普通僵尸
+		// 饰品已标记为移除，handleArmorDestroyedWhileEating 不会重复处理
+		if behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, zombieID); ok {
+			switch behavior.Type {
+			case components.BehaviorZombieConehead, components.BehaviorZombieBuckethead:
+				behavior.Type = components.BehaviorZombieBasic
+			}
+			switch behavior.UnitID {
+			case types.UnitIDZombieConehead, types.UnitIDZombieBuckethead:
+				behavior.UnitID = types.UnitIDZombie
+			}
+		}
+	}
+
+	if piece.Tier == 2 {
+		s.onZombieShieldLost(zombieID, piece)
+	}
+
+	// 跳跳僵尸失去跳跳杆后变为普通行走
+	if piece.Tag == types.AccessoryPogoStick {
+		s.dropVaultProp(zombieID)
+	}
+
+	// 小丑僵尸失去八音盒后不再爆炸
+	if piece.Tag == types.AccessoryJackBox {
+		s.disarmJack(zombieID)
+	}
+
+	// 矿工僵尸在地下失去矿工镐时就地钻出
+	if piece.Tag == types.AccessoryPickaxe {
+		s.disarmDigger(zombieID)
+	}
+
+	return true
+}
+
+// updateMagnetHeldAccessory 根据冷却进度缩小吸附的饰品
+func (s *BehaviorSystem) updateMagnetHeldAccessory(magnet *components.MagnetshroomComponent) {
+	if magnet.HeldEntity == 0 {
+		return
+	}
+
+	scale, ok := ecs.GetComponent[*components.ScaleComponent](s.entityManager, magnet.HeldEntity)
+	if !ok {
+		return
+	}
+
+	ratio := magnet.RechargeTimer / config.MagnetshroomCooldown
+	if ratio < 0 {
+		ratio = 0
+	}
+	scale.ScaleX = ratio
+	scale.ScaleY = ratio
+}
+
+// releaseMagnetHeldAccessory 冷却结束：删除吸附的饰品并恢复待机动画
+func (s *BehaviorSystem) releaseMagnetHeldAccessory(entityID ecs.EntityID, magnet *components.MagnetshroomComponent) {
+	if magnet.HeldEntity != 0 {
+		s.entityManager.DestroyEntity(magnet.HeldEntity)
+	}
+
+	log.Printf("[BehaviorSystem] 磁力菇 %d 冷却结束，%s 已消失", entityID, magnet.HeldAccessory)
+
+	magnet.HeldAccessory = types.AccessoryNone
+	magnet.HeldEntity = 0
+	magnet.RechargeTimer = 0
+
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    "magnetshroom",
+		ComboName: "idle",
+		Processed: false,
+	})
+}
@@ -0,0 +1,164 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// createTestMagnetshroom 创建测试用的磁力菇实体
+func createTestMagnetshroom(em *ecs.EntityManager, x, y float64) ecs.EntityID {
+	id := em.CreateEntity()
+	ecs.AddComponent(em, id, &components.PositionComponent{X: x, Y: y})
+	ecs.AddComponent(em, id, &components.BehaviorComponent{Type: components.BehaviorMagnetshroom})
+	ecs.AddComponent(em, id, &components.MagnetshroomComponent{})
+	return id
+}
+
+// createTestArmoredZombie 创建测试用的带头部饰品僵尸实体
+func createTestArmoredZombie(em *ecs.EntityManager, behaviorType components.BehaviorType, unitID string, tag types.AccessoryTag, x, y float64) ecs.EntityID {
+	id := em.CreateEntity()
+	ecs.AddComponent(em, id, &components.PositionComponent{X: x, Y: y})
+	ecs.AddComponent(em, id, &components.BehaviorComponent{Type: behaviorType, UnitID: unitID})
+	ecs.AddComponent(em, id, &components.ReanimComponent{})
+	ecs.AddComponent(em, id, &components.AccessoryComponent{
+		Pieces: []*components.AccessoryPiece{{Tag: tag, Tier: 1, Durability: 1100, MaxDurability: 1100}},
+	})
+	return id
+}
+
+// TestMagnetshroomStealsBucket 测试磁力菇吸走范围内铁桶僵尸的铁桶
+func TestMagnetshroomStealsBucket(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	magnetID := createTestMagnetshroom(em, 300, 300)
+	zombieID := createTestArmoredZombie(em, components.BehaviorZombieBuckethead,
+		types.UnitIDZombieBuckethead, types.AccessoryBucket, 400, 300)
+
+	bs.handleMagnetshroomBehavior(magnetID, 0.016)
+
+	accessory, _ := ecs.GetComponent[*components.AccessoryComponent](em, zombieID)
+	if accessory.Has(types.AccessoryBucket) {
+		t.Error("铁桶应被磁力菇吸走")
+	}
+
+	if bucket := accessory.Pieces[0]; !bucket.Removed {
+		t.Error("铁桶应标记为已移除，渲染时隐藏铁桶轨道")
+	}
+
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, zombieID)
+	if behavior.Type != components.BehaviorZombieBasic {
+		t.Errorf("铁桶僵尸应变为普通僵尸，实际为 %v", behavior.Type)
+	}
+	if behavior.UnitID != types.UnitIDZombie {
+		t.Errorf("UnitID 应变为 %s，实际为 %s", types.UnitIDZombie, behavior.UnitID)
+	}
+
+	magnet, _ := ecs.GetComponent[*components.MagnetshroomComponent](em, magnetID)
+	if magnet.HeldAccessory != types.AccessoryBucket {
+		t.Errorf("磁力菇应吸附铁桶，实际为 %s", magnet.HeldAccessory)
+	}
+	if magnet.RechargeTimer != config.MagnetshroomCooldown {
+		t.Errorf("磁力菇应进入冷却 %.1f 秒，实际为 %.1f", config.MagnetshroomCooldown, magnet.RechargeTimer)
+	}
+}
+
+// TestMagnetshroomIgnoresNonMetal 测试磁力菇不会吸走路障（非金属）
+func TestMagnetshroomIgnoresNonMetal(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	magnetID := createTestMagnetshroom(em, 300, 300)
+	zombieID := createTestArmoredZombie(em, components.BehaviorZombieConehead,
+		types.UnitIDZombieConehead, types.AccessoryCone, 400, 300)
+
+	bs.handleMagnetshroomBehavior(magnetID, 0.016)
+
+	accessory, _ := ecs.GetComponent[*components.AccessoryComponent](em, zombieID)
+	if !accessory.Has(types.AccessoryCone) {
+		t.Error("路障不是金属，不应被吸走")
+	}
+
+	magnet, _ := ecs.GetComponent[*components.MagnetshroomComponent](em, magnetID)
+	if !magnet.IsReady() {
+		t.Error("未吸取饰品时磁力菇不应进入冷却")
+	}
+}
+
+// TestMagnetshroomOutOfRange 测试磁力菇不会吸走范围外的饰品
+func TestMagnetshroomOutOfRange(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	magnetID := createTestMagnetshroom(em, 100, 300)
+	zombieID := createTestArmoredZombie(em, components.BehaviorZombieBuckethead,
+		types.UnitIDZombieBuckethead, types.AccessoryBucket, 100+config.MagnetshroomRange+50, 300)
+
+	bs.handleMagnetshroomBehavior(magnetID, 0.016)
+
+	accessory, _ := ecs.GetComponent[*components.AccessoryComponent](em, zombieID)
+	if !accessory.Has(types.AccessoryBucket) {
+		t.Error("范围外的铁桶不应被吸走")
+	}
+}
+
+// TestMagnetshroomRecharge 测试磁力菇冷却结束后恢复可用
+func TestMagnetshroomRecharge(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	magnetID := createTestMagnetshroom(em, 300, 300)
+	magnet, _ := ecs.GetComponent[*components.MagnetshroomComponent](em, magnetID)
+	magnet.HeldAccessory = types.AccessoryBucket
+	magnet.RechargeTimer = 1.0
+
+	bs.handleMagnetshroomBehavior(magnetID, 0.5)
+	if magnet.IsReady() {
+		t.Fatal("冷却未结束时不应可用")
+	}
+
+	bs.handleMagnetshroomBehavior(magnetID, 0.6)
+	if !magnet.IsReady() {
+		t.Error("冷却结束后应可用")
+	}
+	if magnet.HeldAccessory != types.AccessoryNone {
+		t.Errorf("冷却结束后应清除吸附的饰品，实际为 %s", magnet.HeldAccessory)
+	}
+}
+
+// TestMagnetshroomIgnoresBrokenBucket 测试被打掉的铁桶从饰品列表中移除，磁力菇不会再吸取
+func TestMagnetshroomIgnoresBrokenBucket(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	magnetID := createTestMagnetshroom(em, 300, 300)
+	zombieID := createTestArmoredZombie(em, components.BehaviorZombieEating,
+		types.UnitIDZombieBuckethead, types.AccessoryBucket, 400, 300)
+
+	// 啃食中的铁桶僵尸护甲被打光
+	accessory, _ := ecs.GetComponent[*components.AccessoryComponent](em, zombieID)
+	accessory.Pieces[0].Durability = 0
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, zombieID)
+	bs.handleArmorDestroyedWhileEating(zombieID, behavior)
+
+	if accessory.Has(types.AccessoryBucket) {
+		t.Fatal("被打掉的铁桶应从饰品列表中移除")
+	}
+
+	bs.handleMagnetshroomBehavior(magnetID, 0.016)
+
+	magnet, _ := ecs.GetComponent[*components.MagnetshroomComponent](em, magnetID)
+	if !magnet.IsReady() || magnet.HeldAccessory != types.AccessoryNone {
+		t.Errorf("磁力菇不应吸取已被打掉的铁桶，冷却 %.1f 秒，吸附 %q", magnet.RechargeTimer, magnet.HeldAccessory)
+	}
+}
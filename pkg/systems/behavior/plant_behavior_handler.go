@@ -10,7 +10,7 @@ import (
 	"github.com/gonewx/pvz/pkg/ecs"
 	"github.com/gonewx/pvz/pkg/entities"
 	"github.com/gonewx/pvz/pkg/game"
-	"github.com/gonewx/pvz/pkg/utils"
+	"github.com/gonewx/pvz/pkg/systems"
 )
 
 func (s *BehaviorSystem) handleSunflowerBehavior(entityID ecs.EntityID, deltaTime float64) {
@@ -173,7 +173,7 @@ func (s *BehaviorSystem) handlePeashooterBehavior(entityID ecs.EntityID, deltaTi
 	}
 
 	// 计算豌豆射手所在的行
-	peashooterRow := utils.GetEntityRow(peashooterPos.Y, config.GridWorldStartY, config.CellHeight)
+	peashooterRow := s.lawnLayout.RowAt(peashooterPos.X, peashooterPos.Y)
 
 	// 扫描同行僵尸：查找在豌豆射手正前方（右侧）且在攻击范围内的僵尸
 	hasZombieInLine := false
@@ -192,7 +192,7 @@ func (s *BehaviorSystem) handlePeashooterBehavior(entityID ecs.EntityID, deltaTi
 		}
 
 		// 计算僵尸所在的行
-		zombieRow := utils.GetEntityRow(zombiePos.Y, config.GridWorldStartY, config.CellHeight)
+		zombieRow := s.lawnLayout.RowAt(zombiePos.X, zombiePos.Y)
 
 		// 检查僵尸是否在同一行、在豌豆射手右侧、且已进入屏幕可见区域
 		if zombieRow == peashooterRow &&
@@ -274,6 +274,35 @@ func (s *BehaviorSystem) handlePeashooterBehavior(entityID ecs.EntityID, deltaTi
 	}
 }
 
+// nutAppearance 坚果类植物的外观资源（坚果墙、高坚果共用坚果墙行为）
+type nutAppearance struct {
+	unitID        string    // 动画配置 ID
+	bodyImageKey  string    // PartImages 中身体图片的键
+	bodyImagePath [3]string // 完好、轻伤、重伤三种状态的身体图片路径
+}
+
+// nutAppearances 按植物类型索引的坚果外观资源
+var nutAppearances = map[components.PlantType]nutAppearance{
+	components.PlantWallnut: {
+		unitID:       "wallnut",
+		bodyImageKey: "IMAGE_REANIM_WALLNUT_BODY",
+		bodyImagePath: [3]string{
+			"assets/reanim/Wallnut_body.png",
+			"assets/reanim/Wallnut_cracked1.png",
+			"assets/reanim/Wallnut_cracked2.png",
+		},
+	},
+	components.PlantTallnut: {
+		unitID:       "tallnut",
+		bodyImageKey: "IMAGE_REANIM_TALLNUT_BODY",
+		bodyImagePath: [3]string{
+			"assets/reanim/Tallnut_body.png",
+			"assets/reanim/Tallnut_cracked1.png",
+			"assets/reanim/Tallnut_cracked2.png",
+		},
+	},
+}
+
 func (s *BehaviorSystem) handleWallnutBehavior(entityID ecs.EntityID, deltaTime float64) {
 	// 获取生命值组件
 	health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, entityID)
@@ -287,6 +316,11 @@ func (s *BehaviorSystem) handleWallnutBehavior(entityID ecs.EntityID, deltaTime
 		return
 	}
 
+	appearance, ok := nutAppearances[plantComp.PlantType]
+	if !ok {
+		appearance = nutAppearances[components.PlantWallnut]
+	}
+
 	// 计算生命值百分比
 	healthPercent := float64(health.CurrentHealth) / float64(health.MaxHealth)
 
@@ -324,7 +358,7 @@ func (s *BehaviorSystem) handleWallnutBehavior(entityID ecs.EntityID, deltaTime
 			reanim.AnimationPausedStates["anim_face"] = false
 			// 切换回 idle 动画（如果之前在播放眨眼动画）
 			ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
-				UnitID:    "wallnut",
+				UnitID:    appearance.unitID,
 				ComboName: "idle",
 				Processed: false,
 			})
@@ -340,7 +374,7 @@ func (s *BehaviorSystem) handleWallnutBehavior(entityID ecs.EntityID, deltaTime
 			if plantComp.WallnutBlinkDuration <= 0 {
 				// 眨眼动画播放完成，切换回 being_eaten 组合（只有身体，没有眨眼轨道）
 				ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
-					UnitID:    "wallnut",
+					UnitID:    appearance.unitID,
 					ComboName: "being_eaten",
 					Processed: false,
 				})
@@ -363,7 +397,7 @@ func (s *BehaviorSystem) handleWallnutBehavior(entityID ecs.EntityID, deltaTime
 			}
 			// 触发眨眼动画（配置中已设置 loop: false，播放一次后停止）
 			ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
-				UnitID:    "wallnut",
+				UnitID:    appearance.unitID,
 				ComboName: blinkAnim,
 				Processed: false,
 			})
@@ -380,25 +414,21 @@ func (s *BehaviorSystem) handleWallnutBehavior(entityID ecs.EntityID, deltaTime
 	}
 
 	// 确定应显示的身体图片路径和当前状态
-	// 文件名使用正确的大小写：Wallnut_xxx.png
-	var targetBodyImagePath string
 	var newDamageState int // 0=完好, 1=轻伤, 2=重伤
 	if healthPercent > config.WallnutCracked1Threshold {
 		// 完好状态 (> 66%)
-		targetBodyImagePath = "assets/reanim/Wallnut_body.png"
 		newDamageState = 0
 	} else if healthPercent > config.WallnutCracked2Threshold {
 		// 轻伤状态 (33% - 66%)
-		targetBodyImagePath = "assets/reanim/Wallnut_cracked1.png"
 		newDamageState = 1
 	} else {
 		// 重伤状态 (< 33%)
-		targetBodyImagePath = "assets/reanim/Wallnut_cracked2.png"
 		newDamageState = 2
 	}
+	targetBodyImagePath := appearance.bodyImagePath[newDamageState]
 
 	// 检查是否需要切换图片（避免每帧重复加载）
-	currentBodyImage, exists := reanim.PartImages["IMAGE_REANIM_WALLNUT_BODY"]
+	currentBodyImage, exists := reanim.PartImages[appearance.bodyImageKey]
 	if !exists {
 		return
 	}
@@ -435,7 +465,7 @@ func (s *BehaviorSystem) handleWallnutBehavior(entityID ecs.EntityID, deltaTime
 			plantComp.WallnutDamageState = newDamageState
 		}
 
-		reanim.PartImages["IMAGE_REANIM_WALLNUT_BODY"] = targetBodyImage
+		reanim.PartImages[appearance.bodyImageKey] = targetBodyImage
 		log.Printf("[BehaviorSystem] 坚果墙 %d 切换外观: HP=%d/%d (%.1f%%), 图片=%s",
 			entityID, health.CurrentHealth, health.MaxHealth, healthPercent*100, targetBodyImagePath)
 	}
@@ -467,7 +497,7 @@ func (s *BehaviorSystem) isPlantBeingEaten(row, col int) bool {
 
 		// 计算僵尸碰撞盒中心所在格子
 		zombieCol := int((pos.X + collisionOffsetX - config.GridWorldStartX) / config.CellWidth)
-		zombieRow := int((pos.Y - config.GridWorldStartY - config.ZombieVerticalOffset - config.CellHeight/2.0) / config.CellHeight)
+		zombieRow := s.lawnLayout.RowAt(pos.X, pos.Y-config.ZombieVerticalOffset)
 
 		if zombieRow == row && zombieCol == col {
 			return true
@@ -575,7 +605,7 @@ func (s *BehaviorSystem) triggerCherryBombExplosion(entityID ecs.EntityID) {
 		distanceSq := dx*dx + dy*dy
 
 		// 计算僵尸所在行（用于调试）
-		zombieRow := int((zombiePos.Y - config.GridWorldStartY - config.ZombieVerticalOffset) / config.CellHeight)
+		zombieRow := s.lawnLayout.RowAt(zombiePos.X, zombiePos.Y-config.ZombieVerticalOffset)
 		log.Printf("[BehaviorSystem] 检测僵尸 %d: pos=(%.1f, %.1f), 碰撞盒中心Y=%.1f, row=%d, 到碰撞盒距离=%.1f, 半径=%.1f",
 			zombieID, zombiePos.X, zombiePos.Y, zombieCenterY, zombieRow, math.Sqrt(distanceSq), explosionRadius)
 
@@ -587,20 +617,11 @@ func (s *BehaviorSystem) triggerCherryBombExplosion(entityID ecs.EntityID) {
 			// 应用伤害：先扣护甲，护甲不足或无护甲则扣生命值
 			damage := config.CherryBombDamage
 
-			// 检查是否有护甲组件
-			armor, hasArmor := ecs.GetComponent[*components.ArmorComponent](s.entityManager, zombieID)
-			if hasArmor {
-				if armor.CurrentArmor > 0 {
-					// 护甲优先扣除
-					armorDamage := damage
-					if armorDamage > armor.CurrentArmor {
-						armorDamage = armor.CurrentArmor
-					}
-					armor.CurrentArmor -= armorDamage
-					damage -= armorDamage
-					log.Printf("[BehaviorSystem] 僵尸 %d 护甲受损：-%d，剩余护甲：%d，剩余伤害：%d",
-						zombieID, armorDamage, armor.CurrentArmor, damage)
-				}
+			// 头部防具优先扣除
+			if remaining := systems.AbsorbHelmetDamage(s.entityManager, zombieID, damage); remaining != damage {
+				log.Printf("[BehaviorSystem] 僵尸 %d 护甲受损：-%d，剩余伤害：%d",
+					zombieID, damage-remaining, remaining)
+				damage = remaining
 			}
 
 			// 如果还有剩余伤害，扣除生命值
@@ -651,7 +672,7 @@ func (s *BehaviorSystem) triggerCherryBombExplosion(entityID ecs.EntityID) {
 	// 释放樱桃炸弹占用的网格，允许重新种植
 	if plantComp, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, entityID); ok {
 		if s.lawnGridSystem != nil && s.lawnGridEntityID != 0 {
-			err := s.lawnGridSystem.ReleaseCell(s.lawnGridEntityID, plantComp.GridCol, plantComp.GridRow)
+			err := s.lawnGridSystem.ReleaseCells(s.lawnGridEntityID, plantComp.GridCol, plantComp.GridRow, components.PlantFootprintWidth(plantComp.PlantType))
 			if err != nil {
 				log.Printf("[BehaviorSystem] 警告：释放樱桃炸弹网格占用失败: %v", err)
 			} else {
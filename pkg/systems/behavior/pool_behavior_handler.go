@@ -0,0 +1,221 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// handleTangleKelpBehavior 处理缠绕水草的行为
+//
+// 缠绕水草缠住第一个进入所在格子的地面僵尸，在 TangleKelpDragDuration 内把它拖入水中，
+// 之后僵尸死亡，缠绕水草随之消失
+func (s *BehaviorSystem) handleTangleKelpBehavior(entityID ecs.EntityID, deltaTime float64, zombieEntityList []ecs.EntityID) {
+	kelp, ok := ecs.GetComponent[*components.TangleKelpComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	plant, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	if kelp.TargetID == 0 {
+		if targetID, found := s.findTangleKelpTarget(plant, zombieEntityList); found {
+			s.grabZombie(entityID, kelp, targetID)
+		}
+		return
+	}
+
+	kelp.DragTimer -= deltaTime
+	progress := 1 - kelp.DragTimer/config.TangleKelpDragDuration
+	if progress > 1 {
+		progress = 1
+	}
+	if tangled, ok := ecs.GetComponent[*components.TangledComponent](s.entityManager, kelp.TargetID); ok {
+		if position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, kelp.TargetID); ok {
+			position.Y = tangled.StartY + progress*config.TangleKelpDragDepth
+		}
+	}
+	if kelp.DragTimer > 0 {
+		return
+	}
+
+	// 拖拽期间被其他植物打死的僵尸由死亡流程处理
+	if behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, kelp.TargetID); ok &&
+		behavior.Type != components.BehaviorZombieDying {
+		s.recordZombieKilled(kelp.TargetID)
+		s.entityManager.DestroyEntity(kelp.TargetID)
+	}
+
+	if position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID); ok {
+		if _, err := entities.CreateParticleEffect(s.entityManager, s.resourceManager, "PoolSplash", position.X, position.Y); err != nil {
+			log.Printf("[BehaviorSystem] 警告：创建缠绕水草水花效果失败: %v", err)
+		}
+	}
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_ZOMBIESPLASH")
+	}
+
+	log.Printf("[BehaviorSystem] 缠绕水草 %d 把僵尸 %d 拖入水中", entityID, kelp.TargetID)
+	s.destroyPlant(entityID)
+}
+
+// findTangleKelpTarget 查找进入缠绕水草所在格子的地面僵尸
+// 空中、地下、被魅惑和已被其他缠绕水草缠住的僵尸不会被缠住
+func (s *BehaviorSystem) findTangleKelpTarget(plant *components.PlantComponent, zombieEntityList []ecs.EntityID) (ecs.EntityID, bool) {
+	for _, zombieID := range zombieEntityList {
+		if s.isCharmedZombie(zombieID) || s.isAirborneZombie(zombieID) || s.isUndergroundZombie(zombieID) || s.isZombieTangled(zombieID) {
+			continue
+		}
+		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, zombieID)
+		if !ok || behavior.Type == components.BehaviorZombieDying {
+			continue
+		}
+		position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, zombieID)
+		if !ok {
+			continue
+		}
+		if s.zombieRowOf(position) != plant.GridRow || iceColumnAt(s.zombieCenterX(zombieID, position)) != plant.GridCol {
+			continue
+		}
+		return zombieID, true
+	}
+	return 0, false
+}
+
+// grabZombie 缠绕水草缠住僵尸：僵尸原地不动，开始被拖入水中
+func (s *BehaviorSystem) grabZombie(kelpID ecs.EntityID, kelp *components.TangleKelpComponent, zombieID ecs.EntityID) {
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, zombieID)
+	if !ok {
+		return
+	}
+
+	kelp.TargetID = zombieID
+	kelp.DragTimer = config.TangleKelpDragDuration
+	ecs.AddComponent(s.entityManager, zombieID, &components.TangledComponent{
+		KelpID: kelpID,
+		StartY: position.Y,
+	})
+	if velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, zombieID); ok {
+		velocity.VX = 0
+	}
+
+	ecs.AddComponent(s.entityManager, kelpID, &components.AnimationCommandComponent{
+		UnitID:    "tanglekelp",
+		ComboName: "grab",
+		Processed: false,
+	})
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_FLOOP")
+	}
+
+	log.Printf("[BehaviorSystem] 缠绕水草 %d 缠住僵尸 %d", kelpID, zombieID)
+}
+
+// isZombieTangled 僵尸是否被缠绕水草缠住（原地不动，由缠绕水草拖入水中）
+func (s *BehaviorSystem) isZombieTangled(entityID ecs.EntityID) bool {
+	return ecs.HasComponent[*components.TangledComponent](s.entityManager, entityID)
+}
+
+// handleSeaShroomBehavior 处理海蘑菇的行为逻辑
+// 计时器就绪且同一行射程内有僵尸时，向右发射一颗短程孢子
+func (s *BehaviorSystem) handleSeaShroomBehavior(entityID ecs.EntityID, deltaTime float64, zombieEntityList []ecs.EntityID) {
+	plant, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	timer, ok := ecs.GetComponent[*components.TimerComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	// 喷射动画播放完毕后切换回待机动画
+	if plant.AttackAnimState == components.AttackAnimAttacking {
+		if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok && reanim.IsFinished {
+			ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+				UnitID:    "seashroom",
+				ComboName: "idle",
+				Processed: false,
+			})
+			plant.AttackAnimState = components.AttackAnimIdle
+		}
+	}
+
+	timer.CurrentTime += deltaTime
+	if timer.CurrentTime < timer.TargetTime {
+		return
+	}
+	if !s.hasZombieInRange(position, zombieEntityList, config.SeaShroomRange) {
+		return // 没有目标时保持就绪
+	}
+
+	timer.CurrentTime = 0
+
+	startX := position.X + config.PuffLaunchOffsetX
+	startY := position.Y + config.PuffLaunchOffsetY
+	if _, err := entities.NewPuffProjectile(s.entityManager, s.resourceManager, startX, startY); err != nil {
+		log.Printf("[BehaviorSystem] 海蘑菇 %d 创建孢子失败: %v", entityID, err)
+		return
+	}
+
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_PUFF")
+	}
+
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    "seashroom",
+		ComboName: "attack",
+		Processed: false,
+	})
+	plant.AttackAnimState = components.AttackAnimAttacking
+
+	log.Printf("[BehaviorSystem] 海蘑菇 %d 发射孢子", entityID)
+}
+
+// hasZombieInRange 检查同一行前方 maxDistance 以内是否有存活的僵尸
+func (s *BehaviorSystem) hasZombieInRange(position *components.PositionComponent, zombieEntityList []ecs.EntityID, maxDistance float64) bool {
+	row := s.lawnLayout.RowAt(position.X, position.Y)
+
+	for _, zombieID := range zombieEntityList {
+		zombiePos, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, zombieID)
+		if !ok {
+			continue
+		}
+		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, zombieID)
+		if !ok || behavior.Type == components.BehaviorZombieDying {
+			continue
+		}
+		if s.lawnLayout.RowAt(zombiePos.X, zombiePos.Y) != row {
+			continue
+		}
+		if zombiePos.X > position.X && zombiePos.X <= position.X+maxDistance {
+			return true
+		}
+	}
+	return false
+}
+
+// handlePuffProjectileBehavior 处理孢子的飞行
+// 孢子沿直线飞行，飞出射程后消散
+func (s *BehaviorSystem) handlePuffProjectileBehavior(entityID ecs.EntityID, deltaTime float64) {
+	if !s.moveDirectProjectile(entityID, deltaTime, "孢子") {
+		return
+	}
+
+	puff, ok := ecs.GetComponent[*components.PuffComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	if position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID); ok && position.X > puff.MaxX {
+		s.entityManager.DestroyEntity(entityID)
+	}
+}
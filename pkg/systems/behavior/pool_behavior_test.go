@@ -0,0 +1,215 @@
+package behavior
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// poolTestZombieX 测试僵尸的初始 X 坐标：刚越过草坪右边缘，位于第 8 列
+const poolTestZombieX = config.GridWorldEndX - 10
+
+// poolLayout 后院泳池场景的草坪行布局
+var poolLayout = config.GetLawnLayout("pool")
+
+// poolZombieYForRow 返回泳池草坪指定行僵尸的 Y 坐标
+func poolZombieYForRow(row int) float64 {
+	return poolLayout.StartY + float64(row)*poolLayout.CellHeight + poolLayout.CellHeight/2 + config.ZombieVerticalOffset
+}
+
+// createTestPoolPlant 在泳池草坪的指定格子上创建测试植物
+func createTestPoolPlant(em *ecs.EntityManager, plantType components.PlantType, col, row int) ecs.EntityID {
+	id := createTestGridPlant(em, plantType, col, row)
+	position, _ := ecs.GetComponent[*components.PositionComponent](em, id)
+	position.Y = poolLayout.StartY + float64(row)*poolLayout.CellHeight + poolLayout.CellHeight/2
+	return id
+}
+
+// createTestSwimZombie 创建测试用的水路僵尸实体
+func createTestSwimZombie(em *ecs.EntityManager, x, y float64, swim *components.SwimComponent) ecs.EntityID {
+	id := createTestWalkingZombie(em, x, y)
+	ecs.AddComponent(em, id, swim)
+	return id
+}
+
+// TestTangleKelpDragsZombieUnderwater 测试缠绕水草缠住进入格子的僵尸，拖入水中后连同自己一起消失
+func TestTangleKelpDragsZombieUnderwater(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystemWithLayout(em, rm, game.GetGameState(), poolLayout)
+
+	kelpID := createTestPoolPlant(em, components.PlantTangleKelp, 6, 2)
+	kelp := &components.TangleKelpComponent{}
+	ecs.AddComponent(em, kelpID, kelp)
+	if err := bs.lawnGridSystem.OccupyCell(bs.lawnGridEntityID, 6, 2, kelpID); err != nil {
+		t.Fatalf("Failed to occupy cell: %v", err)
+	}
+
+	kelpPos, _ := ecs.GetComponent[*components.PositionComponent](em, kelpID)
+	farZombie := createTestWalkingZombie(em, kelpPos.X+2*config.CellWidth, poolZombieYForRow(2))
+	otherRow := createTestWalkingZombie(em, kelpPos.X, poolZombieYForRow(3))
+	zombieID := createTestWalkingZombie(em, kelpPos.X, poolZombieYForRow(2))
+	zombieList := []ecs.EntityID{farZombie, otherRow, zombieID}
+
+	bs.handleTangleKelpBehavior(kelpID, 0.1, zombieList)
+	if kelp.TargetID != zombieID {
+		t.Fatalf("应缠住进入格子的僵尸 %d，实际 %d", zombieID, kelp.TargetID)
+	}
+	if !bs.isZombieTangled(zombieID) {
+		t.Fatal("被缠住的僵尸应带有缠绕状态")
+	}
+
+	position, _ := ecs.GetComponent[*components.PositionComponent](em, zombieID)
+	startX := position.X
+	bs.handleZombieBasicBehavior(zombieID, 0.1)
+	if position.X != startX {
+		t.Errorf("被缠住的僵尸不应移动，实际 X %.1f → %.1f", startX, position.X)
+	}
+
+	bs.handleTangleKelpBehavior(kelpID, config.TangleKelpDragDuration/2, zombieList)
+	if position.Y <= poolZombieYForRow(2) {
+		t.Errorf("拖拽期间僵尸应被拉向水下，实际 Y %.1f", position.Y)
+	}
+
+	bs.handleTangleKelpBehavior(kelpID, config.TangleKelpDragDuration, zombieList)
+	em.RemoveMarkedEntities()
+
+	if _, ok := ecs.GetComponent[*components.BehaviorComponent](em, zombieID); ok {
+		t.Error("被拖入水中的僵尸应被移除")
+	}
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, kelpID); ok {
+		t.Error("缠绕水草应随僵尸一起消失")
+	}
+	if bs.lawnGridSystem.IsOccupied(bs.lawnGridEntityID, 6, 2) {
+		t.Error("缠绕水草消失后格子应被释放")
+	}
+	if _, ok := ecs.GetComponent[*components.BehaviorComponent](em, farZombie); !ok {
+		t.Error("格子外的僵尸不应受影响")
+	}
+}
+
+// TestZombieSwimsAfterReachingPool 测试水路僵尸到达水池边缘后开始游动，陆地行的僵尸不受影响
+func TestZombieSwimsAfterReachingPool(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystemWithLayout(em, rm, game.GetGameState(), poolLayout)
+
+	duckyID := createTestSwimZombie(em, poolTestZombieX, poolZombieYForRow(3), &components.SwimComponent{})
+	offshoreID := createTestSwimZombie(em, config.GridWorldEndX+100, poolZombieYForRow(3), &components.SwimComponent{})
+	landID := createTestSwimZombie(em, poolTestZombieX, poolZombieYForRow(1), &components.SwimComponent{})
+
+	bs.updateSwimmingZombies()
+
+	if !bs.isZombieInWater(duckyID) {
+		t.Error("到达水池边缘的僵尸应开始游动")
+	}
+	if bs.isZombieInWater(offshoreID) {
+		t.Error("还没到达水池边缘的僵尸不应入水")
+	}
+	if bs.isZombieInWater(landID) {
+		t.Error("陆地行的僵尸不应入水")
+	}
+	if bs.isSubmergedZombie(duckyID) {
+		t.Error("鸭子救生圈僵尸浮在水面上，不应潜在水下")
+	}
+
+	command, ok := ecs.GetComponent[*components.AnimationCommandComponent](em, duckyID)
+	if !ok || command.ComboName != "swim" {
+		t.Errorf("入水后应播放 swim 动画，实际 %+v", command)
+	}
+}
+
+// TestSnorkelZombieSubmerges 测试潜水僵尸入水动画期间原地不动，之后潜入水下游动，不会被攻击
+func TestSnorkelZombieSubmerges(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystemWithLayout(em, rm, game.GetGameState(), poolLayout)
+
+	zombieID := createTestSwimZombie(em, poolTestZombieX, poolZombieYForRow(2), &components.SwimComponent{
+		EnterCombo: "jumpinpool",
+		Dives:      true,
+	})
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, zombieID)
+	behavior.UnitID = types.UnitIDZombieSnorkel
+	position, _ := ecs.GetComponent[*components.PositionComponent](em, zombieID)
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, zombieID)
+
+	bs.updateSwimmingZombies()
+	if !bs.isZombieEnteringWater(zombieID) {
+		t.Fatal("潜水僵尸到达水池边缘应开始跳入水中")
+	}
+	bs.handleZombieBasicBehavior(zombieID, 0.1)
+	if position.X != poolTestZombieX {
+		t.Errorf("入水动画期间不应移动，实际 X %.1f", position.X)
+	}
+
+	reanim.IsFinished = true
+	bs.updateSwimmingZombies()
+	if !bs.isSubmergedZombie(zombieID) {
+		t.Fatal("入水动画结束后潜水僵尸应潜在水下")
+	}
+	if !systems.IsSubmergedZombie(em, zombieID) {
+		t.Error("物理系统应把潜在水下的僵尸视为不可碰撞")
+	}
+
+	behavior.Type = components.BehaviorZombieEating
+	if bs.isSubmergedZombie(zombieID) {
+		t.Error("啃食植物时潜水僵尸应浮出水面")
+	}
+}
+
+// TestDolphinRiderJumpsOnlyInWater 测试海豚骑士僵尸只在水中骑海豚跳过植物，落水后失去海豚继续游动
+func TestDolphinRiderJumpsOnlyInWater(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystemWithLayout(em, rm, game.GetGameState(), poolLayout)
+
+	plantID := createTestPoolPlant(em, components.PlantLilyPad, 7, 2)
+	zombieID := createTestSwimZombie(em, poolTestZombieX, poolZombieYForRow(2), &components.SwimComponent{})
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, zombieID)
+	behavior.UnitID = types.UnitIDZombieDolphinRider
+	vault := &components.VaultComponent{
+		Kind:      components.VaultKindDolphin,
+		State:     components.VaultStateReady,
+		MoveSpeed: config.ZombieWalkSpeed,
+		MoveCombo: "walkdolphin",
+	}
+	ecs.AddComponent(em, zombieID, vault)
+
+	if bs.tryStartVault(zombieID, plantID, vault) {
+		t.Fatal("海豚骑士僵尸在陆地上不应跳跃")
+	}
+
+	bs.updateSwimmingZombies()
+	velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, zombieID)
+	if velocity.VX != config.DolphinRideSpeed {
+		t.Errorf("入水后应骑海豚快速游动，期望速度 %.1f，实际 %.1f", config.DolphinRideSpeed, velocity.VX)
+	}
+
+	if !bs.tryStartVault(zombieID, plantID, vault) {
+		t.Fatal("海豚骑士僵尸在水中遇到植物应跳跃")
+	}
+
+	position, _ := ecs.GetComponent[*components.PositionComponent](em, zombieID)
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, zombieID)
+	reanim.IsFinished = true
+	bs.updateVaultJump(zombieID, vault, position, 0.1)
+
+	// 测试环境没有 Reanim 数据，使用默认跳跃距离
+	if math.Abs(position.X-(poolTestZombieX-config.DolphinJumpDistance)) > 1e-9 {
+		t.Errorf("期望落在 X=%.1f，实际 %.1f", poolTestZombieX-config.DolphinJumpDistance, position.X)
+	}
+	if vault.State != components.VaultStateDone {
+		t.Errorf("落水后应失去海豚，实际状态 %v", vault.State)
+	}
+	command, ok := ecs.GetComponent[*components.AnimationCommandComponent](em, zombieID)
+	if !ok || command.ComboName != "swim" {
+		t.Errorf("失去海豚后应在水中游动，实际 %+v", command)
+	}
+}
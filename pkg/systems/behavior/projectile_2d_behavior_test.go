@@ -0,0 +1,192 @@
+package behavior
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// plantYForRow 返回指定行植物的 Y 坐标（格子中心）
+func plantYForRow(row int) float64 {
+	return config.GridWorldStartY + float64(row)*config.CellHeight + config.CellHeight/2
+}
+
+// TestSteerTowardsLimitsTurn 测试追踪转向每次不超过最大转向角，且保持速度大小
+func TestSteerTowardsLimitsTurn(t *testing.T) {
+	// 向右飞行，目标在正上方，最多转 0.1 弧度
+	vx, vy := steerTowards(100, 0, 0, -50, 100, 0.1)
+	angle := math.Atan2(vy, vx)
+	if math.Abs(angle-(-0.1)) > 1e-9 {
+		t.Errorf("期望转向 -0.1 弧度，实际 %.4f", angle)
+	}
+	if speed := math.Hypot(vx, vy); math.Abs(speed-100) > 1e-9 {
+		t.Errorf("转向后速度大小应保持 100，实际 %.4f", speed)
+	}
+
+	// 转向角足够时直接指向目标
+	vx, vy = steerTowards(100, 0, 30, 40, 50, math.Pi)
+	if math.Abs(vx-30) > 1e-9 || math.Abs(vy-40) > 1e-9 {
+		t.Errorf("期望速度 (30, 40)，实际 (%.4f, %.4f)", vx, vy)
+	}
+}
+
+// TestCattailSpikeRetargetsNearestZombie 测试目标死亡后尖刺重新锁定任意行中最近的僵尸
+func TestCattailSpikeRetargetsNearestZombie(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	gs := game.GetGameState()
+	bs := createTestBehaviorSystem(em, rm, gs)
+
+	deadID := createTestWalkingZombie(em, 700, zombieYForRow(2))
+	deadBehavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, deadID)
+	deadBehavior.Type = components.BehaviorZombieDying
+
+	nearID := createTestWalkingZombie(em, 420, zombieYForRow(0))
+	farID := createTestWalkingZombie(em, 800, zombieYForRow(4))
+
+	spikeID := em.CreateEntity()
+	ecs.AddComponent(em, spikeID, &components.PositionComponent{X: 400, Y: plantYForRow(2)})
+	ecs.AddComponent(em, spikeID, &components.VelocityComponent{VX: config.CattailSpikeSpeed})
+	ecs.AddComponent(em, spikeID, &components.BehaviorComponent{Type: components.BehaviorCattailSpike})
+	homing := &components.HomingComponent{
+		TargetEntity: deadID,
+		Speed:        config.CattailSpikeSpeed,
+		TurnRate:     config.CattailSpikeTurnRate,
+	}
+	ecs.AddComponent(em, spikeID, homing)
+
+	bs.handleCattailSpikeBehavior(spikeID, 0.016, []ecs.EntityID{deadID, nearID, farID})
+
+	if homing.TargetEntity != nearID {
+		t.Fatalf("期望重新锁定最近的僵尸 %d，实际 %d", nearID, homing.TargetEntity)
+	}
+	velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, spikeID)
+	if velocity.VY >= 0 {
+		t.Errorf("目标在上方的行，尖刺应向上转向，实际 VY=%.1f", velocity.VY)
+	}
+}
+
+// TestCattailSpikeRetargetsWhenTargetSubmerges 测试目标在尖刺飞行途中潜入水下时，尖刺重新锁定其他僵尸
+func TestCattailSpikeRetargetsWhenTargetSubmerges(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	snorkelID := createTestWalkingZombie(em, 500, zombieYForRow(2))
+	swim := &components.SwimComponent{State: components.SwimStateLand, Dives: true}
+	ecs.AddComponent(em, snorkelID, swim)
+	otherID := createTestWalkingZombie(em, 800, zombieYForRow(4))
+
+	spikeID := em.CreateEntity()
+	ecs.AddComponent(em, spikeID, &components.PositionComponent{X: 400, Y: plantYForRow(2)})
+	ecs.AddComponent(em, spikeID, &components.VelocityComponent{VX: config.CattailSpikeSpeed})
+	ecs.AddComponent(em, spikeID, &components.BehaviorComponent{Type: components.BehaviorCattailSpike})
+	homing := &components.HomingComponent{
+		TargetEntity: snorkelID,
+		Speed:        config.CattailSpikeSpeed,
+		TurnRate:     config.CattailSpikeTurnRate,
+	}
+	ecs.AddComponent(em, spikeID, homing)
+
+	bs.handleCattailSpikeBehavior(spikeID, 0.016, []ecs.EntityID{snorkelID, otherID})
+	if homing.TargetEntity != snorkelID {
+		t.Fatalf("目标在水面上时应保持锁定 %d，实际 %d", snorkelID, homing.TargetEntity)
+	}
+
+	// 飞行途中目标潜入水下
+	swim.State = components.SwimStateSwimming
+	bs.handleCattailSpikeBehavior(spikeID, 0.016, []ecs.EntityID{snorkelID, otherID})
+
+	if homing.TargetEntity != otherID {
+		t.Errorf("目标潜入水下后应重新锁定僵尸 %d，实际 %d", otherID, homing.TargetEntity)
+	}
+}
+
+// TestStarfruitDetectsZombieOnDiagonal 测试杨桃检测斜向弹道上（其他行）的僵尸
+func TestStarfruitDetectsZombieOnDiagonal(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	gs := game.GetGameState()
+	bs := createTestBehaviorSystem(em, rm, gs)
+
+	originX := 400.0
+	originY := plantYForRow(2) + config.StarLaunchOffsetY
+
+	// 右下斜向 30° 的弹道上距离 200 像素处
+	dir := starfruitDirections[4]
+	onPathX := originX + dir[0]*200
+	onPathY := originY + dir[1]*200 + config.ZombieVerticalOffset
+	onPath := createTestWalkingZombie(em, onPathX, onPathY)
+	if !bs.hasZombieOnStarPath(originX, originY, []ecs.EntityID{onPath}) {
+		t.Error("斜向弹道上的僵尸应被检测到")
+	}
+
+	// 正右方同行的僵尸不在任何弹道上（杨桃没有向正右方的星星）
+	ahead := createTestWalkingZombie(em, originX+300, originY+config.ZombieVerticalOffset)
+	if bs.hasZombieOnStarPath(originX, originY, []ecs.EntityID{ahead}) {
+		t.Error("正右方远处的僵尸不在杨桃弹道上")
+	}
+
+	// 正后方的僵尸在后方弹道上
+	behind := createTestWalkingZombie(em, originX-200, originY+config.ZombieVerticalOffset)
+	if !bs.hasZombieOnStarPath(originX, originY, []ecs.EntityID{behind}) {
+		t.Error("正后方的僵尸应被检测到")
+	}
+}
+
+// TestSplitPeaBackHeadDetectsZombieBehind 测试裂荚射手后头只检测身后同行的僵尸
+func TestSplitPeaBackHeadDetectsZombieBehind(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	gs := game.GetGameState()
+	bs := createTestBehaviorSystem(em, rm, gs)
+
+	position := &components.PositionComponent{X: 500, Y: plantYForRow(2)}
+
+	behind := createTestWalkingZombie(em, 350, plantYForRow(2))
+	if !bs.hasZombieInRow(position, []ecs.EntityID{behind}, false) {
+		t.Error("后头应检测到身后同行的僵尸")
+	}
+	if bs.hasZombieInRow(position, []ecs.EntityID{behind}, true) {
+		t.Error("前头不应检测到身后的僵尸")
+	}
+
+	otherRow := createTestWalkingZombie(em, 350, plantYForRow(3))
+	if bs.hasZombieInRow(position, []ecs.EntityID{otherRow}, false) {
+		t.Error("后头不应检测到其他行的僵尸")
+	}
+}
+
+// TestDirectProjectileDeletedOffScreen 测试向左、向上飞出屏幕的子弹被删除
+func TestDirectProjectileDeletedOffScreen(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	gs := game.GetGameState()
+	bs := createTestBehaviorSystem(em, rm, gs)
+
+	leftID := em.CreateEntity()
+	ecs.AddComponent(em, leftID, &components.PositionComponent{X: config.ProjectileDeletionLeftBoundary + 1, Y: 300})
+	ecs.AddComponent(em, leftID, &components.VelocityComponent{VX: -config.PeaBulletSpeed})
+
+	upID := em.CreateEntity()
+	ecs.AddComponent(em, upID, &components.PositionComponent{X: 400, Y: config.ProjectileDeletionTopBoundary + 1})
+	ecs.AddComponent(em, upID, &components.VelocityComponent{VY: -config.StarSpeed})
+
+	inID := em.CreateEntity()
+	ecs.AddComponent(em, inID, &components.PositionComponent{X: 400, Y: 300})
+	ecs.AddComponent(em, inID, &components.VelocityComponent{VX: -config.PeaBulletSpeed})
+
+	if bs.moveDirectProjectile(leftID, 0.1, "豌豆子弹") {
+		t.Error("飞出左边界的子弹应被删除")
+	}
+	if bs.moveDirectProjectile(upID, 0.1, "星星子弹") {
+		t.Error("飞出上边界的子弹应被删除")
+	}
+	if !bs.moveDirectProjectile(inID, 0.1, "豌豆子弹") {
+		t.Error("屏幕内的子弹不应被删除")
+	}
+}
@@ -10,27 +10,51 @@ import (
 )
 
 func (s *BehaviorSystem) handlePeaProjectileBehavior(entityID ecs.EntityID, deltaTime float64) {
+	s.moveDirectProjectile(entityID, deltaTime, "豌豆子弹")
+}
+
+// handleStarProjectileBehavior 处理星星子弹的飞行（沿固定方向直线飞行）
+func (s *BehaviorSystem) handleStarProjectileBehavior(entityID ecs.EntityID, deltaTime float64) {
+	s.moveDirectProjectile(entityID, deltaTime, "星星子弹")
+}
+
+// moveDirectProjectile 按速度移动直线子弹，飞出草坪边界时删除
+// 子弹可以朝任意方向飞行，因此四个方向都需要检查边界
+//
+// 返回:
+//   - bool: 子弹是否仍然存在（false 表示已被删除）
+func (s *BehaviorSystem) moveDirectProjectile(entityID ecs.EntityID, deltaTime float64, name string) bool {
 	// 获取位置组件
 	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
 	if !ok {
-		return
+		return false
 	}
 
 	// 获取速度组件
 	velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, entityID)
 	if !ok {
-		return
+		return false
 	}
 
 	// 更新位置：根据速度和时间增量移动子弹
 	position.X += velocity.VX * deltaTime
 	position.Y += velocity.VY * deltaTime
 
-	// 边界检查：如果子弹飞出屏幕右侧，标记删除
-	if position.X > config.PeaBulletDeletionBoundary {
-		log.Printf("[BehaviorSystem] 豌豆子弹 %d 飞出屏幕右侧 (X=%.1f)，标记删除", entityID, position.X)
+	// 边界检查：如果子弹飞出屏幕，标记删除
+	if isProjectileOutOfBounds(position.X, position.Y) {
+		log.Printf("[BehaviorSystem] %s %d 飞出屏幕 (X=%.1f, Y=%.1f)，标记删除", name, entityID, position.X, position.Y)
 		s.entityManager.DestroyEntity(entityID)
+		return false
 	}
+	return true
+}
+
+// isProjectileOutOfBounds 判断子弹是否已飞出屏幕边界
+func isProjectileOutOfBounds(x, y float64) bool {
+	return x > config.PeaBulletDeletionBoundary ||
+		x < config.ProjectileDeletionLeftBoundary ||
+		y < config.ProjectileDeletionTopBoundary ||
+		y > config.ProjectileDeletionBottomBoundary
 }
 
 // handleHitEffectBehavior 处理击中效果的生命周期
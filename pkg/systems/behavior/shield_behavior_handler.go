@@ -0,0 +1,131 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// updateZombieShield 更新僵尸手持防具（II类饰品）的状态
+//
+// 处理内容:
+//   - 防具耐久耗尽时移除防具（报纸被打碎、铁栅门被打坏）
+//   - 读报僵尸失去报纸后的惊愕阶段
+//
+// 防具的受损外观由渲染系统根据剩余耐久绘制
+//
+// 返回:
+//   - true: 僵尸正在惊愕，本帧不移动也不检测碰撞
+//   - false: 继续执行正常行为
+func (s *BehaviorSystem) updateZombieShield(entityID ecs.EntityID) bool {
+	if accessory, ok := ecs.GetComponent[*components.AccessoryComponent](s.entityManager, entityID); ok {
+		if shield := accessory.Shield(); shield != nil && shield.Durability <= 0 {
+			s.breakZombieShield(entityID, shield)
+		}
+	}
+
+	enrage, ok := ecs.GetComponent[*components.EnrageComponent](s.entityManager, entityID)
+	if !ok || enrage.State != components.EnrageStateGasping {
+		return false
+	}
+
+	// 惊愕动画播放完毕后进入狂暴，使用加速的行走动画
+	if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok && reanim.IsFinished {
+		enrage.State = components.EnrageStateEnraged
+		s.resetZombieRootMotion(entityID)
+		s.changeZombieAnimation(entityID, components.ZombieAnimWalking)
+		log.Printf("[BehaviorSystem] 读报僵尸 %d 进入狂暴状态", entityID)
+		return false
+	}
+	return true
+}
+
+// breakZombieShield 手持防具耐久耗尽，从僵尸身上移除
+func (s *BehaviorSystem) breakZombieShield(entityID ecs.EntityID, shield *components.AccessoryPiece) {
+	log.Printf("[BehaviorSystem] 僵尸 %d 的 %s 被打坏", entityID, shield.Tag)
+
+	if shield.Tag == types.AccessoryNewspaper {
+		if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+			audioManager.PlaySound("SOUND_NEWSPAPER_RIP")
+		}
+	}
+
+	s.spawnAccessoryDropEffect(entityID, shield.Tag)
+	s.removeZombieAccessory(entityID, shield.Tag)
+}
+
+// onZombieShieldLost 僵尸失去手持防具（被打坏或被磁力菇吸走）后的处理
+//
+// 处理内容:
+//   - 铁栅门僵尸变为普通僵尸（切换单位后持门手臂恢复为普通手臂）
+//   - 扶梯僵尸从扛梯动画换成普通行走/啃食动画
+//   - 读报僵尸行走中失去报纸时原地惊愕，啃食中失去报纸时直接狂暴
+func (s *BehaviorSystem) onZombieShieldLost(zombieID ecs.EntityID, piece *components.AccessoryPiece) {
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, zombieID)
+	if !ok || behavior.Type == components.BehaviorZombieDying {
+		return
+	}
+
+	if behavior.UnitID == types.UnitIDZombieScreendoor {
+		behavior.UnitID = types.UnitIDZombie
+		s.replayZombieAnimation(zombieID, behavior)
+		return
+	}
+
+	if behavior.UnitID == types.UnitIDZombieLadder {
+		s.replayZombieAnimation(zombieID, behavior)
+		return
+	}
+
+	enrage, ok := ecs.GetComponent[*components.EnrageComponent](s.entityManager, zombieID)
+	if !ok || enrage.State != components.EnrageStateCalm {
+		return
+	}
+
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_NEWSPAPER_RARRGH")
+	}
+
+	// 啃食中不播放惊愕动画，直接换成加速的啃食动画
+	if behavior.Type == components.BehaviorZombieEating {
+		enrage.State = components.EnrageStateEnraged
+		s.replayZombieAnimation(zombieID, behavior)
+		log.Printf("[BehaviorSystem] 读报僵尸 %d 啃食中失去%s，直接狂暴", zombieID, piece.Tag)
+		return
+	}
+
+	enrage.State = components.EnrageStateGasping
+	behavior.ZombieAnimState = components.ZombieAnimIdle
+	ecs.AddComponent(s.entityManager, zombieID, &components.AnimationCommandComponent{
+		UnitID:    behavior.UnitID,
+		ComboName: "gasp",
+		Processed: false,
+	})
+	log.Printf("[BehaviorSystem] 读报僵尸 %d 失去%s，开始惊愕", zombieID, piece.Tag)
+}
+
+// replayZombieAnimation 按当前状态重新播放行走或啃食动画
+// 用于僵尸单位或狂暴状态改变后立即刷新动画（changeZombieAnimation 不会重复播放同一状态）
+func (s *BehaviorSystem) replayZombieAnimation(zombieID ecs.EntityID, behavior *components.BehaviorComponent) {
+	state := behavior.ZombieAnimState
+	if state != components.ZombieAnimWalking && state != components.ZombieAnimEating {
+		return
+	}
+	s.resetZombieRootMotion(zombieID)
+	behavior.ZombieAnimState = components.ZombieAnimIdle
+	s.changeZombieAnimation(zombieID, state)
+}
+
+// resetZombieRootMotion 重置根运动状态，避免切换动画时位移跳变
+func (s *BehaviorSystem) resetZombieRootMotion(zombieID ecs.EntityID) {
+	if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, zombieID); ok {
+		reanim.LastGroundX = 0
+		reanim.LastGroundY = 0
+		reanim.LastAnimFrame = -1
+		reanim.AccumulatedDeltaX = 0
+		reanim.AccumulatedDeltaY = 0
+	}
+}
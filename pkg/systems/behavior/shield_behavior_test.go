@@ -0,0 +1,142 @@
+package behavior
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// createTestShieldZombie 创建测试用的手持防具僵尸（读报僵尸或铁栅门僵尸）
+func createTestShieldZombie(em *ecs.EntityManager, tag types.AccessoryTag) (ecs.EntityID, *components.AccessoryPiece) {
+	id := createTestWalkingZombie(em, 500, zombieYForRow(2))
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, id)
+	behavior.ZombieAnimState = components.ZombieAnimWalking
+
+	shield := &components.AccessoryPiece{Tag: tag, Tier: 2}
+	if tag == types.AccessoryNewspaper {
+		behavior.UnitID = types.UnitIDZombieNewspaper
+		shield.Durability = config.NewspaperHealth
+		ecs.AddComponent(em, id, &components.EnrageComponent{
+			State:       components.EnrageStateCalm,
+			ComboSuffix: "_nopaper",
+		})
+	} else {
+		behavior.UnitID = types.UnitIDZombieScreendoor
+		shield.Durability = config.ScreenDoorHealth
+	}
+	shield.MaxDurability = shield.Durability
+	ecs.AddComponent(em, id, &components.AccessoryComponent{Pieces: []*components.AccessoryPiece{shield}})
+	return id, shield
+}
+
+// TestNewspaperZombieGaspsThenEnrages 测试读报僵尸报纸被打碎后先惊愕，再使用狂暴动画行走
+func TestNewspaperZombieGaspsThenEnrages(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	zombieID, paper := createTestShieldZombie(em, types.AccessoryNewspaper)
+	enrage, _ := ecs.GetComponent[*components.EnrageComponent](em, zombieID)
+
+	paper.Durability = 0
+	if !bs.updateZombieShield(zombieID) {
+		t.Fatal("失去报纸后应进入惊愕，跳过本帧移动")
+	}
+	if enrage.State != components.EnrageStateGasping {
+		t.Fatalf("期望惊愕状态，实际 %v", enrage.State)
+	}
+	accessory, _ := ecs.GetComponent[*components.AccessoryComponent](em, zombieID)
+	if accessory.Has(types.AccessoryNewspaper) {
+		t.Error("报纸应被移除")
+	}
+	cmd, _ := ecs.GetComponent[*components.AnimationCommandComponent](em, zombieID)
+	if cmd == nil || cmd.ComboName != "gasp" {
+		t.Errorf("期望播放惊愕动画，实际 %+v", cmd)
+	}
+
+	// 惊愕动画播放完毕后狂暴
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, zombieID)
+	reanim.IsFinished = true
+	if bs.updateZombieShield(zombieID) {
+		t.Fatal("惊愕结束后应恢复移动")
+	}
+	if !enrage.IsEnraged() {
+		t.Fatalf("期望狂暴状态，实际 %v", enrage.State)
+	}
+	cmd, _ = ecs.GetComponent[*components.AnimationCommandComponent](em, zombieID)
+	if cmd == nil || !strings.HasSuffix(cmd.ComboName, "_nopaper") {
+		t.Errorf("狂暴后应使用 *_nopaper 行走动画，实际 %+v", cmd)
+	}
+}
+
+// TestNewspaperZombieEnragesWhileEating 测试读报僵尸啃食中失去报纸时直接狂暴
+func TestNewspaperZombieEnragesWhileEating(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	zombieID, paper := createTestShieldZombie(em, types.AccessoryNewspaper)
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, zombieID)
+	behavior.Type = components.BehaviorZombieEating
+	behavior.ZombieAnimState = components.ZombieAnimEating
+
+	paper.Durability = -10
+	bs.updateZombieShield(zombieID)
+
+	enrage, _ := ecs.GetComponent[*components.EnrageComponent](em, zombieID)
+	if !enrage.IsEnraged() {
+		t.Fatalf("啃食中失去报纸应直接狂暴，实际 %v", enrage.State)
+	}
+	cmd, _ := ecs.GetComponent[*components.AnimationCommandComponent](em, zombieID)
+	if cmd == nil || cmd.ComboName != "eat_nopaper" {
+		t.Errorf("期望播放 eat_nopaper，实际 %+v", cmd)
+	}
+}
+
+// TestScreenDoorLossTurnsIntoBasicZombie 测试铁栅门被打坏或被磁力菇吸走后变为普通僵尸
+func TestScreenDoorLossTurnsIntoBasicZombie(t *testing.T) {
+	tests := []struct {
+		name   string
+		remove func(bs *BehaviorSystem, zombieID ecs.EntityID, door *components.AccessoryPiece)
+	}{
+		{"耐久耗尽", func(bs *BehaviorSystem, zombieID ecs.EntityID, door *components.AccessoryPiece) {
+			door.Durability = 0
+			bs.updateZombieShield(zombieID)
+		}},
+		{"被磁力菇吸走", func(bs *BehaviorSystem, zombieID ecs.EntityID, door *components.AccessoryPiece) {
+			bs.removeZombieAccessory(zombieID, types.AccessoryScreenDoor)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em := ecs.NewEntityManager()
+			rm := game.NewResourceManager(getTestAudioContext())
+			bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+			zombieID, door := createTestShieldZombie(em, types.AccessoryScreenDoor)
+			tt.remove(bs, zombieID, door)
+
+			accessory, _ := ecs.GetComponent[*components.AccessoryComponent](em, zombieID)
+			if accessory.Has(types.AccessoryScreenDoor) {
+				t.Error("铁栅门应被移除")
+			}
+			behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, zombieID)
+			if behavior.UnitID != types.UnitIDZombie {
+				t.Errorf("期望变为普通僵尸，实际 UnitID=%s", behavior.UnitID)
+			}
+			if !door.Removed {
+				t.Error("铁栅门应标记为已移除，渲染时隐藏铁栅门轨道")
+			}
+			cmd, _ := ecs.GetComponent[*components.AnimationCommandComponent](em, zombieID)
+			if cmd == nil || cmd.UnitID != types.UnitIDZombie {
+				t.Errorf("应使用普通僵尸单位重新播放动画，实际 %+v", cmd)
+			}
+		})
+	}
+}
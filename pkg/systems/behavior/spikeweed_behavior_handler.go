@@ -0,0 +1,105 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+)
+
+// handleSpikeweedBehavior 处理地刺的行为
+//
+// 僵尸从地刺上走过而不会啃食它（见 detectPlantCollision）：
+//   - 车辆僵尸（投篮车、雪橇车）驶上地刺时被扎破报废，地刺同时被压碎
+//   - 其他地面僵尸每隔 SpikeweedAttackInterval 秒被扎伤一次
+func (s *BehaviorSystem) handleSpikeweedBehavior(entityID ecs.EntityID, deltaTime float64, zombieEntityList []ecs.EntityID) {
+	plant, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	timer, ok := ecs.GetComponent[*components.TimerComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	// 扎刺动画播放完毕后切换回待机动画
+	if plant.AttackAnimState == components.AttackAnimAttacking {
+		if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok && reanim.IsFinished {
+			ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+				UnitID:    "caltrop",
+				ComboName: "idle",
+				Processed: false,
+			})
+			plant.AttackAnimState = components.AttackAnimIdle
+		}
+	}
+
+	targets := s.findZombiesOnSpikeweed(plant, zombieEntityList)
+	for _, zombieID := range targets {
+		if s.tryPopVehicleOnSpikeweed(entityID, zombieID) {
+			return
+		}
+	}
+
+	timer.CurrentTime += deltaTime
+	if timer.CurrentTime < timer.TargetTime {
+		return
+	}
+	if len(targets) == 0 {
+		return // 没有目标时保持就绪
+	}
+	timer.CurrentTime = 0
+
+	for _, zombieID := range targets {
+		s.applyLobDamage(zombieID, config.SpikeweedDamage)
+	}
+	log.Printf("[BehaviorSystem] 地刺 %d 扎伤 %d 个僵尸", entityID, len(targets))
+
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    "caltrop",
+		ComboName: "attack",
+		Processed: false,
+	})
+	plant.AttackAnimState = components.AttackAnimAttacking
+}
+
+// findZombiesOnSpikeweed 查找站在地刺所在格子上的僵尸
+// 空中的僵尸（气球僵尸）不在 zombieEntityList 中，不会被扎到
+func (s *BehaviorSystem) findZombiesOnSpikeweed(plant *components.PlantComponent, zombieEntityList []ecs.EntityID) []ecs.EntityID {
+	var targets []ecs.EntityID
+	for _, zombieID := range zombieEntityList {
+		if ecs.HasComponent[*components.ZombossComponent](s.entityManager, zombieID) {
+			continue
+		}
+		position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, zombieID)
+		if !ok {
+			continue
+		}
+		if s.zombieRowOf(position) != plant.GridRow || iceColumnAt(s.zombieCenterX(zombieID, position)) != plant.GridCol {
+			continue
+		}
+		targets = append(targets, zombieID)
+	}
+	return targets
+}
+
+// tryPopVehicleOnSpikeweed 车辆僵尸驶上地刺时被扎破轮胎，地刺同时被压碎
+//
+// 返回:
+//   - bool: 是否扎破了车辆（非车辆僵尸返回 false，按普通僵尸扎伤）
+func (s *BehaviorSystem) tryPopVehicleOnSpikeweed(spikeweedID, zombieID ecs.EntityID) bool {
+	if !s.isVehicleZombie(zombieID) {
+		return false
+	}
+
+	log.Printf("[BehaviorSystem] 车辆僵尸 %d 驶上地刺 %d", zombieID, spikeweedID)
+	if s.isZomboni(zombieID) {
+		s.wreckZomboni(zombieID)
+	} else {
+		s.wreckCatapult(zombieID)
+	}
+
+	s.destroyPlant(spikeweedID)
+	return true
+}
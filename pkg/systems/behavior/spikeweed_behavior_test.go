@@ -0,0 +1,95 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// createTestSpikeweed 创建测试用的地刺
+func createTestSpikeweed(em *ecs.EntityManager, col, row int) ecs.EntityID {
+	id := createTestGridPlant(em, components.PlantSpikeweed, col, row)
+	ecs.AddComponent(em, id, &components.BehaviorComponent{Type: components.BehaviorSpikeweed})
+	ecs.AddComponent(em, id, &components.TimerComponent{Name: "attack_cooldown", TargetTime: config.SpikeweedAttackInterval})
+	ecs.AddComponent(em, id, &components.ReanimComponent{})
+	return id
+}
+
+// TestSpikeweedDamagesZombiesWalkingOverIt 测试僵尸从地刺上走过而不啃食，并被定时扎伤
+func TestSpikeweedDamagesZombiesWalkingOverIt(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	spikeweedID := createTestSpikeweed(em, 4, 2)
+	onTop := createTestWalkingZombie(em, cellCenterX(4), zombieYForRow(2))
+	nextCell := createTestWalkingZombie(em, cellCenterX(6), zombieYForRow(2))
+
+	if plantID, ok := bs.detectPlantCollision(2, 4); ok {
+		t.Fatalf("僵尸不应啃食地刺，实际碰到植物 %d", plantID)
+	}
+
+	bs.Update(config.SpikeweedAttackInterval)
+
+	health, _ := ecs.GetComponent[*components.HealthComponent](em, onTop)
+	if health.MaxHealth-health.CurrentHealth != config.SpikeweedDamage {
+		t.Errorf("地刺上的僵尸应受到 %d 点伤害，实际 %d", config.SpikeweedDamage, health.MaxHealth-health.CurrentHealth)
+	}
+	if health, _ := ecs.GetComponent[*components.HealthComponent](em, nextCell); health.CurrentHealth != health.MaxHealth {
+		t.Error("其他格子的僵尸不应被扎伤")
+	}
+	if !ecs.HasComponent[*components.PlantComponent](em, spikeweedID) {
+		t.Error("普通僵尸走过时地刺不应消失")
+	}
+}
+
+// TestCatapultCrushedBySpikeweed 测试投篮车驶上地刺时被扎破报废，地刺同时被压碎
+func TestCatapultCrushedBySpikeweed(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	spikeweedID := createTestSpikeweed(em, 4, 2)
+	catapultID, _ := createTestCatapult(em, cellCenterX(4), zombieYForRow(2), 0)
+
+	bs.Update(0.01)
+	em.RemoveMarkedEntities()
+
+	if ecs.HasComponent[*components.PlantComponent](em, spikeweedID) {
+		t.Error("地刺扎破投篮车后应被压碎")
+	}
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, catapultID)
+	if behavior.Type != components.BehaviorZombieDyingExplosion {
+		t.Fatalf("投篮车应报废，实际行为 %v", behavior.Type)
+	}
+	if cmd, _ := ecs.GetComponent[*components.AnimationCommandComponent](em, catapultID); cmd == nil || cmd.UnitID != types.UnitIDZombieCharredCatapult {
+		t.Errorf("应播放投篮车专用的烧焦动画，实际 %+v", cmd)
+	}
+}
+
+// TestZomboniWreckedBySpikeweed 测试雪橇车驶上地刺时被扎破报废，地刺同时被压碎
+func TestZomboniWreckedBySpikeweed(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	spikeweedID := createTestSpikeweed(em, 6, 1)
+	zomboniID := createTestZomboni(em, cellCenterX(6), zombieYForRow(1))
+
+	bs.Update(0.01)
+	em.RemoveMarkedEntities()
+
+	if ecs.HasComponent[*components.PlantComponent](em, spikeweedID) {
+		t.Error("地刺扎破雪橇车后应被压碎")
+	}
+	if health, _ := ecs.GetComponent[*components.HealthComponent](em, zomboniID); health.CurrentHealth > 0 {
+		t.Errorf("雪橇车被扎破后生命值应归零，实际 %d", health.CurrentHealth)
+	}
+	if cmd, _ := ecs.GetComponent[*components.AnimationCommandComponent](em, zomboniID); cmd == nil || cmd.UnitID != types.UnitIDZombieCharredZomboni {
+		t.Errorf("应播放雪橇车专用的烧焦动画，实际 %+v", cmd)
+	}
+}
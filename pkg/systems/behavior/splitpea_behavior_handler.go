@@ -0,0 +1,121 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+)
+
+// handleSplitPeaBehavior 处理裂荚射手的行为逻辑
+//
+// 前后两个头独立计时：
+//   - 前头：同行右侧有僵尸时向右发射一颗豌豆
+//   - 后头：同行左侧（身后）有僵尸时向左连发两颗豌豆
+func (s *BehaviorSystem) handleSplitPeaBehavior(entityID ecs.EntityID, deltaTime float64, zombieEntityList []ecs.EntityID) {
+	plant, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	timer, ok := ecs.GetComponent[*components.TimerComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	splitPea, ok := ecs.GetComponent[*components.SplitPeaComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	// 射击动画播放完毕后切换回待机动画
+	if plant.AttackAnimState == components.AttackAnimAttacking {
+		if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok && reanim.IsFinished {
+			s.playSplitPeaAnimation(entityID, "idle")
+			plant.AttackAnimState = components.AttackAnimIdle
+		}
+	}
+
+	timer.CurrentTime += deltaTime
+	splitPea.BackTimer += deltaTime
+
+	// 前头：向右发射一颗豌豆
+	if timer.CurrentTime >= timer.TargetTime && s.hasZombieInRow(position, zombieEntityList, true) {
+		timer.CurrentTime = 0
+
+		startX := position.X + config.PeaBulletOffsetX
+		startY := position.Y + config.PeaBulletOffsetY
+		if _, err := entities.NewPeaProjectileWithVelocity(s.entityManager, s.resourceManager, startX, startY, config.PeaBulletSpeed, 0); err != nil {
+			log.Printf("[BehaviorSystem] 裂荚射手 %d 前头创建豌豆失败: %v", entityID, err)
+		} else {
+			s.playShootSound()
+			s.playSplitPeaAnimation(entityID, "shoot_front")
+			plant.AttackAnimState = components.AttackAnimAttacking
+		}
+	}
+
+	// 后头：向左连发两颗豌豆（第二颗紧跟在第一颗后面）
+	if splitPea.BackTimer >= splitPea.BackInterval && s.hasZombieInRow(position, zombieEntityList, false) {
+		splitPea.BackTimer = 0
+
+		startX := position.X - config.PeaBulletOffsetX
+		startY := position.Y + config.PeaBulletOffsetY
+		for i := 0; i < 2; i++ {
+			x := startX + float64(i)*config.SplitPeaBackPeaSpacing
+			if _, err := entities.NewPeaProjectileWithVelocity(s.entityManager, s.resourceManager, x, startY, -config.PeaBulletSpeed, 0); err != nil {
+				log.Printf("[BehaviorSystem] 裂荚射手 %d 后头创建豌豆失败: %v", entityID, err)
+				return
+			}
+		}
+
+		s.playShootSound()
+		s.playSplitPeaAnimation(entityID, "shoot_back")
+		plant.AttackAnimState = components.AttackAnimAttacking
+		log.Printf("[BehaviorSystem] 裂荚射手 %d 后头向左发射两颗豌豆", entityID)
+	}
+}
+
+// hasZombieInRow 检查植物所在行是否有可攻击的僵尸
+//
+// 参数:
+//   - position: 植物位置
+//   - zombieEntityList: 候选僵尸列表（已排除被魅惑的僵尸）
+//   - ahead: true 检查植物右侧（且已进入屏幕），false 检查植物左侧
+func (s *BehaviorSystem) hasZombieInRow(position *components.PositionComponent, zombieEntityList []ecs.EntityID, ahead bool) bool {
+	row := s.lawnLayout.RowAt(position.X, position.Y)
+	screenRightBoundary := config.GridWorldEndX + 50.0
+
+	for _, zombieID := range zombieEntityList {
+		zombiePos, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, zombieID)
+		if !ok {
+			continue
+		}
+		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, zombieID)
+		if !ok || behavior.Type == components.BehaviorZombieDying {
+			continue
+		}
+		if s.lawnLayout.RowAt(zombiePos.X, zombiePos.Y) != row {
+			continue
+		}
+		if ahead && zombiePos.X > position.X && zombiePos.X < screenRightBoundary {
+			return true
+		}
+		if !ahead && zombiePos.X < position.X {
+			return true
+		}
+	}
+	return false
+}
+
+// playSplitPeaAnimation 播放裂荚射手的动画组合
+func (s *BehaviorSystem) playSplitPeaAnimation(entityID ecs.EntityID, comboName string) {
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    "splitpea",
+		ComboName: comboName,
+		Processed: false,
+	})
+}
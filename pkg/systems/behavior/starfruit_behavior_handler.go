@@ -0,0 +1,115 @@
+package behavior
+
+import (
+	"log"
+	"math"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+)
+
+// starfruitDirections 杨桃五颗星星的飞行方向（单位向量，Y 轴向下）
+// 依次为：上、下、后方、右上斜向、右下斜向
+var starfruitDirections = [5][2]float64{
+	{0, -1},
+	{0, 1},
+	{-1, 0},
+	{math.Cos(math.Pi / 6), -math.Sin(math.Pi / 6)},
+	{math.Cos(math.Pi / 6), math.Sin(math.Pi / 6)},
+}
+
+// handleStarfruitBehavior 处理杨桃的行为逻辑
+// 计时器就绪且任意一条弹道上有僵尸时，向五个方向各发射一颗星星
+func (s *BehaviorSystem) handleStarfruitBehavior(entityID ecs.EntityID, deltaTime float64, zombieEntityList []ecs.EntityID) {
+	plant, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	timer, ok := ecs.GetComponent[*components.TimerComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+
+	// 射击动画播放完毕后切换回待机动画
+	if plant.AttackAnimState == components.AttackAnimAttacking {
+		if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok && reanim.IsFinished {
+			ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+				UnitID:    "starfruit",
+				ComboName: "idle",
+				Processed: false,
+			})
+			plant.AttackAnimState = components.AttackAnimIdle
+		}
+	}
+
+	timer.CurrentTime += deltaTime
+	if timer.CurrentTime < timer.TargetTime {
+		return
+	}
+
+	startX := position.X
+	startY := position.Y + config.StarLaunchOffsetY
+	if !s.hasZombieOnStarPath(startX, startY, zombieEntityList) {
+		return // 没有目标时保持就绪
+	}
+
+	timer.CurrentTime = 0
+
+	for _, dir := range starfruitDirections {
+		vx := dir[0] * config.StarSpeed
+		vy := dir[1] * config.StarSpeed
+		if _, err := entities.NewStarProjectile(s.entityManager, s.resourceManager, startX, startY, vx, vy); err != nil {
+			log.Printf("[BehaviorSystem] 杨桃 %d 创建星星失败: %v", entityID, err)
+			return
+		}
+	}
+
+	s.playShootSound()
+
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    "starfruit",
+		ComboName: "shoot",
+		Processed: false,
+	})
+	plant.AttackAnimState = components.AttackAnimAttacking
+
+	log.Printf("[BehaviorSystem] 杨桃 %d 向五个方向发射星星", entityID)
+}
+
+// hasZombieOnStarPath 检查是否有僵尸位于杨桃任意一条弹道附近
+// 僵尸碰撞盒中心在弹道前方，且到弹道的垂直距离不超过检测宽度即视为在弹道上
+func (s *BehaviorSystem) hasZombieOnStarPath(originX, originY float64, zombieEntityList []ecs.EntityID) bool {
+	screenRightBoundary := config.GridWorldEndX + 50.0
+
+	for _, zombieID := range zombieEntityList {
+		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, zombieID)
+		if !ok || behavior.Type == components.BehaviorZombieDying {
+			continue
+		}
+
+		zombieX, zombieY := s.lobTargetPoint(zombieID)
+		if zombieX >= screenRightBoundary {
+			continue // 尚未进入屏幕
+		}
+
+		dx := zombieX - originX
+		dy := zombieY - originY
+		for _, dir := range starfruitDirections {
+			along := dx*dir[0] + dy*dir[1]
+			if along <= 0 {
+				continue
+			}
+			perpendicular := math.Abs(dx*dir[1] - dy*dir[0])
+			if perpendicular <= config.StarfruitDetectionHalfWidth {
+				return true
+			}
+		}
+	}
+	return false
+}
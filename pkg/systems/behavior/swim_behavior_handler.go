@@ -0,0 +1,137 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+)
+
+// updateSwimmingZombies 水路僵尸到达水池边缘时跳入水中，入水动画播放完毕后开始游动
+func (s *BehaviorSystem) updateSwimmingZombies() {
+	for _, entityID := range ecs.GetEntitiesWith1[*components.SwimComponent](s.entityManager) {
+		swim, _ := ecs.GetComponent[*components.SwimComponent](s.entityManager, entityID)
+		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+		if !ok || behavior.Type == components.BehaviorZombieDying || behavior.Type == components.BehaviorZombieDyingExplosion {
+			continue
+		}
+
+		switch swim.State {
+		case components.SwimStateLand:
+			if behavior.Type != components.BehaviorZombieBasic || !s.hasReachedPool(entityID) {
+				continue
+			}
+			s.enterPool(entityID, swim)
+		case components.SwimStateEntering:
+			if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok && !reanim.IsFinished {
+				continue
+			}
+			s.startSwimming(entityID, swim)
+		}
+	}
+}
+
+// hasReachedPool 行走中的僵尸是否已经到达水路的水池边缘（碰撞盒中心越过草坪右边缘）
+func (s *BehaviorSystem) hasReachedPool(entityID ecs.EntityID) bool {
+	if waveState, ok := ecs.GetComponent[*components.ZombieWaveStateComponent](s.entityManager, entityID); ok && !waveState.IsActivated {
+		return false
+	}
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+	if !ok {
+		return false
+	}
+	return s.lawnLayout.IsWaterRow(s.zombieRowOf(position)) && s.zombieCenterX(entityID, position) < config.GridWorldEndX
+}
+
+// enterPool 僵尸跳入水中：有入水动画的僵尸原地播放动画，否则直接开始游动
+func (s *BehaviorSystem) enterPool(entityID ecs.EntityID, swim *components.SwimComponent) {
+	if position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID); ok {
+		if _, err := entities.CreateParticleEffect(s.entityManager, s.resourceManager, "PoolSplash", position.X, position.Y); err != nil {
+			log.Printf("[BehaviorSystem] 警告：创建入水水花效果失败: %v", err)
+		}
+	}
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_ZOMBIE_ENTERING_WATER")
+	}
+
+	if swim.EnterCombo == "" {
+		s.startSwimming(entityID, swim)
+		return
+	}
+
+	swim.State = components.SwimStateEntering
+	s.playSwimCombo(entityID, swim.EnterCombo)
+	log.Printf("[BehaviorSystem] 僵尸 %d 跳入水中", entityID)
+}
+
+// startSwimming 僵尸开始在水中游动
+// 骑着海豚的海豚骑士僵尸改为骑海豚快速游动，其他僵尸以行走速度游动
+func (s *BehaviorSystem) startSwimming(entityID ecs.EntityID, swim *components.SwimComponent) {
+	swim.State = components.SwimStateSwimming
+
+	speed := s.zombieWalkSpeed(entityID)
+	comboName := "swim"
+	if vault, ok := ecs.GetComponent[*components.VaultComponent](s.entityManager, entityID); ok && vault.HasProp() {
+		if vault.Kind == components.VaultKindDolphin {
+			vault.MoveSpeed = config.DolphinRideSpeed
+			vault.MoveCombo = "ride"
+		}
+		speed = vault.MoveSpeed
+		comboName = vault.MoveCombo
+	}
+
+	if velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, entityID); ok {
+		velocity.VX = speed
+	}
+	s.resetZombieRootMotion(entityID)
+
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	behavior.ZombieAnimState = components.ZombieAnimWalking
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    behavior.UnitID,
+		ComboName: comboName,
+		Processed: false,
+	})
+	log.Printf("[BehaviorSystem] 僵尸 %d 开始游动 (%s)", entityID, comboName)
+}
+
+// playSwimCombo 原地播放水路僵尸的入水动画
+func (s *BehaviorSystem) playSwimCombo(entityID ecs.EntityID, comboName string) {
+	if velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, entityID); ok {
+		velocity.VX = 0
+	}
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	behavior.ZombieAnimState = components.ZombieAnimIdle
+	s.resetZombieRootMotion(entityID)
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    behavior.UnitID,
+		ComboName: comboName,
+		Processed: false,
+	})
+}
+
+// isZombieEnteringWater 僵尸是否正在跳入水中（原地不动）
+func (s *BehaviorSystem) isZombieEnteringWater(entityID ecs.EntityID) bool {
+	swim, ok := ecs.GetComponent[*components.SwimComponent](s.entityManager, entityID)
+	return ok && swim.State == components.SwimStateEntering
+}
+
+// isZombieInWater 僵尸是否已经进入水中
+func (s *BehaviorSystem) isZombieInWater(entityID ecs.EntityID) bool {
+	return systems.IsZombieInWater(s.entityManager, entityID)
+}
+
+// isSubmergedZombie 僵尸是否潜在水下（游动中的潜水僵尸）
+func (s *BehaviorSystem) isSubmergedZombie(entityID ecs.EntityID) bool {
+	return systems.IsSubmergedZombie(s.entityManager, entityID)
+}
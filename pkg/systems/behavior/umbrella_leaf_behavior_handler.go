@@ -0,0 +1,66 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// handleUmbrellaLeafBehavior 处理叶子保护伞的行为
+// 挡开蹦极僵尸由 updateBungeeZombies 触发（见 blockBungee），这里只负责撑伞动画结束后恢复待机
+func (s *BehaviorSystem) handleUmbrellaLeafBehavior(entityID ecs.EntityID) {
+	plant, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, entityID)
+	if !ok || plant.AttackAnimState != components.AttackAnimAttacking {
+		return
+	}
+	if reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID); ok && reanim.IsFinished {
+		ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+			UnitID:    "umbrellaleaf",
+			ComboName: "idle",
+			Processed: false,
+		})
+		plant.AttackAnimState = components.AttackAnimIdle
+	}
+}
+
+// findUmbrellaLeafCovering 查找保护指定格子的叶子保护伞（以自身为中心的 3x3 格子）
+func (s *BehaviorSystem) findUmbrellaLeafCovering(col, row int) ecs.EntityID {
+	for _, plantID := range ecs.GetEntitiesWith1[*components.PlantComponent](s.entityManager) {
+		plant, _ := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID)
+		if plant.PlantType != components.PlantUmbrellaLeaf {
+			continue
+		}
+		dc, dr := plant.GridCol-col, plant.GridRow-row
+		if dc >= -config.UmbrellaLeafProtectRange && dc <= config.UmbrellaLeafProtectRange &&
+			dr >= -config.UmbrellaLeafProtectRange && dr <= config.UmbrellaLeafProtectRange {
+			return plantID
+		}
+	}
+	return 0
+}
+
+// blockBungee 叶子保护伞撑伞挡开蹦极僵尸：取消抓取，蹦极僵尸空手弹回高空
+func (s *BehaviorSystem) blockBungee(entityID ecs.EntityID, bungee *components.BungeeComponent, umbrellaID ecs.EntityID) {
+	log.Printf("[BehaviorSystem] 叶子保护伞 %d 挡开蹦极僵尸 %d", umbrellaID, entityID)
+
+	if plant, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, umbrellaID); ok {
+		plant.AttackAnimState = components.AttackAnimAttacking
+		ecs.AddComponent(s.entityManager, umbrellaID, &components.AnimationCommandComponent{
+			UnitID:    "umbrellaleaf",
+			ComboName: "block",
+			Processed: false,
+		})
+	}
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_BOING")
+	}
+
+	s.removeBungeeTarget(bungee)
+	bungee.State = components.BungeeStateRaising
+	bungee.Timer = 0
+	s.setZombieShadowVisible(entityID, false)
+	s.playBungeeCombo(entityID, "idle")
+}
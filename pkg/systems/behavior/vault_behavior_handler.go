@@ -0,0 +1,200 @@
+package behavior
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
+	"github.com/gonewx/pvz/pkg/utils"
+)
+
+// poleVaulterPoleTracks 撑杆跳僵尸丢弃撑杆后隐藏的轨道
+var poleVaulterPoleTracks = []string{"Zombie_polevaulter_pole", "Zombie_polevaulter_pole2"}
+
+// tryStartVault 持有跳跃道具的僵尸遇到植物时起跳
+//
+// 遇到高坚果时无法越过：撑杆跳僵尸丢弃撑杆，跳跳僵尸丢弃跳跳杆，海豚骑士僵尸失去海豚，然后开始啃食高坚果
+// 海豚骑士僵尸只有骑海豚游动时才能跳跃
+//
+// 返回:
+//   - bool: 是否已处理此次植物碰撞（起跳或被挡住后开始啃食）
+func (s *BehaviorSystem) tryStartVault(zombieID, plantID ecs.EntityID, vault *components.VaultComponent) bool {
+	if vault.State != components.VaultStateReady {
+		return false
+	}
+	if vault.Kind == components.VaultKindDolphin && !s.isZombieInWater(zombieID) {
+		return false
+	}
+
+	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, zombieID)
+	if !ok {
+		return false
+	}
+	plant, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID)
+	if !ok {
+		return false
+	}
+
+	if plant.PlantType == components.PlantTallnut {
+		log.Printf("[BehaviorSystem] 跳跃僵尸 %d 被高坚果 %d 挡住", zombieID, plantID)
+		if vault.Kind == components.VaultKindPogo {
+			s.removeZombieAccessory(zombieID, types.AccessoryPogoStick)
+		} else {
+			s.dropVaultProp(zombieID)
+		}
+		s.startEatingPlant(zombieID, plantID)
+		return true
+	}
+
+	vault.State = components.VaultStateJumping
+	vault.PlantID = plantID
+	vault.Elapsed = 0
+
+	// 跳跃期间由跳跃逻辑控制位移
+	if velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, zombieID); ok {
+		velocity.VX = 0
+	}
+
+	switch vault.Kind {
+	case components.VaultKindPole:
+		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, zombieID)
+		if ok {
+			ecs.AddComponent(s.entityManager, zombieID, &components.AnimationCommandComponent{
+				UnitID:    behavior.UnitID,
+				ComboName: "jump",
+				Processed: false,
+			})
+		}
+		log.Printf("[BehaviorSystem] 撑杆跳僵尸 %d 起跳越过植物 %d", zombieID, plantID)
+	case components.VaultKindDolphin:
+		s.resetZombieRootMotion(zombieID)
+		if behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, zombieID); ok {
+			ecs.AddComponent(s.entityManager, zombieID, &components.AnimationCommandComponent{
+				UnitID:    behavior.UnitID,
+				ComboName: "jump",
+				Processed: false,
+			})
+		}
+		if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+			audioManager.PlaySound("SOUND_DOLPHIN_BEFORE_JUMPING")
+		}
+		log.Printf("[BehaviorSystem] 海豚骑士僵尸 %d 骑海豚跳过植物 %d", zombieID, plantID)
+	case components.VaultKindPogo:
+		// 落点：碰撞盒中心越过植物最左侧格子的左边缘
+		collisionOffsetX := 0.0
+		if collision, ok := ecs.GetComponent[*components.CollisionComponent](s.entityManager, zombieID); ok {
+			collisionOffsetX = collision.OffsetX
+		}
+		landingCenterX := config.GridWorldStartX + float64(plant.GridCol)*config.CellWidth - config.PogoLandingMargin
+		vault.StartX = position.X
+		vault.TargetX = landingCenterX - collisionOffsetX
+		log.Printf("[BehaviorSystem] 跳跳僵尸 %d 跳过植物 %d: X %.1f → %.1f", zombieID, plantID, vault.StartX, vault.TargetX)
+	}
+
+	return true
+}
+
+// updateVaultJump 推进跳跃中的僵尸
+//
+// 撑杆跳：anim_jump 期间 _ground 轨道静止，动画播放完毕后按身体轨道的位移一次性移动实体，然后丢弃撑杆
+// 海豚跳跃：与撑杆跳相同，使用 anim_dolphinjump 的身体轨道位移，落水后失去海豚
+// 跳跳杆：在 PogoHopDuration 内从起点线性移动到落点，落地后继续持杆前进
+func (s *BehaviorSystem) updateVaultJump(zombieID ecs.EntityID, vault *components.VaultComponent, position *components.PositionComponent, deltaTime float64) {
+	vault.Elapsed += deltaTime
+
+	switch vault.Kind {
+	case components.VaultKindPole, components.VaultKindDolphin:
+		reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, zombieID)
+		if ok && !reanim.IsFinished {
+			return
+		}
+
+		animName, trackName, deltaX := "anim_jump", config.PoleVaulterJumpTrack, -config.PoleVaulterJumpDistance
+		if vault.Kind == components.VaultKindDolphin {
+			animName, trackName, deltaX = "anim_dolphinjump", config.DolphinJumpTrack, -config.DolphinJumpDistance
+		}
+		if ok {
+			if dx, _, err := utils.CalculateTrackDisplacement(reanim, animName, trackName); err == nil {
+				deltaX = dx
+			} else {
+				log.Printf("[BehaviorSystem] ⚠️ 跳跃僵尸 %d 无法计算跳跃位移: %v，使用默认距离", zombieID, err)
+			}
+		}
+		position.X += deltaX
+
+		log.Printf("[BehaviorSystem] 跳跃僵尸 %d 落地: X=%.1f (位移 %.1f)", zombieID, position.X, deltaX)
+		s.dropVaultProp(zombieID)
+
+	case components.VaultKindPogo:
+		t := vault.Elapsed / config.PogoHopDuration
+		if t > 1 {
+			t = 1
+		}
+		position.X = vault.StartX + (vault.TargetX-vault.StartX)*t
+		if t < 1 {
+			return
+		}
+
+		vault.State = components.VaultStateReady
+		if velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, zombieID); ok {
+			velocity.VX = vault.MoveSpeed
+		}
+		log.Printf("[BehaviorSystem] 跳跳僵尸 %d 落地: X=%.1f", zombieID, position.X)
+	}
+}
+
+// dropVaultProp 跳跃僵尸失去道具（撑杆跳完成、被高坚果挡住、跳跳杆被磁力菇吸走、海豚跳跃完成）
+// 之后像普通僵尸一样使用根运动行走，在水中则游动
+func (s *BehaviorSystem) dropVaultProp(zombieID ecs.EntityID) {
+	vault, ok := ecs.GetComponent[*components.VaultComponent](s.entityManager, zombieID)
+	if !ok || vault.State == components.VaultStateDone {
+		return
+	}
+	vault.State = components.VaultStateDone
+
+	reanim, hasReanim := ecs.GetComponent[*components.ReanimComponent](s.entityManager, zombieID)
+	if hasReanim && vault.Kind == components.VaultKindPole {
+		if reanim.HiddenTracks == nil {
+			reanim.HiddenTracks = make(map[string]bool)
+		}
+		for _, track := range poleVaulterPoleTracks {
+			reanim.HiddenTracks[track] = true
+		}
+	}
+
+	// 只有行走中的僵尸需要切换动画和速度（啃食、死亡状态由各自逻辑处理）
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, zombieID)
+	if !ok || behavior.Type != components.BehaviorZombieBasic {
+		return
+	}
+	velocity, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, zombieID)
+	if !ok {
+		return
+	}
+	velocity.VX = s.zombieWalkSpeed(zombieID)
+
+	// 重置根运动状态，避免切换动画时位移跳变
+	if hasReanim {
+		reanim.LastGroundX = 0
+		reanim.LastGroundY = 0
+		reanim.LastAnimFrame = -1
+		reanim.AccumulatedDeltaX = 0
+		reanim.AccumulatedDeltaY = 0
+	}
+
+	comboName := "walk"
+	if s.isZombieInWater(zombieID) {
+		comboName = "swim"
+	}
+	behavior.ZombieAnimState = components.ZombieAnimWalking
+	ecs.AddComponent(s.entityManager, zombieID, &components.AnimationCommandComponent{
+		UnitID:    behavior.UnitID,
+		ComboName: comboName,
+		Processed: false,
+	})
+
+	log.Printf("[BehaviorSystem] 跳跃僵尸 %d 失去道具，开始行走", zombieID)
+}
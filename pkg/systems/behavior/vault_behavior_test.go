@@ -0,0 +1,194 @@
+package behavior
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// vaultTestZombieX 测试僵尸的初始 X 坐标：紧贴第 4 列植物的右侧
+const vaultTestZombieX = config.GridWorldStartX + 5*config.CellWidth
+
+// createTestVaultZombie 创建测试用的跳跃僵尸实体（撑杆跳僵尸或跳跳僵尸）
+func createTestVaultZombie(em *ecs.EntityManager, kind components.VaultKind, x, y float64) (ecs.EntityID, *components.VaultComponent) {
+	id := createTestWalkingZombie(em, x, y)
+	vault := &components.VaultComponent{Kind: kind, State: components.VaultStateReady}
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, id)
+	if kind == components.VaultKindPogo {
+		behavior.UnitID = types.UnitIDZombiePogo
+		vault.MoveSpeed = config.PogoZombieSpeed
+		vault.MoveCombo = "pogo"
+		ecs.AddComponent(em, id, &components.AccessoryComponent{
+			Pieces: []*components.AccessoryPiece{{Tag: types.AccessoryPogoStick, Tier: 0}},
+		})
+	} else {
+		behavior.UnitID = types.UnitIDZombiePolevaulter
+		vault.MoveSpeed = config.PoleVaulterRunSpeed
+		vault.MoveCombo = "run"
+	}
+	velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, id)
+	velocity.VX = vault.MoveSpeed
+	ecs.AddComponent(em, id, vault)
+	return id, vault
+}
+
+// createTestGridPlant 创建测试用的网格植物实体
+func createTestGridPlant(em *ecs.EntityManager, plantType components.PlantType, col, row int) ecs.EntityID {
+	id := em.CreateEntity()
+	ecs.AddComponent(em, id, &components.PositionComponent{
+		X: config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2,
+		Y: plantYForRow(row),
+	})
+	ecs.AddComponent(em, id, &components.PlantComponent{PlantType: plantType, GridRow: row, GridCol: col})
+	ecs.AddComponent(em, id, &components.HealthComponent{CurrentHealth: 300, MaxHealth: 300})
+	return id
+}
+
+// TestPogoHopsOverPlant 测试跳跳僵尸越过植物后落在植物左侧，并继续持杆前进
+func TestPogoHopsOverPlant(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	plantID := createTestGridPlant(em, components.PlantPeashooter, 4, 2)
+	zombieID, vault := createTestVaultZombie(em, components.VaultKindPogo, vaultTestZombieX, zombieYForRow(2))
+
+	if !bs.tryStartVault(zombieID, plantID, vault) {
+		t.Fatal("跳跳僵尸遇到植物应起跳")
+	}
+	if vault.State != components.VaultStateJumping {
+		t.Fatalf("期望跳跃中状态，实际 %v", vault.State)
+	}
+
+	position, _ := ecs.GetComponent[*components.PositionComponent](em, zombieID)
+	bs.updateVaultJump(zombieID, vault, position, config.PogoHopDuration/2)
+	if position.X >= vault.StartX || position.X <= vault.TargetX {
+		t.Errorf("跳跃中途应位于起点和落点之间，实际 X=%.1f", position.X)
+	}
+
+	bs.updateVaultJump(zombieID, vault, position, config.PogoHopDuration)
+	if math.Abs(position.X-vault.TargetX) > 1e-9 {
+		t.Errorf("期望落在 X=%.1f，实际 %.1f", vault.TargetX, position.X)
+	}
+
+	plantLeftEdge := config.GridWorldStartX + 4*config.CellWidth
+	if position.X >= plantLeftEdge {
+		t.Errorf("落点 %.1f 应越过植物左边缘 %.1f", position.X, plantLeftEdge)
+	}
+	if vault.State != components.VaultStateReady {
+		t.Errorf("落地后应继续持杆，实际状态 %v", vault.State)
+	}
+	velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, zombieID)
+	if velocity.VX != config.PogoZombieSpeed {
+		t.Errorf("落地后速度应恢复为 %.1f，实际 %.1f", config.PogoZombieSpeed, velocity.VX)
+	}
+}
+
+// TestPoleVaulterDropsPoleAfterJump 测试撑杆跳僵尸跳跃动画结束后向左位移并丢弃撑杆
+func TestPoleVaulterDropsPoleAfterJump(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	plantID := createTestGridPlant(em, components.PlantPeashooter, 4, 2)
+	zombieID, vault := createTestVaultZombie(em, components.VaultKindPole, vaultTestZombieX, zombieYForRow(2))
+
+	if !bs.tryStartVault(zombieID, plantID, vault) {
+		t.Fatal("撑杆跳僵尸遇到植物应起跳")
+	}
+
+	position, _ := ecs.GetComponent[*components.PositionComponent](em, zombieID)
+	reanim, _ := ecs.GetComponent[*components.ReanimComponent](em, zombieID)
+
+	// 跳跃动画未结束时保持原位
+	bs.updateVaultJump(zombieID, vault, position, 0.1)
+	if position.X != vaultTestZombieX || vault.State != components.VaultStateJumping {
+		t.Fatalf("跳跃动画播放期间不应移动，实际 X=%.1f 状态=%v", position.X, vault.State)
+	}
+
+	// 测试环境没有 Reanim 数据，使用默认跳跃距离
+	reanim.IsFinished = true
+	bs.updateVaultJump(zombieID, vault, position, 0.1)
+	if math.Abs(position.X-(vaultTestZombieX-config.PoleVaulterJumpDistance)) > 1e-9 {
+		t.Errorf("期望落在 X=%.1f，实际 %.1f", vaultTestZombieX-config.PoleVaulterJumpDistance, position.X)
+	}
+	if vault.State != components.VaultStateDone {
+		t.Errorf("落地后应丢弃撑杆，实际状态 %v", vault.State)
+	}
+	for _, track := range poleVaulterPoleTracks {
+		if !reanim.HiddenTracks[track] {
+			t.Errorf("撑杆轨道 %s 应被隐藏", track)
+		}
+	}
+
+	// 丢弃撑杆后不会再次起跳
+	if bs.tryStartVault(zombieID, plantID, vault) {
+		t.Error("丢弃撑杆后不应再次起跳")
+	}
+}
+
+// TestTallnutBlocksVaulters 测试高坚果挡住撑杆跳僵尸和跳跳僵尸
+func TestTallnutBlocksVaulters(t *testing.T) {
+	tests := []struct {
+		name string
+		kind components.VaultKind
+	}{
+		{"撑杆跳僵尸", components.VaultKindPole},
+		{"跳跳僵尸", components.VaultKindPogo},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em := ecs.NewEntityManager()
+			rm := game.NewResourceManager(getTestAudioContext())
+			bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+			tallnutID := createTestGridPlant(em, components.PlantTallnut, 4, 2)
+			zombieID, vault := createTestVaultZombie(em, tt.kind, vaultTestZombieX, zombieYForRow(2))
+
+			if !bs.tryStartVault(zombieID, tallnutID, vault) {
+				t.Fatal("遇到高坚果应处理碰撞")
+			}
+			if vault.State != components.VaultStateDone {
+				t.Errorf("被高坚果挡住后应失去道具，实际状态 %v", vault.State)
+			}
+
+			behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, zombieID)
+			if behavior.Type != components.BehaviorZombieEating {
+				t.Errorf("被高坚果挡住后应开始啃食，实际行为 %v", behavior.Type)
+			}
+
+			if accessory, ok := ecs.GetComponent[*components.AccessoryComponent](em, zombieID); ok {
+				if accessory.Has(types.AccessoryPogoStick) {
+					t.Error("跳跳杆应被移除")
+				}
+			}
+		})
+	}
+}
+
+// TestMagnetshroomStealsPogoStick 测试跳跳杆被磁力菇吸走后跳跳僵尸变为普通行走
+func TestMagnetshroomStealsPogoStick(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	zombieID, vault := createTestVaultZombie(em, components.VaultKindPogo, vaultTestZombieX, zombieYForRow(2))
+
+	if !bs.removeZombieAccessory(zombieID, types.AccessoryPogoStick) {
+		t.Fatal("应能移除跳跳杆")
+	}
+	if vault.State != components.VaultStateDone {
+		t.Errorf("失去跳跳杆后应像普通僵尸一样行走，实际状态 %v", vault.State)
+	}
+
+	velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, zombieID)
+	if velocity.VX == config.PogoZombieSpeed {
+		t.Error("失去跳跳杆后不应保持跳跃速度")
+	}
+}
@@ -9,9 +9,9 @@ import (
 	"github.com/gonewx/pvz/pkg/ecs"
 	"github.com/gonewx/pvz/pkg/entities"
 	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
 	"github.com/gonewx/pvz/pkg/types"
 	"github.com/gonewx/pvz/pkg/utils"
-	"github.com/hajimehoshi/ebiten/v2"
 )
 
 func (s *BehaviorSystem) handleZombieBasicBehavior(entityID ecs.EntityID, deltaTime float64) {
@@ -46,6 +46,69 @@ func (s *BehaviorSystem) handleZombieBasicBehavior(entityID ecs.EntityID, deltaT
 		}
 	}
 
+	// 头部防具（如橄榄球头盔）外观和破坏检查
+	s.updateZombieHelmet(entityID)
+
+	// 手持防具（报纸、铁栅门）耐久检查，读报僵尸失去报纸后原地惊愕
+	if s.updateZombieShield(entityID) {
+		return
+	}
+
+	// 伴舞出土中、舞王召唤中原地不动
+	if s.isDancerBusy(entityID) {
+		return
+	}
+
+	// 小丑僵尸打开盒子期间原地不动
+	if s.isJackPopping(entityID) {
+		return
+	}
+
+	// 气球僵尸坠落期间原地不动
+	if s.isBalloonFalling(entityID) {
+		return
+	}
+
+	// 矿工僵尸钻出、落地、头晕期间原地不动
+	if s.isDiggerSurfacing(entityID) {
+		return
+	}
+
+	// 巨人砸击、扔小鬼期间原地不动；被扔出的小鬼在飞行、落地期间由 updateThrownImps 控制
+	if s.isGargantuarBusy(entityID) || s.isImpThrown(entityID) {
+		return
+	}
+
+	// 蹦极僵尸不走路，瞄准、下落、抓取、上升由 updateBungeeZombies 控制
+	if s.isBungeeZombie(entityID) {
+		return
+	}
+
+	// 架梯期间原地不动，翻越梯子期间由 updateLadders 控制位移
+	if s.isLadderBusy(entityID) {
+		return
+	}
+
+	// 投篮车停车投篮期间原地不动
+	if s.isCatapultShooting(entityID) {
+		return
+	}
+
+	// 雪橇队僵尸滑行、跳下雪橇期间由 updateBobsleds 控制
+	if s.isBobsledRiding(entityID) {
+		return
+	}
+
+	// 从墓碑下爬出期间原地不动，由 updateRisingZombies 控制位置
+	if s.isZombieRising(entityID) {
+		return
+	}
+
+	// 跳入水中期间原地不动，被缠绕水草缠住期间由缠绕水草拖入水中
+	if s.isZombieEnteringWater(entityID) || s.isZombieTangled(entityID) {
+		return
+	}
+
 	// 获取位置组件
 	position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
 	if !ok {
@@ -63,15 +126,66 @@ func (s *BehaviorSystem) handleZombieBasicBehavior(entityID ecs.EntityID, deltaT
 	// 计算僵尸碰撞盒中心所在格子
 	// 使用碰撞盒中心而非实体位置，确保旗帜僵尸等有偏移的僵尸正确检测
 	zombieCol := int((position.X + collisionOffsetX - config.GridWorldStartX) / config.CellWidth)
-	zombieRow := int((position.Y - config.GridWorldStartY - config.ZombieVerticalOffset - config.CellHeight/2.0) / config.CellHeight)
+	zombieRow := s.lawnLayout.RowAt(position.X, position.Y-config.ZombieVerticalOffset)
+
+	// 被魅惑的僵尸属于植物阵营，不啃食植物
+	charmed := s.isCharmedZombie(entityID)
+
+	// 跳跃僵尸（撑杆跳、跳跳杆）跳跃期间不检测植物碰撞
+	vault, hasVault := ecs.GetComponent[*components.VaultComponent](s.entityManager, entityID)
+	if hasVault && vault.State == components.VaultStateJumping {
+		s.updateVaultJump(entityID, vault, position, deltaTime)
+		return
+	}
+
+	// 空中的僵尸（气球僵尸）从植物上方飞过，地下的僵尸（矿工僵尸）从植物下方穿过，均不啃食
+	airborne := hasCollision && collision.IsAirborne()
+	underground := s.isUndergroundZombie(entityID)
+	offGround := airborne || underground
 
 	// 检测是否与植物在同一格子
-	plantID, hasCollision := s.detectPlantCollision(zombieRow, zombieCol)
-	if hasCollision {
-		log.Printf("[BehaviorSystem] ✅ 僵尸 %d 检测到植物 %d，位置(%d,%d)，开始啃食！", entityID, plantID, zombieRow, zombieCol)
-		// 进入啃食状态
-		s.startEatingPlant(entityID, plantID)
-		return // 跳过移动逻辑
+	if !charmed && !offGround {
+		plantID, hasCollision := s.detectPlantCollision(zombieRow, zombieCol)
+		// 刚越过的植物不会被立即啃食
+		if hasCollision && hasVault && plantID == vault.PlantID {
+			hasCollision = false
+		}
+		// 持有跳跃道具时起跳越过植物，而不是啃食
+		if hasCollision && hasVault && s.tryStartVault(entityID, plantID, vault) {
+			return
+		}
+		// 巨人不啃食植物，而是一击砸扁
+		if hasCollision && s.tryStartGargantuarSmash(entityID, plantID) {
+			return
+		}
+		// 投篮车、雪橇车不啃食植物，而是直接碾过
+		if hasCollision && s.tryCrushPlant(entityID, plantID) {
+			return
+		}
+		// 扶梯僵尸在坚果墙上架梯，其他僵尸顺着梯子翻过植物
+		if hasCollision && s.tryUseLadder(entityID, plantID) {
+			return
+		}
+		if hasCollision {
+			log.Printf("[BehaviorSystem] ✅ 僵尸 %d 检测到植物 %d，位置(%d,%d)，开始啃食！", entityID, plantID, zombieRow, zombieCol)
+			// 进入啃食状态
+			s.startEatingPlant(entityID, plantID)
+			return // 跳过移动逻辑
+		}
+	}
+
+	// 检测前方是否有敌对阵营的僵尸（被魅惑僵尸与普通僵尸互相啃食）
+	// 车辆僵尸不啃食，从敌对僵尸身边驶过
+	isVehicle := s.isVehicleZombie(entityID)
+	if !offGround && !isVehicle {
+		if targetID, found := s.detectHostileZombieCollision(entityID, position.X+collisionOffsetX, zombieRow); found {
+			if s.tryStartGargantuarSmash(entityID, targetID) {
+				return
+			}
+			log.Printf("[BehaviorSystem] 僵尸 %d 检测到敌对僵尸 %d，开始啃食！", entityID, targetID)
+			s.startEatingPlant(entityID, targetID)
+			return // 跳过移动逻辑
+		}
 	}
 
 	// 获取速度组件
@@ -83,15 +197,24 @@ func (s *BehaviorSystem) handleZombieBasicBehavior(entityID ecs.EntityID, deltaT
 
 	// 尝试使用根运动法计算位移
 	// 根运动法：从 Reanim 动画的 _ground 轨道读取帧间位移增量，实现脚步与地面同步
+	// 持有跳跃道具的僵尸（持杆奔跑、跳跳杆）使用固定速度移动
+	// 舞团成员使用领舞统一设置的速度，保证步调一致
+	// 气球僵尸飞行、矿工僵尸挖掘、车辆行驶、水中游动的动画没有脚步，使用固定速度
 	reanim, hasReanim := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID)
+	_, isDancer := ecs.GetComponent[*components.DancerComponent](s.entityManager, entityID)
+	inWater := s.isZombieInWater(entityID)
 	useRootMotion := false
 
-	if hasReanim {
+	if hasReanim && !(hasVault && vault.HasProp()) && !isDancer && !offGround && !isVehicle && !inWater {
 		// 尝试使用根运动法
 		deltaX, deltaY, err := utils.CalculateRootMotionDelta(reanim, "_ground")
 
 		if err == nil {
 			// 成功：应用根运动位移
+			// 被魅惑的僵尸、转身的矿工僵尸镜像显示，水平位移方向相反
+			if charmed || s.isDiggerWalkingBackward(entityID) {
+				deltaX = -deltaX
+			}
 			position.X += deltaX
 			position.Y += deltaY
 			useRootMotion = true
@@ -117,6 +240,13 @@ func (s *BehaviorSystem) handleZombieBasicBehavior(entityID ecs.EntityID, deltaT
 		position.Y += velocity.VY * deltaTime
 	}
 
+	// 边界检查：被魅惑的僵尸走出草坪右侧后删除（已在被魅惑时计入消灭数）
+	if charmed && position.X > config.CharmedZombieDeletionBoundary {
+		log.Printf("[BehaviorSystem] 被魅惑的僵尸 %d 移出屏幕右侧 (X=%.1f)，标记删除", entityID, position.X)
+		s.entityManager.DestroyEntity(entityID)
+		return
+	}
+
 	// 边界检查：如果僵尸移出屏幕左侧，标记删除
 	// 使用 config.ZombieDeletionBoundary 提供容错空间，避免僵尸刚移出就被删除
 	if position.X < config.ZombieDeletionBoundary {
@@ -133,6 +263,12 @@ func (s *BehaviorSystem) handleZombieBasicBehavior(entityID ecs.EntityID, deltaT
 // 注意：手臂掉落效果在 updateZombieDamageState 中根据 DeathEffectType 判断是否触发
 
 func (s *BehaviorSystem) triggerZombieDeath(entityID ecs.EntityID) {
+	// 雪橇车没有死亡动画，直接报废
+	if s.isZomboni(entityID) {
+		s.wreckZomboni(entityID)
+		return
+	}
+
 	// 1. 切换行为类型为 BehaviorZombieDying
 	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
 	if !ok {
@@ -175,11 +311,15 @@ func (s *BehaviorSystem) triggerZombieDeath(entityID ecs.EntityID) {
 			audioManager.PlaySound("SOUND_LIMBS_POP")
 		}
 
-		// 触发僵尸头部掉落粒子效果
+		// 触发僵尸头部掉落粒子效果（水中的僵尸头部落入水中）
+		headEffect := "ZombieHead"
+		if s.isZombieInWater(entityID) {
+			headEffect = "ZombieHeadPool"
+		}
 		_, err := entities.CreateParticleEffect(
 			s.entityManager,
 			s.resourceManager,
-			"ZombieHead", // 粒子效果名称（不带.xml后缀）
+			headEffect, // 粒子效果名称（不带.xml后缀）
 			position.X, position.Y,
 			angleOffset, // 传递角度偏移
 		)
@@ -242,6 +382,9 @@ func (s *BehaviorSystem) triggerZombieDeath(entityID ecs.EntityID) {
 	if unitID == "" {
 		unitID = types.UnitIDZombie // 后备默认值
 	}
+	if s.isZombieInWater(entityID) {
+		deathComboName = "swim_death"
+	}
 	if unitID == "zombie_flag" {
 		if health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, entityID); ok {
 			if health.ArmLost {
@@ -275,7 +418,7 @@ func (s *BehaviorSystem) handleZombieDyingBehavior(entityID ecs.EntityID) {
 		// 如果没有 ReanimComponent，直接删除僵尸
 		log.Printf("[BehaviorSystem] 死亡中的僵尸 %d 缺少 ReanimComponent，直接删除", entityID)
 		// 僵尸死亡，增加计数
-		s.gameState.IncrementZombiesKilled()
+		s.recordZombieKilled(entityID)
 		s.entityManager.DestroyEntity(entityID)
 		return
 	}
@@ -287,7 +430,7 @@ func (s *BehaviorSystem) handleZombieDyingBehavior(entityID ecs.EntityID) {
 		log.Printf("[BehaviorSystem] 僵尸 %d 死亡动画完成 (frame %d)，删除实体",
 			entityID, reanim.CurrentFrame)
 		// 僵尸死亡，增加计数
-		s.gameState.IncrementZombiesKilled()
+		s.recordZombieKilled(entityID)
 		s.entityManager.DestroyEntity(entityID)
 	}
 }
@@ -399,6 +542,8 @@ func (s *BehaviorSystem) detectPlantCollision(zombieRow, zombieCol int) (ecs.Ent
 	plantEntityList := ecs.GetEntitiesWith1[*components.PlantComponent](s.entityManager)
 
 	// 遍历所有植物，比对网格位置
+	// 睡莲、花盆上种有植物时，僵尸先吃上面的植物，再吃睡莲、花盆
+	var platformID ecs.EntityID
 	for _, plantID := range plantEntityList {
 		plant, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID)
 		if !ok {
@@ -411,12 +556,30 @@ func (s *BehaviorSystem) detectPlantCollision(zombieRow, zombieCol int) (ecs.Ent
 			continue
 		}
 
-		// 检查是否在同一格子
-		if plant.GridRow == zombieRow && plant.GridCol == zombieCol {
+		// 跳过缠绕水草，它潜伏在水下等待僵尸经过
+		if plant.PlantType == components.PlantTangleKelp {
+			continue
+		}
+
+		// 跳过地刺，僵尸从上面走过并被扎伤（见 handleSpikeweedBehavior），巨人也不会砸它
+		if plant.PlantType.IsSpike() {
+			continue
+		}
+
+		// 检查是否在同一格子（多格植物检查其占用的所有格子）
+		if plant.OccupiesCell(zombieCol, zombieRow) {
+			if plant.PlantType.IsPlatform() {
+				platformID = plantID
+				continue
+			}
 			return plantID, true
 		}
 	}
 
+	if platformID != 0 {
+		return platformID, true
+	}
+
 	// 没有找到植物
 	return 0, false
 }
@@ -467,6 +630,10 @@ func (s *BehaviorSystem) changeZombieAnimation(zombieID ecs.EntityID, newState c
 		} else {
 			comboName = baseWalk
 		}
+		// 舞者使用舞团当前的舞步
+		if dancer, ok := ecs.GetComponent[*components.DancerComponent](s.entityManager, zombieID); ok {
+			comboName = danceComboName(dancer.Phase)
+		}
 	case components.ZombieAnimEating:
 		// 旗帜僵尸受损时使用 eat_damaged 动画
 		if isFlagZombieDamaged {
@@ -499,6 +666,33 @@ func (s *BehaviorSystem) changeZombieAnimation(zombieID ecs.EntityID, newState c
 		return
 	}
 
+	// 扛着梯子的扶梯僵尸使用扛梯行走/啃食动画
+	if systems.IsCarryingLadder(s.entityManager, zombieID) {
+		switch newState {
+		case components.ZombieAnimWalking:
+			comboName = "ladderwalk"
+		case components.ZombieAnimEating:
+			comboName = "laddereat"
+		}
+	}
+
+	// 水中的僵尸使用游泳/水中啃食动画
+	if s.isZombieInWater(zombieID) {
+		switch newState {
+		case components.ZombieAnimWalking:
+			comboName = "swim"
+		case components.ZombieAnimEating:
+			comboName = "swim_eat"
+		}
+	}
+
+	// 狂暴的僵尸（失去报纸的读报僵尸）使用加速的行走/啃食动画
+	if enrage, ok := ecs.GetComponent[*components.EnrageComponent](s.entityManager, zombieID); ok && enrage.IsEnraged() {
+		if newState == components.ZombieAnimWalking || newState == components.ZombieAnimEating {
+			comboName += enrage.ComboSuffix
+		}
+	}
+
 	// 使用 BehaviorComponent 中存储的 UnitID
 	unitID := behavior.UnitID
 	if unitID == "" {
@@ -617,9 +811,9 @@ func (s *BehaviorSystem) stopEatingAndResume(zombieID ecs.EntityID) {
 		log.Printf("[BehaviorSystem] 僵尸 %d 重置根运动状态", zombieID)
 	}
 
-	// 4. 恢复 VelocityComponent
+	// 4. 恢复 VelocityComponent（按阵营决定移动方向）
 	ecs.AddComponent(s.entityManager, zombieID, &components.VelocityComponent{
-		VX: config.ZombieWalkSpeed,
+		VX: s.zombieWalkSpeed(zombieID),
 		VY: 0,
 	})
 }
@@ -633,6 +827,9 @@ func (s *BehaviorSystem) handleZombieEatingBehavior(entityID ecs.EntityID, delta
 	// DEBUG: 添加日志确认函数被调用
 	log.Printf("[BehaviorSystem] 🍴 处理僵尸 %d 啃食行为", entityID)
 
+	// 啃食中手持防具被打坏时直接狂暴（不惊愕）
+	s.updateZombieShield(entityID)
+
 	// 获取行为组件和动画组件，用于伤害和音效同步
 	behavior, hasBehavior := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
 	reanim, hasReanim := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID)
@@ -657,7 +854,11 @@ func (s *BehaviorSystem) handleZombieEatingBehavior(entityID ecs.EntityID, delta
 
 		// 获取动画总帧数（用于计算中间点）
 		totalFrames := 0
-		if animVisibles, ok := reanim.AnimVisiblesMap["anim_eat"]; ok {
+		eatAnimName := "anim_eat"
+		if enrage, ok := ecs.GetComponent[*components.EnrageComponent](s.entityManager, entityID); ok && enrage.IsEnraged() {
+			eatAnimName += enrage.ComboSuffix
+		}
+		if animVisibles, ok := reanim.AnimVisiblesMap[eatAnimName]; ok {
 			for _, v := range animVisibles {
 				if v == 0 {
 					totalFrames++
@@ -713,10 +914,10 @@ func (s *BehaviorSystem) handleZombieEatingBehavior(entityID ecs.EntityID, delta
 	// 检查护甲状态（护甲僵尸即使在啃食也需要检测护甲破坏）
 	// 当护甲被打掉时，需要立即隐藏护甲轨道并更新 UnitID，
 	// 防止恢复移动时使用错误的动画配置导致护甲重新显示
-	armor, hasArmor := ecs.GetComponent[*components.ArmorComponent](s.entityManager, entityID)
-	if hasArmor && armor.CurrentArmor <= 0 {
+	if s.isHelmetBroken(entityID) {
 		s.handleArmorDestroyedWhileEating(entityID, behavior)
 	}
+	s.updateZombieHelmet(entityID)
 
 	// 获取僵尸当前网格位置
 	pos, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
@@ -734,17 +935,40 @@ func (s *BehaviorSystem) handleZombieEatingBehavior(entityID ecs.EntityID, delta
 	// 计算僵尸碰撞盒中心所在格子
 	// 使用碰撞盒中心而非实体位置，确保旗帜僵尸等有偏移的僵尸正确检测
 	zombieCol := int((pos.X + collisionOffsetX - config.GridWorldStartX) / config.CellWidth)
-	zombieRow := int((pos.Y - config.GridWorldStartY - config.ZombieVerticalOffset - config.CellHeight/2.0) / config.CellHeight)
+	zombieRow := s.lawnLayout.RowAt(pos.X, pos.Y-config.ZombieVerticalOffset)
 
-	// 检测植物
-	plantID, hasPlant := s.detectPlantCollision(zombieRow, zombieCol)
+	// 检测植物（被魅惑的僵尸不啃食植物）
+	var plantID ecs.EntityID
+	hasPlant := false
+	if !s.isCharmedZombie(entityID) {
+		plantID, hasPlant = s.detectPlantCollision(zombieRow, zombieCol)
+	}
 
 	if !hasPlant {
-		// 植物不存在（可能被其他僵尸吃掉），恢复移动
-		s.stopEatingAndResume(entityID)
+		// 没有植物时，检测是否正在啃食敌对僵尸
+		targetID, hasTarget := s.detectHostileZombieCollision(entityID, pos.X+collisionOffsetX, zombieRow)
+		if !hasTarget {
+			// 目标不存在（可能被其他僵尸吃掉或已死亡），恢复移动
+			s.stopEatingAndResume(entityID)
+			return
+		}
+
+		if shouldDealDamage {
+			s.biteZombie(entityID, targetID)
+		}
 		return
 	}
 
+	// 催眠菇：咬下第一口即被魅惑
+	if shouldDealDamage {
+		if plantComp, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID); ok {
+			if plantComp.PlantType == components.PlantHypnoshroom {
+				s.eatHypnoshroom(entityID, plantID)
+				return
+			}
+		}
+	}
+
 	// 基于动画帧触发伤害（与音效同步）
 	if shouldDealDamage {
 		// 植物存在，造成伤害
@@ -756,7 +980,7 @@ func (s *BehaviorSystem) handleZombieEatingBehavior(entityID ecs.EntityID, delta
 			// WallnutEatSmall: 每次啃食伤害时触发
 			// WallnutEatLarge: 在受损状态变化时触发（在 handleWallnutBehavior 中）
 			if plantComp, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID); ok {
-				if plantComp.PlantType == components.PlantWallnut {
+				if plantComp.PlantType == components.PlantWallnut || plantComp.PlantType == components.PlantTallnut {
 					// 粒子位置：僵尸嘴巴位置（啃食接触点）
 					particleX := pos.X + config.ZombieEatParticleOffsetX
 					particleY := pos.Y + config.ZombieEatParticleOffsetY
@@ -789,22 +1013,7 @@ func (s *BehaviorSystem) handleZombieEatingBehavior(entityID ecs.EntityID, delta
 			if plantHealth.CurrentHealth <= 0 {
 				log.Printf("[BehaviorSystem] 植物 %d 被吃掉，删除实体", plantID)
 
-				// 释放网格占用状态，允许重新种植
-				if plantComp, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID); ok {
-					if s.lawnGridSystem != nil && s.lawnGridEntityID != 0 {
-						err := s.lawnGridSystem.ReleaseCell(s.lawnGridEntityID, plantComp.GridCol, plantComp.GridRow)
-						if err != nil {
-							log.Printf("[BehaviorSystem] 警告：释放网格占用失败: %v", err)
-						} else {
-							log.Printf("[BehaviorSystem] 网格 (%d, %d) 已释放", plantComp.GridCol, plantComp.GridRow)
-						}
-					} else {
-						log.Printf("[BehaviorSystem] 警告：无法释放网格，lawnGridSystem=%v, lawnGridEntityID=%d",
-							s.lawnGridSystem != nil, s.lawnGridEntityID)
-					}
-				}
-
-				s.entityManager.DestroyEntity(plantID)
+				s.destroyPlant(plantID)
 				// 恢复僵尸移动
 				s.stopEatingAndResume(entityID)
 				return
@@ -813,28 +1022,48 @@ func (s *BehaviorSystem) handleZombieEatingBehavior(entityID ecs.EntityID, delta
 			// 植物没有 HealthComponent（不应该发生，但作为保护措施）
 			log.Printf("[BehaviorSystem] 警告：植物 %d 没有 HealthComponent，直接删除", plantID)
 
-			// Bug Fix: 释放网格占用状态，允许重新种植
-			if plantComp, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID); ok {
-				if s.lawnGridSystem != nil && s.lawnGridEntityID != 0 {
-					err := s.lawnGridSystem.ReleaseCell(s.lawnGridEntityID, plantComp.GridCol, plantComp.GridRow)
-					if err != nil {
-						log.Printf("[BehaviorSystem] 警告：释放网格占用失败: %v", err)
-					} else {
-						log.Printf("[BehaviorSystem] 网格 (%d, %d) 已释放", plantComp.GridCol, plantComp.GridRow)
-					}
-				} else {
-					log.Printf("[BehaviorSystem] 警告：无法释放网格，lawnGridSystem=%v, lawnGridEntityID=%d",
-						s.lawnGridSystem != nil, s.lawnGridEntityID)
-				}
-			}
-
-			s.entityManager.DestroyEntity(plantID)
+			s.destroyPlant(plantID)
 			s.stopEatingAndResume(entityID)
 			return
 		}
 	}
 }
 
+// destroyPlant 删除被僵尸摧毁的植物（被吃掉、被小丑炸毁等）
+// 先释放植物占用的网格，允许重新种植，再删除植物实体
+// 睡莲、花盆被摧毁时，种在上面的植物失去依托一并消失
+func (s *BehaviorSystem) destroyPlant(plantID ecs.EntityID) {
+	if plantComp, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID); ok &&
+		plantComp.PlantType.IsPlatform() && s.lawnGridSystem != nil && s.lawnGridEntityID != 0 {
+		if occupant := s.lawnGridSystem.GetOccupant(s.lawnGridEntityID, plantComp.GridCol, plantComp.GridRow); occupant != 0 {
+			s.releasePlantCells(occupant)
+			s.entityManager.DestroyEntity(occupant)
+		}
+	}
+	s.releasePlantCells(plantID)
+	s.entityManager.DestroyEntity(plantID)
+}
+
+// releasePlantCells 释放植物占用的网格，允许重新种植
+func (s *BehaviorSystem) releasePlantCells(plantID ecs.EntityID) {
+	if plantComp, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID); ok {
+		if plantComp.PlantType.IsPlatform() && s.lawnGridSystem != nil && s.lawnGridEntityID != 0 {
+			// 睡莲、花盆记录在种植平台层，不占用格子
+			s.lawnGridSystem.RemovePlatform(s.lawnGridEntityID, plantComp.GridCol, plantComp.GridRow)
+		} else if s.lawnGridSystem != nil && s.lawnGridEntityID != 0 {
+			err := s.lawnGridSystem.ReleaseCells(s.lawnGridEntityID, plantComp.GridCol, plantComp.GridRow, components.PlantFootprintWidth(plantComp.PlantType))
+			if err != nil {
+				log.Printf("[BehaviorSystem] 警告：释放网格占用失败: %v", err)
+			} else {
+				log.Printf("[BehaviorSystem] 网格 (%d, %d) 已释放", plantComp.GridCol, plantComp.GridRow)
+			}
+		} else {
+			log.Printf("[BehaviorSystem] 警告：无法释放网格，lawnGridSystem=%v, lawnGridEntityID=%d",
+				s.lawnGridSystem != nil, s.lawnGridEntityID)
+		}
+	}
+}
+
 // playEatingSound 播放僵尸啃食音效
 func (s *BehaviorSystem) playEatingSound() {
 	// 使用 AudioManager 统一管理音效（Story 10.9）
@@ -857,16 +1086,16 @@ func (s *BehaviorSystem) handleConeheadZombieBehavior(entityID ecs.EntityID, del
 	}
 
 	// 首先检查护甲状态
-	armor, ok := ecs.GetComponent[*components.ArmorComponent](s.entityManager, entityID)
+	accessory, ok := ecs.GetComponent[*components.AccessoryComponent](s.entityManager, entityID)
 	if !ok {
-		// 没有护甲组件（不应该发生），退化为普通僵尸行为
-		log.Printf("[BehaviorSystem] 警告：路障僵尸 %d 缺少 ArmorComponent，转为普通僵尸", entityID)
+		// 没有饰品组件（不应该发生），退化为普通僵尸行为
+		log.Printf("[BehaviorSystem] 警告：路障僵尸 %d 缺少 AccessoryComponent，转为普通僵尸", entityID)
 		s.handleZombieBasicBehavior(entityID, deltaTime)
 		return
 	}
 
-	// 如果护甲已破坏，切换为普通僵尸
-	if armor.CurrentArmor <= 0 {
+	// 如果护甲已破坏（被打掉或被磁力菇吸走），切换为普通僵尸
+	if helmet := accessory.Helmet(); helmet == nil || helmet.Durability <= 0 {
 		// 检查是否已经切换过（避免每帧都触发）
 		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
 		if ok {
@@ -889,6 +1118,7 @@ func (s *BehaviorSystem) handleConeheadZombieBehavior(entityID ecs.EntityID, del
 					reanim.HiddenTracks["anim_cone"] = true // 隐藏路障
 					log.Printf("[BehaviorSystem] 路障僵尸 %d 隐藏 anim_cone 轨道", entityID)
 				}
+				s.removeBrokenHelmet(entityID)
 
 				// 4. 触发路障掉落粒子效果
 				position, hasPos := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
@@ -922,9 +1152,6 @@ func (s *BehaviorSystem) handleConeheadZombieBehavior(entityID ecs.EntityID, del
 		return
 	}
 
-	// 护甲完好，更新外观状态（根据受损程度切换图片）
-	s.updateArmorVisualState(entityID, armor, "cone")
-
 	// 执行普通僵尸的基本行为（移动、碰撞检测、啃食植物）
 	s.handleZombieBasicBehavior(entityID, deltaTime)
 }
@@ -939,16 +1166,16 @@ func (s *BehaviorSystem) handleBucketheadZombieBehavior(entityID ecs.EntityID, d
 	}
 
 	// 首先检查护甲状态
-	armor, ok := ecs.GetComponent[*components.ArmorComponent](s.entityManager, entityID)
+	accessory, ok := ecs.GetComponent[*components.AccessoryComponent](s.entityManager, entityID)
 	if !ok {
-		// 没有护甲组件（不应该发生），退化为普通僵尸行为
-		log.Printf("[BehaviorSystem] 警告：铁桶僵尸 %d 缺少 ArmorComponent，转为普通僵尸", entityID)
+		// 没有饰品组件（不应该发生），退化为普通僵尸行为
+		log.Printf("[BehaviorSystem] 警告：铁桶僵尸 %d 缺少 AccessoryComponent，转为普通僵尸", entityID)
 		s.handleZombieBasicBehavior(entityID, deltaTime)
 		return
 	}
 
-	// 如果护甲已破坏，切换为普通僵尸
-	if armor.CurrentArmor <= 0 {
+	// 如果护甲已破坏（被打掉或被磁力菇吸走），切换为普通僵尸
+	if helmet := accessory.Helmet(); helmet == nil || helmet.Durability <= 0 {
 		// 检查是否已经切换过（避免每帧都触发）
 		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
 		if ok {
@@ -971,6 +1198,7 @@ func (s *BehaviorSystem) handleBucketheadZombieBehavior(entityID ecs.EntityID, d
 					reanim.HiddenTracks["anim_bucket"] = true // 隐藏铁桶
 					log.Printf("[BehaviorSystem] 铁桶僵尸 %d 隐藏 anim_bucket 轨道", entityID)
 				}
+				s.removeBrokenHelmet(entityID)
 
 				// 4. 触发铁桶掉落粒子效果
 				position, hasPos := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
@@ -1004,9 +1232,6 @@ func (s *BehaviorSystem) handleBucketheadZombieBehavior(entityID ecs.EntityID, d
 		return
 	}
 
-	// 护甲完好，更新外观状态（根据受损程度切换图片）
-	s.updateArmorVisualState(entityID, armor, "bucket")
-
 	// 执行普通僵尸的基本行为（移动、碰撞检测、啃食植物）
 	s.handleZombieBasicBehavior(entityID, deltaTime)
 }
@@ -1080,14 +1305,22 @@ func (s *BehaviorSystem) triggerZombieExplosionDeath(entityID ecs.EntityID) {
 	// 3. 使用 AnimationCommand 触发烧焦死亡动画
 	//    Story 5.4.1: ReanimSystem.PlayCombo 现在支持单位切换
 	//    当 UnitID 与当前 ReanimName 不同时，自动重新加载 Reanim 数据
+	//    投篮车、雪橇车使用各自专用的烧焦动画
+	charredUnitID := types.UnitIDZombieCharred
+	switch behavior.UnitID {
+	case types.UnitIDZombieCatapult:
+		charredUnitID = types.UnitIDZombieCharredCatapult
+	case types.UnitIDZombieZomboni:
+		charredUnitID = types.UnitIDZombieCharredZomboni
+	}
 	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
-		UnitID:    types.UnitIDZombieCharred, // 指向 zombie_charred 配置
-		ComboName: "death",                   // 配置中的 death 组合
+		UnitID:    charredUnitID, // 指向 zombie_charred 配置
+		ComboName: "death",       // 配置中的 death 组合
 		Processed: false,
 	})
-	log.Printf("[BehaviorSystem] 僵尸 %d 添加烧焦死亡动画命令 (zombie_charred/death)", entityID)
+	log.Printf("[BehaviorSystem] 僵尸 %d 添加烧焦死亡动画命令 (%s/death)", entityID, charredUnitID)
 
-	log.Printf("[BehaviorSystem] 僵尸 %d 烧焦死亡动画已开始播放 (zombie_charred/death, 不循环)", entityID)
+	log.Printf("[BehaviorSystem] 僵尸 %d 烧焦死亡动画已开始播放 (%s/death, 不循环)", entityID, charredUnitID)
 }
 
 // handleZombieDyingExplosionBehavior 处理僵尸爆炸烧焦死亡动画播放
@@ -1109,7 +1342,7 @@ func (s *BehaviorSystem) handleZombieDyingExplosionBehavior(entityID ecs.EntityI
 	if !ok {
 		// 如果没有 ReanimComponent，直接删除僵尸
 		log.Printf("[BehaviorSystem] 爆炸死亡中的僵尸 %d 缺少 ReanimComponent，直接删除", entityID)
-		s.gameState.IncrementZombiesKilled()
+		s.recordZombieKilled(entityID)
 		s.entityManager.DestroyEntity(entityID)
 		return
 	}
@@ -1119,81 +1352,13 @@ func (s *BehaviorSystem) handleZombieDyingExplosionBehavior(entityID ecs.EntityI
 		log.Printf("[BehaviorSystem] 僵尸 %d 烧焦死亡动画完成，删除实体", entityID)
 
 		// 增加僵尸消灭计数
-		s.gameState.IncrementZombiesKilled()
+		s.recordZombieKilled(entityID)
 
 		// 删除僵尸实体
 		s.entityManager.DestroyEntity(entityID)
 	}
 }
 
-// updateArmorVisualState 更新护甲僵尸的外观状态
-// 根据护甲的受损程度（剩余百分比）切换不同的护甲图片
-// 支持路障僵尸（cone）和铁桶僵尸（bucket）
-func (s *BehaviorSystem) updateArmorVisualState(entityID ecs.EntityID, armor *components.ArmorComponent, armorType string) {
-	reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID)
-	if !ok || reanim.PartImages == nil {
-		return
-	}
-
-	var targetImageName string
-	var imageKey string
-	maxArmor := float64(armor.MaxArmor)
-	currentArmor := float64(armor.CurrentArmor)
-	ratio := currentArmor / maxArmor
-
-	if armorType == "cone" {
-		imageKey = "IMAGE_REANIM_ZOMBIE_CONE1"
-		// 阶段1: 完整 (66% - 100%)
-		// 阶段2: 轻微受损 (33% - 66%)
-		// 阶段3: 严重受损 (0% - 33%)
-		if ratio > 0.66 {
-			targetImageName = "assets/reanim/Zombie_cone1.png"
-		} else if ratio > 0.33 {
-			targetImageName = "assets/reanim/Zombie_cone2.png"
-		} else {
-			targetImageName = "assets/reanim/Zombie_cone3.png"
-		}
-	} else if armorType == "bucket" {
-		imageKey = "IMAGE_REANIM_ZOMBIE_BUCKET1"
-		if ratio > 0.66 {
-			targetImageName = "assets/reanim/Zombie_bucket1.png"
-		} else if ratio > 0.33 {
-			targetImageName = "assets/reanim/Zombie_bucket2.png"
-		} else {
-			targetImageName = "assets/reanim/Zombie_bucket3.png"
-		}
-	} else {
-		return
-	}
-
-	// 加载目标图片
-	targetImage, err := s.resourceManager.LoadImage(targetImageName)
-	if err != nil {
-		// 降低日志频率，避免每帧刷屏
-		if s.logFrameCounter%100 == 0 {
-			log.Printf("[BehaviorSystem] 警告：无法加载受损护甲图片 %s: %v", targetImageName, err)
-		}
-		return
-	}
-
-	// 检查当前显示的图片是否已经是目标图片
-	if reanim.PartImages[imageKey] != targetImage {
-		// 确保 PartImages 是独立的副本
-		// 我们无法简单判断是否已经是独立副本，所以如果需要修改，就总是创建一个新的 map
-		// 这是一个浅拷贝，开销很小
-		newPartImages := make(map[string]*ebiten.Image)
-		for k, v := range reanim.PartImages {
-			newPartImages[k] = v
-		}
-		// 更新目标图片的映射
-		newPartImages[imageKey] = targetImage
-		// 替换组件中的 map
-		reanim.PartImages = newPartImages
-
-		log.Printf("[BehaviorSystem] 僵尸 %d 护甲外观更新: %s -> %s (HP ratio: %.2f)", entityID, imageKey, targetImageName, ratio)
-	}
-}
-
 // handleArmorDestroyedWhileEating 处理僵尸在啃食状态下护甲被打掉的情况
 // 这个函数确保护甲被破坏时，即使僵尸正在啃食，也能正确隐藏护甲轨道并更新 UnitID
 // 防止恢复移动时使用错误的动画配置（如 zombie_conehead）导致护甲重新显示
@@ -1234,6 +1399,8 @@ func (s *BehaviorSystem) handleArmorDestroyedWhileEating(entityID ecs.EntityID,
 		log.Printf("[BehaviorSystem] 啃食中的僵尸 %d 护甲破坏，隐藏 %s 轨道", entityID, armorTrackName)
 	}
 
+	s.removeBrokenHelmet(entityID)
+
 	// 更新 UnitID 为普通僵尸，这样恢复移动时会使用正确的动画配置
 	oldUnitID := behavior.UnitID
 	behavior.UnitID = "zombie"
@@ -1260,5 +1427,27 @@ func (s *BehaviorSystem) handleArmorDestroyedWhileEating(entityID ecs.EntityID,
 	}
 }
 
+// removeBrokenHelmet 护甲被打掉后，将头部饰品（I类饰品）标记为已移除
+// 被打掉的路障、铁桶已经不在僵尸身上，磁力菇不能再吸走它
+func (s *BehaviorSystem) removeBrokenHelmet(entityID ecs.EntityID) {
+	accessory, ok := ecs.GetComponent[*components.AccessoryComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	if helmet := accessory.Helmet(); helmet != nil {
+		accessory.Remove(helmet.Tag)
+	}
+}
+
+// isHelmetBroken 判断僵尸的头部防具是否耐久耗尽但尚未被打掉
+func (s *BehaviorSystem) isHelmetBroken(entityID ecs.EntityID) bool {
+	accessory, ok := ecs.GetComponent[*components.AccessoryComponent](s.entityManager, entityID)
+	if !ok {
+		return false
+	}
+	helmet := accessory.Helmet()
+	return helmet != nil && helmet.Durability <= 0
+}
+
 // handleCherryBombBehavior 处理樱桃炸弹的行为逻辑
 // 樱桃炸弹种植后开始引信倒计时（1.5秒），倒计时结束后触发爆炸
@@ -0,0 +1,71 @@
+package behavior
+
+import (
+	"log"
+	"math"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// updateZombonis 雪橇车僵尸在身后铺设冰道
+//
+// 雪橇车存活期间，从所在格子到草坪右边缘的冰道持续刷新；
+// 雪橇车报废或离开后，冰道由 LawnGridSystem 计时淡出
+func (s *BehaviorSystem) updateZombonis() {
+	if s.lawnGridSystem == nil {
+		return
+	}
+	for _, entityID := range ecs.GetEntitiesWith1[*components.ZomboniComponent](s.entityManager) {
+		behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+		if !ok || behavior.Type != components.BehaviorZombieBasic {
+			continue
+		}
+		if waveState, ok := ecs.GetComponent[*components.ZombieWaveStateComponent](s.entityManager, entityID); ok && !waveState.IsActivated {
+			continue
+		}
+		position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+		if !ok {
+			continue
+		}
+
+		col := iceColumnAt(s.zombieCenterX(entityID, position))
+		if col < 0 {
+			col = 0
+		}
+		row := s.zombieRowOf(position)
+		for c := col; c < config.GridColumns; c++ {
+			s.lawnGridSystem.LayIce(s.lawnGridEntityID, c, row)
+		}
+	}
+}
+
+// iceColumnAt 返回世界坐标 X 所在的列（草坪左侧为负数，右侧不小于 GridColumns）
+func iceColumnAt(x float64) int {
+	return int(math.Floor((x - config.GridWorldStartX) / config.CellWidth))
+}
+
+// wreckZomboni 雪橇车报废：生命值耗尽或被地刺扎破时直接播放雪橇车专用的烧焦动画
+func (s *BehaviorSystem) wreckZomboni(entityID ecs.EntityID) {
+	if health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, entityID); ok && health.CurrentHealth > 0 {
+		health.CurrentHealth = 0
+	}
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_EXPLOSION")
+	}
+	log.Printf("[BehaviorSystem] 雪橇车 %d 报废", entityID)
+	s.triggerZombieExplosionDeath(entityID)
+}
+
+// isZomboni 是否是雪橇车僵尸
+func (s *BehaviorSystem) isZomboni(entityID ecs.EntityID) bool {
+	return ecs.HasComponent[*components.ZomboniComponent](s.entityManager, entityID)
+}
+
+// isVehicleZombie 是否是车辆僵尸（投篮车、雪橇车）
+// 车辆以固定速度行驶，碾过挡路的植物，不啃食植物和敌对僵尸
+func (s *BehaviorSystem) isVehicleZombie(entityID ecs.EntityID) bool {
+	return s.isZomboni(entityID) || ecs.HasComponent[*components.CatapultComponent](s.entityManager, entityID)
+}
@@ -0,0 +1,91 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// createTestZomboni 创建测试用的雪橇车僵尸
+func createTestZomboni(em *ecs.EntityManager, x, y float64) ecs.EntityID {
+	id := createTestWalkingZombie(em, x, y)
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, id)
+	behavior.UnitID = types.UnitIDZombieZomboni
+	health, _ := ecs.GetComponent[*components.HealthComponent](em, id)
+	health.CurrentHealth = config.ZomboniZombieDefaultHealth
+	health.MaxHealth = config.ZomboniZombieDefaultHealth
+	velocity, _ := ecs.GetComponent[*components.VelocityComponent](em, id)
+	velocity.VX = config.ZomboniDriveSpeed
+	ecs.AddComponent(em, id, &components.ZomboniComponent{})
+	return id
+}
+
+// TestZomboniLaysIceTrailAndCrushesPlants 测试雪橇车在身后铺设冰道，并碾过挡路的植物
+func TestZomboniLaysIceTrailAndCrushesPlants(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	plantID := createTestGridPlant(em, components.PlantPeashooter, 5, 1)
+	if err := bs.lawnGridSystem.OccupyCell(bs.lawnGridEntityID, 5, 1, plantID); err != nil {
+		t.Fatalf("Failed to occupy cell: %v", err)
+	}
+	zomboniID := createTestZomboni(em, cellCenterX(5), zombieYForRow(1))
+
+	bs.updateZombonis()
+	for col := 5; col < config.GridColumns; col++ {
+		if !bs.lawnGridSystem.HasIce(bs.lawnGridEntityID, col, 1) {
+			t.Errorf("雪橇车身后的格子 (%d, 1) 应有冰道", col)
+		}
+	}
+	if bs.lawnGridSystem.HasIce(bs.lawnGridEntityID, 4, 1) || bs.lawnGridSystem.HasIce(bs.lawnGridEntityID, 5, 2) {
+		t.Error("雪橇车前方和其他行不应有冰道")
+	}
+
+	bs.handleZombieBasicBehavior(zomboniID, 0.1)
+	em.RemoveMarkedEntities()
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, plantID); ok {
+		t.Error("雪橇车应碾过挡路的植物")
+	}
+	if bs.lawnGridSystem.IsOccupied(bs.lawnGridEntityID, 5, 1) {
+		t.Error("被碾过的植物应释放格子")
+	}
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, zomboniID)
+	if behavior.Type != components.BehaviorZombieBasic {
+		t.Errorf("雪橇车不应啃食植物，实际行为 %v", behavior.Type)
+	}
+}
+
+// TestZomboniWrecksWithCharredZamboni 测试雪橇车生命值耗尽时直接报废，播放雪橇车专用的烧焦动画，冰道随后淡出
+func TestZomboniWrecksWithCharredZamboni(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	zomboniID := createTestZomboni(em, cellCenterX(7), zombieYForRow(3))
+	bs.updateZombonis()
+
+	health, _ := ecs.GetComponent[*components.HealthComponent](em, zomboniID)
+	health.CurrentHealth = 0
+	bs.handleZombieBasicBehavior(zomboniID, 0.1)
+
+	behavior, _ := ecs.GetComponent[*components.BehaviorComponent](em, zomboniID)
+	if behavior.Type != components.BehaviorZombieDyingExplosion {
+		t.Fatalf("雪橇车应直接报废，实际行为 %v", behavior.Type)
+	}
+	cmd, ok := ecs.GetComponent[*components.AnimationCommandComponent](em, zomboniID)
+	if !ok || cmd.UnitID != types.UnitIDZombieCharredZomboni || cmd.ComboName != "death" {
+		t.Errorf("期望播放 %s/death，实际 %+v", types.UnitIDZombieCharredZomboni, cmd)
+	}
+
+	// 报废的雪橇车不再刷新冰道
+	bs.updateZombonis()
+	bs.lawnGridSystem.Update(config.ZomboniIceTrailDuration)
+	if bs.lawnGridSystem.HasIce(bs.lawnGridEntityID, 7, 3) {
+		t.Error("雪橇车报废后冰道应淡出")
+	}
+}
@@ -0,0 +1,312 @@
+package behavior
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/systems"
+)
+
+// updateZomboss 更新僵王博士的攻击循环
+//
+// 僵王博士不走路，整个战斗由状态机控制：
+//   - 入场：播放 enter 动画，结束后进入待机
+//   - 待机：计时结束后选择下一次攻击；每进行 ZombossAttacksPerHead 次攻击后低头
+//   - 攻击：踩踏右侧几列的植物、放下蹦极僵尸、在一行中召唤僵尸、扔房车压扁 3x2 的植物
+//   - 低头：头部低垂 ZombossHeadExposedDuration 秒（此时可以被攻击），然后吐出火球或冰球并抬头
+//   - 死亡：生命值耗尽后播放死亡动画，结束时场上的僵尸全部倒下，关卡胜利
+//
+// 攻击在动画结束时生效
+func (s *BehaviorSystem) updateZomboss(deltaTime float64) {
+	entityID, zomboss, ok := systems.GetZomboss(s.entityManager)
+	if !ok {
+		return
+	}
+
+	if zomboss.State != components.ZombossStateDying {
+		if health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, entityID); ok && health.CurrentHealth <= 0 {
+			s.startZombossDeath(entityID, zomboss)
+			return
+		}
+	}
+
+	switch zomboss.State {
+	case components.ZombossStateEntering:
+		if s.isZombossAnimFinished(entityID) {
+			s.startZombossIdle(entityID, zomboss)
+		}
+	case components.ZombossStateIdle:
+		zomboss.Timer -= deltaTime
+		if zomboss.Timer <= 0 {
+			s.startZombossAttack(entityID, zomboss)
+		}
+	case components.ZombossStateStomping:
+		if s.isZombossAnimFinished(entityID) {
+			s.zombossStomp(zomboss)
+			s.startZombossIdle(entityID, zomboss)
+		}
+	case components.ZombossStateBungeeEnter:
+		if s.isZombossAnimFinished(entityID) {
+			s.zombossDropBungees()
+			zomboss.State = components.ZombossStateBungeeLeave
+			s.playZombossCombo(entityID, "bungee_leave")
+		}
+	case components.ZombossStateBungeeLeave:
+		if s.isZombossAnimFinished(entityID) {
+			s.startZombossIdle(entityID, zomboss)
+		}
+	case components.ZombossStateSummoning:
+		if s.isZombossAnimFinished(entityID) {
+			s.zombossSummon(zomboss)
+			s.startZombossIdle(entityID, zomboss)
+		}
+	case components.ZombossStateRV:
+		if s.isZombossAnimFinished(entityID) {
+			s.zombossCrushRV(zomboss)
+			s.startZombossIdle(entityID, zomboss)
+		}
+	case components.ZombossStateHeadEnter:
+		if s.isZombossAnimFinished(entityID) {
+			zomboss.State = components.ZombossStateHeadIdle
+			zomboss.Timer = config.ZombossHeadExposedDuration
+			s.playZombossCombo(entityID, "head_idle")
+			log.Printf("[BehaviorSystem] 僵王博士低头，露出弱点")
+		}
+	case components.ZombossStateHeadIdle:
+		zomboss.Timer -= deltaTime
+		if zomboss.Timer <= 0 {
+			zomboss.State = components.ZombossStateHeadAttack
+			s.playZombossCombo(entityID, fmt.Sprintf("head_attack_%d", zomboss.TargetRow+1))
+		}
+	case components.ZombossStateHeadAttack:
+		if s.isZombossAnimFinished(entityID) {
+			s.zombossSpitBall(zomboss)
+			zomboss.State = components.ZombossStateHeadLeave
+			s.playZombossCombo(entityID, "head_leave")
+		}
+	case components.ZombossStateHeadLeave:
+		if s.isZombossAnimFinished(entityID) {
+			zomboss.AttackCount = 0
+			s.startZombossIdle(entityID, zomboss)
+		}
+	case components.ZombossStateDying:
+		if s.isZombossAnimFinished(entityID) {
+			s.finishZombossDeath(entityID)
+		}
+	}
+}
+
+// startZombossIdle 进入待机状态
+func (s *BehaviorSystem) startZombossIdle(entityID ecs.EntityID, zomboss *components.ZombossComponent) {
+	zomboss.State = components.ZombossStateIdle
+	zomboss.Timer = config.ZombossIdleDuration
+	s.playZombossCombo(entityID, "idle")
+}
+
+// startZombossAttack 选择下一次攻击：攻击次数达到 ZombossAttacksPerHead 后低头，否则随机选择一种攻击
+func (s *BehaviorSystem) startZombossAttack(entityID ecs.EntityID, zomboss *components.ZombossComponent) {
+	if zomboss.AttackCount >= config.ZombossAttacksPerHead {
+		zomboss.State = components.ZombossStateHeadEnter
+		zomboss.TargetRow = rand.Intn(s.lawnLayout.Rows)
+		zomboss.BallElement = components.ZombossElement(rand.Intn(2))
+		s.playZombossCombo(entityID, "head_enter")
+		return
+	}
+
+	zomboss.AttackCount++
+	switch rand.Intn(4) {
+	case 0:
+		// 踩踏动画共 4 种，分别踩踏相邻的两行
+		zomboss.State = components.ZombossStateStomping
+		zomboss.TargetRow = rand.Intn(s.lawnLayout.Rows - 1)
+		s.playZombossCombo(entityID, fmt.Sprintf("stomp_%d", zomboss.TargetRow+1))
+	case 1:
+		zomboss.State = components.ZombossStateBungeeEnter
+		s.playZombossCombo(entityID, "bungee_enter")
+	case 2:
+		zomboss.State = components.ZombossStateSummoning
+		zomboss.TargetRow = rand.Intn(s.lawnLayout.Rows)
+		s.playZombossCombo(entityID, fmt.Sprintf("spawn_%d", zomboss.TargetRow+1))
+	default:
+		zomboss.State = components.ZombossStateRV
+		zomboss.TargetRow = rand.Intn(s.lawnLayout.Rows - 1)
+		zomboss.TargetCol = config.ZombossRVMinCol + rand.Intn(config.GridColumns-2-config.ZombossRVMinCol)
+		s.playZombossCombo(entityID, "rv")
+	}
+	log.Printf("[BehaviorSystem] 僵王博士发动攻击 %d（行 %d，列 %d）", zomboss.State, zomboss.TargetRow, zomboss.TargetCol)
+}
+
+// zombossStomp 踩踏：摧毁相邻两行最右侧 ZombossStompColumns 列的植物
+func (s *BehaviorSystem) zombossStomp(zomboss *components.ZombossComponent) {
+	destroyed := s.destroyPlantsInBlock(config.GridColumns-config.ZombossStompColumns, zomboss.TargetRow, config.ZombossStompColumns, 2)
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_GARGANTUAR_THUMP")
+	}
+	log.Printf("[BehaviorSystem] 僵王博士踩踏第 %d-%d 行，摧毁 %d 株植物", zomboss.TargetRow, zomboss.TargetRow+1, destroyed)
+}
+
+// zombossCrushRV 房车落地：压扁以目标格子为左上角的 3x2 区域内的植物
+func (s *BehaviorSystem) zombossCrushRV(zomboss *components.ZombossComponent) {
+	destroyed := s.destroyPlantsInBlock(zomboss.TargetCol, zomboss.TargetRow, 3, 2)
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_EXPLOSION")
+	}
+	log.Printf("[BehaviorSystem] 僵王博士的房车压扁 (%d, %d) 起的 3x2 区域，摧毁 %d 株植物", zomboss.TargetCol, zomboss.TargetRow, destroyed)
+}
+
+// destroyPlantsInBlock 摧毁以 (col, row) 为左上角、cols 列 rows 行区域内的所有植物
+// 返回被摧毁的植物数量
+func (s *BehaviorSystem) destroyPlantsInBlock(col, row, cols, rows int) int {
+	destroyed := 0
+	for _, plantID := range ecs.GetEntitiesWith1[*components.PlantComponent](s.entityManager) {
+		plant, _ := ecs.GetComponent[*components.PlantComponent](s.entityManager, plantID)
+		hit := false
+		for r := row; r < row+rows && !hit; r++ {
+			for c := col; c < col+cols; c++ {
+				if plant.OccupiesCell(c, r) {
+					hit = true
+					break
+				}
+			}
+		}
+		if !hit {
+			continue
+		}
+		s.destroyPlant(plantID)
+		destroyed++
+	}
+	return destroyed
+}
+
+// zombossDropBungees 放下 ZombossBungeeCount 只蹦极僵尸，蹦极僵尸自行选择要偷取的植物
+func (s *BehaviorSystem) zombossDropBungees() {
+	for i := 0; i < config.ZombossBungeeCount; i++ {
+		row := rand.Intn(s.lawnLayout.Rows)
+		zombieID, err := entities.NewBungeeZombieEntity(s.entityManager, s.resourceManager, s.lawnLayout, row, config.GridWorldEndX)
+		if err != nil {
+			log.Printf("[BehaviorSystem] 警告：僵王博士放下蹦极僵尸失败: %v", err)
+			return
+		}
+		s.activateZombossMinion(zombieID)
+	}
+}
+
+// zombossSummon 在目标行的草坪右侧召唤 ZombossSummonCount 只随机类型的僵尸
+func (s *BehaviorSystem) zombossSummon(zomboss *components.ZombossComponent) {
+	for i := 0; i < config.ZombossSummonCount; i++ {
+		zombieType := config.ZombossSummonTypes[rand.Intn(len(config.ZombossSummonTypes))]
+		x := config.GridWorldEndX + float64(i)*config.ZombossSummonSpacing
+		zombieID, err := entities.NewZombieEntityByType(s.entityManager, s.resourceManager, zombieType, s.lawnLayout, zomboss.TargetRow, x)
+		if err != nil {
+			log.Printf("[BehaviorSystem] 警告：僵王博士召唤僵尸失败: %v", err)
+			return
+		}
+		s.activateZombossMinion(zombieID)
+	}
+	log.Printf("[BehaviorSystem] 僵王博士在第 %d 行召唤了 %d 只僵尸", zomboss.TargetRow, config.ZombossSummonCount)
+}
+
+// activateZombossMinion 激活僵王博士召唤的僵尸
+// 召唤的僵尸不在关卡配置中，记为被召唤的僵尸
+func (s *BehaviorSystem) activateZombossMinion(zombieID ecs.EntityID) {
+	entities.ActivateZombie(s.entityManager, zombieID)
+	systems.RegisterSummonedZombie(s.entityManager, s.gameState, zombieID, 0)
+}
+
+// zombossSpitBall 吐出火球或冰球，沿目标行向左滚动
+func (s *BehaviorSystem) zombossSpitBall(zomboss *components.ZombossComponent) {
+	ballID, err := entities.NewZombossBallEntity(s.entityManager, s.resourceManager, zomboss.BallElement, s.lawnLayout, zomboss.TargetRow)
+	if err != nil {
+		log.Printf("[BehaviorSystem] 警告：僵王博士吐球失败: %v", err)
+		return
+	}
+	log.Printf("[BehaviorSystem] 僵王博士在第 %d 行吐出球 %d（属性 %d）", zomboss.TargetRow, ballID, zomboss.BallElement)
+}
+
+// updateZombossBalls 火球和冰球向左滚动，碾碎所在格子的植物，滚出草坪左侧后消失
+func (s *BehaviorSystem) updateZombossBalls(deltaTime float64) {
+	for _, ballID := range ecs.GetEntitiesWith1[*components.ZombossBallComponent](s.entityManager) {
+		ball, _ := ecs.GetComponent[*components.ZombossBallComponent](s.entityManager, ballID)
+		position, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, ballID)
+		if !ok {
+			continue
+		}
+		if vel, ok := ecs.GetComponent[*components.VelocityComponent](s.entityManager, ballID); ok {
+			position.X += vel.VX * deltaTime
+		}
+
+		if position.X < config.GridWorldStartX-config.CellWidth {
+			s.entityManager.DestroyEntity(ballID)
+			continue
+		}
+
+		col := int(math.Floor((position.X - config.GridWorldStartX) / config.CellWidth))
+		// 碾碎格子上的植物，连同下面的花盆
+		if plantID := s.findPlantAtCell(col, ball.Row); plantID != 0 {
+			log.Printf("[BehaviorSystem] 僵王博士的球 %d 碾碎了 (%d, %d) 的植物 %d", ballID, col, ball.Row, plantID)
+			s.destroyPlant(plantID)
+			if s.lawnGridSystem != nil && s.lawnGridEntityID != 0 {
+				if platformID := s.lawnGridSystem.GetPlatform(s.lawnGridEntityID, col, ball.Row); platformID != 0 && platformID != plantID {
+					s.destroyPlant(platformID)
+				}
+			}
+		}
+	}
+}
+
+// startZombossDeath 生命值耗尽：播放死亡动画
+func (s *BehaviorSystem) startZombossDeath(entityID ecs.EntityID, zomboss *components.ZombossComponent) {
+	zomboss.State = components.ZombossStateDying
+	s.playZombossCombo(entityID, "death")
+	log.Printf("[BehaviorSystem] 僵王博士 %d 被击败", entityID)
+}
+
+// finishZombossDeath 死亡动画结束：场上的僵尸全部倒下，球消失，记录击败僵王博士
+func (s *BehaviorSystem) finishZombossDeath(entityID ecs.EntityID) {
+	for _, zombieID := range s.queryMovingZombies() {
+		if helmet := systems.ZombieHelmet(s.entityManager, zombieID); helmet != nil {
+			helmet.Durability = 0
+		}
+		if health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, zombieID); ok {
+			health.CurrentHealth = 0
+		}
+	}
+	for _, ballID := range ecs.GetEntitiesWith1[*components.ZombossBallComponent](s.entityManager) {
+		s.entityManager.DestroyEntity(ballID)
+	}
+
+	if s.gameState != nil {
+		s.gameState.BossDefeated = true
+	}
+	s.entityManager.DestroyEntity(entityID)
+}
+
+// isZombossAnimFinished 僵王博士当前的非循环动画是否播放完毕
+// 新的动画命令尚未被 ReanimSystem 处理时视为未完成
+func (s *BehaviorSystem) isZombossAnimFinished(entityID ecs.EntityID) bool {
+	if cmd, ok := ecs.GetComponent[*components.AnimationCommandComponent](s.entityManager, entityID); ok && !cmd.Processed {
+		return false
+	}
+	reanim, ok := ecs.GetComponent[*components.ReanimComponent](s.entityManager, entityID)
+	return !ok || reanim.IsFinished
+}
+
+// playZombossCombo 播放僵王博士的动画组合
+func (s *BehaviorSystem) playZombossCombo(entityID ecs.EntityID, comboName string) {
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+	if !ok {
+		return
+	}
+	ecs.AddComponent(s.entityManager, entityID, &components.AnimationCommandComponent{
+		UnitID:    behavior.UnitID,
+		ComboName: comboName,
+		Processed: false,
+	})
+}
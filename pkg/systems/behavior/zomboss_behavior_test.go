@@ -0,0 +1,192 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// createTestZomboss 创建测试用的僵王博士（不加载 Reanim，动画视为立即播放完毕）
+func createTestZomboss(em *ecs.EntityManager, state components.ZombossState) (ecs.EntityID, *components.ZombossComponent) {
+	id := em.CreateEntity()
+	ecs.AddComponent(em, id, &components.PositionComponent{X: config.GameCameraX})
+	ecs.AddComponent(em, id, &components.BehaviorComponent{Type: components.BehaviorZombieBoss, UnitID: types.UnitIDZombieDrZomboss})
+	ecs.AddComponent(em, id, &components.HealthComponent{CurrentHealth: config.ZombossHealth, MaxHealth: config.ZombossHealth})
+	zomboss := &components.ZombossComponent{State: state}
+	ecs.AddComponent(em, id, zomboss)
+	return id, zomboss
+}
+
+// updateZombossFrame 模拟 ReanimSystem 处理动画命令后更新一帧
+func updateZombossFrame(em *ecs.EntityManager, bs *BehaviorSystem, id ecs.EntityID, deltaTime float64) {
+	if cmd, ok := ecs.GetComponent[*components.AnimationCommandComponent](em, id); ok {
+		cmd.Processed = true
+	}
+	bs.updateZomboss(deltaTime)
+}
+
+// TestZombossLowersHeadAfterAttacks 测试僵王博士攻击 ZombossAttacksPerHead 次后低头露出弱点，吐球后抬头
+func TestZombossLowersHeadAfterAttacks(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	id, zomboss := createTestZomboss(em, components.ZombossStateEntering)
+	updateZombossFrame(em, bs, id, 0.1)
+	if zomboss.State != components.ZombossStateIdle {
+		t.Fatalf("入场动画结束后应进入待机，实际状态 %d", zomboss.State)
+	}
+
+	for i := 1; i <= config.ZombossAttacksPerHead; i++ {
+		updateZombossFrame(em, bs, id, config.ZombossIdleDuration)
+		if zomboss.AttackCount != i || zomboss.IsHeadExposed() {
+			t.Fatalf("第 %d 次攻击：AttackCount=%d, 状态 %d", i, zomboss.AttackCount, zomboss.State)
+		}
+		// 攻击动画结束后回到待机
+		for zomboss.State != components.ZombossStateIdle {
+			updateZombossFrame(em, bs, id, 0.1)
+		}
+	}
+
+	updateZombossFrame(em, bs, id, config.ZombossIdleDuration)
+	if zomboss.State != components.ZombossStateHeadEnter {
+		t.Fatalf("攻击 %d 次后应低头，实际状态 %d", config.ZombossAttacksPerHead, zomboss.State)
+	}
+	updateZombossFrame(em, bs, id, 0.1)
+	if !zomboss.IsHeadExposed() {
+		t.Fatal("低头动画结束后应露出弱点")
+	}
+
+	updateZombossFrame(em, bs, id, config.ZombossHeadExposedDuration)
+	if zomboss.State != components.ZombossStateHeadAttack || !zomboss.IsHeadExposed() {
+		t.Fatalf("头部低垂结束后应吐球，实际状态 %d", zomboss.State)
+	}
+	updateZombossFrame(em, bs, id, 0.1)
+	updateZombossFrame(em, bs, id, 0.1)
+	if zomboss.State != components.ZombossStateIdle || zomboss.AttackCount != 0 {
+		t.Errorf("抬头后应回到待机并重新计数，实际状态 %d，AttackCount %d", zomboss.State, zomboss.AttackCount)
+	}
+}
+
+// TestZombossStompAndRVCrushPlants 测试踩踏摧毁相邻两行最右侧几列的植物，房车压扁 3x2 区域的植物
+func TestZombossStompAndRVCrushPlants(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	stomped := []ecs.EntityID{
+		createTestGridPlant(em, components.PlantPeashooter, config.GridColumns-1, 1),
+		createTestGridPlant(em, components.PlantPeashooter, config.GridColumns-config.ZombossStompColumns, 2),
+	}
+	spared := []ecs.EntityID{
+		createTestGridPlant(em, components.PlantPeashooter, config.GridColumns-config.ZombossStompColumns-1, 1),
+		createTestGridPlant(em, components.PlantPeashooter, config.GridColumns-1, 3),
+	}
+
+	bs.zombossStomp(&components.ZombossComponent{TargetRow: 1})
+	em.RemoveMarkedEntities()
+	for _, id := range stomped {
+		if _, ok := ecs.GetComponent[*components.PlantComponent](em, id); ok {
+			t.Errorf("踩踏范围内的植物 %d 应被摧毁", id)
+		}
+	}
+	for _, id := range spared {
+		if _, ok := ecs.GetComponent[*components.PlantComponent](em, id); !ok {
+			t.Errorf("踩踏范围外的植物 %d 不应被摧毁", id)
+		}
+	}
+
+	crushed := []ecs.EntityID{
+		createTestGridPlant(em, components.PlantPeashooter, 4, 0),
+		createTestGridPlant(em, components.PlantPeashooter, 6, 1),
+	}
+	outside := createTestGridPlant(em, components.PlantPeashooter, 7, 0)
+
+	bs.zombossCrushRV(&components.ZombossComponent{TargetCol: 4, TargetRow: 0})
+	em.RemoveMarkedEntities()
+	for _, id := range crushed {
+		if _, ok := ecs.GetComponent[*components.PlantComponent](em, id); ok {
+			t.Errorf("房车落点 3x2 范围内的植物 %d 应被压扁", id)
+		}
+	}
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, outside); !ok {
+		t.Error("房车落点范围外的植物不应被压扁")
+	}
+}
+
+// TestZombossBallRollsAndCrushesPlants 测试火球沿所在行向左滚动碾碎植物，滚出草坪后消失
+func TestZombossBallRollsAndCrushesPlants(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	bs := createTestBehaviorSystem(em, rm, game.GetGameState())
+
+	inLane := createTestGridPlant(em, components.PlantWallnut, 6, 2)
+	otherLane := createTestGridPlant(em, components.PlantWallnut, 6, 3)
+
+	ballID := em.CreateEntity()
+	ecs.AddComponent(em, ballID, &components.PositionComponent{X: cellCenterX(8), Y: plantYForRow(2)})
+	ecs.AddComponent(em, ballID, &components.VelocityComponent{VX: config.ZombossBallSpeed})
+	ecs.AddComponent(em, ballID, &components.ZombossBallComponent{Element: components.ZombossElementFire, Row: 2})
+
+	seconds := 2 * config.CellWidth / -config.ZombossBallSpeed
+	for elapsed := 0.0; elapsed < seconds; elapsed += 0.1 {
+		bs.updateZombossBalls(0.1)
+	}
+	em.RemoveMarkedEntities()
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, inLane); ok {
+		t.Error("火球经过的植物应被碾碎")
+	}
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, otherLane); !ok {
+		t.Error("其他行的植物不应被碾碎")
+	}
+
+	seconds = float64(config.GridColumns+1) * config.CellWidth / -config.ZombossBallSpeed
+	for elapsed := 0.0; elapsed < seconds; elapsed += 0.1 {
+		bs.updateZombossBalls(0.1)
+	}
+	em.RemoveMarkedEntities()
+	if ecs.HasComponent[*components.ZombossBallComponent](em, ballID) {
+		t.Error("滚出草坪左侧的火球应消失")
+	}
+}
+
+// TestZombossDefeated 测试僵王博士生命值耗尽后播放死亡动画，结束时场上僵尸全部倒下并记录胜利
+func TestZombossDefeated(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	gs := game.GetGameState()
+	gs.BossDefeated = false
+	defer func() { gs.BossDefeated = false }()
+	bs := createTestBehaviorSystem(em, rm, gs)
+
+	id, zomboss := createTestZomboss(em, components.ZombossStateHeadIdle)
+	zomboss.Timer = config.ZombossHeadExposedDuration
+	zombieID := createTestWalkingZombie(em, cellCenterX(7), zombieYForRow(2))
+
+	health, _ := ecs.GetComponent[*components.HealthComponent](em, id)
+	health.CurrentHealth = 0
+	updateZombossFrame(em, bs, id, 0.1)
+	if zomboss.State != components.ZombossStateDying || zomboss.IsHeadExposed() {
+		t.Fatalf("生命值耗尽后应进入死亡状态，实际状态 %d", zomboss.State)
+	}
+	if gs.BossDefeated {
+		t.Fatal("死亡动画结束前不应记录胜利")
+	}
+
+	updateZombossFrame(em, bs, id, 0.1)
+	em.RemoveMarkedEntities()
+	if !gs.BossDefeated {
+		t.Error("死亡动画结束后应记录击败僵王博士")
+	}
+	if ecs.HasComponent[*components.ZombossComponent](em, id) {
+		t.Error("死亡动画结束后僵王博士应被删除")
+	}
+	zombieHealth, _ := ecs.GetComponent[*components.HealthComponent](em, zombieID)
+	if zombieHealth.CurrentHealth > 0 {
+		t.Errorf("僵王博士被击败后场上的僵尸应倒下，剩余生命值 %d", zombieHealth.CurrentHealth)
+	}
+}
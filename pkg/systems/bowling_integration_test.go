@@ -7,6 +7,7 @@ import (
 	"github.com/gonewx/pvz/pkg/config"
 	"github.com/gonewx/pvz/pkg/ecs"
 	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
 )
 
 // =============================================================================
@@ -152,7 +153,7 @@ func TestBowlingIntegration_PhaseTransition(t *testing.T) {
 		phaseSystem.SetOnDisableGuidedTutorial(func() {
 			disableGuidedCalled = true
 		})
-		phaseSystem.SetOnActivateBowling(func() {
+		phaseSystem.SetOnTransitionComplete(func() {
 			activateBowlingCalled = true
 		})
 
@@ -190,7 +191,7 @@ func TestBowlingIntegration_PhaseTransition(t *testing.T) {
 		}
 
 		if !activateBowlingCalled {
-			t.Error("OnActivateBowling callback should have been called")
+			t.Error("OnTransitionComplete callback should have been called")
 		}
 	})
 
@@ -237,7 +238,7 @@ func TestBowlingIntegration_ConveyorAndNutSystem(t *testing.T) {
 	t.Run("conveyor_card_generation_weights", func(t *testing.T) {
 		em := ecs.NewEntityManager()
 		gs := game.GetGameState()
-		conveyorSystem := NewConveyorBeltSystem(em, gs, nil)
+		conveyorSystem := NewConveyorBeltSystem(em, gs, nil, config.GetLawnLayout("day"))
 
 		conveyorSystem.Activate()
 
@@ -271,7 +272,7 @@ func TestBowlingIntegration_ConveyorAndNutSystem(t *testing.T) {
 	t.Run("final_wave_explosion_nut_injection", func(t *testing.T) {
 		em := ecs.NewEntityManager()
 		gs := game.GetGameState()
-		conveyorSystem := NewConveyorBeltSystem(em, gs, nil)
+		conveyorSystem := NewConveyorBeltSystem(em, gs, nil, config.GetLawnLayout("day"))
 
 		conveyorSystem.Activate()
 
@@ -321,7 +322,7 @@ func TestBowlingIntegration_ConveyorAndNutSystem(t *testing.T) {
 	t.Run("placement_validation_red_line", func(t *testing.T) {
 		em := ecs.NewEntityManager()
 		gs := game.GetGameState()
-		conveyorSystem := NewConveyorBeltSystem(em, gs, nil)
+		conveyorSystem := NewConveyorBeltSystem(em, gs, nil, config.GetLawnLayout("day"))
 
 		// 红线左侧应该允许放置
 		validX := config.GridWorldStartX + float64(config.BowlingRedLineColumn-1)*config.CellWidth
@@ -406,9 +407,10 @@ func TestBowlingIntegration_CollisionAndBounce(t *testing.T) {
 			MaxHealth:     270,
 			CurrentHealth: 270,
 		})
-		em.AddComponent(zombieEntity, &components.ArmorComponent{
-			MaxArmor:     370,
-			CurrentArmor: 370,
+		em.AddComponent(zombieEntity, &components.AccessoryComponent{
+			Pieces: []*components.AccessoryPiece{
+				{Tag: types.AccessoryCone, Tier: 1, Durability: 370, MaxDurability: 370},
+			},
 		})
 		em.AddComponent(zombieEntity, &components.CollisionComponent{
 			Width:   50,
@@ -421,18 +423,18 @@ func TestBowlingIntegration_CollisionAndBounce(t *testing.T) {
 		nutSystem.Update(0.016)
 
 		// 验证护甲被移除但身体血量不变
-		armor, armorOk := ecs.GetComponent[*components.ArmorComponent](em, zombieEntity)
+		accessory, accessoryOk := ecs.GetComponent[*components.AccessoryComponent](em, zombieEntity)
 		health, healthOk := ecs.GetComponent[*components.HealthComponent](em, zombieEntity)
 
-		if !armorOk {
-			t.Fatal("ArmorComponent not found")
+		if !accessoryOk {
+			t.Fatal("AccessoryComponent not found")
 		}
 		if !healthOk {
 			t.Fatal("HealthComponent not found")
 		}
 
-		if armor.CurrentArmor != 0 {
-			t.Errorf("Armor = %d, expected 0 (armor removed)", armor.CurrentArmor)
+		if helmet := accessory.Helmet(); helmet == nil || helmet.Durability != 0 {
+			t.Errorf("Helmet = %+v, expected durability 0 (armor removed)", helmet)
 		}
 		if health.CurrentHealth != 270 {
 			t.Errorf("Health = %d, expected 270 (no body damage)", health.CurrentHealth)
@@ -589,7 +591,7 @@ func TestBowlingIntegration_EdgeCases(t *testing.T) {
 	t.Run("conveyor_belt_full_capacity", func(t *testing.T) {
 		em := ecs.NewEntityManager()
 		gs := game.GetGameState()
-		conveyorSystem := NewConveyorBeltSystem(em, gs, nil)
+		conveyorSystem := NewConveyorBeltSystem(em, gs, nil, config.GetLawnLayout("day"))
 
 		conveyorSystem.Activate()
 
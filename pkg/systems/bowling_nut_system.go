@@ -250,13 +250,13 @@ func (s *BowlingNutSystem) isZombieType(behaviorType components.BehaviorType) bo
 // - 使用 DeathEffectInstant 死亡效果（无肢体掉落）
 func (s *BowlingNutSystem) applyDamageToZombie(zombieID ecs.EntityID) {
 	// 检查是否有护甲
-	armor, hasArmor := ecs.GetComponent[*components.ArmorComponent](s.entityManager, zombieID)
+	helmet := ZombieHelmet(s.entityManager, zombieID)
 	health, hasHealth := ecs.GetComponent[*components.HealthComponent](s.entityManager, zombieID)
 
-	if hasArmor && armor.CurrentArmor > 0 {
+	if helmet != nil {
 		// 有护甲且护甲未破坏：移除护甲，不造成身体伤害
-		log.Printf("[BowlingNutSystem] 僵尸护甲破坏: zombieID=%d, 原护甲=%d", zombieID, armor.CurrentArmor)
-		armor.CurrentArmor = 0
+		log.Printf("[BowlingNutSystem] 僵尸护甲破坏: zombieID=%d, 原护甲=%d", zombieID, helmet.Durability)
+		helmet.Durability = 0
 	} else if hasHealth {
 		// 没有护甲或护甲已破坏：秒杀僵尸
 		log.Printf("[BowlingNutSystem] 僵尸被秒杀: zombieID=%d, 原血量=%d", zombieID, health.CurrentHealth)
@@ -385,13 +385,13 @@ func (s *BowlingNutSystem) applyExplosionDamageToZombie(zombieID ecs.EntityID) {
 	damage := config.ExplosiveNutDamage
 
 	// 检查是否有护甲
-	armor, hasArmor := ecs.GetComponent[*components.ArmorComponent](s.entityManager, zombieID)
+	helmet := ZombieHelmet(s.entityManager, zombieID)
 	health, hasHealth := ecs.GetComponent[*components.HealthComponent](s.entityManager, zombieID)
 
-	if hasArmor && armor.CurrentArmor > 0 {
+	if helmet != nil {
 		// 有护甲且护甲未破坏
-		overflowDamage := damage - armor.CurrentArmor
-		armor.CurrentArmor = 0 // 护甲完全破坏
+		overflowDamage := damage - helmet.Durability
+		helmet.Durability = 0 // 护甲完全破坏
 
 		// 溢出伤害扣除身体生命值
 		if overflowDamage > 0 && hasHealth {
@@ -6,6 +6,7 @@ import (
 	"github.com/gonewx/pvz/pkg/components"
 	"github.com/gonewx/pvz/pkg/config"
 	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/types"
 )
 
 // TestNewBowlingNutSystem 测试系统创建
@@ -316,16 +317,18 @@ func TestBowlingNutSystem_ArmorDamage(t *testing.T) {
 	em.AddComponent(zombieID, &components.PositionComponent{X: 510.0, Y: nutY})
 	em.AddComponent(zombieID, &components.BehaviorComponent{Type: components.BehaviorZombieConehead})
 	em.AddComponent(zombieID, &components.HealthComponent{CurrentHealth: 270, MaxHealth: 270})
-	em.AddComponent(zombieID, &components.ArmorComponent{CurrentArmor: 370, MaxArmor: 370})
+	em.AddComponent(zombieID, &components.AccessoryComponent{Pieces: []*components.AccessoryPiece{
+		{Tag: types.AccessoryCone, Tier: 1, Durability: 370, MaxDurability: 370},
+	}})
 	em.AddComponent(zombieID, &components.CollisionComponent{Width: 40, Height: 115})
 
 	// 更新系统（第一次碰撞）
 	system.Update(0.016)
 
 	// 验证护甲被破坏
-	armor, _ := ecs.GetComponent[*components.ArmorComponent](em, zombieID)
-	if armor.CurrentArmor != 0 {
-		t.Errorf("Armor should be destroyed, got %d", armor.CurrentArmor)
+	accessory, _ := ecs.GetComponent[*components.AccessoryComponent](em, zombieID)
+	if helmet := accessory.Helmet(); helmet.Durability != 0 {
+		t.Errorf("Armor should be destroyed, got %d", helmet.Durability)
 	}
 
 	// 验证第一次碰撞后身体血量不变
@@ -357,16 +360,18 @@ func TestBowlingNutSystem_BucketheadZombieArmorDamage(t *testing.T) {
 	em.AddComponent(zombieID, &components.PositionComponent{X: 510.0, Y: nutY})
 	em.AddComponent(zombieID, &components.BehaviorComponent{Type: components.BehaviorZombieBuckethead})
 	em.AddComponent(zombieID, &components.HealthComponent{CurrentHealth: 270, MaxHealth: 270})
-	em.AddComponent(zombieID, &components.ArmorComponent{CurrentArmor: 1100, MaxArmor: 1100})
+	em.AddComponent(zombieID, &components.AccessoryComponent{Pieces: []*components.AccessoryPiece{
+		{Tag: types.AccessoryBucket, Tier: 1, Durability: 1100, MaxDurability: 1100},
+	}})
 	em.AddComponent(zombieID, &components.CollisionComponent{Width: 40, Height: 115})
 
 	// 更新系统（第一次碰撞）
 	system.Update(0.016)
 
 	// 验证护甲被破坏
-	armor, _ := ecs.GetComponent[*components.ArmorComponent](em, zombieID)
-	if armor.CurrentArmor != 0 {
-		t.Errorf("Armor should be destroyed, got %d", armor.CurrentArmor)
+	accessory, _ := ecs.GetComponent[*components.AccessoryComponent](em, zombieID)
+	if helmet := accessory.Helmet(); helmet.Durability != 0 {
+		t.Errorf("Armor should be destroyed, got %d", helmet.Durability)
 	}
 
 	// 验证第一次碰撞后身体血量不变
@@ -823,16 +828,18 @@ func TestBowlingNutSystem_ExplosiveNut_Damage1800(t *testing.T) {
 	em.AddComponent(zombieID, &components.PositionComponent{X: 510.0, Y: nutY})
 	em.AddComponent(zombieID, &components.BehaviorComponent{Type: components.BehaviorZombieBuckethead})
 	em.AddComponent(zombieID, &components.HealthComponent{CurrentHealth: 270, MaxHealth: 270})
-	em.AddComponent(zombieID, &components.ArmorComponent{CurrentArmor: 1100, MaxArmor: 1100})
+	em.AddComponent(zombieID, &components.AccessoryComponent{Pieces: []*components.AccessoryPiece{
+		{Tag: types.AccessoryBucket, Tier: 1, Durability: 1100, MaxDurability: 1100},
+	}})
 	em.AddComponent(zombieID, &components.CollisionComponent{Width: 40, Height: 115})
 
 	// 更新系统
 	system.Update(0.016)
 
 	// 验证护甲被破坏
-	armor, _ := ecs.GetComponent[*components.ArmorComponent](em, zombieID)
-	if armor.CurrentArmor > 0 {
-		t.Errorf("Armor should be destroyed, got %d", armor.CurrentArmor)
+	accessory, _ := ecs.GetComponent[*components.AccessoryComponent](em, zombieID)
+	if helmet := accessory.Helmet(); helmet.Durability > 0 {
+		t.Errorf("Armor should be destroyed, got %d", helmet.Durability)
 	}
 
 	// 验证铁桶僵尸被秒杀
@@ -911,16 +918,18 @@ func TestBowlingNutSystem_ExplosiveNut_ArmorPriority(t *testing.T) {
 	em.AddComponent(zombieID, &components.PositionComponent{X: 510.0, Y: nutY})
 	em.AddComponent(zombieID, &components.BehaviorComponent{Type: components.BehaviorZombieConehead})
 	em.AddComponent(zombieID, &components.HealthComponent{CurrentHealth: 270, MaxHealth: 270})
-	em.AddComponent(zombieID, &components.ArmorComponent{CurrentArmor: 370, MaxArmor: 370})
+	em.AddComponent(zombieID, &components.AccessoryComponent{Pieces: []*components.AccessoryPiece{
+		{Tag: types.AccessoryCone, Tier: 1, Durability: 370, MaxDurability: 370},
+	}})
 	em.AddComponent(zombieID, &components.CollisionComponent{Width: 40, Height: 115})
 
 	// 更新系统
 	system.Update(0.016)
 
 	// 验证护甲被完全破坏
-	armor, _ := ecs.GetComponent[*components.ArmorComponent](em, zombieID)
-	if armor.CurrentArmor != 0 {
-		t.Errorf("Armor should be 0, got %d", armor.CurrentArmor)
+	accessory, _ := ecs.GetComponent[*components.AccessoryComponent](em, zombieID)
+	if helmet := accessory.Helmet(); helmet.Durability != 0 {
+		t.Errorf("Armor should be 0, got %d", helmet.Durability)
 	}
 
 	// 验证溢出伤害应用到身体
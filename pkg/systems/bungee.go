@@ -0,0 +1,14 @@
+package systems
+
+import (
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/ecs"
+)
+
+// IsBungeeZombie 判断僵尸是否为蹦极僵尸
+//
+// 蹦极僵尸从高空落下偷取植物，不属于任何行：不会触发除草车和进家判定，
+// 但被消灭或离开草坪时仍计入波次的消灭数量
+func IsBungeeZombie(em *ecs.EntityManager, zombieID ecs.EntityID) bool {
+	return ecs.HasComponent[*components.BungeeComponent](em, zombieID)
+}
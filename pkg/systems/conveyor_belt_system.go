@@ -49,14 +49,18 @@ type ConveyorBeltSystem struct {
 	// Story 19.12: 动态调节配置
 	phaseConfigs      []config.PhaseConfig            // 各阶段配置
 	dynamicAdjustment *config.DynamicAdjustmentConfig // 动态调节参数
+
+	// layout 当前场景的草坪行布局（用于统计各行的危机僵尸）
+	layout config.LawnLayout
 }
 
 // NewConveyorBeltSystem 创建传送带系统
-func NewConveyorBeltSystem(em *ecs.EntityManager, gs *game.GameState, rm *game.ResourceManager) *ConveyorBeltSystem {
+func NewConveyorBeltSystem(em *ecs.EntityManager, gs *game.GameState, rm *game.ResourceManager, layout config.LawnLayout) *ConveyorBeltSystem {
 	system := &ConveyorBeltSystem{
 		entityManager:   em,
 		gameState:       gs,
 		resourceManager: rm,
+		layout:          layout,
 		beltSpeed:       config.ConveyorBeltSpeed,
 		cardWidth:       100.0 * config.ConveyorCardScale, // 默认卡片宽度
 		beltWidth:       config.ConveyorBeltWidth,
@@ -791,7 +795,7 @@ func (s *ConveyorBeltSystem) checkCrisisExplodeNut(beltComp *components.Conveyor
 
 		// 使用配置常量定义危机距离阈值
 		if posComp.X <= safeLineX+s.dynamicAdjustment.CrisisDistanceThreshold {
-			row := int((posComp.Y - config.GridWorldStartY) / config.CellHeight)
+			row := s.layout.RowAt(posComp.X, posComp.Y)
 			laneCounts[row]++
 		}
 	}
@@ -12,7 +12,7 @@ import (
 // createTestConveyorBeltSystem 创建测试用传送带系统
 func createTestConveyorBeltSystem() (*ConveyorBeltSystem, *ecs.EntityManager) {
 	em := ecs.NewEntityManager()
-	system := NewConveyorBeltSystem(em, nil, nil)
+	system := NewConveyorBeltSystem(em, nil, nil, config.GetLawnLayout("day"))
 	return system, em
 }
 
@@ -20,7 +20,7 @@ func createTestConveyorBeltSystem() (*ConveyorBeltSystem, *ecs.EntityManager) {
 func createTestConveyorBeltSystemWithGameState() (*ConveyorBeltSystem, *ecs.EntityManager, *game.GameState) {
 	em := ecs.NewEntityManager()
 	gs := game.GetGameState()
-	system := NewConveyorBeltSystem(em, gs, nil)
+	system := NewConveyorBeltSystem(em, gs, nil, config.GetLawnLayout("day"))
 	return system, em, gs
 }
 
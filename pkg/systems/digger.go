@@ -0,0 +1,14 @@
+package systems
+
+import (
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/ecs"
+)
+
+// IsUndergroundZombie 判断僵尸是否在地下（如挖掘中的矿工僵尸）
+//
+// 地下的僵尸无法被子弹和植物攻击，也不会触发除草车和进家判定
+func IsUndergroundZombie(em *ecs.EntityManager, zombieID ecs.EntityID) bool {
+	digger, ok := ecs.GetComponent[*components.DiggerComponent](em, zombieID)
+	return ok && digger.IsUnderground()
+}
@@ -0,0 +1,25 @@
+package systems
+
+import (
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/ecs"
+)
+
+// IsCharmedZombie 判断实体是否为被魅惑（倒戈到植物阵营）的僵尸
+//
+// 被魅惑的僵尸不再是敌人：
+//   - 不参与胜负判定、波次血量计算
+//   - 不触发除草车和进家失败
+//   - 不会被植物子弹击中
+func IsCharmedZombie(em *ecs.EntityManager, entityID ecs.EntityID) bool {
+	faction, ok := ecs.GetComponent[*components.FactionComponent](em, entityID)
+	return ok && faction.IsCharmed()
+}
+
+// ZombieFacingX 返回僵尸面朝的水平方向：普通僵尸面朝左侧（-1），被魅惑的僵尸转身面朝右侧（1）
+func ZombieFacingX(em *ecs.EntityManager, entityID ecs.EntityID) float64 {
+	if IsCharmedZombie(em, entityID) {
+		return 1
+	}
+	return -1
+}
@@ -0,0 +1,169 @@
+package systems
+
+import (
+	"log"
+	"math"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// FogSystem 雾系统（雾夜关卡）
+//
+// 职责：
+//   - 关卡开始和被三叶草吹散后，雾从草坪右侧逐渐推进到 FogComponent.Columns 列
+//   - 植物灯笼周围 PlanternLightRadius 格以内的格子没有雾
+//   - 每个格子的雾浓度逐渐趋近目标浓度（FogFadeSpeed）
+//   - 雷雨关卡周期性触发闪电，短暂照亮整个草坪
+//
+// 雾的渲染由 RenderSystem 完成，其他系统通过 IsHiddenByFog 判断某个位置是否被雾遮挡
+type FogSystem struct {
+	entityManager *ecs.EntityManager
+	layout        config.LawnLayout // 当前场景的草坪行布局
+}
+
+// NewFogSystem 创建雾系统
+func NewFogSystem(em *ecs.EntityManager, layout config.LawnLayout) *FogSystem {
+	return &FogSystem{
+		entityManager: em,
+		layout:        layout,
+	}
+}
+
+// Update 更新雾的推进、浓度和闪电
+func (s *FogSystem) Update(deltaTime float64) {
+	fog, ok := getFog(s.entityManager)
+	if !ok {
+		return
+	}
+
+	if fog.BlowTimer > 0 {
+		fog.BlowTimer -= deltaTime
+	} else if fog.RollIn < 1 {
+		fog.RollIn = math.Min(1, fog.RollIn+deltaTime/config.FogRollInDuration)
+	}
+
+	s.updateDensity(fog, deltaTime)
+
+	if fog.Storm {
+		s.updateLightning(fog, deltaTime)
+	}
+}
+
+// updateDensity 计算每个格子的目标雾浓度，当前浓度按 FogFadeSpeed 逐渐趋近目标
+func (s *FogSystem) updateDensity(fog *components.FogComponent, deltaTime float64) {
+	planterns := s.planternCells()
+	step := config.FogFadeSpeed * deltaTime
+
+	for row := 0; row < s.layout.Rows; row++ {
+		for col := 0; col < config.GridColumns; col++ {
+			target := fogCoverage(fog, col)
+			if target > 0 && isLitByPlantern(planterns, col, row) {
+				target = 0
+			}
+
+			density := fog.Density[row][col]
+			if density < target {
+				density = math.Min(target, density+step)
+			} else {
+				density = math.Max(target, density-step)
+			}
+			fog.Density[row][col] = density
+		}
+	}
+}
+
+// fogCoverage 按推进进度计算某一列的雾覆盖程度（0~1）
+// 雾从最右侧一列开始推进，推进到一半的列覆盖程度在 0~1 之间
+func fogCoverage(fog *components.FogComponent, col int) float64 {
+	depth := float64(config.GridColumns - col) // 最右侧一列为 1
+	return math.Max(0, math.Min(1, fog.RollIn*float64(fog.Columns)-depth+1))
+}
+
+// planternCells 返回所有植物灯笼所在的格子
+func (s *FogSystem) planternCells() [][2]int {
+	var cells [][2]int
+	for _, entityID := range ecs.GetEntitiesWith1[*components.PlantComponent](s.entityManager) {
+		plant, _ := ecs.GetComponent[*components.PlantComponent](s.entityManager, entityID)
+		if plant.PlantType == components.PlantPlantern {
+			cells = append(cells, [2]int{plant.GridCol, plant.GridRow})
+		}
+	}
+	return cells
+}
+
+// isLitByPlantern 格子是否在某个植物灯笼的照明范围内
+func isLitByPlantern(planterns [][2]int, col, row int) bool {
+	for _, cell := range planterns {
+		dc := float64(col - cell[0])
+		dr := float64(row - cell[1])
+		if dc*dc+dr*dr <= config.PlanternLightRadius*config.PlanternLightRadius {
+			return true
+		}
+	}
+	return false
+}
+
+// updateLightning 雷雨关卡的闪电：每隔 FogLightningInterval 秒照亮草坪 FogLightningFlashDuration 秒
+func (s *FogSystem) updateLightning(fog *components.FogComponent, deltaTime float64) {
+	if fog.FlashTimer > 0 {
+		fog.FlashTimer = math.Max(0, fog.FlashTimer-deltaTime)
+	}
+
+	fog.LightningTimer -= deltaTime
+	if fog.LightningTimer > 0 {
+		return
+	}
+
+	fog.LightningTimer = config.FogLightningInterval
+	fog.FlashTimer = config.FogLightningFlashDuration
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_THUNDER")
+	}
+	log.Printf("[FogSystem] 闪电照亮草坪")
+}
+
+// BlowAwayFog 吹散草坪上所有的雾（三叶草）
+// 雾在 FogBlowAwayDuration 秒后重新从右侧推进
+func BlowAwayFog(em *ecs.EntityManager) {
+	fog, ok := getFog(em)
+	if !ok {
+		return
+	}
+	fog.RollIn = 0
+	fog.BlowTimer = config.FogBlowAwayDuration
+	log.Printf("[FogSystem] 雾被吹散，%.0f 秒后重新推进", config.FogBlowAwayDuration)
+}
+
+// IsHiddenByFog 世界坐标位置是否被雾遮挡（闪电照亮期间不遮挡）
+// 草坪右侧街道上的位置使用最右侧一列的雾浓度
+func IsHiddenByFog(em *ecs.EntityManager, layout config.LawnLayout, worldX, worldY float64) bool {
+	fog, ok := getFog(em)
+	if !ok || fog.IsFlashing() {
+		return false
+	}
+
+	row := layout.RowAt(worldX, worldY)
+	if row < 0 || row >= layout.Rows {
+		return false
+	}
+	col := int(math.Floor((worldX - config.GridWorldStartX) / config.CellWidth))
+	if col < 0 {
+		return false
+	}
+	if col >= config.GridColumns {
+		col = config.GridColumns - 1
+	}
+	return fog.Density[row][col] >= config.FogHiddenDensity
+}
+
+// getFog 获取雾组件（没有雾的关卡返回 false）
+func getFog(em *ecs.EntityManager) (*components.FogComponent, bool) {
+	fogEntities := ecs.GetEntitiesWith1[*components.FogComponent](em)
+	if len(fogEntities) == 0 {
+		return nil, false
+	}
+	return ecs.GetComponent[*components.FogComponent](em, fogEntities[0])
+}
@@ -0,0 +1,149 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+)
+
+// createTestFog 创建测试用的雾实体（不加载图片）
+func createTestFog(em *ecs.EntityManager, columns int) *components.FogComponent {
+	fog := &components.FogComponent{
+		Columns:        columns,
+		LightningTimer: config.FogLightningInterval,
+	}
+	ecs.AddComponent(em, em.CreateEntity(), fog)
+	return fog
+}
+
+// fogLayout 雾夜场景的草坪行布局
+var fogLayout = config.GetLawnLayout("fog")
+
+// cellCenter 返回格子中心的世界坐标（不含屋顶斜坡偏移）
+func cellCenter(layout config.LawnLayout, col, row int) (float64, float64) {
+	return config.GridWorldStartX + (float64(col)+0.5)*config.CellWidth,
+		layout.StartY + (float64(row)+0.5)*layout.CellHeight
+}
+
+// updateFog 以 0.1 秒为步长更新雾系统 seconds 秒
+func updateFog(system *FogSystem, seconds float64) {
+	for t := 0.0; t < seconds; t += 0.1 {
+		system.Update(0.1)
+	}
+}
+
+// TestFogRollsInFromRight 测试雾从右侧推进，最终只覆盖最右侧 Columns 列
+func TestFogRollsInFromRight(t *testing.T) {
+	em := ecs.NewEntityManager()
+	fog := createTestFog(em, 4)
+	system := NewFogSystem(em, fogLayout)
+
+	x, y := cellCenter(fogLayout, config.GridColumns-1, 2)
+	if IsHiddenByFog(em, fogLayout, x, y) {
+		t.Error("关卡开始时雾还没有推进")
+	}
+
+	updateFog(system, config.FogRollInDuration+1/config.FogFadeSpeed+0.5)
+
+	if fog.RollIn != 1 {
+		t.Errorf("推进完成后 RollIn 应为 1，实际 %.2f", fog.RollIn)
+	}
+	for col := 0; col < config.GridColumns; col++ {
+		x, y := cellCenter(fogLayout, col, 2)
+		want := col >= config.GridColumns-4
+		if IsHiddenByFog(em, fogLayout, x, y) != want {
+			t.Errorf("第 %d 列被雾遮挡 = %v，期望 %v", col, !want, want)
+		}
+	}
+
+	// 草坪右侧街道上的僵尸同样被雾遮挡
+	if !IsHiddenByFog(em, fogLayout, config.GridWorldEndX+100, y) {
+		t.Error("街道上的位置应被雾遮挡")
+	}
+}
+
+// TestPlanternClearsFog 测试植物灯笼照亮周围的格子
+func TestPlanternClearsFog(t *testing.T) {
+	em := ecs.NewEntityManager()
+	createTestFog(em, config.GridColumns)
+	system := NewFogSystem(em, fogLayout)
+
+	planternID := em.CreateEntity()
+	ecs.AddComponent(em, planternID, &components.PlantComponent{
+		PlantType: components.PlantPlantern,
+		GridCol:   4,
+		GridRow:   2,
+	})
+
+	updateFog(system, config.FogRollInDuration+1/config.FogFadeSpeed+0.5)
+
+	for _, cell := range [][2]int{{4, 2}, {6, 2}, {4, 0}, {5, 3}} {
+		if x, y := cellCenter(fogLayout, cell[0], cell[1]); IsHiddenByFog(em, fogLayout, x, y) {
+			t.Errorf("格子 %v 在植物灯笼的照明范围内，不应被雾遮挡", cell)
+		}
+	}
+	for _, cell := range [][2]int{{7, 2}, {4, 5}, {1, 0}} {
+		if x, y := cellCenter(fogLayout, cell[0], cell[1]); !IsHiddenByFog(em, fogLayout, x, y) {
+			t.Errorf("格子 %v 在植物灯笼的照明范围外，应被雾遮挡", cell)
+		}
+	}
+}
+
+// TestBlowAwayFog 测试三叶草吹散雾后，雾在 FogBlowAwayDuration 秒后重新推进
+func TestBlowAwayFog(t *testing.T) {
+	em := ecs.NewEntityManager()
+	fog := createTestFog(em, 4)
+	fog.RollIn = 1
+	system := NewFogSystem(em, fogLayout)
+	updateFog(system, 1/config.FogFadeSpeed+0.5)
+
+	x, y := cellCenter(fogLayout, config.GridColumns-1, 1)
+	if !IsHiddenByFog(em, fogLayout, x, y) {
+		t.Fatal("雾推进完成后最右侧一列应被遮挡")
+	}
+
+	BlowAwayFog(em)
+	updateFog(system, 1/config.FogFadeSpeed+0.5)
+	if IsHiddenByFog(em, fogLayout, x, y) {
+		t.Error("雾被吹散后不应遮挡")
+	}
+	if fog.RollIn != 0 {
+		t.Errorf("雾被吹散期间不应推进，实际 RollIn %.2f", fog.RollIn)
+	}
+
+	updateFog(system, config.FogBlowAwayDuration+config.FogRollInDuration)
+	if !IsHiddenByFog(em, fogLayout, x, y) {
+		t.Error("雾应重新推进")
+	}
+}
+
+// TestLightningRevealsFog 测试雷雨关卡的闪电短暂照亮整个草坪
+func TestLightningRevealsFog(t *testing.T) {
+	em := ecs.NewEntityManager()
+	fog := createTestFog(em, 4)
+	fog.RollIn = 1
+	fog.Storm = true
+	system := NewFogSystem(em, fogLayout)
+	updateFog(system, 1/config.FogFadeSpeed+0.5)
+
+	x, y := cellCenter(fogLayout, config.GridColumns-1, 3)
+	if !IsHiddenByFog(em, fogLayout, x, y) {
+		t.Fatal("闪电之前最右侧一列应被遮挡")
+	}
+
+	fog.LightningTimer = 0.05
+	system.Update(0.1)
+	if !fog.IsFlashing() {
+		t.Fatal("闪电计时结束后应照亮草坪")
+	}
+	if IsHiddenByFog(em, fogLayout, x, y) {
+		t.Error("闪电照亮期间不应被雾遮挡")
+	}
+
+	updateFog(system, config.FogLightningFlashDuration+0.1)
+	if !IsHiddenByFog(em, fogLayout, x, y) {
+		t.Error("闪电结束后应重新被雾遮挡")
+	}
+}
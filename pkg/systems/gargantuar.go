@@ -0,0 +1,14 @@
+package systems
+
+import (
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/ecs"
+)
+
+// CanBeSwallowed 判断僵尸能否被大嘴花吞下
+//
+// 巨人僵尸（白眼、红眼）体型过大，大嘴花无法吞下
+func CanBeSwallowed(em *ecs.EntityManager, zombieID ecs.EntityID) bool {
+	_, isGargantuar := ecs.GetComponent[*components.GargantuarComponent](em, zombieID)
+	return !isGargantuar
+}
@@ -1,6 +1,7 @@
 package systems
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/gonewx/pvz/pkg/components"
@@ -32,6 +33,21 @@ type InputSystem struct {
 	isDragPlanting      bool                 // 是否处于拖拽种植模式
 	dragPlantType       components.PlantType // 拖拽中的植物类型
 	dragStartCardEntity ecs.EntityID         // 拖拽开始时的卡片实体ID
+
+	// 玉米加农炮瞄准模式状态（先点击加农炮，再点击草坪选择落点）
+	aimingCobCannon ecs.EntityID // 正在瞄准的玉米加农炮实体ID（0 表示未在瞄准）
+	cobTargetEntity ecs.EntityID // 瞄准准星实体ID
+
+	// 小游戏规则禁用的输入
+	plantCardsDisabled    bool // 禁用植物卡片（点击、快捷键和拖拽种植）
+	sunCollectionDisabled bool // 禁用点击收集阳光
+
+	// lawnClickHandler 小游戏规则的草坪点击处理（如锤僵尸），为 nil 时不处理
+	lawnClickHandler func(worldX, worldY float64)
+
+	// 小游戏规则的种植方式（如砸罐子：植物来自拾取的种子包）
+	freePlanting   bool                                               // 种植不消耗阳光
+	plantedHandler func(plantType components.PlantType, col, row int) // 种植成功后的回调，为 nil 时不处理
 }
 
 // NewInputSystem 创建一个新的输入系统
@@ -54,6 +70,49 @@ func NewInputSystem(em *ecs.EntityManager, rm *game.ResourceManager, gs *game.Ga
 	return system
 }
 
+// SetPlantCardsEnabled 启用/禁用植物卡片输入（小游戏规则使用）
+func (s *InputSystem) SetPlantCardsEnabled(enabled bool) {
+	s.plantCardsDisabled = !enabled
+}
+
+// SetSunCollectionEnabled 启用/禁用点击收集阳光（小游戏规则使用）
+func (s *InputSystem) SetSunCollectionEnabled(enabled bool) {
+	s.sunCollectionDisabled = !enabled
+}
+
+// SetLawnClickHandler 设置非种植模式下点击草坪的处理函数（小游戏规则使用）
+//
+// 处理函数在阳光、植物卡片和玉米加农炮之后调用，worldX, worldY 为点击的世界坐标；
+// 需要点中僵尸的规则用 FindZombieAt 查找。传入 nil 取消处理
+func (s *InputSystem) SetLawnClickHandler(handler func(worldX, worldY float64)) {
+	s.lawnClickHandler = handler
+}
+
+// SetFreePlanting 启用/禁用免费种植（小游戏规则使用，如砸罐子的植物来自种子包）
+func (s *InputSystem) SetFreePlanting(enabled bool) {
+	s.freePlanting = enabled
+}
+
+// SetPlantedHandler 设置在草坪上种植成功后的回调（小游戏规则使用）
+// 传入 nil 取消回调
+func (s *InputSystem) SetPlantedHandler(handler func(plantType components.PlantType, col, row int)) {
+	s.plantedHandler = handler
+}
+
+// BeginPlanting 不经过植物卡片直接进入种植模式（如拾起草坪上的种子包）
+//
+// 参数:
+//   - plantType: 要种植的植物类型
+//   - worldX, worldY: 植物预览的初始位置（世界坐标）
+func (s *InputSystem) BeginPlanting(plantType components.PlantType, worldX, worldY float64) {
+	s.gameState.EnterPlantingMode(plantType)
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_SEEDLIFT")
+	}
+	s.createPlantPreview(plantType, worldX, worldY)
+	log.Printf("[InputSystem] 进入种植模式（无卡片）: PlantType=%v", plantType)
+}
+
 // Update 处理用户输入
 // 参数:
 //   - deltaTime: 时间增量（秒）
@@ -175,8 +234,15 @@ func (s *InputSystem) Update(deltaTime float64, cameraX float64) {
 			s.destroyPlantPreview()
 			s.cancelDragPlanting()
 		}
+		if s.aimingCobCannon != 0 {
+			log.Printf("[InputSystem] 右键取消玉米加农炮瞄准")
+			s.cancelCobCannonAiming()
+		}
 	}
 
+	// 更新玉米加农炮瞄准准星（进入种植模式或加农炮消失时取消瞄准）
+	s.updateCobCannonAiming(cameraX)
+
 	// ========================================================================
 	// 拖拽种植处理（仅移动端触摸拖拽支持）
 	// 桌面端使用传统点击模式，不触发拖拽逻辑
@@ -215,18 +281,76 @@ func (s *InputSystem) Update(deltaTime float64, cameraX float64) {
 			return // 已处理卡片点击，不继续处理其他点击
 		}
 
+		// 检查玉米加农炮的选中和落点选择（非种植模式）
+		cobHandled := s.handleCobCannonClick(mouseScreenX, mouseScreenY, cameraX)
+		if cobHandled {
+			return // 已处理加农炮瞄准
+		}
+
 		// 检查是否在种植模式下点击草坪
 		// 注意：handleLawnClick 内部会调用 MouseToGridCoords 进行坐标转换，所以传递屏幕坐标
 		lawnHandled := s.handleLawnClick(mouseScreenX, mouseScreenY)
 		if lawnHandled {
 			return // 已处理草坪种植
 		}
+
+		// 小游戏规则处理非种植模式下的草坪点击（如用锤子砸僵尸）
+		if s.lawnClickHandler != nil && !s.gameState.IsPlantingMode {
+			s.lawnClickHandler(mouseWorldX, mouseWorldY)
+		}
+	}
+}
+
+// FindZombieAt 查找世界坐标处可以点中的僵尸
+//
+// 使用僵尸碰撞盒（左右各扩展 ZombieClickPaddingX）进行点击检测；
+// 死亡中、被魅惑、地下和水下的僵尸不能被点中。
+// 多个僵尸重叠时返回绘制在最上层的僵尸（Y 坐标最大，同一行中 X 坐标最小）
+//
+// 返回：
+//   - 僵尸实体ID，没有点中僵尸时返回 0
+func (s *InputSystem) FindZombieAt(worldX, worldY float64) ecs.EntityID {
+	var hitID ecs.EntityID
+	var hitPos *components.PositionComponent
+
+	for _, entityID := range ecs.GetEntitiesWith3[
+		*components.BehaviorComponent,
+		*components.PositionComponent,
+		*components.CollisionComponent,
+	](s.entityManager) {
+		behavior, _ := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+		if !isZombieType(behavior.Type) {
+			continue
+		}
+		if IsCharmedZombie(s.entityManager, entityID) || IsUndergroundZombie(s.entityManager, entityID) || IsSubmergedZombie(s.entityManager, entityID) {
+			continue
+		}
+
+		pos, _ := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+		collision, _ := ecs.GetComponent[*components.CollisionComponent](s.entityManager, entityID)
+		centerX := pos.X + collision.OffsetX
+		centerY := pos.Y + collision.OffsetY
+		halfWidth := collision.Width/2 + config.ZombieClickPaddingX
+		if worldX < centerX-halfWidth || worldX > centerX+halfWidth ||
+			worldY < centerY-collision.Height/2 || worldY > centerY+collision.Height/2 {
+			continue
+		}
+
+		if hitPos == nil || pos.Y > hitPos.Y || (pos.Y == hitPos.Y && pos.X < hitPos.X) {
+			hitID = entityID
+			hitPos = pos
+		}
 	}
+	return hitID
 }
 
 // handleSunClickAt 检测并处理阳光点击
 // 返回 true 表示处理了点击，false 表示未处理
 func (s *InputSystem) handleSunClickAt(mouseWorldX, mouseWorldY float64) bool {
+	if s.sunCollectionDisabled {
+		return false
+	}
+
 	// 查询所有可点击的阳光实体（使用世界坐标）
 	sunEntities := ecs.GetEntitiesWith3[
 		*components.PositionComponent,
@@ -327,6 +451,9 @@ func (s *InputSystem) handleSunClick(sunID ecs.EntityID, pos *components.Positio
 // 返回 true 表示处理了点击，false 表示未处理
 // Story 19.3: 强引导模式下阻止卡片点击
 func (s *InputSystem) handlePlantCardClick(mouseX, mouseY int, cameraX float64) bool {
+	if s.plantCardsDisabled {
+		return false
+	}
 	// Story 19.3: 检查强引导模式是否阻止卡片点击
 	if IsGuidedTutorialBlocking("click_plant_card") {
 		return false // 静默忽略，不处理卡片点击
@@ -503,13 +630,7 @@ func (s *InputSystem) handleLawnClick(mouseX, mouseY int) bool {
 	}
 
 	// 转换鼠标坐标到网格坐标（使用世界坐标系统）
-	col, row, isValid := utils.MouseToGridCoords(
-		mouseX, mouseY,
-		s.gameState.CameraX,
-		config.GridWorldStartX, config.GridWorldStartY,
-		config.GridColumns, config.GridRows,
-		config.CellWidth, config.CellHeight,
-	)
+	col, row, isValid := utils.MouseToGridCoords(mouseX, mouseY, s.gameState.CameraX, s.lawnGridSystem.Layout())
 	if !isValid {
 		// DEBUG: 网格外点击日志（已禁用避免刷屏）
 		// log.Printf("[InputSystem] 鼠标点击在网格外: (%d, %d)", mouseX, mouseY)
@@ -527,14 +648,18 @@ func (s *InputSystem) handleLawnClick(mouseX, mouseY int) bool {
 		return true // 处理了点击，但该行禁用
 	}
 
-	// 检查格子是否已被占用
-	if s.lawnGridSystem.IsOccupied(s.lawnGridEntityID, col, row) {
-		log.Printf("[InputSystem] 格子 (%d, %d) 已被占用，无法种植", col, row)
+	// 检查格子是否可以种植（升级植物需要种植在基础植物上）
+	col, ok := s.resolvePlantingCell(plantType, col, row)
+	if !ok {
+		log.Printf("[InputSystem] 格子 (%d, %d) 无法种植 %v", col, row, plantType)
 		return true // 处理了点击（虽然没有种植），防止继续处理阳光
 	}
 
-	// 获取植物消耗
+	// 获取植物消耗（免费种植时不消耗阳光）
 	sunCost := s.getPlantCost(plantType)
+	if s.freePlanting {
+		sunCost = 0
+	}
 
 	// 尝试扣除阳光
 	if !s.gameState.SpendSun(sunCost) {
@@ -555,12 +680,18 @@ func (s *InputSystem) handleLawnClick(mouseX, mouseY int) bool {
 
 	log.Printf("[InputSystem] 成功创建植物实体 (ID: %d, Type: %v) 在 (%d, %d)", plantID, plantType, col, row)
 
+	// 标记格子为占用（多格植物占用所有格子，升级植物接管基础植物的格子后再移除基础植物）
+	err = s.occupyPlantingCells(plantType, col, row, plantID)
+	if err != nil {
+		log.Printf("[InputSystem] 标记格子占用失败: %v", err)
+		// 失败时删除植物实体并返还阳光
+		s.entityManager.DestroyEntity(plantID)
+		s.gameState.AddSun(sunCost)
+		return true
+	}
+
 	// 触发种植粒子效果
-	worldX, worldY := utils.GridToWorldCoords(
-		col, row,
-		config.GridWorldStartX, config.GridWorldStartY,
-		config.CellWidth, config.CellHeight,
-	)
+	worldX, worldY := utils.GridToWorldCoords(col, row, s.lawnGridSystem.Layout())
 	_, err = entities.NewPlantingParticleEffect(s.entityManager, s.resourceManager, worldX, worldY)
 	if err != nil {
 		log.Printf("[InputSystem] 警告：创建种植粒子效果失败: %v", err)
@@ -569,16 +700,6 @@ func (s *InputSystem) handleLawnClick(mouseX, mouseY int) bool {
 		log.Printf("[InputSystem] 触发种植粒子效果，位置: (%.1f, %.1f)", worldX, worldY)
 	}
 
-	// 标记格子为占用
-	err = s.lawnGridSystem.OccupyCell(s.lawnGridEntityID, col, row, plantID)
-	if err != nil {
-		log.Printf("[InputSystem] 标记格子占用失败: %v", err)
-		// 失败时删除植物实体并返还阳光
-		s.entityManager.DestroyEntity(plantID)
-		s.gameState.AddSun(sunCost)
-		return true
-	}
-
 	// 播放种植音效（使用 AudioManager 统一管理 - Story 10.9）
 	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
 		audioManager.PlaySound("SOUND_PLANT")
@@ -598,22 +719,266 @@ func (s *InputSystem) handleLawnClick(mouseX, mouseY int) bool {
 	s.gameState.ExitPlantingMode()
 	log.Printf("[InputSystem] 种植完成，退出种植模式")
 
+	if s.plantedHandler != nil {
+		s.plantedHandler(plantType, col, row)
+	}
+
 	return true // 已处理点击
 }
 
+// resolvePlantingCell 确定植物实际种植的格子
+// 普通植物只能种植在没有冰道的空格子上；升级植物（如玉米加农炮）占用的格子必须全部是基础植物，
+// 点击其中任意一株基础植物即可种植。
+// 水路上只能种植水生植物（睡莲、缠绕水草、海蘑菇），陆生植物必须种在睡莲上；陆地上不能种植水生植物。
+// 屋顶上的植物必须种在花盆上，花盆不能叠放
+//
+// 返回:
+//   - int: 植物占用区域最左侧的列
+//   - bool: 是否可以种植
+func (s *InputSystem) resolvePlantingCell(plantType components.PlantType, col, row int) (int, bool) {
+	basePlant, isUpgrade := components.UpgradeBasePlant(plantType)
+	if !isUpgrade {
+		if s.lawnGridSystem.HasIce(s.lawnGridEntityID, col, row) {
+			return col, false
+		}
+		// 墓碑吞噬者只能种在墓碑上，其他植物不能种在墓碑上
+		if s.lawnGridSystem.HasGrave(s.lawnGridEntityID, col, row) != (plantType == components.PlantGraveBuster) {
+			return col, false
+		}
+		hasPlatform := s.lawnGridSystem.HasPlatform(s.lawnGridEntityID, col, row)
+		if plantType == components.PlantFlowerPot {
+			if hasPlatform || s.lawnGridSystem.IsWater(row) {
+				return col, false
+			}
+		} else if s.lawnGridSystem.IsWater(row) {
+			if plantType.IsAquatic() == hasPlatform {
+				return col, false
+			}
+		} else if plantType.IsAquatic() {
+			return col, false
+		} else if s.lawnGridSystem.Layout().NeedsFlowerPot && !hasPlatform {
+			return col, false
+		}
+		return col, !s.lawnGridSystem.IsOccupied(s.lawnGridEntityID, col, row)
+	}
+
+	// 优先以点击的格子作为最左侧格子，再依次向左尝试
+	width := components.PlantFootprintWidth(plantType)
+	for startCol := col; startCol > col-width; startCol-- {
+		if s.isUpgradeFootprint(basePlant, startCol, row, width) {
+			return startCol, true
+		}
+	}
+	return col, false
+}
+
+// occupyPlantingCells 在草坪网格上登记新种植的植物
+// 睡莲、花盆记录在种植平台层（其上还可以种植其他植物），其他植物占用所有格子（多格植物占用多个格子）。
+// 升级植物先检查并接管基础植物的格子，接管成功后才移除基础植物；失败时基础植物和格子保持不变
+func (s *InputSystem) occupyPlantingCells(plantType components.PlantType, col, row int, plantID ecs.EntityID) error {
+	if plantType == components.PlantLilyPad {
+		return s.lawnGridSystem.PlaceLilyPad(s.lawnGridEntityID, col, row, plantID)
+	}
+	if plantType == components.PlantFlowerPot {
+		return s.lawnGridSystem.PlaceFlowerPot(s.lawnGridEntityID, col, row, plantID)
+	}
+
+	width := components.PlantFootprintWidth(plantType)
+	basePlant, isUpgrade := components.UpgradeBasePlant(plantType)
+	if !isUpgrade {
+		return s.lawnGridSystem.OccupyCells(s.lawnGridEntityID, col, row, width, plantID)
+	}
+
+	if !s.isUpgradeFootprint(basePlant, col, row, width) {
+		return fmt.Errorf("cells (%d-%d, %d) are not all %v", col, col+width-1, row, basePlant)
+	}
+	basePlants := make([]ecs.EntityID, 0, width)
+	for c := col; c < col+width; c++ {
+		basePlants = append(basePlants, s.lawnGridSystem.GetOccupant(s.lawnGridEntityID, c, row))
+	}
+	if err := s.lawnGridSystem.ReplaceCells(s.lawnGridEntityID, col, row, width, plantID); err != nil {
+		return err
+	}
+	s.removeUpgradeBasePlants(plantType, basePlants)
+	return nil
+}
+
+// isUpgradeFootprint 检查从 col 开始的 width 个格子是否全部被基础植物占用
+func (s *InputSystem) isUpgradeFootprint(basePlant components.PlantType, col, row, width int) bool {
+	for c := col; c < col+width; c++ {
+		occupant := s.lawnGridSystem.GetOccupant(s.lawnGridEntityID, c, row)
+		if occupant == 0 {
+			return false
+		}
+		plant, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, occupant)
+		if !ok || plant.PlantType != basePlant {
+			return false
+		}
+	}
+	return true
+}
+
+// removeUpgradeBasePlants 销毁被升级植物替换的基础植物（格子已由升级植物接管）
+func (s *InputSystem) removeUpgradeBasePlants(plantType components.PlantType, basePlants []ecs.EntityID) {
+	for _, basePlant := range basePlants {
+		s.entityManager.DestroyEntity(basePlant)
+		log.Printf("[InputSystem] 升级植物 %v 替换基础植物 %d", plantType, basePlant)
+	}
+}
+
+// handleCobCannonClick 处理玉米加农炮的两步瞄准
+// 第一步：点击已装填的玉米加农炮，进入瞄准模式
+// 第二步：点击草坪任意位置作为落点，加农炮在下一帧发射
+// 返回 true 表示处理了点击，false 表示未处理
+func (s *InputSystem) handleCobCannonClick(mouseX, mouseY int, cameraX float64) bool {
+	if s.gameState.IsPlantingMode {
+		return false // 种植模式下的点击由 handleLawnClick 处理
+	}
+
+	col, row, isValid := utils.MouseToGridCoords(mouseX, mouseY, cameraX, s.lawnGridSystem.Layout())
+
+	// 第二步：选择落点
+	if s.aimingCobCannon != 0 {
+		if !isValid {
+			return false // 落点必须在草坪上
+		}
+		if cannon, ok := ecs.GetComponent[*components.CobCannonComponent](s.entityManager, s.aimingCobCannon); ok {
+			cannon.TargetX = float64(mouseX) + cameraX
+			cannon.TargetY = float64(mouseY)
+			cannon.FireRequested = true
+			log.Printf("[InputSystem] 玉米加农炮 %d 选择落点 (%.1f, %.1f)", s.aimingCobCannon, cannon.TargetX, cannon.TargetY)
+		}
+		s.cancelCobCannonAiming()
+		return true
+	}
+
+	// 第一步：选择已装填的玉米加农炮
+	if !isValid {
+		return false
+	}
+	occupant := s.lawnGridSystem.GetOccupant(s.lawnGridEntityID, col, row)
+	if occupant == 0 {
+		return false
+	}
+	cannon, ok := ecs.GetComponent[*components.CobCannonComponent](s.entityManager, occupant)
+	if !ok || !cannon.IsReady() {
+		return false
+	}
+
+	s.aimingCobCannon = occupant
+	worldX := float64(mouseX) + cameraX
+	worldY := float64(mouseY)
+	if targetID, err := entities.NewCobTargetEntity(s.entityManager, s.resourceManager, worldX, worldY); err != nil {
+		log.Printf("[InputSystem] 警告：创建玉米加农炮准星失败: %v", err)
+	} else {
+		s.cobTargetEntity = targetID
+	}
+	log.Printf("[InputSystem] 选中玉米加农炮 %d，进入瞄准模式", occupant)
+	return true
+}
+
+// updateCobCannonAiming 每帧更新瞄准准星位置
+// 进入种植模式或加农炮已不存在（被吃掉、被铲除）时自动取消瞄准
+func (s *InputSystem) updateCobCannonAiming(cameraX float64) {
+	if s.aimingCobCannon == 0 {
+		return
+	}
+
+	cannon, ok := ecs.GetComponent[*components.CobCannonComponent](s.entityManager, s.aimingCobCannon)
+	if !ok || !cannon.IsReady() || s.gameState.IsPlantingMode {
+		s.cancelCobCannonAiming()
+		return
+	}
+
+	if pos, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, s.cobTargetEntity); ok {
+		mouseX, mouseY := utils.GetPointerPosition()
+		pos.X = float64(mouseX) + cameraX
+		pos.Y = float64(mouseY)
+	}
+}
+
+// cancelCobCannonAiming 退出玉米加农炮瞄准模式并删除准星
+func (s *InputSystem) cancelCobCannonAiming() {
+	if s.cobTargetEntity != 0 {
+		s.entityManager.DestroyEntity(s.cobTargetEntity)
+		s.cobTargetEntity = 0
+	}
+	s.aimingCobCannon = 0
+}
+
 // createPlantEntity 创建植物实体的辅助方法
 // 根据植物类型选择合适的工厂函数
 func (s *InputSystem) createPlantEntity(plantType components.PlantType, col, row int) (ecs.EntityID, error) {
 	// 传递 reanimSystem 给工厂函数以初始化动画
 	// 坚果墙和樱桃炸弹使用专用的工厂函数
 	if plantType == components.PlantWallnut {
-		return entities.NewWallnutEntity(s.entityManager, s.resourceManager, s.gameState, s.reanimSystem, col, row)
+		return entities.NewWallnutEntity(s.entityManager, s.resourceManager, s.gameState, s.reanimSystem, s.lawnGridSystem.Layout(), col, row)
 	}
 	if plantType == components.PlantCherryBomb {
-		return entities.NewCherryBombEntity(s.entityManager, s.resourceManager, s.gameState, col, row)
+		return entities.NewCherryBombEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantMagnetshroom {
+		return entities.NewMagnetshroomEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantHypnoshroom {
+		return entities.NewHypnoshroomEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantKernelpult {
+		return entities.NewKernelpultEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantCobCannon {
+		return entities.NewCobCannonEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantSplitPea {
+		return entities.NewSplitPeaEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantStarfruit {
+		return entities.NewStarfruitEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantCattail {
+		return entities.NewCattailEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantTallnut {
+		return entities.NewTallnutEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantGraveBuster {
+		return entities.NewGraveBusterEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row, s.lawnGridSystem.GetGrave(s.lawnGridEntityID, col, row))
+	}
+	if plantType == components.PlantLilyPad {
+		return entities.NewLilyPadEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantTangleKelp {
+		return entities.NewTangleKelpEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantSeaShroom {
+		return entities.NewSeaShroomEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantPlantern {
+		return entities.NewPlanternEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantBlover {
+		return entities.NewBloverEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantFlowerPot {
+		return entities.NewFlowerPotEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantJalapeno {
+		return entities.NewJalapenoEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantIceShroom {
+		return entities.NewIceShroomEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantSpikeweed {
+		return entities.NewSpikeweedEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantChomper {
+		return entities.NewChomperEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
+	}
+	if plantType == components.PlantUmbrellaLeaf {
+		return entities.NewUmbrellaLeafEntity(s.entityManager, s.resourceManager, s.gameState, s.lawnGridSystem.Layout(), col, row)
 	}
 	// 其他植物使用通用工厂函数
-	return entities.NewPlantEntity(s.entityManager, s.resourceManager, s.gameState, s.reanimSystem, plantType, col, row)
+	return entities.NewPlantEntity(s.entityManager, s.resourceManager, s.gameState, s.reanimSystem, plantType, s.lawnGridSystem.Layout(), col, row)
 }
 
 // getPlantCost 获取植物的阳光消耗
@@ -627,6 +992,46 @@ func (s *InputSystem) getPlantCost(plantType components.PlantType) int {
 		return config.WallnutCost // 50
 	case components.PlantCherryBomb:
 		return config.CherryBombSunCost // 150
+	case components.PlantMagnetshroom:
+		return config.MagnetshroomSunCost // 100
+	case components.PlantHypnoshroom:
+		return config.HypnoshroomSunCost // 75
+	case components.PlantKernelpult:
+		return config.KernelpultSunCost // 100
+	case components.PlantCobCannon:
+		return config.CobCannonSunCost // 500
+	case components.PlantSplitPea:
+		return config.SplitPeaSunCost // 125
+	case components.PlantStarfruit:
+		return config.StarfruitSunCost // 125
+	case components.PlantCattail:
+		return config.CattailSunCost // 225
+	case components.PlantTallnut:
+		return config.TallnutSunCost // 125
+	case components.PlantGraveBuster:
+		return config.GraveBusterSunCost // 75
+	case components.PlantLilyPad:
+		return config.LilyPadSunCost // 25
+	case components.PlantTangleKelp:
+		return config.TangleKelpSunCost // 25
+	case components.PlantSeaShroom:
+		return config.SeaShroomSunCost // 0
+	case components.PlantPlantern:
+		return config.PlanternSunCost // 25
+	case components.PlantBlover:
+		return config.BloverSunCost // 100
+	case components.PlantFlowerPot:
+		return config.FlowerPotSunCost // 25
+	case components.PlantJalapeno:
+		return config.JalapenoSunCost // 125
+	case components.PlantIceShroom:
+		return config.IceShroomSunCost // 75
+	case components.PlantSpikeweed:
+		return config.SpikeweedSunCost // 100
+	case components.PlantChomper:
+		return config.ChomperSunCost // 150
+	case components.PlantUmbrellaLeaf:
+		return config.UmbrellaLeafSunCost // 100
 	default:
 		return 0
 	}
@@ -823,6 +1228,46 @@ func (s *InputSystem) getPlantName(plantType components.PlantType) string {
 		return "樱桃炸弹"
 	case components.PlantWallnut:
 		return "坚果墙"
+	case components.PlantMagnetshroom:
+		return "磁力菇"
+	case components.PlantHypnoshroom:
+		return "催眠菇"
+	case components.PlantKernelpult:
+		return "玉米投手"
+	case components.PlantCobCannon:
+		return "玉米加农炮"
+	case components.PlantSplitPea:
+		return "裂荚射手"
+	case components.PlantStarfruit:
+		return "杨桃"
+	case components.PlantCattail:
+		return "香蒲"
+	case components.PlantTallnut:
+		return "高坚果"
+	case components.PlantGraveBuster:
+		return "墓碑吞噬者"
+	case components.PlantLilyPad:
+		return "睡莲"
+	case components.PlantTangleKelp:
+		return "缠绕水草"
+	case components.PlantSeaShroom:
+		return "海蘑菇"
+	case components.PlantPlantern:
+		return "植物灯笼"
+	case components.PlantBlover:
+		return "三叶草"
+	case components.PlantFlowerPot:
+		return "花盆"
+	case components.PlantJalapeno:
+		return "火爆辣椒"
+	case components.PlantIceShroom:
+		return "寒冰菇"
+	case components.PlantSpikeweed:
+		return "地刺"
+	case components.PlantChomper:
+		return "大嘴花"
+	case components.PlantUmbrellaLeaf:
+		return "叶子保护伞"
 	default:
 		return "未知植物"
 	}
@@ -831,6 +1276,10 @@ func (s *InputSystem) getPlantName(plantType components.PlantType) string {
 // handlePlantCardHotkeys 处理植物卡片快捷键（数字键 1-9）
 // 按卡片在卡槽中的位置从左到右对应数字键 1-9
 func (s *InputSystem) handlePlantCardHotkeys(cameraX float64) {
+	if s.plantCardsDisabled {
+		return
+	}
+
 	// 定义快捷键映射
 	hotkeys := []ebiten.Key{
 		ebiten.Key1, ebiten.Key2, ebiten.Key3,
@@ -993,7 +1442,7 @@ func (s *InputSystem) updateSunHover(cameraX float64) {
 		sun, _ := ecs.GetComponent[*components.SunComponent](s.entityManager, id)
 
 		// 跳过不可点击或正在被收集的阳光
-		if !clickable.IsEnabled || sun.State == components.SunCollecting {
+		if s.sunCollectionDisabled || !clickable.IsEnabled || sun.State == components.SunCollecting {
 			clickable.IsHovered = false
 			continue
 		}
@@ -1058,7 +1507,7 @@ func (s *InputSystem) handleDragPlanting(cameraX float64) bool {
 // 检测拖拽是否从有效的植物卡片开始（仅触摸输入）
 func (s *InputSystem) handleDragStart(dragInfo utils.DragInfo, cameraX float64) bool {
 	// 只处理触摸输入的拖拽，桌面端鼠标使用传统点击模式
-	if !dragInfo.IsTouchInput {
+	if !dragInfo.IsTouchInput || s.plantCardsDisabled {
 		return false
 	}
 
@@ -1151,13 +1600,7 @@ func (s *InputSystem) handleDragEnd(dragInfo utils.DragInfo, cameraX float64) {
 	defer s.cancelDragPlanting()
 
 	// 检测释放位置是否在有效的草坪格子上
-	col, row, isValid := utils.MouseToGridCoords(
-		dragInfo.CurrentX, dragInfo.CurrentY,
-		cameraX,
-		config.GridWorldStartX, config.GridWorldStartY,
-		config.GridColumns, config.GridRows,
-		config.CellWidth, config.CellHeight,
-	)
+	col, row, isValid := utils.MouseToGridCoords(dragInfo.CurrentX, dragInfo.CurrentY, cameraX, s.lawnGridSystem.Layout())
 
 	if !isValid {
 		log.Printf("[InputSystem] 拖拽结束: 位置在网格外，取消种植")
@@ -1171,9 +1614,10 @@ func (s *InputSystem) handleDragEnd(dragInfo utils.DragInfo, cameraX float64) {
 		return
 	}
 
-	// 检查格子是否已被占用
-	if s.lawnGridSystem.IsOccupied(s.lawnGridEntityID, col, row) {
-		log.Printf("[InputSystem] 拖拽结束: 格子 (%d, %d) 已被占用，取消种植", col, row)
+	// 检查格子是否可以种植（升级植物需要种植在基础植物上）
+	col, ok := s.resolvePlantingCell(s.dragPlantType, col, row)
+	if !ok {
+		log.Printf("[InputSystem] 拖拽结束: 格子 (%d, %d) 无法种植 %v，取消种植", col, row, s.dragPlantType)
 		return
 	}
 
@@ -1198,19 +1642,8 @@ func (s *InputSystem) handleDragEnd(dragInfo utils.DragInfo, cameraX float64) {
 
 	log.Printf("[InputSystem] 拖拽结束: 成功创建植物实体 (ID: %d, Type: %v) 在 (%d, %d)", plantID, s.dragPlantType, col, row)
 
-	// 触发种植粒子效果
-	worldX, worldY := utils.GridToWorldCoords(
-		col, row,
-		config.GridWorldStartX, config.GridWorldStartY,
-		config.CellWidth, config.CellHeight,
-	)
-	_, err = entities.NewPlantingParticleEffect(s.entityManager, s.resourceManager, worldX, worldY)
-	if err != nil {
-		log.Printf("[InputSystem] 警告：创建种植粒子效果失败: %v", err)
-	}
-
-	// 标记格子为占用
-	err = s.lawnGridSystem.OccupyCell(s.lawnGridEntityID, col, row, plantID)
+	// 标记格子为占用（多格植物占用所有格子，升级植物接管基础植物的格子后再移除基础植物）
+	err = s.occupyPlantingCells(s.dragPlantType, col, row, plantID)
 	if err != nil {
 		log.Printf("[InputSystem] 标记格子占用失败: %v", err)
 		s.entityManager.DestroyEntity(plantID)
@@ -1218,6 +1651,13 @@ func (s *InputSystem) handleDragEnd(dragInfo utils.DragInfo, cameraX float64) {
 		return
 	}
 
+	// 触发种植粒子效果
+	worldX, worldY := utils.GridToWorldCoords(col, row, s.lawnGridSystem.Layout())
+	_, err = entities.NewPlantingParticleEffect(s.entityManager, s.resourceManager, worldX, worldY)
+	if err != nil {
+		log.Printf("[InputSystem] 警告：创建种植粒子效果失败: %v", err)
+	}
+
 	// 播放种植音效
 	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
 		audioManager.PlaySound("SOUND_PLANT")
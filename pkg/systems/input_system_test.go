@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
 	"github.com/gonewx/pvz/pkg/ecs"
 	"github.com/gonewx/pvz/pkg/game"
 )
@@ -54,7 +55,7 @@ func TestSunClickStateChange(t *testing.T) {
 	initialSun := gs.GetSun()
 
 	// 创建草坪网格系统和实体（测试用）
-	lawnGridSystem := NewLawnGridSystem(em, nil)
+	lawnGridSystem := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil)
 	lawnGridEntityID := em.CreateEntity()
 	em.AddComponent(lawnGridEntityID, &components.LawnGridComponent{})
 
@@ -124,7 +125,7 @@ func TestClickFallingSunNoEffect(t *testing.T) {
 	gs := game.GetGameState()
 
 	// 创建草坪网格系统和实体（测试用）
-	lawnGridSystem := NewLawnGridSystem(em, nil)
+	lawnGridSystem := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil)
 	lawnGridEntityID := em.CreateEntity()
 	em.AddComponent(lawnGridEntityID, &components.LawnGridComponent{})
 
@@ -169,7 +170,7 @@ func TestClickDisabledSunNoEffect(t *testing.T) {
 	gs := game.GetGameState()
 
 	// 创建草坪网格系统和实体（测试用）
-	lawnGridSystem := NewLawnGridSystem(em, nil)
+	lawnGridSystem := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil)
 	lawnGridEntityID := em.CreateEntity()
 	em.AddComponent(lawnGridEntityID, &components.LawnGridComponent{})
 
@@ -209,7 +210,7 @@ func TestSunCollectionAnimationTarget(t *testing.T) {
 	targetY := 80.0
 
 	// 创建草坪网格系统和实体（测试用）
-	lawnGridSystem := NewLawnGridSystem(em, nil)
+	lawnGridSystem := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil)
 	lawnGridEntityID := em.CreateEntity()
 	em.AddComponent(lawnGridEntityID, &components.LawnGridComponent{})
 
@@ -273,7 +274,7 @@ func TestGetPlantCost(t *testing.T) {
 	em := ecs.NewEntityManager()
 	rm := game.NewResourceManager(getTestAudioContext())
 	gs := game.GetGameState()
-	lawnGridSystem := NewLawnGridSystem(em, nil)
+	lawnGridSystem := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil)
 	lawnGridEntityID := em.CreateEntity()
 	em.AddComponent(lawnGridEntityID, &components.LawnGridComponent{})
 
@@ -304,7 +305,7 @@ func TestTriggerPlantCardCooldown(t *testing.T) {
 	em := ecs.NewEntityManager()
 	rm := game.NewResourceManager(getTestAudioContext())
 	gs := game.GetGameState()
-	lawnGridSystem := NewLawnGridSystem(em, nil)
+	lawnGridSystem := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil)
 	lawnGridEntityID := em.CreateEntity()
 	em.AddComponent(lawnGridEntityID, &components.LawnGridComponent{})
 
@@ -331,3 +332,239 @@ func TestTriggerPlantCardCooldown(t *testing.T) {
 		t.Errorf("Expected CurrentCooldown=7.5, got %f", card.CurrentCooldown)
 	}
 }
+
+// TestResolvePlantingCellCobCannon 测试玉米加农炮只能种植在两株相邻的玉米投手上
+func TestResolvePlantingCellCobCannon(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	gs := game.GetGameState()
+	lawnGridSystem := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil)
+	lawnGridEntityID := em.CreateEntity()
+	em.AddComponent(lawnGridEntityID, &components.LawnGridComponent{})
+
+	system := NewInputSystem(em, rm, gs, nil, 21.0, 80.0, lawnGridSystem, lawnGridEntityID)
+
+	plant := func(plantType components.PlantType, col, row int) ecs.EntityID {
+		id := em.CreateEntity()
+		em.AddComponent(id, &components.PlantComponent{PlantType: plantType, GridCol: col, GridRow: row})
+		if err := lawnGridSystem.OccupyCell(lawnGridEntityID, col, row, id); err != nil {
+			t.Fatalf("OccupyCell failed: %v", err)
+		}
+		return id
+	}
+
+	left := plant(components.PlantKernelpult, 3, 1)
+	right := plant(components.PlantKernelpult, 4, 1)
+	plant(components.PlantKernelpult, 6, 1)
+	plant(components.PlantPeashooter, 7, 1)
+
+	tests := []struct {
+		name    string
+		col     int
+		wantCol int
+		wantOK  bool
+	}{
+		{"点击左侧玉米投手", 3, 3, true},
+		{"点击右侧玉米投手", 4, 3, true},
+		{"右侧是豌豆射手", 6, 6, false},
+		{"空格子", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			col, ok := system.resolvePlantingCell(components.PlantCobCannon, tt.col, 1)
+			if ok != tt.wantOK || (ok && col != tt.wantCol) {
+				t.Errorf("resolvePlantingCell(col=%d) = (%d, %v), want (%d, %v)", tt.col, col, ok, tt.wantCol, tt.wantOK)
+			}
+		})
+	}
+
+	// 普通植物不能种植在玉米投手上
+	if _, ok := system.resolvePlantingCell(components.PlantPeashooter, 3, 1); ok {
+		t.Error("普通植物不应种植在已占用的格子上")
+	}
+
+	// 玉米加农炮接管两格后移除玉米投手
+	cannon := em.CreateEntity()
+	if err := system.occupyPlantingCells(components.PlantCobCannon, 3, 1, cannon); err != nil {
+		t.Fatalf("occupyPlantingCells failed: %v", err)
+	}
+	if lawnGridSystem.GetOccupant(lawnGridEntityID, 3, 1) != cannon || lawnGridSystem.GetOccupant(lawnGridEntityID, 4, 1) != cannon {
+		t.Error("升级后两个格子应由玉米加农炮占用")
+	}
+	em.RemoveMarkedEntities()
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, left); ok {
+		t.Error("左侧玉米投手应被移除")
+	}
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, right); ok {
+		t.Error("右侧玉米投手应被移除")
+	}
+}
+
+// TestOccupyPlantingCellsCobCannonFailure 测试玉米加农炮占用失败时玉米投手和格子保持不变
+func TestOccupyPlantingCellsCobCannonFailure(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	gs := game.GetGameState()
+	lawnGridSystem := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil)
+	lawnGridEntityID := em.CreateEntity()
+	em.AddComponent(lawnGridEntityID, &components.LawnGridComponent{})
+
+	system := NewInputSystem(em, rm, gs, nil, 21.0, 80.0, lawnGridSystem, lawnGridEntityID)
+
+	kernelpult := em.CreateEntity()
+	em.AddComponent(kernelpult, &components.PlantComponent{PlantType: components.PlantKernelpult, GridCol: 3, GridRow: 2})
+	lawnGridSystem.OccupyCell(lawnGridEntityID, 3, 2, kernelpult)
+	peashooter := em.CreateEntity()
+	em.AddComponent(peashooter, &components.PlantComponent{PlantType: components.PlantPeashooter, GridCol: 4, GridRow: 2})
+	lawnGridSystem.OccupyCell(lawnGridEntityID, 4, 2, peashooter)
+
+	cannon := em.CreateEntity()
+	if err := system.occupyPlantingCells(components.PlantCobCannon, 3, 2, cannon); err == nil {
+		t.Fatal("右侧不是玉米投手时占用应失败")
+	}
+
+	em.RemoveMarkedEntities()
+	if _, ok := ecs.GetComponent[*components.PlantComponent](em, kernelpult); !ok {
+		t.Error("占用失败时玉米投手不应被移除")
+	}
+	if lawnGridSystem.GetOccupant(lawnGridEntityID, 3, 2) != kernelpult || lawnGridSystem.GetOccupant(lawnGridEntityID, 4, 2) != peashooter {
+		t.Error("占用失败时格子不应被修改")
+	}
+}
+
+// TestResolvePlantingCellIce 测试冰道上不能种植，冰道消失后恢复
+func TestResolvePlantingCellIce(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	gs := game.GetGameState()
+	lawnGridSystem := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil)
+	lawnGridEntityID := em.CreateEntity()
+	em.AddComponent(lawnGridEntityID, &components.LawnGridComponent{})
+
+	system := NewInputSystem(em, rm, gs, nil, 21.0, 80.0, lawnGridSystem, lawnGridEntityID)
+
+	lawnGridSystem.LayIce(lawnGridEntityID, 5, 2)
+	if _, ok := system.resolvePlantingCell(components.PlantPeashooter, 5, 2); ok {
+		t.Error("冰道上不应能种植")
+	}
+	if _, ok := system.resolvePlantingCell(components.PlantPeashooter, 4, 2); !ok {
+		t.Error("没有冰道的空格子应能种植")
+	}
+
+	lawnGridSystem.Update(config.ZomboniIceTrailDuration)
+	if _, ok := system.resolvePlantingCell(components.PlantPeashooter, 5, 2); !ok {
+		t.Error("冰道消失后应能种植")
+	}
+}
+
+// TestResolvePlantingCellPool 测试泳池场景的种植规则：水路只能种水生植物或种在睡莲上
+func TestResolvePlantingCellPool(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	gs := game.GetGameState()
+	lawnGridSystem := NewLawnGridSystem(em, config.GetLawnLayout("pool"), nil)
+	lawnGridEntityID := em.CreateEntity()
+	em.AddComponent(lawnGridEntityID, &components.LawnGridComponent{})
+
+	system := NewInputSystem(em, rm, gs, nil, 21.0, 80.0, lawnGridSystem, lawnGridEntityID)
+
+	if _, ok := system.resolvePlantingCell(components.PlantPeashooter, 4, 2); ok {
+		t.Error("水面上没有睡莲时不应能种陆生植物")
+	}
+	if _, ok := system.resolvePlantingCell(components.PlantLilyPad, 4, 1); ok {
+		t.Error("陆地上不应能种睡莲")
+	}
+	if _, ok := system.resolvePlantingCell(components.PlantTangleKelp, 4, 2); !ok {
+		t.Error("水面上应能种缠绕水草")
+	}
+	if _, ok := system.resolvePlantingCell(components.PlantLilyPad, 4, 2); !ok {
+		t.Error("水面上应能种睡莲")
+	}
+
+	lilyPadID := em.CreateEntity()
+	if err := system.occupyPlantingCells(components.PlantLilyPad, 4, 2, lilyPadID); err != nil {
+		t.Fatalf("Failed to place lily pad: %v", err)
+	}
+	if _, ok := system.resolvePlantingCell(components.PlantLilyPad, 4, 2); ok {
+		t.Error("睡莲上不应再种睡莲")
+	}
+	if _, ok := system.resolvePlantingCell(components.PlantPeashooter, 4, 2); !ok {
+		t.Error("睡莲上应能种陆生植物")
+	}
+
+	if err := system.occupyPlantingCells(components.PlantPeashooter, 4, 2, em.CreateEntity()); err != nil {
+		t.Fatalf("Failed to occupy cell: %v", err)
+	}
+	if _, ok := system.resolvePlantingCell(components.PlantPeashooter, 4, 2); ok {
+		t.Error("睡莲上已有植物时不应再种植")
+	}
+}
+
+// TestResolvePlantingCellRoof 测试屋顶场景的种植规则：植物必须种在花盆上，花盆不能叠放
+func TestResolvePlantingCellRoof(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	gs := game.GetGameState()
+	lawnGridSystem := NewLawnGridSystem(em, config.GetLawnLayout("roof"), nil)
+	lawnGridEntityID := em.CreateEntity()
+	em.AddComponent(lawnGridEntityID, &components.LawnGridComponent{})
+
+	system := NewInputSystem(em, rm, gs, nil, 21.0, 80.0, lawnGridSystem, lawnGridEntityID)
+
+	if _, ok := system.resolvePlantingCell(components.PlantPeashooter, 2, 1); ok {
+		t.Error("屋顶上没有花盆时不应能种植物")
+	}
+	if _, ok := system.resolvePlantingCell(components.PlantFlowerPot, 2, 1); !ok {
+		t.Error("屋顶上应能种花盆")
+	}
+
+	if err := system.occupyPlantingCells(components.PlantFlowerPot, 2, 1, em.CreateEntity()); err != nil {
+		t.Fatalf("Failed to place flower pot: %v", err)
+	}
+	if _, ok := system.resolvePlantingCell(components.PlantFlowerPot, 2, 1); ok {
+		t.Error("花盆上不应再种花盆")
+	}
+	if _, ok := system.resolvePlantingCell(components.PlantPeashooter, 2, 1); !ok {
+		t.Error("花盆上应能种植物")
+	}
+}
+
+// TestFindZombieAt 测试点击僵尸的命中检测
+func TestFindZombieAt(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	gs := game.GetGameState()
+	system := NewInputSystem(em, rm, gs, nil, 21.0, 80.0, nil, 0)
+
+	zombie := func(behaviorType components.BehaviorType, x, y float64) ecs.EntityID {
+		id := em.CreateEntity()
+		em.AddComponent(id, &components.BehaviorComponent{Type: behaviorType})
+		em.AddComponent(id, &components.PositionComponent{X: x, Y: y})
+		em.AddComponent(id, &components.CollisionComponent{Width: config.ZombieCollisionWidth, Height: config.ZombieCollisionHeight})
+		return id
+	}
+
+	back := zombie(components.BehaviorZombieBasic, 500, 200)
+	front := zombie(components.BehaviorZombieConehead, 510, 300)
+	zombie(components.BehaviorZombieDying, 700, 300)
+
+	if got := system.FindZombieAt(500, 200); got != back {
+		t.Errorf("expected zombie %d at its center, got %d", back, got)
+	}
+	// 扩展的点击区域覆盖碰撞盒两侧
+	if got := system.FindZombieAt(500+config.ZombieCollisionWidth/2+config.ZombieClickPaddingX-1, 200); got != back {
+		t.Errorf("expected padded hit on zombie %d, got %d", back, got)
+	}
+	// 重叠时选中绘制在上层（Y 更大）的僵尸
+	if got := system.FindZombieAt(505, 250); got != front {
+		t.Errorf("expected front zombie %d in overlap, got %d", front, got)
+	}
+	// 死亡中的僵尸不能被点中
+	if got := system.FindZombieAt(700, 300); got != 0 {
+		t.Errorf("expected dying zombie to be ignored, got %d", got)
+	}
+	if got := system.FindZombieAt(100, 100); got != 0 {
+		t.Errorf("expected miss on empty lawn, got %d", got)
+	}
+}
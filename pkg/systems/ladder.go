@@ -0,0 +1,15 @@
+package systems
+
+import (
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// IsCarryingLadder 判断僵尸是否扛着梯子
+//
+// 扶梯僵尸扛着梯子时使用扛梯行走/啃食动画；梯子架到植物上、被打坏或被磁力菇吸走后改用普通动画
+func IsCarryingLadder(em *ecs.EntityManager, zombieID ecs.EntityID) bool {
+	accessory, ok := ecs.GetComponent[*components.AccessoryComponent](em, zombieID)
+	return ok && accessory.Has(types.AccessoryLadder)
+}
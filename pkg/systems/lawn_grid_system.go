@@ -16,7 +16,8 @@ import (
 // 支持草坪闪烁效果（教学提示）
 type LawnGridSystem struct {
 	entityManager *ecs.EntityManager
-	EnabledLanes  []int // 启用的行列表（1-based），如 [1,2,3] 表示前3行可用
+	layout        config.LawnLayout // 当前场景的草坪行布局
+	EnabledLanes  []int             // 启用的行列表（1-based），如 [1,2,3] 表示前3行可用
 
 	// 草坪闪烁效果（Story 8.2 教学）
 	flashEnabled bool    // 是否启用闪烁效果
@@ -26,11 +27,12 @@ type LawnGridSystem struct {
 // NewLawnGridSystem 创建草坪网格系统
 // 参数:
 //   - em: EntityManager 实例
+//   - layout: 当前场景的草坪行布局
 //   - enabledLanes: 启用的行列表（1-based），如 [1,2,3]。如果为空或 nil，默认所有5行启用
 //
 // 返回:
 //   - *LawnGridSystem: 草坪网格系统实例
-func NewLawnGridSystem(em *ecs.EntityManager, enabledLanes []int) *LawnGridSystem {
+func NewLawnGridSystem(em *ecs.EntityManager, layout config.LawnLayout, enabledLanes []int) *LawnGridSystem {
 	// 如果未指定启用的行，默认所有行启用
 	if len(enabledLanes) == 0 {
 		enabledLanes = []int{1, 2, 3, 4, 5}
@@ -38,10 +40,16 @@ func NewLawnGridSystem(em *ecs.EntityManager, enabledLanes []int) *LawnGridSyste
 
 	return &LawnGridSystem{
 		entityManager: em,
+		layout:        layout,
 		EnabledLanes:  enabledLanes,
 	}
 }
 
+// Layout 返回当前场景的草坪行布局
+func (s *LawnGridSystem) Layout() config.LawnLayout {
+	return s.layout
+}
+
 // IsOccupied 检查指定格子是否已被占用
 // 参数:
 //   - gridEntity: 草坪网格实体ID
@@ -121,9 +129,321 @@ func (s *LawnGridSystem) ReleaseCell(gridEntity ecs.EntityID, col, row int) erro
 	return nil
 }
 
+// OccupyCells 标记多格植物占用的一组水平相邻格子
+// 所有格子都有效且为空时才会占用，否则不修改任何格子
+// 参数:
+//   - gridEntity: 草坪网格实体ID
+//   - col: 最左侧格子的列索引 (0-8)
+//   - row: 行索引 (0-4)
+//   - width: 占用的列数
+//   - plantEntity: 占用这些格子的植物实体ID
+//
+// 返回:
+//   - error: 如果任一位置无效或格子已被占用，返回错误
+func (s *LawnGridSystem) OccupyCells(gridEntity ecs.EntityID, col, row, width int, plantEntity ecs.EntityID) error {
+	grid, ok := ecs.GetComponent[*components.LawnGridComponent](s.entityManager, gridEntity)
+	if !ok {
+		return fmt.Errorf("failed to get LawnGridComponent from entity %d", gridEntity)
+	}
+
+	// 先检查全部格子，避免部分占用
+	for c := col; c < col+width; c++ {
+		if !s.isValidGridPosition(c, row) {
+			return fmt.Errorf("invalid grid position: col=%d, row=%d (valid range: col 0-8, row 0-4)", c, row)
+		}
+		if grid.Occupancy[row][c] != 0 {
+			return fmt.Errorf("grid cell (%d, %d) is already occupied by entity %d", c, row, grid.Occupancy[row][c])
+		}
+	}
+
+	for c := col; c < col+width; c++ {
+		grid.Occupancy[row][c] = plantEntity
+	}
+	return nil
+}
+
+// ReplaceCells 让新植物接管一组已被占用的水平相邻格子（升级植物替换基础植物）
+// 所有格子都有效且已被占用时才会接管，否则不修改任何格子；原占用者的实体由调用方销毁
+// 参数:
+//   - gridEntity: 草坪网格实体ID
+//   - col: 最左侧格子的列索引 (0-8)
+//   - row: 行索引 (0-4)
+//   - width: 接管的列数
+//   - plantEntity: 接管这些格子的植物实体ID
+//
+// 返回:
+//   - error: 如果任一位置无效或格子为空，返回错误
+func (s *LawnGridSystem) ReplaceCells(gridEntity ecs.EntityID, col, row, width int, plantEntity ecs.EntityID) error {
+	grid, ok := ecs.GetComponent[*components.LawnGridComponent](s.entityManager, gridEntity)
+	if !ok {
+		return fmt.Errorf("failed to get LawnGridComponent from entity %d", gridEntity)
+	}
+
+	// 先检查全部格子，避免部分接管
+	for c := col; c < col+width; c++ {
+		if !s.isValidGridPosition(c, row) {
+			return fmt.Errorf("invalid grid position: col=%d, row=%d", c, row)
+		}
+		if grid.Occupancy[row][c] == 0 {
+			return fmt.Errorf("grid cell (%d, %d) has nothing to replace", c, row)
+		}
+	}
+
+	for c := col; c < col+width; c++ {
+		grid.Occupancy[row][c] = plantEntity
+	}
+	return nil
+}
+
+// ReleaseCells 清空多格植物占用的一组水平相邻格子
+// 参数:
+//   - gridEntity: 草坪网格实体ID
+//   - col: 最左侧格子的列索引 (0-8)
+//   - row: 行索引 (0-4)
+//   - width: 占用的列数
+//
+// 返回:
+//   - error: 如果任一位置无效，返回错误（有效的格子仍会被清空）
+func (s *LawnGridSystem) ReleaseCells(gridEntity ecs.EntityID, col, row, width int) error {
+	var firstErr error
+	for c := col; c < col+width; c++ {
+		if err := s.ReleaseCell(gridEntity, c, row); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// GetOccupant 返回占用指定格子的植物实体ID
+// 参数:
+//   - gridEntity: 草坪网格实体ID
+//   - col: 列索引 (0-8)
+//   - row: 行索引 (0-4)
+//
+// 返回:
+//   - ecs.EntityID: 占用该格子的植物实体ID，空格子或无效位置返回 0
+func (s *LawnGridSystem) GetOccupant(gridEntity ecs.EntityID, col, row int) ecs.EntityID {
+	if !s.isValidGridPosition(col, row) {
+		return 0
+	}
+
+	grid, ok := ecs.GetComponent[*components.LawnGridComponent](s.entityManager, gridEntity)
+	if !ok {
+		return 0
+	}
+
+	return grid.Occupancy[row][col]
+}
+
+// LayIce 在指定格子上铺设冰道（雪橇车僵尸经过时调用）
+// 已有冰道的格子重新计时，雪橇车离开后冰道保留 ZomboniIceTrailDuration 秒
+// 参数:
+//   - gridEntity: 草坪网格实体ID
+//   - col: 列索引 (0-8)
+//   - row: 行索引 (0-4)
+func (s *LawnGridSystem) LayIce(gridEntity ecs.EntityID, col, row int) {
+	if !s.isValidGridPosition(col, row) {
+		return
+	}
+
+	grid, ok := ecs.GetComponent[*components.LawnGridComponent](s.entityManager, gridEntity)
+	if !ok {
+		return
+	}
+
+	grid.Ice[row][col] = config.ZomboniIceTrailDuration
+}
+
+// HasIce 检查指定格子上是否有冰道
+// 参数:
+//   - gridEntity: 草坪网格实体ID
+//   - col: 列索引 (0-8)
+//   - row: 行索引 (0-4)
+//
+// 返回:
+//   - bool: true 表示格子上有冰道（不能种植），无效位置返回 false
+func (s *LawnGridSystem) HasIce(gridEntity ecs.EntityID, col, row int) bool {
+	if !s.isValidGridPosition(col, row) {
+		return false
+	}
+
+	grid, ok := ecs.GetComponent[*components.LawnGridComponent](s.entityManager, gridEntity)
+	if !ok {
+		return false
+	}
+
+	return grid.Ice[row][col] > 0
+}
+
+// PlaceGrave 在指定格子上放置墓碑
+// 参数:
+//   - gridEntity: 草坪网格实体ID
+//   - col: 列索引 (0-8)
+//   - row: 行索引 (0-4)
+//   - graveEntity: 墓碑实体ID
+//
+// 返回:
+//   - error: 如果位置无效或格子上已有墓碑，返回错误
+func (s *LawnGridSystem) PlaceGrave(gridEntity ecs.EntityID, col, row int, graveEntity ecs.EntityID) error {
+	if !s.isValidGridPosition(col, row) {
+		return fmt.Errorf("invalid grid position: col=%d, row=%d (valid range: col 0-8, row 0-4)", col, row)
+	}
+
+	grid, ok := ecs.GetComponent[*components.LawnGridComponent](s.entityManager, gridEntity)
+	if !ok {
+		return fmt.Errorf("failed to get LawnGridComponent from entity %d", gridEntity)
+	}
+
+	if grid.Graves[row][col] != 0 {
+		return fmt.Errorf("grid cell (%d, %d) already has grave entity %d", col, row, grid.Graves[row][col])
+	}
+
+	grid.Graves[row][col] = graveEntity
+	return nil
+}
+
+// RemoveGrave 移除指定格子上的墓碑记录（墓碑实体由调用方删除）
+func (s *LawnGridSystem) RemoveGrave(gridEntity ecs.EntityID, col, row int) {
+	if !s.isValidGridPosition(col, row) {
+		return
+	}
+
+	grid, ok := ecs.GetComponent[*components.LawnGridComponent](s.entityManager, gridEntity)
+	if !ok {
+		return
+	}
+
+	grid.Graves[row][col] = 0
+}
+
+// GetGrave 获取指定格子上的墓碑实体
+// 返回:
+//   - ecs.EntityID: 墓碑实体ID，没有墓碑或位置无效时返回 0
+func (s *LawnGridSystem) GetGrave(gridEntity ecs.EntityID, col, row int) ecs.EntityID {
+	if !s.isValidGridPosition(col, row) {
+		return 0
+	}
+
+	grid, ok := ecs.GetComponent[*components.LawnGridComponent](s.entityManager, gridEntity)
+	if !ok {
+		return 0
+	}
+
+	return grid.Graves[row][col]
+}
+
+// HasGrave 检查指定格子上是否有墓碑
+func (s *LawnGridSystem) HasGrave(gridEntity ecs.EntityID, col, row int) bool {
+	return s.GetGrave(gridEntity, col, row) != 0
+}
+
+// PlaceLilyPad 在指定水路格子上放置睡莲
+// 参数:
+//   - gridEntity: 草坪网格实体ID
+//   - col: 列索引 (0-8)
+//   - row: 行索引 (0-5)
+//   - lilyPadEntity: 睡莲实体ID
+//
+// 返回:
+//   - error: 如果位置无效、不是水路或格子上已有平台，返回错误
+func (s *LawnGridSystem) PlaceLilyPad(gridEntity ecs.EntityID, col, row int, lilyPadEntity ecs.EntityID) error {
+	if !s.isValidGridPosition(col, row) {
+		return fmt.Errorf("invalid grid position: col=%d, row=%d (valid range: col 0-8, row 0-%d)", col, row, s.layout.Rows-1)
+	}
+
+	if !s.IsWater(row) {
+		return fmt.Errorf("grid cell (%d, %d) is not a water cell", col, row)
+	}
+
+	grid, ok := ecs.GetComponent[*components.LawnGridComponent](s.entityManager, gridEntity)
+	if !ok {
+		return fmt.Errorf("failed to get LawnGridComponent from entity %d", gridEntity)
+	}
+
+	if grid.Platforms[row][col] != 0 {
+		return fmt.Errorf("grid cell (%d, %d) already has platform entity %d", col, row, grid.Platforms[row][col])
+	}
+
+	grid.Platforms[row][col] = lilyPadEntity
+	return nil
+}
+
+// PlaceFlowerPot 在指定陆地格子上放置花盆
+// 参数:
+//   - gridEntity: 草坪网格实体ID
+//   - col: 列索引 (0-8)
+//   - row: 行索引 (0-5)
+//   - flowerPotEntity: 花盆实体ID
+//
+// 返回:
+//   - error: 如果位置无效、是水路或格子上已有平台，返回错误
+func (s *LawnGridSystem) PlaceFlowerPot(gridEntity ecs.EntityID, col, row int, flowerPotEntity ecs.EntityID) error {
+	if !s.isValidGridPosition(col, row) {
+		return fmt.Errorf("invalid grid position: col=%d, row=%d (valid range: col 0-8, row 0-%d)", col, row, s.layout.Rows-1)
+	}
+
+	if s.IsWater(row) {
+		return fmt.Errorf("grid cell (%d, %d) is a water cell", col, row)
+	}
+
+	grid, ok := ecs.GetComponent[*components.LawnGridComponent](s.entityManager, gridEntity)
+	if !ok {
+		return fmt.Errorf("failed to get LawnGridComponent from entity %d", gridEntity)
+	}
+
+	if grid.Platforms[row][col] != 0 {
+		return fmt.Errorf("grid cell (%d, %d) already has platform entity %d", col, row, grid.Platforms[row][col])
+	}
+
+	grid.Platforms[row][col] = flowerPotEntity
+	return nil
+}
+
+// RemovePlatform 移除指定格子上的种植平台记录（睡莲、花盆实体由调用方删除）
+func (s *LawnGridSystem) RemovePlatform(gridEntity ecs.EntityID, col, row int) {
+	if !s.isValidGridPosition(col, row) {
+		return
+	}
+
+	grid, ok := ecs.GetComponent[*components.LawnGridComponent](s.entityManager, gridEntity)
+	if !ok {
+		return
+	}
+
+	grid.Platforms[row][col] = 0
+}
+
+// GetPlatform 获取指定格子上的种植平台实体（睡莲、花盆）
+// 返回:
+//   - ecs.EntityID: 平台实体ID，没有平台或位置无效时返回 0
+func (s *LawnGridSystem) GetPlatform(gridEntity ecs.EntityID, col, row int) ecs.EntityID {
+	if !s.isValidGridPosition(col, row) {
+		return 0
+	}
+
+	grid, ok := ecs.GetComponent[*components.LawnGridComponent](s.entityManager, gridEntity)
+	if !ok {
+		return 0
+	}
+
+	return grid.Platforms[row][col]
+}
+
+// HasPlatform 检查指定格子上是否有种植平台（睡莲、花盆）
+func (s *LawnGridSystem) HasPlatform(gridEntity ecs.EntityID, col, row int) bool {
+	return s.GetPlatform(gridEntity, col, row) != 0
+}
+
+// IsWater 检查指定行是否为水路（泳池场景）
+// 参数:
+//   - row: 行索引（0-based）
+func (s *LawnGridSystem) IsWater(row int) bool {
+	return s.layout.IsWaterRow(row)
+}
+
 // isValidGridPosition 检查网格位置是否有效
 func (s *LawnGridSystem) isValidGridPosition(col, row int) bool {
-	return col >= 0 && col < config.GridColumns && row >= 0 && row < config.GridRows
+	return col >= 0 && col < config.GridColumns && row >= 0 && row < s.layout.Rows
 }
 
 // IsLaneEnabled 检查指定行是否启用（Story 8.1）
@@ -135,7 +455,7 @@ func (s *LawnGridSystem) isValidGridPosition(col, row int) bool {
 func (s *LawnGridSystem) IsLaneEnabled(lane int) bool {
 	// 如果未设置 EnabledLanes，默认所有行启用
 	if len(s.EnabledLanes) == 0 {
-		return lane >= 1 && lane <= config.GridRows
+		return lane >= 1 && lane <= s.layout.Rows
 	}
 
 	// 检查行是否在启用列表中
@@ -162,11 +482,31 @@ func (s *LawnGridSystem) DisableFlash() {
 	s.flashTime = 0
 }
 
-// Update 更新草坪闪烁动画
+// Update 更新草坪闪烁动画和冰道计时
 func (s *LawnGridSystem) Update(dt float64) {
 	if s.flashEnabled {
 		s.flashTime += dt
 	}
+	s.updateIce(dt)
+}
+
+// updateIce 推进所有格子上冰道的计时，到期的冰道消失
+func (s *LawnGridSystem) updateIce(dt float64) {
+	for _, gridEntity := range ecs.GetEntitiesWith1[*components.LawnGridComponent](s.entityManager) {
+		grid, _ := ecs.GetComponent[*components.LawnGridComponent](s.entityManager, gridEntity)
+		for row := range grid.Ice {
+			for col := range grid.Ice[row] {
+				if grid.Ice[row][col] <= 0 {
+					continue
+				}
+				grid.Ice[row][col] -= dt
+				if grid.Ice[row][col] <= 0 {
+					grid.Ice[row][col] = 0
+					log.Printf("[LawnGridSystem] 冰道消失: col=%d, row=%d", col, row)
+				}
+			}
+		}
+	}
 }
 
 // GetFlashAlpha 获取当前闪烁效果的 alpha 值
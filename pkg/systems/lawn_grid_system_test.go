@@ -4,13 +4,14 @@ import (
 	"testing"
 
 	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
 	"github.com/gonewx/pvz/pkg/ecs"
 )
 
 // TestIsOccupied 测试占用检测功能
 func TestIsOccupied(t *testing.T) {
 	em := ecs.NewEntityManager()
-	system := NewLawnGridSystem(em, nil)
+	system := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil)
 
 	// 创建草坪网格实体
 	gridEntity := em.CreateEntity()
@@ -39,7 +40,7 @@ func TestIsOccupied(t *testing.T) {
 // TestOccupyCell 测试标记格子占用
 func TestOccupyCell(t *testing.T) {
 	em := ecs.NewEntityManager()
-	system := NewLawnGridSystem(em, nil)
+	system := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil)
 
 	// 创建草坪网格实体
 	gridEntity := em.CreateEntity()
@@ -76,7 +77,7 @@ func TestOccupyCell(t *testing.T) {
 // TestReleaseCell 测试释放格子
 func TestReleaseCell(t *testing.T) {
 	em := ecs.NewEntityManager()
-	system := NewLawnGridSystem(em, nil)
+	system := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil)
 
 	// 创建草坪网格实体
 	gridEntity := em.CreateEntity()
@@ -115,7 +116,7 @@ func TestReleaseCell(t *testing.T) {
 // TestBoundaryChecks 测试边界检查
 func TestBoundaryChecks(t *testing.T) {
 	em := ecs.NewEntityManager()
-	system := NewLawnGridSystem(em, nil)
+	system := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil)
 
 	// 创建草坪网格实体
 	gridEntity := em.CreateEntity()
@@ -161,7 +162,7 @@ func TestBoundaryChecks(t *testing.T) {
 // TestValidBoundaries 测试有效边界值
 func TestValidBoundaries(t *testing.T) {
 	em := ecs.NewEntityManager()
-	system := NewLawnGridSystem(em, nil)
+	system := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil)
 
 	// 创建草坪网格实体
 	gridEntity := em.CreateEntity()
@@ -199,7 +200,7 @@ func TestValidBoundaries(t *testing.T) {
 // TestMultipleOccupations 测试多个格子的占用
 func TestMultipleOccupations(t *testing.T) {
 	em := ecs.NewEntityManager()
-	system := NewLawnGridSystem(em, nil)
+	system := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil)
 
 	// 创建草坪网格实体
 	gridEntity := em.CreateEntity()
@@ -329,7 +330,7 @@ func TestIsLaneEnabled(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			system := NewLawnGridSystem(em, tt.enabledLanes)
+			system := NewLawnGridSystem(em, config.GetLawnLayout("day"), tt.enabledLanes)
 			result := system.IsLaneEnabled(tt.testLane)
 
 			if result != tt.expected {
@@ -345,7 +346,7 @@ func TestLawnGridSystemWithEnabledLanes(t *testing.T) {
 	em := ecs.NewEntityManager()
 
 	// 只启用前3行
-	system := NewLawnGridSystem(em, []int{1, 2, 3})
+	system := NewLawnGridSystem(em, config.GetLawnLayout("day"), []int{1, 2, 3})
 
 	// 验证 EnabledLanes 字段正确设置
 	if len(system.EnabledLanes) != 3 {
@@ -366,3 +367,171 @@ func TestLawnGridSystemWithEnabledLanes(t *testing.T) {
 		}
 	}
 }
+
+// TestOccupyCellsMultiCellFootprint 测试多格植物占用和释放
+func TestOccupyCellsMultiCellFootprint(t *testing.T) {
+	em := ecs.NewEntityManager()
+	system := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil)
+
+	gridEntity := em.CreateEntity()
+	gridComp := &components.LawnGridComponent{}
+	em.AddComponent(gridEntity, gridComp)
+
+	plantEntity := em.CreateEntity()
+
+	// 占用两个相邻格子
+	if err := system.OccupyCells(gridEntity, 3, 1, 2, plantEntity); err != nil {
+		t.Fatalf("Failed to occupy cells: %v", err)
+	}
+	for col := 3; col <= 4; col++ {
+		if system.GetOccupant(gridEntity, col, 1) != plantEntity {
+			t.Errorf("Cell (%d, 1) should be occupied by %d", col, plantEntity)
+		}
+	}
+	if system.IsOccupied(gridEntity, 5, 1) {
+		t.Error("Cell (5, 1) should not be occupied")
+	}
+
+	// 与已占用格子重叠时不应部分占用
+	other := em.CreateEntity()
+	if err := system.OccupyCells(gridEntity, 4, 1, 2, other); err == nil {
+		t.Error("Expected error when footprint overlaps an occupied cell")
+	}
+	if system.IsOccupied(gridEntity, 5, 1) {
+		t.Error("Failed OccupyCells should not leave cell (5, 1) occupied")
+	}
+
+	// 超出草坪右边界
+	if err := system.OccupyCells(gridEntity, 8, 2, 2, other); err == nil {
+		t.Error("Expected error when footprint exceeds the lawn")
+	}
+	if system.IsOccupied(gridEntity, 8, 2) {
+		t.Error("Failed OccupyCells should not leave cell (8, 2) occupied")
+	}
+
+	// 释放全部格子
+	if err := system.ReleaseCells(gridEntity, 3, 1, 2); err != nil {
+		t.Fatalf("Failed to release cells: %v", err)
+	}
+	for col := 3; col <= 4; col++ {
+		if system.IsOccupied(gridEntity, col, 1) {
+			t.Errorf("Cell (%d, 1) should be released", col)
+		}
+	}
+}
+
+// TestIceTrailFades 测试冰道铺设、重新计时和到期消失
+func TestIceTrailFades(t *testing.T) {
+	em := ecs.NewEntityManager()
+	system := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil)
+
+	gridEntity := em.CreateEntity()
+	em.AddComponent(gridEntity, &components.LawnGridComponent{})
+
+	system.LayIce(gridEntity, 6, 2)
+	system.LayIce(gridEntity, 9, 2) // 草坪外，忽略
+	if !system.HasIce(gridEntity, 6, 2) {
+		t.Fatal("Cell (6, 2) should have ice")
+	}
+	if system.HasIce(gridEntity, 5, 2) || system.HasIce(gridEntity, 6, 3) {
+		t.Error("Ice should only cover the laid cell")
+	}
+
+	// 重新铺设后重新计时
+	system.Update(config.ZomboniIceTrailDuration - 1)
+	system.LayIce(gridEntity, 6, 2)
+	system.Update(config.ZomboniIceTrailDuration - 1)
+	if !system.HasIce(gridEntity, 6, 2) {
+		t.Fatal("Relaid ice should restart its timer")
+	}
+
+	system.Update(1)
+	if system.HasIce(gridEntity, 6, 2) {
+		t.Error("Ice should fade after ZomboniIceTrailDuration")
+	}
+}
+
+func TestPlaceAndRemoveGrave(t *testing.T) {
+	em := ecs.NewEntityManager()
+	system := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil)
+
+	gridEntity := em.CreateEntity()
+	em.AddComponent(gridEntity, &components.LawnGridComponent{})
+
+	graveID := em.CreateEntity()
+	if err := system.PlaceGrave(gridEntity, 7, 1, graveID); err != nil {
+		t.Fatalf("PlaceGrave failed: %v", err)
+	}
+	if !system.HasGrave(gridEntity, 7, 1) || system.GetGrave(gridEntity, 7, 1) != graveID {
+		t.Fatal("Cell (7, 1) should have the grave")
+	}
+	if system.HasGrave(gridEntity, 6, 1) {
+		t.Error("Grave should only cover its own cell")
+	}
+	if err := system.PlaceGrave(gridEntity, 7, 1, em.CreateEntity()); err == nil {
+		t.Error("Placing a second grave on the same cell should fail")
+	}
+	if err := system.PlaceGrave(gridEntity, 9, 1, em.CreateEntity()); err == nil {
+		t.Error("Placing a grave outside the lawn should fail")
+	}
+
+	system.RemoveGrave(gridEntity, 7, 1)
+	if system.HasGrave(gridEntity, 7, 1) {
+		t.Error("Grave should be removed")
+	}
+}
+
+func TestPlaceAndRemoveLilyPad(t *testing.T) {
+	em := ecs.NewEntityManager()
+	system := NewLawnGridSystem(em, config.GetLawnLayout("pool"), nil)
+
+	gridEntity := em.CreateEntity()
+	em.AddComponent(gridEntity, &components.LawnGridComponent{})
+
+	lilyPadID := em.CreateEntity()
+	if err := system.PlaceLilyPad(gridEntity, 4, 2, lilyPadID); err != nil {
+		t.Fatalf("PlaceLilyPad failed: %v", err)
+	}
+	if !system.HasPlatform(gridEntity, 4, 2) || system.GetPlatform(gridEntity, 4, 2) != lilyPadID {
+		t.Fatal("Cell (4, 2) should have the lily pad")
+	}
+	if system.IsOccupied(gridEntity, 4, 2) {
+		t.Error("Lily pad should leave the cell free for a plant on top")
+	}
+	if err := system.PlaceLilyPad(gridEntity, 4, 2, em.CreateEntity()); err == nil {
+		t.Error("Placing a second lily pad on the same cell should fail")
+	}
+	if err := system.PlaceLilyPad(gridEntity, 4, 1, em.CreateEntity()); err == nil {
+		t.Error("Placing a lily pad on a land row should fail")
+	}
+	if err := system.PlaceLilyPad(gridEntity, 4, 5, em.CreateEntity()); err == nil {
+		t.Error("Placing a lily pad on the bottom land row should fail")
+	}
+
+	system.RemovePlatform(gridEntity, 4, 2)
+	if system.HasPlatform(gridEntity, 4, 2) {
+		t.Error("Lily pad should be removed")
+	}
+}
+
+func TestPlaceFlowerPot(t *testing.T) {
+	em := ecs.NewEntityManager()
+	system := NewLawnGridSystem(em, config.GetLawnLayout("pool"), nil)
+
+	gridEntity := em.CreateEntity()
+	em.AddComponent(gridEntity, &components.LawnGridComponent{})
+
+	potID := em.CreateEntity()
+	if err := system.PlaceFlowerPot(gridEntity, 3, 1, potID); err != nil {
+		t.Fatalf("PlaceFlowerPot failed: %v", err)
+	}
+	if system.GetPlatform(gridEntity, 3, 1) != potID || system.IsOccupied(gridEntity, 3, 1) {
+		t.Error("Flower pot should be recorded as a platform and leave the cell free")
+	}
+	if err := system.PlaceFlowerPot(gridEntity, 3, 1, em.CreateEntity()); err == nil {
+		t.Error("Placing a second flower pot on the same cell should fail")
+	}
+	if err := system.PlaceFlowerPot(gridEntity, 3, 2, em.CreateEntity()); err == nil {
+		t.Error("Placing a flower pot on a water row should fail")
+	}
+}
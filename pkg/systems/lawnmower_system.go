@@ -24,6 +24,7 @@ type LawnmowerSystem struct {
 	resourceManager *game.ResourceManager // 用于播放音效
 	gameState       *game.GameState       // 用于增加消灭僵尸计数
 	stateEntityID   ecs.EntityID          // 全局状态实体ID
+	layout          config.LawnLayout     // 当前场景的草坪行布局
 }
 
 // NewLawnmowerSystem 创建除草车系统
@@ -31,10 +32,11 @@ type LawnmowerSystem struct {
 //   - em: EntityManager 实例
 //   - rm: ResourceManager 实例（用于播放音效和粒子效果）
 //   - gs: GameState 实例（用于记录游戏统计）
+//   - layout: 当前场景的草坪行布局
 //
 // 返回:
 //   - *LawnmowerSystem: 除草车系统实例
-func NewLawnmowerSystem(em *ecs.EntityManager, rm *game.ResourceManager, gs *game.GameState) *LawnmowerSystem {
+func NewLawnmowerSystem(em *ecs.EntityManager, rm *game.ResourceManager, gs *game.GameState, layout config.LawnLayout) *LawnmowerSystem {
 	// 创建全局状态实体
 	stateEntity := em.CreateEntity()
 	ecs.AddComponent(em, stateEntity, &components.LawnmowerStateComponent{
@@ -46,6 +48,7 @@ func NewLawnmowerSystem(em *ecs.EntityManager, rm *game.ResourceManager, gs *gam
 		resourceManager: rm,
 		gameState:       gs,
 		stateEntityID:   stateEntity,
+		layout:          layout,
 	}
 }
 
@@ -146,8 +149,8 @@ func (s *LawnmowerSystem) checkTriggerConditions() {
 		// 计算除草车的碰撞边界
 		lawnmowerLeft := lawnmowerPos.X - config.LawnmowerWidth/2
 		lawnmowerRight := lawnmowerPos.X + config.LawnmowerWidth/2
-		laneTop := config.GridWorldStartY + float64(lawnmower.Lane-1)*config.CellHeight
-		laneBottom := laneTop + config.CellHeight
+		laneTop := s.layout.StartY + float64(lawnmower.Lane-1)*s.layout.CellHeight
+		laneBottom := laneTop + s.layout.CellHeight
 
 		// 检测该除草车与所有僵尸的碰撞
 		for _, zombieID := range zombieEntities {
@@ -159,14 +162,30 @@ func (s *LawnmowerSystem) checkTriggerConditions() {
 				continue
 			}
 
+			// 被魅惑的僵尸不会触发除草车
+			if IsCharmedZombie(s.entityManager, zombieID) {
+				continue
+			}
+
+			// 地下的僵尸（矿工僵尸）从除草车下方穿过
+			if IsUndergroundZombie(s.entityManager, zombieID) {
+				continue
+			}
+
+			// 蹦极僵尸不属于任何行，不会触发除草车
+			if IsBungeeZombie(s.entityManager, zombieID) {
+				continue
+			}
+
 			// 只检查已激活的僵尸
 			waveState, hasWaveState := ecs.GetComponent[*components.ZombieWaveStateComponent](s.entityManager, zombieID)
 			if hasWaveState && !waveState.IsActivated {
 				continue
 			}
 
-			// Y 方向碰撞检测：检查僵尸是否在同一行
-			if zombiePos.Y < laneTop || zombiePos.Y > laneBottom {
+			// Y 方向碰撞检测：检查僵尸是否在同一行（屋顶斜坡上需减去斜坡偏移）
+			zombieY := zombiePos.Y - s.layout.SlopeOffsetY(zombiePos.X)
+			if zombieY < laneTop || zombieY > laneBottom {
 				continue
 			}
 
@@ -220,7 +239,11 @@ func (s *LawnmowerSystem) triggerLawnmowerByID(lawnmowerID ecs.EntityID, lawnmow
 
 	// 播放音效（使用 AudioManager 统一管理 - Story 10.9）
 	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
-		audioManager.PlaySound("SOUND_LAWNMOWER")
+		if lawnmower.IsPoolCleaner {
+			audioManager.PlaySound("SOUND_POOL_CLEANER")
+		} else {
+			audioManager.PlaySound("SOUND_LAWNMOWER")
+		}
 	}
 
 	// 恢复动画播放（触发后开始播放车轮滚动动画）
@@ -230,6 +253,14 @@ func (s *LawnmowerSystem) triggerLawnmowerByID(lawnmowerID ecs.EntityID, lawnmow
 		log.Printf("[LawnmowerSystem] Resumed animation for lawnmower on lane %d", lawnmower.Lane)
 	}
 
+	// 泳池清洁车驶入水中
+	if lawnmower.IsPoolCleaner {
+		ecs.AddComponent(s.entityManager, lawnmowerID, &components.AnimationCommandComponent{
+			AnimationName: "anim_water",
+			Processed:     false,
+		})
+	}
+
 	log.Printf("[LawnmowerSystem] Lawnmower triggered on lane %d (collision detected)", lawnmower.Lane)
 }
 
@@ -289,6 +320,21 @@ func (s *LawnmowerSystem) checkZombieCollisions() {
 				continue
 			}
 
+			// 被魅惑的僵尸与除草车同一阵营，不会被碾压
+			if IsCharmedZombie(s.entityManager, zombieID) {
+				continue
+			}
+
+			// 地下的僵尸（矿工僵尸）不会被碾压
+			if IsUndergroundZombie(s.entityManager, zombieID) {
+				continue
+			}
+
+			// 蹦极僵尸不属于任何行，不会被碾压
+			if IsBungeeZombie(s.entityManager, zombieID) {
+				continue
+			}
+
 			// 跳过已死亡的僵尸
 			if health.CurrentHealth <= 0 {
 				continue
@@ -303,11 +349,12 @@ func (s *LawnmowerSystem) checkZombieCollisions() {
 
 			// Y 方向碰撞检测：检查僵尸中心点是否在除草车所在行的网格范围内
 			// 除草车所在行的 Y 范围
-			laneTop := config.GridWorldStartY + float64(lawnmower.Lane-1)*config.CellHeight
-			laneBottom := laneTop + config.CellHeight
+			laneTop := s.layout.StartY + float64(lawnmower.Lane-1)*s.layout.CellHeight
+			laneBottom := laneTop + s.layout.CellHeight
 
-			// 使用僵尸中心点判断是否在同一行
-			if zombiePos.Y < laneTop || zombiePos.Y > laneBottom {
+			// 使用僵尸中心点判断是否在同一行（屋顶斜坡上需减去斜坡偏移）
+			zombieY := zombiePos.Y - s.layout.SlopeOffsetY(zombiePos.X)
+			if zombieY < laneTop || zombieY > laneBottom {
 				continue
 			}
 
@@ -331,6 +378,12 @@ func (s *LawnmowerSystem) checkZombieCollisions() {
 				log.Printf("[LawnmowerSystem] Lawnmower on lane %d killed zombie at (%.1f, %.1f)",
 					lawnmower.Lane, zombiePos.X, zombiePos.Y)
 
+				// 泳池清扫车把僵尸卷入水中，没有碾压压扁动画
+				if lawnmower.IsPoolCleaner {
+					s.triggerZombieDeathFallback(zombieID)
+					continue
+				}
+
 				// 触发僵尸死亡（播放动画和粒子效果）
 				s.triggerZombieDeath(zombieID)
 			}
@@ -377,10 +430,10 @@ func (s *LawnmowerSystem) checkLawnmowerCompletion() {
 //   - int: 行号（1-5）
 func (s *LawnmowerSystem) getEntityLane(y float64) int {
 	// 计算相对于网格起点的Y偏移
-	offsetY := y - config.GridWorldStartY
+	offsetY := y - s.layout.StartY
 
 	// 计算行号（0-4）
-	row := int(offsetY / config.CellHeight)
+	row := int(offsetY / s.layout.CellHeight)
 
 	// 转换为行号（1-5）
 	lane := row + 1
@@ -389,8 +442,8 @@ func (s *LawnmowerSystem) getEntityLane(y float64) int {
 	if lane < 1 {
 		lane = 1
 	}
-	if lane > 5 {
-		lane = 5
+	if lane > s.layout.Rows {
+		lane = s.layout.Rows
 	}
 
 	return lane
@@ -456,7 +509,7 @@ func (s *LawnmowerSystem) triggerZombieDeath(zombieID ecs.EntityID) {
 	// 2. 获取对应的除草车实体ID（用于跟随移动）
 	lawnmowers := ecs.GetEntitiesWith1[*components.LawnmowerComponent](s.entityManager)
 	var lawnmowerID ecs.EntityID = 0
-	zombieLane := s.getEntityLane(position.Y)
+	zombieLane := s.getEntityLane(position.Y - s.layout.SlopeOffsetY(position.X))
 
 	for _, lwID := range lawnmowers {
 		lawnmower, _ := ecs.GetComponent[*components.LawnmowerComponent](s.entityManager, lwID)
@@ -15,7 +15,7 @@ func TestNewLawnmowerSystem(t *testing.T) {
 	rm := &game.ResourceManager{}
 	gs := game.GetGameState()
 
-	system := NewLawnmowerSystem(em, rm, gs)
+	system := NewLawnmowerSystem(em, rm, gs, config.GetLawnLayout("day"))
 
 	if system == nil {
 		t.Fatal("NewLawnmowerSystem should return non-nil")
@@ -49,7 +49,7 @@ func TestNewLawnmowerSystem(t *testing.T) {
 // TestLawnmowerSystemGetEntityLane 测试计算实体所在行
 func TestLawnmowerSystemGetEntityLane(t *testing.T) {
 	em := ecs.NewEntityManager()
-	system := NewLawnmowerSystem(em, nil, nil)
+	system := NewLawnmowerSystem(em, nil, nil, config.GetLawnLayout("day"))
 
 	tests := []struct {
 		y        float64
@@ -76,7 +76,7 @@ func TestLawnmowerSystemGetEntityLane(t *testing.T) {
 // TestLawnmowerSystemUpdatePosition 测试除草车位置更新
 func TestLawnmowerSystemUpdatePosition(t *testing.T) {
 	em := ecs.NewEntityManager()
-	system := NewLawnmowerSystem(em, nil, nil)
+	system := NewLawnmowerSystem(em, nil, nil, config.GetLawnLayout("day"))
 
 	// 创建一个移动中的除草车
 	lawnmowerID := em.CreateEntity()
@@ -105,7 +105,7 @@ func TestLawnmowerSystemUpdatePosition(t *testing.T) {
 // TestLawnmowerSystemCompletion 测试除草车离开屏幕检测
 func TestLawnmowerSystemCompletion(t *testing.T) {
 	em := ecs.NewEntityManager()
-	system := NewLawnmowerSystem(em, nil, nil)
+	system := NewLawnmowerSystem(em, nil, nil, config.GetLawnLayout("day"))
 
 	// 创建一个移动中的除草车（已经离开屏幕）
 	lawnmowerID := em.CreateEntity()
@@ -168,7 +168,7 @@ func TestLawnmowerSystemZombieCollision(t *testing.T) {
 	gs := game.GetGameState()
 	// Story 10.6: ResourceManager 设为 nil，测试回退逻辑
 	// 预期行为：加载 locator 失败，回退到 BehaviorZombieDying
-	system := NewLawnmowerSystem(em, nil, gs)
+	system := NewLawnmowerSystem(em, nil, gs, config.GetLawnLayout("day"))
 
 	// 创建移动中的除草车（第3行）
 	lawnmowerID := em.CreateEntity()
@@ -242,7 +242,7 @@ func TestLawnmowerSystemZombieCollision(t *testing.T) {
 func TestLawnmowerSystemZombieNoCollision(t *testing.T) {
 	em := ecs.NewEntityManager()
 	gs := game.GetGameState()
-	system := NewLawnmowerSystem(em, nil, gs)
+	system := NewLawnmowerSystem(em, nil, gs, config.GetLawnLayout("day"))
 
 	// 创建移动中的除草车（第3行）
 	lawnmowerID := em.CreateEntity()
@@ -290,7 +290,7 @@ func TestLawnmowerSystem_SquashAnimation(t *testing.T) {
 	em := ecs.NewEntityManager()
 	rm := &game.ResourceManager{}
 	gs := game.GetGameState()
-	system := NewLawnmowerSystem(em, rm, gs)
+	system := NewLawnmowerSystem(em, rm, gs, config.GetLawnLayout("day"))
 
 	// 创建测试用的除草车实体
 	lawnmowerID := em.CreateEntity()
@@ -432,7 +432,7 @@ func TestSquashAnimation_EdgeCases(t *testing.T) {
 	em := ecs.NewEntityManager()
 	rm := &game.ResourceManager{}
 	gs := game.GetGameState()
-	system := NewLawnmowerSystem(em, rm, gs)
+	system := NewLawnmowerSystem(em, rm, gs, config.GetLawnLayout("day"))
 
 	t.Run("空帧数组", func(t *testing.T) {
 		zombieID := em.CreateEntity()
@@ -553,7 +553,7 @@ func TestSquashAnimation_EdgeCases(t *testing.T) {
 func TestLawnmowerSystem_EarlyParticleTrigger(t *testing.T) {
 	em := ecs.NewEntityManager()
 	// ResourceManager 为 nil，测试隐藏 tracks 逻辑是否独立执行
-	system := NewLawnmowerSystem(em, nil, game.GetGameState())
+	system := NewLawnmowerSystem(em, nil, game.GetGameState(), config.GetLawnLayout("day"))
 
 	zombieID := em.CreateEntity()
 	ecs.AddComponent(em, zombieID, &components.PositionComponent{X: 100, Y: 200})
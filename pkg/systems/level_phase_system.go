@@ -31,7 +31,6 @@ type LevelPhaseSystem struct {
 
 	// 外部系统回调（遵循零耦合原则）
 	onDisableGuidedTutorial func()                  // 关闭强引导模式回调
-	onActivateBowling       func()                  // 激活保龄球阶段回调
 	onTransitionComplete    func()                  // 转场完成回调
 	daveDialogueKeys        []string                // Dave 对话文本 keys
 	resourceLoader          entities.ResourceLoader // 资源加载器接口
@@ -123,8 +122,8 @@ func (s *LevelPhaseSystem) Update(dt float64) {
 		s.executeShowRedLine(phaseComp)
 
 	case components.TransitionStepActivateBowling:
-		// 步骤 5: 激活保龄球阶段
-		s.executeActivateBowling(phaseComp)
+		// 步骤 5: 激活下一阶段
+		s.executeActivatePhase(phaseComp)
 	}
 }
 
@@ -292,20 +291,16 @@ func (s *LevelPhaseSystem) executeShowRedLine(phaseComp *components.LevelPhaseCo
 	phaseComp.TransitionStep = components.TransitionStepActivateBowling
 }
 
-// executeActivateBowling 执行步骤5：激活保龄球阶段
-func (s *LevelPhaseSystem) executeActivateBowling(phaseComp *components.LevelPhaseComponent) {
-	log.Printf("[LevelPhaseSystem] Step 5: Activating bowling phase")
+// executeActivatePhase 执行步骤5：激活下一阶段
+// 下一阶段需要启动的系统（如保龄球的传送带）由转场完成回调负责
+func (s *LevelPhaseSystem) executeActivatePhase(phaseComp *components.LevelPhaseComponent) {
+	log.Printf("[LevelPhaseSystem] Step 5: Activating phase 2")
 
 	// 更新阶段状态
 	phaseComp.CurrentPhase = 2
 	phaseComp.PhaseState = components.PhaseStateActive
 	phaseComp.TransitionStep = components.TransitionStepNone
 
-	// 调用回调激活保龄球阶段
-	if s.onActivateBowling != nil {
-		s.onActivateBowling()
-	}
-
 	// 调用转场完成回调
 	if s.onTransitionComplete != nil {
 		s.onTransitionComplete()
@@ -386,11 +381,6 @@ func (s *LevelPhaseSystem) SetOnDisableGuidedTutorial(callback func()) {
 	s.onDisableGuidedTutorial = callback
 }
 
-// SetOnActivateBowling 设置激活保龄球阶段回调
-func (s *LevelPhaseSystem) SetOnActivateBowling(callback func()) {
-	s.onActivateBowling = callback
-}
-
 // SetOnTransitionComplete 设置转场完成回调
 func (s *LevelPhaseSystem) SetOnTransitionComplete(callback func()) {
 	s.onTransitionComplete = callback
@@ -141,19 +141,6 @@ func TestLevelPhaseSystem_Callbacks(t *testing.T) {
 		}
 	})
 
-	t.Run("SetOnActivateBowling", func(t *testing.T) {
-		em := ecs.NewEntityManager()
-		gs := game.GetGameState()
-		system := NewLevelPhaseSystem(em, gs, nil)
-
-		system.SetOnActivateBowling(func() {})
-
-		// 验证回调被设置
-		if system.onActivateBowling == nil {
-			t.Error("Expected onActivateBowling callback to be set")
-		}
-	})
-
 	t.Run("SetOnTransitionComplete", func(t *testing.T) {
 		em := ecs.NewEntityManager()
 		gs := game.GetGameState()
@@ -332,13 +319,13 @@ func TestLevelPhaseSystem_PhaseCompletion(t *testing.T) {
 		}
 	})
 
-	t.Run("OnActivateBowling callback called on completion", func(t *testing.T) {
+	t.Run("OnTransitionComplete callback called on completion", func(t *testing.T) {
 		em := ecs.NewEntityManager()
 		gs := game.GetGameState()
 		system := NewLevelPhaseSystem(em, gs, nil)
 
 		called := false
-		system.SetOnActivateBowling(func() {
+		system.SetOnTransitionComplete(func() {
 			called = true
 		})
 
@@ -355,7 +342,7 @@ func TestLevelPhaseSystem_PhaseCompletion(t *testing.T) {
 		system.Update(0.016)
 
 		if !called {
-			t.Error("Expected OnActivateBowling callback to be called")
+			t.Error("Expected OnTransitionComplete callback to be called")
 		}
 	})
 }
@@ -1,6 +1,7 @@
 package systems
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/gonewx/pvz/pkg/components"
@@ -8,6 +9,7 @@ import (
 	"github.com/gonewx/pvz/pkg/ecs"
 	"github.com/gonewx/pvz/pkg/entities"
 	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
 )
 
 // 关卡流程常量
@@ -28,6 +30,8 @@ const (
 //   - Story 17.7: 管理旗帜波警告和最终波白字显示
 //   - Story 17.8: 血量触发加速刷新
 //   - Story 17.9: 类型化进家判定
+//   - Boss 关卡：僵王博士入场，进度条显示僵王博士损失的生命值
+//   - 生存模式：每完成一组旗帜后生成下一组波次，等待玩家重新选择植物
 //
 // 架构说明：
 //   - 通过 GameState 单例管理关卡状态
@@ -42,6 +46,7 @@ type LevelSystem struct {
 	resourceManager      *game.ResourceManager  // 用于加载 FinalWave 音效
 	rewardSystem         *RewardAnimationSystem // 用于触发奖励动画（Story 8.3）
 	lawnmowerSystem      *LawnmowerSystem       // 用于检查除草车状态（Story 10.2）
+	layout               config.LawnLayout      // 当前场景的草坪行布局
 	lastWaveWarningShown bool                   // 已废弃：使用 finalWaveWarningTriggered 代替
 
 	// Story 17.6: 是否使用新的波次计时系统
@@ -62,6 +67,18 @@ type LevelSystem struct {
 
 	// Story 17.7: 旗帜波警告系统
 	flagWaveWarningSystem *FlagWaveWarningSystem // 红字警告系统
+
+	// survivalGenerator 生存模式波次生成器（非生存模式为 nil）
+	survivalGenerator *SurvivalWaveGenerator
+
+	// awaitingPlantReselection 生存模式完成一组旗帜后，是否正在等待玩家重新选择植物
+	awaitingPlantReselection bool
+
+	// resultOverride 小游戏规则的胜负判定（返回 "win"/"lose" 时覆盖默认判定，nil 表示不覆盖）
+	resultOverride func() string
+
+	// overrideDecidesVictory 胜利只由小游戏规则判定（规则自行生成僵尸、关卡没有波次时）
+	overrideDecidesVictory bool
 }
 
 // NewLevelSystem 创建关卡管理系统
@@ -74,9 +91,10 @@ type LevelSystem struct {
 //	rm - 资源管理器（用于加载音效）
 //	rewardSystem - 奖励动画系统（可选，Story 8.3）
 //	lawnmowerSystem - 除草车系统（可选，Story 10.2）
+//	layout - 当前场景的草坪行布局
 //
 // Removed ReanimSystem dependency, using AnimationCommand component
-func NewLevelSystem(em *ecs.EntityManager, gs *game.GameState, waveSpawnSystem *WaveSpawnSystem, rm *game.ResourceManager, rewardSystem *RewardAnimationSystem, lawnmowerSystem *LawnmowerSystem) *LevelSystem {
+func NewLevelSystem(em *ecs.EntityManager, gs *game.GameState, waveSpawnSystem *WaveSpawnSystem, rm *game.ResourceManager, rewardSystem *RewardAnimationSystem, lawnmowerSystem *LawnmowerSystem, layout config.LawnLayout) *LevelSystem {
 	isTutorialLevel := gs.CurrentLevel != nil && gs.CurrentLevel.OpeningType == "tutorial"
 	// Story 19.9: 特殊开场关卡（如保龄球 Level 1-5）也需要暂停波次，等待阶段转场完成
 	isSpecialLevel := gs.CurrentLevel != nil && gs.CurrentLevel.OpeningType == "special"
@@ -88,17 +106,27 @@ func NewLevelSystem(em *ecs.EntityManager, gs *game.GameState, waveSpawnSystem *
 		resourceManager:           rm,
 		rewardSystem:              rewardSystem,
 		lawnmowerSystem:           lawnmowerSystem,
+		layout:                    layout,
 		lastWaveWarningShown:      false, // 已废弃，保留向后兼容
 		finalWaveWarningTriggered: false, // 已废弃，统一由 FlagWaveWarningSystem 处理
 		finalWaveWarningLeadTime:  3.0,   // 已废弃
 		useWaveTimingSystem:       true,  // 统一使用 WaveTimingSystem
 	}
 
+	// 生存模式：在创建波次计时前生成第一组旗帜的波次（读档时由存档中的波次替换）
+	if gs.CurrentLevel != nil && gs.CurrentLevel.Survival {
+		ls.initSurvival()
+	}
+
 	// Story 17.6+统一: 所有关卡都创建 WaveTimingSystem
 	// 教学关卡：初始暂停，等待 TutorialSystem 触发第一波后恢复
 	// 特殊开场关卡（如保龄球）：初始暂停，等待阶段转场完成后恢复
 	// 普通关卡：自动开始计时
-	if gs.CurrentLevel != nil {
+	// Boss 关卡由僵王博士的攻击循环驱动，不创建波次计时
+	if gs.CurrentLevel != nil && gs.CurrentLevel.Boss {
+		ls.useWaveTimingSystem = false
+		log.Printf("[LevelSystem] Boss level: wave flow disabled, Dr. Zomboss enters after %.0fs", config.ZombossEnterDelay)
+	} else if gs.CurrentLevel != nil {
 		ls.waveTimingSystem = NewWaveTimingSystem(em, gs, gs.CurrentLevel)
 
 		// Story 17.7: 创建旗帜波警告系统
@@ -170,11 +198,20 @@ func (s *LevelSystem) Update(deltaTime float64) {
 		s.checkAcceleratedRefresh()
 	}
 
-	// 检查并生成僵尸波次
-	s.checkAndSpawnWaves()
+	// 检查并生成僵尸波次（Boss 关卡改为控制僵王博士入场）
+	if s.gameState.CurrentLevel.Boss {
+		s.updateBossLevel()
+	} else {
+		s.checkAndSpawnWaves()
+	}
 
 	// 最后一波警告现在由 FlagWaveWarningSystem 统一处理（所有关卡类型）
 
+	// 小游戏规则的胜负判定优先于默认判定
+	if s.checkResultOverride() {
+		return
+	}
+
 	// 检查失败条件（必须在胜利条件之前，优先级更高）
 	s.checkDefeatCondition()
 
@@ -197,6 +234,103 @@ func (s *LevelSystem) Update(deltaTime float64) {
 	s.UpdateProgressBar()
 }
 
+// updateBossLevel Boss 关卡：开场 ZombossEnterDelay 秒后僵王博士入场，并显示进度条
+func (s *LevelSystem) updateBossLevel() {
+	if s.gameState.BossSpawned || s.gameState.LevelTime < config.ZombossEnterDelay {
+		return
+	}
+	s.gameState.BossSpawned = true
+
+	if s.resourceManager == nil {
+		log.Printf("[LevelSystem] Warning: no resource manager, Dr. Zomboss not spawned")
+		return
+	}
+	if _, err := entities.NewZombossEntity(s.entityManager, s.resourceManager); err != nil {
+		log.Printf("[LevelSystem] Warning: failed to spawn Dr. Zomboss: %v", err)
+		return
+	}
+	s.ShowProgressBar()
+}
+
+// initSurvival 创建生存模式波次生成器，关卡尚无波次时生成第一组旗帜
+func (s *LevelSystem) initSurvival() {
+	zombieStats, err := config.LoadZombieStats("data/zombie_stats.yaml")
+	if err != nil {
+		log.Printf("[LevelSystem] Warning: Failed to load zombie stats for survival mode: %v", err)
+	}
+	var spawnRules *config.SpawnRulesConfig
+	if s.waveSpawnSystem != nil {
+		spawnRules = s.waveSpawnSystem.spawnRules
+	}
+	s.survivalGenerator = NewSurvivalWaveGenerator(zombieStats, spawnRules, s.gameState.CurrentLevel, s.gameState.WavesPerRound)
+
+	if len(s.gameState.CurrentLevel.Waves) == 0 {
+		s.startSurvivalFlagSet()
+	}
+}
+
+// startSurvivalFlagSet 生成并开始下一组旗帜的波次
+func (s *LevelSystem) startSurvivalFlagSet() {
+	waves := s.survivalGenerator.GenerateFlagSet(s.gameState.GetCurrentRoundNumber())
+	s.gameState.StartSurvivalFlagSet(waves)
+	if s.waveSpawnSystem != nil {
+		s.waveSpawnSystem.ResetSpawnConstraints()
+	}
+}
+
+// completeSurvivalFlagSet 生存模式完成一组旗帜
+//
+// 记录坚持的旗帜数（刷新个人最佳时保存），生成下一组波次并重置波次计时和进度条；
+// 波次计时保持暂停，直到玩家重新选择植物后调用 FinishPlantReselection
+func (s *LevelSystem) completeSurvivalFlagSet() {
+	if s.gameState.CompleteSurvivalFlagSet() {
+		log.Printf("[LevelSystem] Survival: new personal best, %d flags", s.gameState.SurvivalFlags)
+	}
+
+	s.startSurvivalFlagSet()
+	if s.waveTimingSystem != nil {
+		s.waveTimingSystem.RestartWaves()
+		s.waveTimingSystem.Pause()
+	}
+	s.initializeProgressBar()
+	s.awaitingPlantReselection = true
+	s.gameState.SurvivalAwaitingReselection = true
+
+	log.Printf("[LevelSystem] Survival: %d flags survived, waiting for plant reselection", s.gameState.SurvivalFlags)
+}
+
+// IsAwaitingPlantReselection 生存模式是否正在等待玩家重新选择植物
+func (s *LevelSystem) IsAwaitingPlantReselection() bool {
+	return s.awaitingPlantReselection
+}
+
+// FinishPlantReselection 玩家完成重新选择植物，开始下一组旗帜的波次计时
+func (s *LevelSystem) FinishPlantReselection() {
+	if !s.awaitingPlantReselection {
+		return
+	}
+	s.awaitingPlantReselection = false
+	s.gameState.SurvivalAwaitingReselection = false
+	if s.waveTimingSystem != nil {
+		s.waveTimingSystem.Resume()
+	}
+	log.Printf("[LevelSystem] Survival: plant reselection finished, next flag set started")
+}
+
+// RestorePlantReselection 从存档恢复"两组旗帜之间等待重新选择植物"的状态
+//
+// 存档中的波次已是下一组旗帜的波次：重新开始这组波次的计时并保持暂停，
+// 直到玩家重新选择植物后调用 FinishPlantReselection
+func (s *LevelSystem) RestorePlantReselection() {
+	s.awaitingPlantReselection = true
+	s.gameState.SurvivalAwaitingReselection = true
+	if s.waveTimingSystem != nil {
+		s.waveTimingSystem.RestartWaves()
+		s.waveTimingSystem.Pause()
+	}
+	log.Printf("[LevelSystem] Survival: restored waiting for plant reselection (%d flags)", s.gameState.SurvivalFlags)
+}
+
 // checkAcceleratedRefresh 检查加速刷新条件
 //
 // Story 17.7: 旗帜波前一波的加速刷新逻辑（消灭触发）
@@ -257,8 +391,14 @@ func (s *LevelSystem) areCurrentWaveZombiesCleared() bool {
 		}
 
 		// 跳过伴舞僵尸（BackupDancer）
-		// 注意：当前代码库可能没有 BehaviorZombieBackupDancer 类型
-		// 如果有，需要在这里排除
+		if behavior.UnitID == types.UnitIDZombieBackupDancer {
+			continue
+		}
+
+		// 跳过被魅惑的僵尸（已倒戈，不算作敌人）
+		if IsCharmedZombie(s.entityManager, entityID) {
+			continue
+		}
 
 		// 有活跃僵尸，返回 false
 		// Story 17.8: 只统计已激活的僵尸
@@ -337,6 +477,11 @@ func (s *LevelSystem) checkAndSpawnWaves() {
 //
 // 如果达成胜利条件，设置游戏结果为 "win"
 func (s *LevelSystem) checkVictoryCondition() {
+	// 小游戏规则自行判定胜利时不使用默认判定（没有波次的关卡从第一帧起就是"所有波次已生成"）
+	if s.overrideDecidesVictory {
+		return
+	}
+
 	// 检查是否有活跃的除草车
 	// 原版行为：除草车完全消失后，才显示胜利动画
 	hasActiveLawnmowers := false
@@ -355,34 +500,79 @@ func (s *LevelSystem) checkVictoryCondition() {
 
 	// 只有在没有活跃除草车的情况下才能胜利
 	if s.gameState.CheckVictory() && !hasActiveLawnmowers {
-		s.gameState.SetGameResult("win")
+		// 生存模式没有终点：完成一组旗帜后继续下一组
+		if s.survivalGenerator != nil {
+			s.completeSurvivalFlagSet()
+			return
+		}
+
 		log.Println("[LevelSystem] Victory! All zombies defeated!")
+		s.declareVictory()
+	}
+}
 
-		// 淡出 BGM（胜利音乐在点击卡包后播放，见 reward_animation_system.go）
-		if s.resourceManager != nil {
-			s.resourceManager.FadeOutMusic(0.3)
-		}
+// SetResultOverride 设置小游戏规则的胜负判定
+//
+// 每帧在默认的失败/胜利判定之前调用：返回 "win" 立即胜利，返回 "lose" 立即失败，
+// 返回空字符串时继续使用默认判定
+//
+// 参数：
+//   - override: 胜负判定函数
+//   - decidesVictory: 为 true 时跳过默认的胜利判定（失败判定仍然生效），如自行生成僵尸的锤僵尸、砸罐子
+func (s *LevelSystem) SetResultOverride(override func() string, decidesVictory bool) {
+	s.resultOverride = override
+	s.overrideDecidesVictory = decidesVictory
+}
+
+// checkResultOverride 检查小游戏规则的胜负判定，已决出胜负时返回 true
+func (s *LevelSystem) checkResultOverride() bool {
+	if s.resultOverride == nil {
+		return false
+	}
+
+	switch s.resultOverride() {
+	case "win":
+		log.Println("[LevelSystem] Victory! Mini-game rule declared a win")
+		s.declareVictory()
+		return true
+	case "lose":
+		s.gameState.SetGameResult("lose")
+		log.Println("[LevelSystem] Defeat! Mini-game rule declared a loss")
+		// 没有触发僵尸时流程跳过僵尸入侵动画
+		s.triggerZombiesWonFlow(0)
+		return true
+	}
+	return false
+}
 
-		// 清理场上所有阳光实体（避免在奖励动画阶段继续显示）
-		s.cleanupAllSunEntities()
+// declareVictory 设置胜利结果，保存关卡进度并触发奖励动画
+func (s *LevelSystem) declareVictory() {
+	s.gameState.SetGameResult("win")
 
-		// 保存关卡进度
-		if s.gameState.CurrentLevel != nil {
-			levelID := s.gameState.CurrentLevel.ID
-			rewardPlant := s.gameState.CurrentLevel.RewardPlant
-			unlockTools := s.gameState.CurrentLevel.UnlockTools
+	// 淡出 BGM（胜利音乐在点击卡包后播放，见 reward_animation_system.go）
+	if s.resourceManager != nil {
+		s.resourceManager.FadeOutMusic(0.3)
+	}
 
-			// 保存进度（包括关卡完成、植物解锁、工具解锁）
-			if err := s.gameState.CompleteLevel(levelID, rewardPlant, unlockTools); err != nil {
-				log.Printf("[LevelSystem] Warning: Failed to save progress: %v", err)
-			} else {
-				log.Printf("[LevelSystem] Progress saved: level=%s, plant=%s, tools=%v", levelID, rewardPlant, unlockTools)
-			}
-		}
+	// 清理场上所有阳光实体（避免在奖励动画阶段继续显示）
+	s.cleanupAllSunEntities()
+
+	// 保存关卡进度
+	if s.gameState.CurrentLevel != nil {
+		levelID := s.gameState.CurrentLevel.ID
+		rewardPlant := s.gameState.CurrentLevel.RewardPlant
+		unlockTools := s.gameState.CurrentLevel.UnlockTools
 
-		// 检查是否有新植物解锁，触发奖励动画
-		s.triggerRewardIfNeeded()
+		// 保存进度（包括关卡完成、植物解锁、工具解锁）
+		if err := s.gameState.CompleteLevel(levelID, rewardPlant, unlockTools); err != nil {
+			log.Printf("[LevelSystem] Warning: Failed to save progress: %v", err)
+		} else {
+			log.Printf("[LevelSystem] Progress saved: level=%s, plant=%s, tools=%v", levelID, rewardPlant, unlockTools)
+		}
 	}
+
+	// 检查是否有新植物解锁，触发奖励动画
+	s.triggerRewardIfNeeded()
 }
 
 // triggerRewardIfNeeded 检查是否有新植物或工具解锁，如果有则触发奖励动画
@@ -469,6 +659,21 @@ func (s *LevelSystem) checkDefeatWithLawnmower() {
 			continue
 		}
 
+		// 被魅惑的僵尸向右移动，不会进家
+		if IsCharmedZombie(s.entityManager, entityID) {
+			continue
+		}
+
+		// 地下的僵尸（矿工僵尸）会在草坪最左列出土，不会进家
+		if IsUndergroundZombie(s.entityManager, entityID) {
+			continue
+		}
+
+		// 蹦极僵尸不属于任何行，不会进家
+		if IsBungeeZombie(s.entityManager, entityID) {
+			continue
+		}
+
 		pos, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
 		if !ok {
 			continue
@@ -481,7 +686,7 @@ func (s *LevelSystem) checkDefeatWithLawnmower() {
 		// 僵尸到达左边界
 		if pos.X < defeatBoundary {
 			// 计算僵尸所在行
-			lane := s.getEntityLane(pos.Y)
+			lane := s.getEntityLane(pos.Y - s.layout.SlopeOffsetY(pos.X))
 
 			// 检查该行除草车是否已使用
 			if state.UsedLanes[lane] {
@@ -519,6 +724,21 @@ func (s *LevelSystem) checkDefeatWithoutLawnmower() {
 			continue
 		}
 
+		// 被魅惑的僵尸向右移动，不会进家
+		if IsCharmedZombie(s.entityManager, entityID) {
+			continue
+		}
+
+		// 地下的僵尸（矿工僵尸）会在草坪最左列出土，不会进家
+		if IsUndergroundZombie(s.entityManager, entityID) {
+			continue
+		}
+
+		// 蹦极僵尸不属于任何行，不会进家
+		if IsBungeeZombie(s.entityManager, entityID) {
+			continue
+		}
+
 		pos, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
 		if !ok {
 			continue
@@ -585,16 +805,16 @@ func (s *LevelSystem) behaviorTypeToString(behaviorType components.BehaviorType)
 // getEntityLane 根据实体的Y坐标计算所在行（1-5）
 func (s *LevelSystem) getEntityLane(y float64) int {
 	// 使用与 LawnmowerSystem 相同的计算方法
-	offsetY := y - config.GridWorldStartY
-	row := int(offsetY / config.CellHeight)
+	offsetY := y - s.layout.StartY
+	row := int(offsetY / s.layout.CellHeight)
 	lane := row + 1
 
 	// 限制范围
 	if lane < 1 {
 		lane = 1
 	}
-	if lane > 5 {
-		lane = 5
+	if lane > s.layout.Rows {
+		lane = s.layout.Rows
 	}
 
 	return lane
@@ -682,8 +902,10 @@ func (s *LevelSystem) initializeProgressBar() {
 		progressBar.FlagPositions = []float64{} // 无旗帜
 	}
 
-	// 3. 设置关卡文本
-	if s.gameState.CurrentLevel != nil {
+	// 3. 设置关卡文本（生存模式显示已坚持的旗帜数）
+	if s.gameState.CurrentLevel != nil && s.gameState.CurrentLevel.Survival {
+		progressBar.LevelText = fmt.Sprintf("生存模式 %d 旗", s.gameState.SurvivalFlags)
+	} else if s.gameState.CurrentLevel != nil {
 		progressBar.LevelText = "关卡 " + s.gameState.CurrentLevel.ID
 	}
 
@@ -811,6 +1033,12 @@ func (s *LevelSystem) UpdateProgressBar() {
 		return
 	}
 
+	// Boss 关卡：进度条显示僵王博士损失的生命值
+	if s.gameState.CurrentLevel != nil && s.gameState.CurrentLevel.Boss {
+		s.updateBossProgress(progressBar)
+		return
+	}
+
 	// === 废弃逻辑（向后兼容） ===
 	// 统计当前击杀的僵尸数（通过 GameState.ZombiesKilled）
 	killedZombies := s.gameState.ZombiesKilled
@@ -853,6 +1081,28 @@ func (s *LevelSystem) UpdateProgressBar() {
 	}
 }
 
+// updateBossProgress Boss 关卡的进度：1 - 僵王博士剩余生命值 / 最大生命值
+// 僵王博士入场前为 0，被击败后为 1
+func (s *LevelSystem) updateBossProgress(progressBar *components.LevelProgressBarComponent) {
+	progress := 0.0
+	if s.gameState.BossDefeated {
+		progress = 1.0
+	} else if bossID, _, ok := GetZomboss(s.entityManager); ok {
+		if health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, bossID); ok && health.MaxHealth > 0 {
+			progress = 1.0 - float64(health.CurrentHealth)/float64(health.MaxHealth)
+		}
+	}
+	if progress < 0 {
+		progress = 0
+	} else if progress > 1.0 {
+		progress = 1.0
+	}
+
+	progressBar.VirtualProgress = progress
+	progressBar.RealProgress = progress
+	progressBar.ProgressPercent = progress
+}
+
 // ShowProgressBar 显示完整进度条（第一波僵尸生成后调用）
 func (s *LevelSystem) ShowProgressBar() {
 	if s.progressBarEntityID == 0 {
@@ -950,6 +1200,11 @@ func (s *LevelSystem) ResumeWaveTiming() {
 		return
 	}
 
+	// 生存模式等待重新选择植物时保持暂停，由 FinishPlantReselection 恢复
+	if s.awaitingPlantReselection {
+		return
+	}
+
 	// Bug Fix: 检查是否处于铲子教学阶段（Phase 1）
 	// 如果存在 LevelPhaseComponent 且 CurrentPhase == 1，说明还在铲子教学阶段
 	// 此时不应该自动初始化波次计时器，只恢复暂停状态（如果已初始化）
@@ -1206,6 +1461,11 @@ func (s *LevelSystem) updateWaveHealthTracking(pb *components.LevelProgressBarCo
 			continue
 		}
 
+		// 被魅惑的僵尸不计入波次血量
+		if IsCharmedZombie(s.entityManager, entityID) {
+			continue
+		}
+
 		health, ok := ecs.GetComponent[*components.HealthComponent](s.entityManager, entityID)
 		if !ok {
 			continue
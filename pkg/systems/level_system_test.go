@@ -264,6 +264,65 @@ func TestTriggerZombiesWonFlow_OnlyCreatesOnce(t *testing.T) {
 	}
 }
 
+// TestCheckResultOverride 测试小游戏规则覆盖胜负判定
+func TestCheckResultOverride(t *testing.T) {
+	tests := []struct {
+		name         string
+		result       string
+		expectDecide bool
+		expectResult string
+		expectFlow   int
+	}{
+		{"No override", "", false, "", 0},
+		{"Win", "win", true, "win", 0},
+		{"Lose", "lose", true, "lose", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em := ecs.NewEntityManager()
+			gs := &game.GameState{}
+			ls := &LevelSystem{entityManager: em, gameState: gs}
+			ls.SetResultOverride(func() string { return tt.result }, false)
+
+			if got := ls.checkResultOverride(); got != tt.expectDecide {
+				t.Errorf("expected decided=%v, got %v", tt.expectDecide, got)
+			}
+			if gs.GameResult != tt.expectResult {
+				t.Errorf("expected GameResult=%q, got %q", tt.expectResult, gs.GameResult)
+			}
+			if gs.IsGameOver != tt.expectDecide {
+				t.Errorf("expected IsGameOver=%v, got %v", tt.expectDecide, gs.IsGameOver)
+			}
+			// 失败时启动僵尸获胜流程
+			if flows := ecs.GetEntitiesWith1[*components.ZombiesWonPhaseComponent](em); len(flows) != tt.expectFlow {
+				t.Errorf("expected %d zombies won flow entities, got %d", tt.expectFlow, len(flows))
+			}
+		})
+	}
+}
+
+// TestCheckVictoryCondition_DecidedByOverride 测试规则自行判定胜利时跳过默认胜利判定
+func TestCheckVictoryCondition_DecidedByOverride(t *testing.T) {
+	gs := &game.GameState{
+		CurrentLevel:        &config.LevelConfig{ID: "test"},
+		SpawnedWaves:        []bool{},
+		TotalZombiesInLevel: 1,
+		ZombiesKilled:       1,
+	}
+	if !gs.CheckVictory() {
+		t.Fatal("expected the default victory condition to be met")
+	}
+
+	ls := &LevelSystem{entityManager: ecs.NewEntityManager(), gameState: gs}
+	ls.SetResultOverride(func() string { return "" }, true)
+	ls.checkVictoryCondition()
+
+	if gs.GameResult != "" {
+		t.Errorf("expected no result before the rule decides, got %q", gs.GameResult)
+	}
+}
+
 // ========================================
 // Story 17.9: Type-Specific Defeat Boundary Tests
 // ========================================
@@ -982,7 +1041,7 @@ func TestNewLevelSystem_SpecialLevel_PausedWaveTiming(t *testing.T) {
 	gs.CurrentLevel = levelConfig
 
 	// 创建 LevelSystem
-	ls := NewLevelSystem(em, gs, nil, nil, nil, nil)
+	ls := NewLevelSystem(em, gs, nil, nil, nil, nil, config.GetLawnLayout("day"))
 
 	// 验证 WaveTimingSystem 存在
 	if ls.waveTimingSystem == nil {
@@ -1022,7 +1081,7 @@ func TestResumeWaveTiming_InitializesTimer(t *testing.T) {
 	gs.CurrentLevel = levelConfig
 
 	// 创建 LevelSystem（特殊关卡，计时器暂停）
-	ls := NewLevelSystem(em, gs, nil, nil, nil, nil)
+	ls := NewLevelSystem(em, gs, nil, nil, nil, nil, config.GetLawnLayout("day"))
 
 	// 获取计时器组件
 	timer, _ := ecs.GetComponent[*components.WaveTimerComponent](em, ls.waveTimingSystem.GetTimerEntityID())
@@ -1067,7 +1126,7 @@ func TestResumeWaveTiming_ShovelTutorialPhase(t *testing.T) {
 	gs.CurrentLevel = levelConfig
 
 	// 创建 LevelSystem（特殊关卡，计时器暂停）
-	ls := NewLevelSystem(em, gs, nil, nil, nil, nil)
+	ls := NewLevelSystem(em, gs, nil, nil, nil, nil, config.GetLawnLayout("day"))
 
 	// 模拟铲子教学阶段：创建 LevelPhaseComponent 并设置 CurrentPhase = 1
 	phaseEntity := em.CreateEntity()
@@ -1126,10 +1185,54 @@ func TestLevelSystem_BowlingNoFlagWaveWarning(t *testing.T) {
 	gs.CurrentLevel = levelConfig
 
 	// 创建 LevelSystem
-	ls := NewLevelSystem(em, gs, nil, nil, nil, nil)
+	ls := NewLevelSystem(em, gs, nil, nil, nil, nil, config.GetLawnLayout("day"))
 
 	// 验证无旗帜波接近
 	if ls.waveTimingSystem.IsFlagWaveApproaching() {
 		t.Error("Should not have flag wave approaching for bowling level (flags=0)")
 	}
 }
+
+// TestRestorePlantReselection_SurvivalSave 测试生存模式在两组旗帜之间存档，读档后回到重新选择植物的状态
+func TestRestorePlantReselection_SurvivalSave(t *testing.T) {
+	// 一组旗帜刚结束、正在等待重新选择植物时的存档（序列化往返见 TestBattleSerializer_SaveAndLoadBattle_Survival）
+	saveData := &game.SurvivalSaveData{Flags: 2, AwaitingReselection: true}
+
+	// 读档：新建场景的 LevelSystem 正常开始计时，随后按存档恢复
+	em := ecs.NewEntityManager()
+	restored := &game.GameState{
+		SurvivalFlags: saveData.Flags,
+		CurrentLevel: &config.LevelConfig{
+			ID:       config.SurvivalLevelID,
+			Survival: true,
+			Waves: []config.WaveConfig{
+				{Zombies: []config.ZombieGroup{{Type: "basic", Count: 1, Lanes: []int{1}}}},
+				{IsFlag: true, Zombies: []config.ZombieGroup{{Type: "flag", Count: 1, Lanes: []int{2}}}},
+			},
+		},
+	}
+	ls := NewLevelSystem(em, restored, nil, nil, nil, nil, config.GetLawnLayout("day"))
+	ls.waveTimingSystem.RestoreState(0, 0)
+	if saveData.AwaitingReselection {
+		ls.RestorePlantReselection()
+	}
+
+	if !ls.IsAwaitingPlantReselection() || !restored.SurvivalAwaitingReselection {
+		t.Fatal("Expected restored level to wait for plant reselection")
+	}
+	timer, _ := ecs.GetComponent[*components.WaveTimerComponent](em, ls.waveTimingSystem.GetTimerEntityID())
+	if !timer.IsPaused || !timer.IsFirstWave || timer.CurrentWaveIndex != 0 {
+		t.Errorf("Expected next flag set paused at its first wave, got paused=%v first=%v index=%d", timer.IsPaused, timer.IsFirstWave, timer.CurrentWaveIndex)
+	}
+
+	// 暂停菜单关闭时不能跳过重新选卡
+	ls.ResumeWaveTiming()
+	if !timer.IsPaused {
+		t.Error("Expected wave timing to stay paused until plants are reselected")
+	}
+
+	ls.FinishPlantReselection()
+	if timer.IsPaused || restored.SurvivalAwaitingReselection {
+		t.Error("Expected next flag set to start after plant reselection")
+	}
+}
@@ -36,6 +36,7 @@ type OpeningAnimationSystem struct {
 	gameState       *game.GameState
 	resourceManager *game.ResourceManager
 	levelConfig     *config.LevelConfig
+	layout          config.LawnLayout // 关卡场景的草坪行布局
 	cameraSystem    *CameraSystem
 	openingEntity   ecs.EntityID
 	usernameFont    *text.GoTextFace // 用户名字体（24号）
@@ -70,6 +71,7 @@ func NewOpeningAnimationSystem(em *ecs.EntityManager, gs *game.GameState, rm *ga
 		gameState:       gs,
 		resourceManager: rm,
 		levelConfig:     levelConfig,
+		layout:          config.GetLawnLayout(levelConfig.SceneType),
 		cameraSystem:    cameraSystem,
 		openingEntity:   0,
 		usernameFont:    nil,
@@ -377,10 +379,10 @@ func (oas *OpeningAnimationSystem) spawnPreviewZombies(openingComp *components.O
 		zombieType := previewTypes[i]
 
 		// 完全随机选择行
-		lane := rand.Intn(config.GridRows)
+		lane := rand.Intn(oas.layout.Rows)
 
 		// 计算Y坐标，加入随机垂直偏移（±12像素）
-		baseY := config.GridWorldStartY + float64(lane)*config.CellHeight + config.CellHeight/2 + config.ZombieVerticalOffset
+		baseY := oas.layout.StartY + float64(lane)*oas.layout.CellHeight + oas.layout.CellHeight/2 + config.ZombieVerticalOffset
 		yJitter := (rand.Float64() - 0.5) * 24
 		y := baseY + yJitter
 
@@ -89,7 +89,7 @@ func (ps *PhysicsSystem) Update(deltaTime float64) {
 		bulletEntities := ecs.GetEntitiesWith1[*components.VelocityComponent](ps.em)
 		for _, bulletID := range bulletEntities {
 			behaviorComp, ok := ecs.GetComponent[*components.BehaviorComponent](ps.em, bulletID)
-			if ok && behaviorComp.Type == components.BehaviorPeaProjectile {
+			if ok && components.IsDirectProjectile(behaviorComp.Type) {
 				ps.em.DestroyEntity(bulletID)
 			}
 		}
@@ -114,7 +114,7 @@ func (ps *PhysicsSystem) Update(deltaTime float64) {
 		}
 		// 泛型 API 已提供类型安全
 
-		if behavior.Type == components.BehaviorPeaProjectile {
+		if components.IsDirectProjectile(behavior.Type) {
 			bullets = append(bullets, entityID)
 		} else if behavior.Type == components.BehaviorZombieBasic ||
 			behavior.Type == components.BehaviorZombieEating ||
@@ -124,6 +124,13 @@ func (ps *PhysicsSystem) Update(deltaTime float64) {
 			behavior.Type == components.BehaviorZombieFlag {
 			// 包括移动中的僵尸、啃食中的僵尸、死亡中的僵尸（普通、路障、铁桶、旗帜）
 			// 死亡中的僵尸仍然需要碰撞检测，以便子弹不会穿透尸体
+			// 被魅惑的僵尸属于植物阵营，子弹直接穿过
+			if IsCharmedZombie(ps.em, entityID) {
+				continue
+			}
+			zombies = append(zombies, entityID)
+		} else if behavior.Type == components.BehaviorZombieBoss && IsZombossHeadExposed(ps.em, entityID) {
+			// 僵王博士只有头部低垂时才能被子弹击中（碰撞盒只覆盖头部）
 			zombies = append(zombies, entityID)
 		}
 	}
@@ -143,12 +150,23 @@ func (ps *PhysicsSystem) Update(deltaTime float64) {
 		}
 		// 泛型 API 已提供类型安全
 
-		// 找出与子弹碰撞的所有僵尸中 X 坐标最小（最靠前）的那个
-		// 这样确保在同一行多个僵尸位置接近时，只有最前面的僵尸被击中
+		// 找出与子弹碰撞的所有僵尸中沿飞行方向最靠前的那个
+		// 这样确保多个僵尸位置接近时，只有子弹最先碰到的僵尸被击中
+		// （向右飞行的豌豆即 X 最小的僵尸，向左飞行的豌豆即 X 最大的僵尸）
+		dirX, dirY := 1.0, 0.0
+		if bulletVel, ok := ecs.GetComponent[*components.VelocityComponent](ps.em, bulletID); ok && (bulletVel.VX != 0 || bulletVel.VY != 0) {
+			dirX, dirY = bulletVel.VX, bulletVel.VY
+		}
 		var hitZombieID ecs.EntityID
-		var hitZombieX float64 = 1e9 // 初始化为一个很大的值
+		var hitZombieOrder float64 = 1e18 // 初始化为一个很大的值
+
+		// 普通直线子弹从空中僵尸（气球僵尸）下方穿过
+		antiAir := false
+		if bulletBehavior, ok := ecs.GetComponent[*components.BehaviorComponent](ps.em, bulletID); ok {
+			antiAir = components.IsAntiAirProjectile(bulletBehavior.Type)
+		}
 
-		// 检查子弹与所有僵尸的碰撞，找出 X 最小的碰撞目标
+		// 检查子弹与所有僵尸的碰撞，找出沿飞行方向最靠前的碰撞目标
 		for _, zombieID := range zombies {
 			// 获取僵尸的位置和碰撞组件
 			zombiePos, ok := ecs.GetComponent[*components.PositionComponent](ps.em, zombieID)
@@ -160,13 +178,21 @@ func (ps *PhysicsSystem) Update(deltaTime float64) {
 			if !ok {
 				continue
 			}
+			if zombieCol.IsAirborne() && !antiAir {
+				continue
+			}
+			// 地下的僵尸（矿工僵尸）、潜在水下的僵尸（潜水僵尸）无法被任何子弹击中
+			if IsUndergroundZombie(ps.em, zombieID) || IsSubmergedZombie(ps.em, zombieID) {
+				continue
+			}
 
 			// 执行AABB碰撞检测
 			if ps.checkAABBCollision(bulletPos, bulletCol, zombiePos, zombieCol) {
-				// 碰撞发生！记录这个僵尸，但只选择 X 最小的
-				if zombiePos.X < hitZombieX {
+				// 碰撞发生！记录这个僵尸，但只选择在飞行方向上投影最小的
+				order := zombiePos.X*dirX + zombiePos.Y*dirY
+				if order < hitZombieOrder {
 					hitZombieID = zombieID
-					hitZombieX = zombiePos.X
+					hitZombieOrder = order
 				}
 			}
 		}
@@ -175,23 +201,35 @@ func (ps *PhysicsSystem) Update(deltaTime float64) {
 		if hitZombieID != 0 {
 			zombieID := hitZombieID
 
-			// 1. 创建击中效果实体（在子弹位置）
-			_, err := entities.NewPeaBulletHitEffect(ps.em, ps.rm, bulletPos.X, bulletPos.Y)
-			if err != nil {
-				// 如果创建击中效果失败，记录错误但继续处理碰撞
-				// 在实际项目中可以使用日志系统记录错误
-				// 这里为了简化，忽略错误
+			// 获取子弹的 BehaviorComponent 以确定子弹类型和伤害
+			bulletBehavior, ok := ecs.GetComponent[*components.BehaviorComponent](ps.em, bulletID)
+			damage := config.PeaBulletDamage
+			if ok {
+				damage = projectileDamage(bulletBehavior.Type)
 			}
 
-			// 触发豌豆击中溅射粒子效果
-			// 获取子弹的 BehaviorComponent 以确定子弹类型
-			bulletBehavior, ok := ecs.GetComponent[*components.BehaviorComponent](ps.em, bulletID)
+			// 1. 创建击中效果实体（在子弹位置，仅豌豆有水花贴图）
+			if !ok || bulletBehavior.Type == components.BehaviorPeaProjectile {
+				_, err := entities.NewPeaBulletHitEffect(ps.em, ps.rm, bulletPos.X, bulletPos.Y)
+				if err != nil {
+					// 如果创建击中效果失败，记录错误但继续处理碰撞
+					// 在实际项目中可以使用日志系统记录错误
+					// 这里为了简化，忽略错误
+				}
+			}
+
+			// 触发击中溅射粒子效果
 			if ok {
 
 				// 根据子弹类型选择粒子效果
 				var particleEffectName string
-				if bulletBehavior.Type == components.BehaviorPeaProjectile {
+				switch bulletBehavior.Type {
+				case components.BehaviorPeaProjectile:
 					particleEffectName = "PeaSplat" // 豌豆溅射效果
+				case components.BehaviorStarProjectile:
+					particleEffectName = "StarSplat" // 星星溅射效果
+				case components.BehaviorPuffProjectile:
+					particleEffectName = "PuffSplat" // 孢子溅射效果
 				}
 				// 未来扩展: 卷心菜子弹类型
 				// else if bulletBehavior.Type == components.BehaviorCabbageProjectile {
@@ -216,34 +254,36 @@ func (ps *PhysicsSystem) Update(deltaTime float64) {
 				}
 			}
 
-			// 2. 处理护甲伤害（优先扣除护甲值）
-			armor, hasArmor := ecs.GetComponent[*components.ArmorComponent](ps.em, zombieID)
-			if hasArmor {
-				if armor.CurrentArmor > 0 {
-					// 有护甲且护甲未破坏，优先扣除护甲
-					armor.CurrentArmor -= config.PeaBulletDamage
-					// 播放击中护甲音效（根据僵尸类型选择不同音效）
-					ps.playArmorHitSound(zombieID)
-					// 方案A+：护甲受击也添加闪烁效果
-					ps.addFlashEffect(zombieID)
-					// 注意：护甲可以降到负数，BehaviorSystem 会检查 <= 0 的情况并处理护甲破坏
-				} else {
-					// 护甲已破坏，扣除身体生命值
-					zombieHealth, ok := ecs.GetComponent[*components.HealthComponent](ps.em, zombieID)
-					if ok {
-						zombieHealth.CurrentHealth -= config.PeaBulletDamage
-
-						// 方案A+：添加受击闪烁效果
-						ps.addFlashEffect(zombieID)
-					}
-					// 播放击中身体音效
-					ps.playHitSound()
-				}
+			// 对空子弹击中气球僵尸时击破气球，不造成伤害
+			if PopZombieBalloon(ps.em, zombieID) {
+				ps.em.DestroyEntity(bulletID)
+				continue
+			}
+
+			// 手持防具（II类饰品）阻挡从正面飞来的子弹
+			// 只有迎着僵尸面朝方向飞来的子弹会打在防具上（杨桃向后的星星绕过防具）
+			if shield := ZombieShieldFacing(ps.em, zombieID, dirX); shield != nil {
+				shield.Durability -= damage
+				ps.playShieldHitSound(shield)
+				ps.addFlashEffect(zombieID)
+				// 注意：耐久可以降到负数，BehaviorSystem 会检查 <= 0 的情况并移除防具
+				ps.em.DestroyEntity(bulletID)
+				continue
+			}
+
+			// 2. 处理头部防具伤害（优先扣除防具耐久）
+			if helmet := ZombieHelmet(ps.em, zombieID); helmet != nil {
+				helmet.Durability -= damage
+				// 播放击中护甲音效（根据防具材质选择不同音效）
+				ps.playArmorHitSound(helmet)
+				// 方案A+：护甲受击也添加闪烁效果
+				ps.addFlashEffect(zombieID)
+				// 注意：耐久可以降到负数，BehaviorSystem 会检查 <= 0 的情况并打掉防具
 			} else {
-				// 3. 没有护甲，直接减少僵尸生命值（伤害计算）
+				// 3. 没有护甲或护甲已破坏，直接减少僵尸生命值（伤害计算）
 				zombieHealth, ok := ecs.GetComponent[*components.HealthComponent](ps.em, zombieID)
 				if ok {
-					zombieHealth.CurrentHealth -= config.PeaBulletDamage
+					zombieHealth.CurrentHealth -= damage
 					// 注意：生命值可以降到负数，BehaviorSystem 会检查 <= 0 的情况
 
 					// 方案A+：添加受击闪烁效果
@@ -259,6 +299,32 @@ func (ps *PhysicsSystem) Update(deltaTime float64) {
 	}
 }
 
+// projectileDamage 返回不同类型子弹的伤害值
+func projectileDamage(behaviorType components.BehaviorType) int {
+	switch behaviorType {
+	case components.BehaviorStarProjectile:
+		return config.StarDamage
+	case components.BehaviorCattailSpike:
+		return config.CattailSpikeDamage
+	case components.BehaviorPuffProjectile:
+		return config.PuffDamage
+	default:
+		return config.PeaBulletDamage
+	}
+}
+
+// playShieldHitSound 播放子弹击中手持防具的音效
+// 金属防具（铁栅门）使用金属音效，报纸使用普通击中音效
+func (ps *PhysicsSystem) playShieldHitSound(shield *components.AccessoryPiece) {
+	if !shield.IsMetal() {
+		ps.playHitSound()
+		return
+	}
+	if audioManager := game.GetGameState().GetAudioManager(); audioManager != nil {
+		audioManager.PlaySound("SOUND_SHIELDHIT")
+	}
+}
+
 // playHitSound 播放子弹击中僵尸的音效
 // 使用 AudioManager 统一管理音效（Story 10.9）
 func (ps *PhysicsSystem) playHitSound() {
@@ -272,39 +338,37 @@ func (ps *PhysicsSystem) playHitSound() {
 // - 塑料护甲（路障）：使用 SOUND_PLASTICHIT - 塑料路障音效
 // - 金属护甲（铁桶）：使用 SOUND_SHIELDHIT - 金属铁桶音效
 // Story 10.9: 护甲击中音效差异化
-func (ps *PhysicsSystem) playArmorHitSound(zombieID ecs.EntityID) {
-	// 获取僵尸的护甲组件，确定护甲材质类型
-	armor, ok := ecs.GetComponent[*components.ArmorComponent](ps.em, zombieID)
-	if !ok {
-		return
-	}
-
+func (ps *PhysicsSystem) playArmorHitSound(helmet *components.AccessoryPiece) {
 	// 使用 AudioManager 统一管理音效（Story 10.9）
 	audioManager := game.GetGameState().GetAudioManager()
 	if audioManager == nil {
 		return
 	}
 
-	// 根据护甲材质类型选择音效
-	switch armor.Type {
-	case components.ArmorTypePlastic:
-		// 塑料护甲（路障）使用塑料音效
-		audioManager.PlaySound("SOUND_PLASTICHIT")
-	case components.ArmorTypeMetal:
+	// 根据防具材质选择音效
+	if helmet.IsMetal() {
 		// 金属护甲（铁桶）使用金属音效
 		audioManager.PlaySound("SOUND_SHIELDHIT")
-	default:
-		// 默认使用塑料音效（兜底）
-		audioManager.PlaySound("SOUND_PLASTICHIT")
+		return
 	}
+	// 塑料护甲（路障）使用塑料音效
+	audioManager.PlaySound("SOUND_PLASTICHIT")
 }
 
 // addFlashEffect 为僵尸添加受击闪烁效果（方案A+）
 // 参数：
 //   - zombieID: 僵尸实体ID
 func (ps *PhysicsSystem) addFlashEffect(zombieID ecs.EntityID) {
+	AddFlashEffect(ps.em, zombieID)
+}
+
+// AddFlashEffect 为受击的实体添加闪烁效果（子弹、锤子等击中僵尸时使用）
+// 参数：
+//   - em: 实体管理器
+//   - zombieID: 受击的实体ID
+func AddFlashEffect(em *ecs.EntityManager, zombieID ecs.EntityID) {
 	// 检查是否已有闪烁组件
-	flashComp, hasFlash := ecs.GetComponent[*components.FlashEffectComponent](ps.em, zombieID)
+	flashComp, hasFlash := ecs.GetComponent[*components.FlashEffectComponent](em, zombieID)
 
 	if hasFlash {
 		// 已有闪烁组件，重置时间（连续受击时延长闪烁）
@@ -312,7 +376,7 @@ func (ps *PhysicsSystem) addFlashEffect(zombieID ecs.EntityID) {
 		flashComp.IsActive = true
 	} else {
 		// 没有闪烁组件，创建新的
-		ecs.AddComponent(ps.em, zombieID, &components.FlashEffectComponent{
+		ecs.AddComponent(em, zombieID, &components.FlashEffectComponent{
 			Duration:  0.1,  // 闪烁持续0.1秒（原版默认值）
 			Elapsed:   0,    // 从0开始计时
 			Intensity: 0.8,  // 闪烁强度80%（白色叠加）
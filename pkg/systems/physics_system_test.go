@@ -10,6 +10,7 @@ import (
 	"github.com/gonewx/pvz/pkg/config"
 	"github.com/gonewx/pvz/pkg/ecs"
 	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
 )
 
 // init 函数在测试开始前切换到项目根目录
@@ -852,3 +853,173 @@ func TestPhysicsSystem_GameFreezeDeletesBullets(t *testing.T) {
 		t.Error("游戏冻结期间僵尸不应被删除")
 	}
 }
+
+// TestPhysicsSystem_BackwardPeaHitsFirstZombie 测试向左飞行的豌豆击中飞行方向上最先碰到的僵尸（X 最大）
+func TestPhysicsSystem_BackwardPeaHitsFirstZombie(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	ps := NewPhysicsSystem(em, rm)
+
+	bulletID := em.CreateEntity()
+	em.AddComponent(bulletID, &components.BehaviorComponent{Type: components.BehaviorPeaProjectile})
+	em.AddComponent(bulletID, &components.PositionComponent{X: 400, Y: 250})
+	em.AddComponent(bulletID, &components.VelocityComponent{VX: -config.PeaBulletSpeed})
+	em.AddComponent(bulletID, &components.CollisionComponent{Width: config.PeaBulletWidth, Height: config.PeaBulletHeight})
+
+	createZombie := func(x float64) ecs.EntityID {
+		id := em.CreateEntity()
+		em.AddComponent(id, &components.BehaviorComponent{Type: components.BehaviorZombieBasic})
+		em.AddComponent(id, &components.PositionComponent{X: x, Y: 250})
+		em.AddComponent(id, &components.CollisionComponent{Width: config.ZombieCollisionWidth, Height: config.ZombieCollisionHeight})
+		em.AddComponent(id, &components.HealthComponent{CurrentHealth: 270, MaxHealth: 270})
+		return id
+	}
+	leftZombie := createZombie(390)
+	rightZombie := createZombie(410)
+
+	ps.Update(0.016)
+
+	left, _ := ecs.GetComponent[*components.HealthComponent](em, leftZombie)
+	right, _ := ecs.GetComponent[*components.HealthComponent](em, rightZombie)
+	if right.CurrentHealth != 270-config.PeaBulletDamage {
+		t.Errorf("向左飞行的豌豆应击中右侧僵尸，右侧僵尸生命值 %d", right.CurrentHealth)
+	}
+	if left.CurrentHealth != 270 {
+		t.Errorf("左侧僵尸不应受到伤害，生命值 %d", left.CurrentHealth)
+	}
+}
+
+// TestPhysicsSystem_StarHitsZombieWithStarDamage 测试星星子弹可以击中僵尸并造成星星伤害
+func TestPhysicsSystem_StarHitsZombieWithStarDamage(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(getTestAudioContext())
+	ps := NewPhysicsSystem(em, rm)
+
+	starID := em.CreateEntity()
+	em.AddComponent(starID, &components.BehaviorComponent{Type: components.BehaviorStarProjectile})
+	em.AddComponent(starID, &components.PositionComponent{X: 400, Y: 150})
+	em.AddComponent(starID, &components.VelocityComponent{VY: -config.StarSpeed})
+	em.AddComponent(starID, &components.CollisionComponent{Width: config.StarWidth, Height: config.StarHeight})
+
+	zombieID := em.CreateEntity()
+	em.AddComponent(zombieID, &components.BehaviorComponent{Type: components.BehaviorZombieBasic})
+	em.AddComponent(zombieID, &components.PositionComponent{X: 405, Y: 150})
+	em.AddComponent(zombieID, &components.CollisionComponent{Width: config.ZombieCollisionWidth, Height: config.ZombieCollisionHeight})
+	em.AddComponent(zombieID, &components.HealthComponent{CurrentHealth: 270, MaxHealth: 270})
+
+	ps.Update(0.016)
+
+	health, _ := ecs.GetComponent[*components.HealthComponent](em, zombieID)
+	if health.CurrentHealth != 270-config.StarDamage {
+		t.Errorf("期望僵尸生命值 %d，实际 %d", 270-config.StarDamage, health.CurrentHealth)
+	}
+	em.RemoveMarkedEntities()
+	if _, exists := ecs.GetComponent[*components.PositionComponent](em, starID); exists {
+		t.Error("命中后星星应被删除")
+	}
+}
+
+// TestPhysicsSystem_ShieldBlocksFrontShots 测试铁栅门只阻挡从正面飞来的子弹
+func TestPhysicsSystem_ShieldBlocksFrontShots(t *testing.T) {
+	tests := []struct {
+		name           string
+		bulletVX       float64
+		wantDurability int
+		wantHealth     int
+	}{
+		{"正面子弹打在铁栅门上", config.PeaBulletSpeed, config.ScreenDoorHealth - config.PeaBulletDamage, 270},
+		{"背后子弹绕过铁栅门", -config.PeaBulletSpeed, config.ScreenDoorHealth, 270 - config.PeaBulletDamage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em := ecs.NewEntityManager()
+			rm := game.NewResourceManager(getTestAudioContext())
+			ps := NewPhysicsSystem(em, rm)
+
+			bulletID := em.CreateEntity()
+			em.AddComponent(bulletID, &components.BehaviorComponent{Type: components.BehaviorPeaProjectile})
+			em.AddComponent(bulletID, &components.PositionComponent{X: 400, Y: 250})
+			em.AddComponent(bulletID, &components.VelocityComponent{VX: tt.bulletVX})
+			em.AddComponent(bulletID, &components.CollisionComponent{Width: config.PeaBulletWidth, Height: config.PeaBulletHeight})
+
+			door := &components.AccessoryPiece{
+				Tag:           types.AccessoryScreenDoor,
+				Tier:          2,
+				Durability:    config.ScreenDoorHealth,
+				MaxDurability: config.ScreenDoorHealth,
+			}
+			zombieID := em.CreateEntity()
+			em.AddComponent(zombieID, &components.BehaviorComponent{Type: components.BehaviorZombieBasic})
+			em.AddComponent(zombieID, &components.PositionComponent{X: 405, Y: 250})
+			em.AddComponent(zombieID, &components.CollisionComponent{Width: config.ZombieCollisionWidth, Height: config.ZombieCollisionHeight})
+			em.AddComponent(zombieID, &components.HealthComponent{CurrentHealth: 270, MaxHealth: 270})
+			em.AddComponent(zombieID, &components.AccessoryComponent{Pieces: []*components.AccessoryPiece{door}})
+
+			ps.Update(0.016)
+
+			health, _ := ecs.GetComponent[*components.HealthComponent](em, zombieID)
+			if door.Durability != tt.wantDurability {
+				t.Errorf("期望铁栅门耐久 %d，实际 %d", tt.wantDurability, door.Durability)
+			}
+			if health.CurrentHealth != tt.wantHealth {
+				t.Errorf("期望僵尸生命值 %d，实际 %d", tt.wantHealth, health.CurrentHealth)
+			}
+		})
+	}
+}
+
+// TestPhysicsSystem_OnlyAntiAirHitsBalloon 测试普通子弹从气球僵尸下方穿过，对空尖刺击破气球而不造成伤害
+func TestPhysicsSystem_OnlyAntiAirHitsBalloon(t *testing.T) {
+	tests := []struct {
+		name        string
+		bulletType  components.BehaviorType
+		wantHit     bool
+		wantBalloon components.BalloonState
+	}{
+		{"豌豆从气球下方穿过", components.BehaviorPeaProjectile, false, components.BalloonStateFlying},
+		{"香蒲尖刺击破气球", components.BehaviorCattailSpike, true, components.BalloonStatePopped},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em := ecs.NewEntityManager()
+			rm := game.NewResourceManager(getTestAudioContext())
+			ps := NewPhysicsSystem(em, rm)
+
+			bulletID := em.CreateEntity()
+			em.AddComponent(bulletID, &components.BehaviorComponent{Type: tt.bulletType})
+			em.AddComponent(bulletID, &components.PositionComponent{X: 400, Y: 250})
+			em.AddComponent(bulletID, &components.VelocityComponent{VX: config.PeaBulletSpeed})
+			em.AddComponent(bulletID, &components.CollisionComponent{Width: config.PeaBulletWidth, Height: config.PeaBulletHeight})
+
+			balloon := &components.BalloonComponent{State: components.BalloonStateFlying}
+			zombieID := em.CreateEntity()
+			em.AddComponent(zombieID, &components.BehaviorComponent{Type: components.BehaviorZombieBasic})
+			em.AddComponent(zombieID, &components.PositionComponent{X: 405, Y: 250})
+			em.AddComponent(zombieID, &components.CollisionComponent{
+				Width:    config.ZombieCollisionWidth,
+				Height:   config.ZombieCollisionHeight,
+				Altitude: config.BalloonAltitude,
+			})
+			em.AddComponent(zombieID, &components.HealthComponent{CurrentHealth: 270, MaxHealth: 270})
+			em.AddComponent(zombieID, balloon)
+
+			ps.Update(0.016)
+			em.RemoveMarkedEntities()
+
+			if balloon.State != tt.wantBalloon {
+				t.Errorf("期望气球状态 %v，实际 %v", tt.wantBalloon, balloon.State)
+			}
+			if _, exists := ecs.GetComponent[*components.PositionComponent](em, bulletID); exists == tt.wantHit {
+				t.Errorf("子弹是否命中：期望 %v", tt.wantHit)
+			}
+			if health, _ := ecs.GetComponent[*components.HealthComponent](em, zombieID); health.CurrentHealth != 270 {
+				t.Errorf("击破气球不应造成伤害，实际生命值 %d", health.CurrentHealth)
+			}
+			if collision, _ := ecs.GetComponent[*components.CollisionComponent](em, zombieID); collision.IsAirborne() == tt.wantHit {
+				t.Errorf("气球被击破后僵尸应落回地面高度，实际 Altitude=%.1f", collision.Altitude)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package systems
 
 import (
+	"github.com/gonewx/pvz/pkg/config"
 	"testing"
 
 	"github.com/gonewx/pvz/pkg/components"
@@ -13,7 +14,7 @@ import (
 func TestPlantPreviewRenderSystemCreation(t *testing.T) {
 	em := ecs.NewEntityManager()
 	gs := game.GetGameState()
-	previewSystem := NewPlantPreviewSystem(em, gs, nil) // Story 8.1: 传递 nil LawnGridSystem（测试用）
+	previewSystem := NewPlantPreviewSystem(em, gs, config.GetLawnLayout("day"), nil) // Story 8.1: 传递 nil LawnGridSystem（测试用）
 	renderSystem := NewPlantPreviewRenderSystem(em, previewSystem)
 
 	if renderSystem == nil {
@@ -31,7 +32,7 @@ func TestPlantPreviewRenderSystemCreation(t *testing.T) {
 func TestDrawWithNoEntities(t *testing.T) {
 	em := ecs.NewEntityManager()
 	gs := game.GetGameState()
-	previewSystem := NewPlantPreviewSystem(em, gs, nil)
+	previewSystem := NewPlantPreviewSystem(em, gs, config.GetLawnLayout("day"), nil)
 	renderSystem := NewPlantPreviewRenderSystem(em, previewSystem)
 
 	// 创建测试屏幕
@@ -45,7 +46,7 @@ func TestDrawWithNoEntities(t *testing.T) {
 func TestDrawPreviewEntity(t *testing.T) {
 	em := ecs.NewEntityManager()
 	gs := game.GetGameState()
-	previewSystem := NewPlantPreviewSystem(em, gs, nil)
+	previewSystem := NewPlantPreviewSystem(em, gs, config.GetLawnLayout("day"), nil)
 	renderSystem := NewPlantPreviewRenderSystem(em, previewSystem)
 
 	// 创建一个预览实体（使用静态图像）
@@ -82,7 +83,7 @@ func TestDrawPreviewEntity(t *testing.T) {
 func TestDrawMultiplePreviewEntities(t *testing.T) {
 	em := ecs.NewEntityManager()
 	gs := game.GetGameState()
-	previewSystem := NewPlantPreviewSystem(em, gs, nil)
+	previewSystem := NewPlantPreviewSystem(em, gs, config.GetLawnLayout("day"), nil)
 	renderSystem := NewPlantPreviewRenderSystem(em, previewSystem)
 
 	// 创建测试图像
@@ -118,7 +119,7 @@ func TestDrawMultiplePreviewEntities(t *testing.T) {
 func TestDrawWithCameraOffset(t *testing.T) {
 	em := ecs.NewEntityManager()
 	gs := game.GetGameState()
-	previewSystem := NewPlantPreviewSystem(em, gs, nil)
+	previewSystem := NewPlantPreviewSystem(em, gs, config.GetLawnLayout("day"), nil)
 	renderSystem := NewPlantPreviewRenderSystem(em, previewSystem)
 
 	// 创建测试图像
@@ -156,7 +157,7 @@ func TestDrawWithCameraOffset(t *testing.T) {
 func TestDrawWithMissingComponents(t *testing.T) {
 	em := ecs.NewEntityManager()
 	gs := game.GetGameState()
-	previewSystem := NewPlantPreviewSystem(em, gs, nil)
+	previewSystem := NewPlantPreviewSystem(em, gs, config.GetLawnLayout("day"), nil)
 	renderSystem := NewPlantPreviewRenderSystem(em, previewSystem)
 
 	// 创建一个只有部分组件的实体（应该被跳过）
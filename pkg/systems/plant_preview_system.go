@@ -17,7 +17,8 @@ import (
 type PlantPreviewSystem struct {
 	entityManager  *ecs.EntityManager
 	gameState      *game.GameState
-	lawnGridSystem *LawnGridSystem // Story 8.1: 用于检查行是否启用
+	layout         config.LawnLayout // 当前场景的草坪行布局
+	lawnGridSystem *LawnGridSystem   // Story 8.1: 用于检查行是否启用
 
 	// 鼠标光标位置（世界坐标）- 用于渲染不透明光标图像
 	mouseWorldX float64
@@ -33,10 +34,11 @@ type PlantPreviewSystem struct {
 
 // NewPlantPreviewSystem 创建植物预览系统
 // Story 8.1: 添加 lawnGridSystem 参数以支持行启用检查
-func NewPlantPreviewSystem(em *ecs.EntityManager, gs *game.GameState, lawnGridSystem *LawnGridSystem) *PlantPreviewSystem {
+func NewPlantPreviewSystem(em *ecs.EntityManager, gs *game.GameState, layout config.LawnLayout, lawnGridSystem *LawnGridSystem) *PlantPreviewSystem {
 	return &PlantPreviewSystem{
 		entityManager:  em,
 		gameState:      gs,
+		layout:         layout,
 		lawnGridSystem: lawnGridSystem,
 	}
 }
@@ -101,13 +103,7 @@ func (s *PlantPreviewSystem) Update(deltaTime float64) {
 	mouseScreenY := int(s.mouseWorldY)
 
 	// 将鼠标屏幕坐标转换为网格坐标，并计算网格对齐位置
-	col, row, isInGrid := utils.MouseToGridCoords(
-		mouseScreenX, mouseScreenY,
-		s.gameState.CameraX,
-		config.GridWorldStartX, config.GridWorldStartY,
-		config.GridColumns, config.GridRows,
-		config.CellWidth, config.CellHeight,
-	)
+	col, row, isInGrid := utils.MouseToGridCoords(mouseScreenX, mouseScreenY, s.gameState.CameraX, s.layout)
 
 	s.isInGrid = isInGrid
 
@@ -123,12 +119,7 @@ func (s *PlantPreviewSystem) Update(deltaTime float64) {
 
 	if s.isInGrid {
 		// 在网格内，计算格子中心的屏幕坐标
-		gridScreenX, gridScreenY := utils.GridToScreenCoords(
-			col, row,
-			s.gameState.CameraX,
-			config.GridWorldStartX, config.GridWorldStartY,
-			config.CellWidth, config.CellHeight,
-		)
+		gridScreenX, gridScreenY := utils.GridToScreenCoords(col, row, s.gameState.CameraX, s.layout)
 		// 转换为世界坐标
 		s.gridAlignedWorldX = gridScreenX + s.gameState.CameraX
 		s.gridAlignedWorldY = gridScreenY
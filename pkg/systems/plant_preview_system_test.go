@@ -14,9 +14,9 @@ import (
 func TestPlantPreviewSystemCreation(t *testing.T) {
 	em := ecs.NewEntityManager()
 	gs := game.GetGameState()
-	lawnGridSystem := NewLawnGridSystem(em, nil) // Story 8.1: 传递 LawnGridSystem
+	lawnGridSystem := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil) // Story 8.1: 传递 LawnGridSystem
 
-	system := NewPlantPreviewSystem(em, gs, lawnGridSystem)
+	system := NewPlantPreviewSystem(em, gs, config.GetLawnLayout("day"), lawnGridSystem)
 
 	if system == nil {
 		t.Fatal("Expected NewPlantPreviewSystem to return non-nil")
@@ -36,10 +36,10 @@ func TestPlantPreviewSystemCreation(t *testing.T) {
 func TestPlantPreviewUpdate(t *testing.T) {
 	em := ecs.NewEntityManager()
 	gs := game.GetGameState()
-	gs.CameraX = 215                             // 设置摄像机位置
-	lawnGridSystem := NewLawnGridSystem(em, nil) // Story 8.1: 传递 LawnGridSystem
+	gs.CameraX = 215                                                          // 设置摄像机位置
+	lawnGridSystem := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil) // Story 8.1: 传递 LawnGridSystem
 
-	system := NewPlantPreviewSystem(em, gs, lawnGridSystem)
+	system := NewPlantPreviewSystem(em, gs, config.GetLawnLayout("day"), lawnGridSystem)
 
 	// 创建预览实体
 	previewID := em.CreateEntity()
@@ -80,8 +80,8 @@ func TestGridCoordinateConsistency(t *testing.T) {
 	for _, cameraX := range testCameraPositions {
 		t.Run("CameraX="+string(rune(int(cameraX))), func(t *testing.T) {
 			gs.CameraX = cameraX
-			lawnGridSystem := NewLawnGridSystem(em, nil) // Story 8.1: 传递 LawnGridSystem
-			system := NewPlantPreviewSystem(em, gs, lawnGridSystem)
+			lawnGridSystem := NewLawnGridSystem(em, config.GetLawnLayout("day"), nil) // Story 8.1: 传递 LawnGridSystem
+			system := NewPlantPreviewSystem(em, gs, config.GetLawnLayout("day"), lawnGridSystem)
 
 			// 创建预览实体
 			previewID := em.CreateEntity()
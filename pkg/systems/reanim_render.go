@@ -312,10 +312,11 @@ func (s *ReanimSystem) prepareRenderCache(comp *components.ReanimComponent) {
 			}
 
 			comp.CachedRenderData = append(comp.CachedRenderData, components.RenderPartData{
-				Img:     selectedImg,
-				Frame:   selectedFrame,
-				OffsetX: selectedOffsetX,
-				OffsetY: selectedOffsetY,
+				Img:       selectedImg,
+				Frame:     selectedFrame,
+				OffsetX:   selectedOffsetX,
+				OffsetY:   selectedOffsetY,
+				TrackName: trackName,
 			})
 			visibleCount++
 		}
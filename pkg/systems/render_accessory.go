@@ -0,0 +1,39 @@
+package systems
+
+import (
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// accessoryPartImage 根据僵尸饰品状态决定饰品轨道部件的绘制图片
+//
+// 饰品轨道由 config.AccessoryConfigs 配置：
+//   - 已移除的饰品（被打掉、被吸走）不绘制
+//   - 有受损阶段图片的防具按剩余耐久替换为对应阶段的图片
+//
+// 返回:
+//   - *ebiten.Image: 部件应绘制的图片
+//   - bool: false 表示部件属于已移除的饰品，不绘制
+func (s *RenderSystem) accessoryPartImage(accessory *components.AccessoryComponent, part components.RenderPartData) (*ebiten.Image, bool) {
+	for _, piece := range accessory.Pieces {
+		cfg := config.GetAccessoryConfig(piece.Tag)
+		if cfg == nil || cfg.TrackName == "" || cfg.TrackName != part.TrackName {
+			continue
+		}
+		if piece.Removed {
+			return nil, false
+		}
+
+		stage := piece.DamageStage()
+		if stage == 0 || stage >= len(cfg.DamageImages) || part.Frame.ImagePath != cfg.ImageKey || s.resourceManager == nil {
+			return part.Img, true
+		}
+		img, err := s.resourceManager.LoadImage(cfg.DamageImages[stage])
+		if err != nil || img == nil {
+			return part.Img, true
+		}
+		return img, true
+	}
+	return part.Img, true
+}
@@ -133,6 +133,9 @@ func (s *RenderSystem) renderReanimEntity(screen *ebiten.Image, id ecs.EntityID,
 		return
 	}
 
+	// 僵尸饰品部件按饰品状态绘制（已移除的不绘制，防具按剩余耐久显示受损外观）
+	accessory, hasAccessory := ecs.GetComponent[*components.AccessoryComponent](s.entityManager, id)
+
 	// 渲染每个部件
 	for i, partData := range renderData {
 		// DEBUG: 追踪子弹部件数据
@@ -148,6 +151,14 @@ func (s *RenderSystem) renderReanimEntity(screen *ebiten.Image, id ecs.EntityID,
 			continue
 		}
 
+		if hasAccessory {
+			img, visible := s.accessoryPartImage(accessory, partData)
+			if !visible {
+				continue
+			}
+			partData.Img = img
+		}
+
 		frame := partData.Frame
 
 		// 跳过隐藏帧（FrameNum == -1）
@@ -10,10 +10,13 @@ import (
 	"github.com/gonewx/pvz/pkg/components"
 	"github.com/gonewx/pvz/pkg/config"
 	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/entities"
+	"github.com/gonewx/pvz/pkg/game"
 	"github.com/gonewx/pvz/pkg/utils"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
 // RenderSystem 管理游戏世界实体的渲染
@@ -47,11 +50,14 @@ type RenderSystem struct {
 	resourceManager interface {
 		GetImageByID(string) *ebiten.Image
 		GetShadowImage() *ebiten.Image // Story 10.7: 添加获取阴影贴图的方法
-	} // 资源管理器（用于加载房门图片、阴影贴图等）
+		LoadImage(string) (*ebiten.Image, error)
+	} // 资源管理器（用于加载房门图片、阴影贴图、饰品受损图片等）
 	debugPrinted      map[ecs.EntityID]bool // 记录已打印调试信息的实体
 	particleVertices  []ebiten.Vertex       // 粒子顶点数组（复用，避免每帧分配）
 	particleIndices   []uint16              // 粒子索引数组（复用，避免每帧分配）
 	particleDebugOnce bool                  // 粒子调试日志只输出一次
+	largeTutorialFont interface{}           // 小游戏规则设置的教学文本大字体（可为 nil）
+	lawnLayout        config.LawnLayout     // 当前场景的草坪行布局（用于水面、花盆、冰道等按行绘制的元素）
 }
 
 // NewRenderSystem 创建一个新的渲染系统
@@ -62,6 +68,7 @@ func NewRenderSystem(em *ecs.EntityManager) *RenderSystem {
 		particleVertices:  make([]ebiten.Vertex, 0, 4000), // 预分配容量：支持 1000 个粒子（每粒子 4 顶点）
 		particleIndices:   make([]uint16, 0, 6000),        // 预分配容量：支持 1000 个粒子（每粒子 6 索引）
 		particleDebugOnce: true,                           // 启用一次调试日志
+		lawnLayout:        config.GetLawnLayout("day"),
 	}
 }
 
@@ -70,11 +77,23 @@ func (s *RenderSystem) SetReanimSystem(rs *ReanimSystem) {
 	s.reanimSystem = rs
 }
 
-// SetResourceManager 设置 ResourceManager 引用（用于加载房门图片、阴影贴图等）
+// SetLargeTutorialFont 设置教学文本大字体（如保龄球关卡的 42px 字体）
+// 设置后所有教学文本都使用该字体
+func (s *RenderSystem) SetLargeTutorialFont(font interface{}) {
+	s.largeTutorialFont = font
+}
+
+// SetLawnLayout 设置当前场景的草坪行布局（未设置时使用前院布局）
+func (s *RenderSystem) SetLawnLayout(layout config.LawnLayout) {
+	s.lawnLayout = layout
+}
+
+// SetResourceManager 设置 ResourceManager 引用（用于加载房门图片、阴影贴图、饰品受损图片等）
 // Story 10.7: 扩展接口以支持 GetShadowImage()
 func (s *RenderSystem) SetResourceManager(rm interface {
 	GetImageByID(string) *ebiten.Image
 	GetShadowImage() *ebiten.Image
+	LoadImage(string) (*ebiten.Image, error)
 }) {
 	s.resourceManager = rm
 }
@@ -126,10 +145,23 @@ func (s *RenderSystem) DrawGameWorld(screen *ebiten.Image, cameraX float64) {
 		*components.ReanimComponent,
 	](s.entityManager)
 
+	// 冰道铺在草地上，位于所有实体和阴影之下
+	s.drawIceTrails(screen, cameraX)
+
+	// 墓碑立在格子上，种在墓碑上的墓碑吞噬者绘制在墓碑之上
+	s.drawGraves(screen, cameraX)
+
+	// 砸罐子关卡的罐子与墓碑一样立在格子上，僵尸从罐子前走过
+	s.drawVases(screen, cameraX)
+
+	// 雪橇队共乘的雪橇在冰道上，队员绘制在雪橇之上
+	s.drawBobsleds(screen, cameraX)
+
 	// Story 10.7: 第一遍A：渲染植物阴影（底层-阴影层）
 	s.drawPlantShadows(screen, entities, cameraX)
 
 	// 第一遍：渲染植物（底层）
+	plantEntities := make([]ecs.EntityID, 0)
 	for _, id := range entities {
 		// 跳过植物卡片实体（它们由 PlantCardRenderSystem 专门渲染）
 		if _, hasPlantCard := ecs.GetComponent[*components.PlantCardComponent](s.entityManager, id); hasPlantCard {
@@ -149,11 +181,19 @@ func (s *RenderSystem) DrawGameWorld(screen *ebiten.Image, cameraX float64) {
 		}
 
 		// 只渲染植物
-		_, isPlant := ecs.GetComponent[*components.PlantComponent](s.entityManager, id)
+		plant, isPlant := ecs.GetComponent[*components.PlantComponent](s.entityManager, id)
 		if !isPlant {
 			continue // 跳过非植物实体
 		}
 
+		// 睡莲、花盆先绘制，种在上面的植物覆盖在其上方
+		if plant.PlantType.IsPlatform() {
+			s.drawEntity(screen, id, cameraX)
+		} else {
+			plantEntities = append(plantEntities, id)
+		}
+	}
+	for _, id := range plantEntities {
 		s.drawEntity(screen, id, cameraX)
 	}
 
@@ -294,6 +334,9 @@ func (s *RenderSystem) DrawGameWorld(screen *ebiten.Image, cameraX float64) {
 		}
 	}
 
+	// 雾覆盖在植物、僵尸和子弹之上
+	s.drawFog(screen, cameraX)
+
 	// 渲染房门上层图片（门板），遮挡僵尸
 	// 注意：必须在僵尸之后、UI元素（ZombiesWon动画）之前渲染
 	if currentPhase >= 2 && s.resourceManager != nil {
@@ -743,6 +786,10 @@ func (s *RenderSystem) DrawGameWorldParticles(screen *ebiten.Image, cameraX floa
 	for _, id := range entities {
 		_, isUIParticle := ecs.GetComponent[*components.UIComponent](s.entityManager, id)
 		if !isUIParticle {
+			// 粒子绘制在雾之上，雾中的粒子（如被击中僵尸的溅射）不绘制，避免暴露雾中的僵尸
+			if pos, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, id); ok && IsHiddenByFog(s.entityManager, s.lawnLayout, pos.X, pos.Y) {
+				continue
+			}
 			gameWorldEntities = append(gameWorldEntities, id)
 		} else {
 			uiParticleCount++
@@ -1039,9 +1086,12 @@ func (s *RenderSystem) buildParticleVertices(particle *components.ParticleCompon
 // 在屏幕底部中央显示教学提示文本，带半透明黑色背景条
 // 参数:
 //   - screen: 绘制目标屏幕
-//   - tutorialFont: 教学字体（SimHei.ttf 或其他 TrueType 字体）
-//   - bowlingFont: Level 1-5 保龄球关卡专用大字体（可为 nil）
-func (s *RenderSystem) DrawTutorialText(screen *ebiten.Image, tutorialFont interface{}, bowlingFont interface{}) {
+//   - tutorialFont: 教学字体（SimHei.ttf 或其他 TrueType 字体），设置了大字体时使用大字体
+func (s *RenderSystem) DrawTutorialText(screen *ebiten.Image, tutorialFont interface{}) {
+	if s.largeTutorialFont != nil {
+		tutorialFont = s.largeTutorialFont
+	}
+
 	// 查询教学文本实体
 	textEntities := ecs.GetEntitiesWith1[*components.TutorialTextComponent](s.entityManager)
 
@@ -1071,12 +1121,7 @@ func (s *RenderSystem) DrawTutorialText(screen *ebiten.Image, tutorialFont inter
 			bgOffsetFromBottom = config.BowlingTutorialTextBackgroundOffsetFromBottom
 			textOffsetFromBottom = config.BowlingTutorialTextOffsetFromBottom
 			bgHeight = config.BowlingTutorialTextBackgroundHeight
-			// 优先使用保龄球专用字体
-			if bowlingFont != nil {
-				activeFont = bowlingFont
-			} else {
-				activeFont = tutorialFont
-			}
+			activeFont = tutorialFont
 		} else if textComp.IsAdvisory {
 			// 提示性教学（Level 1-2）：更靠下
 			bgOffsetFromBottom = config.AdvisoryTutorialTextBackgroundOffsetFromBottom
@@ -1219,9 +1264,10 @@ func (s *RenderSystem) drawGameOverDoorUnderlay(screen *ebiten.Image, cameraX fl
 	}
 
 	// 加载房门下层图片（阴影）
-	underlayImg := s.resourceManager.GetImageByID("IMAGE_BACKGROUND1_GAMEOVER_INTERIOR_OVERLAY")
+	underlayID := gameOverDoorImageID("_GAMEOVER_INTERIOR_OVERLAY")
+	underlayImg := s.resourceManager.GetImageByID(underlayID)
 	if underlayImg == nil {
-		log.Printf("[RenderSystem] 警告：无法加载房门下层图片 IMAGE_BACKGROUND1_GAMEOVER_INTERIOR_OVERLAY")
+		log.Printf("[RenderSystem] 警告：无法加载房门下层图片 %s", underlayID)
 		return
 	}
 
@@ -1252,9 +1298,10 @@ func (s *RenderSystem) drawGameOverDoorOverlay(screen *ebiten.Image, cameraX flo
 	}
 
 	// 加载房门上层图片（门板）
-	overlayImg := s.resourceManager.GetImageByID("IMAGE_BACKGROUND1_GAMEOVER_MASK")
+	overlayID := gameOverDoorImageID("_GAMEOVER_MASK")
+	overlayImg := s.resourceManager.GetImageByID(overlayID)
 	if overlayImg == nil {
-		log.Printf("[RenderSystem] 警告：无法加载房门上层图片 IMAGE_BACKGROUND1_GAMEOVER_MASK")
+		log.Printf("[RenderSystem] 警告：无法加载房门上层图片 %s", overlayID)
 		return
 	}
 
@@ -1272,6 +1319,15 @@ func (s *RenderSystem) drawGameOverDoorOverlay(screen *ebiten.Image, cameraX flo
 	screen.DrawImage(overlayImg, op)
 }
 
+// gameOverDoorImageID 返回当前场景背景对应的房门图片ID（如 IMAGE_BACKGROUND2_GAMEOVER_MASK）
+func gameOverDoorImageID(suffix string) string {
+	sceneType := ""
+	if level := game.GetGameState().CurrentLevel; level != nil {
+		sceneType = level.SceneType
+	}
+	return config.GetSceneBackground(sceneType).ImageID + suffix
+}
+
 // drawPlantShadows 渲染植物阴影
 // Story 10.7: 为植物添加阴影效果以增加场景深度感
 //
@@ -1303,11 +1359,19 @@ func (s *RenderSystem) drawPlantShadows(screen *ebiten.Image, entities []ecs.Ent
 	// 遍历所有植物实体，渲染阴影
 	for _, id := range entities {
 		// 跳过非植物实体
-		_, isPlant := ecs.GetComponent[*components.PlantComponent](s.entityManager, id)
+		plant, isPlant := ecs.GetComponent[*components.PlantComponent](s.entityManager, id)
 		if !isPlant {
 			continue
 		}
 
+		// 水面上的植物没有阴影，屋顶上种在花盆里的植物使用花盆的阴影
+		if s.lawnLayout.IsWaterRow(plant.GridRow) {
+			continue
+		}
+		if s.lawnLayout.NeedsFlowerPot && plant.PlantType != components.PlantFlowerPot {
+			continue
+		}
+
 		// 获取位置组件
 		pos, hasPos := ecs.GetComponent[*components.PositionComponent](s.entityManager, id)
 		if !hasPos {
@@ -1318,7 +1382,7 @@ func (s *RenderSystem) drawPlantShadows(screen *ebiten.Image, entities []ecs.Ent
 		// 格子底部 Y = pos.Y + CellHeight/2
 		// 阴影上移偏移量，让阴影看起来在植物脚下而不是脚后面
 		shadowOffsetY := config.PlantShadowOffsetY // 可配置的偏移量
-		footY := pos.Y + config.CellHeight/2 + shadowOffsetY
+		footY := pos.Y + s.lawnLayout.CellHeight/2 + shadowOffsetY
 		screenX := pos.X - shadowImgWidth/2 - cameraX
 		screenY := footY - shadowImgHeight/2
 
@@ -1332,6 +1396,188 @@ func (s *RenderSystem) drawPlantShadows(screen *ebiten.Image, entities []ecs.Ent
 	}
 }
 
+// drawIceTrails 渲染雪橇车僵尸留下的冰道
+// 每个有冰道的格子绘制一段冰面，每行冰道最左端绘制冰道端头；
+// 冰道在消失前 ZomboniIceFadeDuration 秒内逐渐淡出
+//
+// 参数:
+//   - screen: 绘制目标屏幕
+//   - cameraX: 摄像机X坐标
+func (s *RenderSystem) drawIceTrails(screen *ebiten.Image, cameraX float64) {
+	if s.resourceManager == nil {
+		return
+	}
+	gridEntities := ecs.GetEntitiesWith1[*components.LawnGridComponent](s.entityManager)
+	if len(gridEntities) == 0 {
+		return
+	}
+	grid, _ := ecs.GetComponent[*components.LawnGridComponent](s.entityManager, gridEntities[0])
+
+	iceImg := s.resourceManager.GetImageByID("IMAGE_ICE")
+	if iceImg == nil {
+		return
+	}
+	capImg := s.resourceManager.GetImageByID("IMAGE_ICE_CAP")
+
+	iceBounds := iceImg.Bounds()
+	iceHeight := float64(iceBounds.Dy())
+	scaleX := config.CellWidth / float64(iceBounds.Dx())
+
+	for row := range grid.Ice {
+		bottomY := s.lawnLayout.StartY + float64(row+1)*s.lawnLayout.CellHeight + config.IceTrailOffsetY
+		for col, remaining := range grid.Ice[row] {
+			if remaining <= 0 {
+				continue
+			}
+			alpha := float32(1.0)
+			if remaining < config.ZomboniIceFadeDuration {
+				alpha = float32(remaining / config.ZomboniIceFadeDuration)
+			}
+			cellX := config.GridWorldStartX + float64(col)*config.CellWidth - cameraX
+
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Scale(scaleX, 1)
+			op.GeoM.Translate(cellX, bottomY-iceHeight)
+			op.ColorScale.ScaleAlpha(alpha)
+			screen.DrawImage(iceImg, op)
+
+			// 冰道最左端
+			if capImg != nil && (col == 0 || grid.Ice[row][col-1] <= 0) {
+				capBounds := capImg.Bounds()
+				capOp := &ebiten.DrawImageOptions{}
+				capOp.GeoM.Translate(cellX-float64(capBounds.Dx()), bottomY-float64(capBounds.Dy()))
+				capOp.ColorScale.ScaleAlpha(alpha)
+				screen.DrawImage(capImg, capOp)
+			}
+		}
+	}
+}
+
+// drawGraves 渲染夜间关卡的墓碑（图片底边中心对齐格子底部中心）
+func (s *RenderSystem) drawGraves(screen *ebiten.Image, cameraX float64) {
+	for _, entityID := range ecs.GetEntitiesWith2[*components.GraveComponent, *components.PositionComponent](s.entityManager) {
+		grave, _ := ecs.GetComponent[*components.GraveComponent](s.entityManager, entityID)
+		if grave.Image == nil {
+			continue
+		}
+		pos, _ := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+
+		bounds := grave.Image.Bounds()
+		bottomY := pos.Y + s.lawnLayout.CellHeight/2 + config.GraveOffsetY
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(pos.X-cameraX-float64(bounds.Dx())/2, bottomY-float64(bounds.Dy()))
+		screen.DrawImage(grave.Image, op)
+	}
+}
+
+// drawBobsleds 渲染雪橇队共乘的雪橇（图片底边对齐格子底部，左边缘对齐最前方座位）
+func (s *RenderSystem) drawBobsleds(screen *ebiten.Image, cameraX float64) {
+	if s.resourceManager == nil {
+		return
+	}
+	sleds := ecs.GetEntitiesWith2[*components.BobsledSledComponent, *components.PositionComponent](s.entityManager)
+	if len(sleds) == 0 {
+		return
+	}
+	sledImg := s.resourceManager.GetImageByID("IMAGE_ZOMBIE_BOBSLED1")
+	if sledImg == nil {
+		return
+	}
+
+	bounds := sledImg.Bounds()
+	for _, entityID := range sleds {
+		pos, _ := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+		bottomY := pos.Y + s.lawnLayout.CellHeight/2 + config.BobsledSledOffsetY
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(pos.X+config.BobsledSledOffsetX-cameraX, bottomY-float64(bounds.Dy()))
+		screen.DrawImage(sledImg, op)
+	}
+}
+
+// drawVases 渲染砸罐子关卡的罐子（图片底边中心对齐格子底部中心）
+func (s *RenderSystem) drawVases(screen *ebiten.Image, cameraX float64) {
+	for _, entityID := range ecs.GetEntitiesWith2[*components.VaseComponent, *components.PositionComponent](s.entityManager) {
+		vase, _ := ecs.GetComponent[*components.VaseComponent](s.entityManager, entityID)
+		if vase.Image == nil {
+			continue
+		}
+		pos, _ := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+
+		bounds := vase.Image.Bounds()
+		bottomY := pos.Y + s.lawnLayout.CellHeight/2 + config.VaseOffsetY
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(pos.X-cameraX-float64(bounds.Dx())/2, bottomY-float64(bounds.Dy()))
+		screen.DrawImage(vase.Image, op)
+	}
+}
+
+// DrawSeedPackets 渲染草坪上可以拾取的种子包（与阳光一样绘制在游戏世界之上）
+// 种子包以位置为中心绘制，被拾起（正在种植）的种子包半透明显示
+func (s *RenderSystem) DrawSeedPackets(screen *ebiten.Image, cameraX float64) {
+	for _, entityID := range ecs.GetEntitiesWith2[*components.SeedPacketComponent, *components.PositionComponent](s.entityManager) {
+		packet, _ := ecs.GetComponent[*components.SeedPacketComponent](s.entityManager, entityID)
+		if packet.Card == nil || packet.Card.BackgroundImage == nil {
+			continue
+		}
+		pos, _ := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+
+		packet.Card.Alpha = 1.0
+		if packet.Held {
+			packet.Card.Alpha = config.SeedPacketHeldAlpha
+		}
+		bounds := packet.Card.BackgroundImage.Bounds()
+		width := float64(bounds.Dx()) * packet.Card.CardScale
+		height := float64(bounds.Dy()) * packet.Card.CardScale
+		entities.RenderPlantCard(screen, packet.Card, pos.X-cameraX-width/2, pos.Y-height/2, nil, 0)
+	}
+}
+
+// drawFog 渲染雾夜关卡的雾
+// 每个格子绘制一帧雾图集（帧以格子中心对齐，相邻格子的雾互相重叠），透明度为格子的雾浓度；
+// 草坪右侧街道使用最右侧一列的雾浓度。闪电期间雾被照亮（变透明）并叠加白色闪光
+func (s *RenderSystem) drawFog(screen *ebiten.Image, cameraX float64) {
+	fogEntities := ecs.GetEntitiesWith1[*components.FogComponent](s.entityManager)
+	if len(fogEntities) == 0 {
+		return
+	}
+	fog, _ := ecs.GetComponent[*components.FogComponent](s.entityManager, fogEntities[0])
+
+	flash := 0.0
+	if fog.IsFlashing() {
+		flash = fog.FlashTimer / config.FogLightningFlashDuration
+	}
+
+	if fog.Image != nil && flash < 1 {
+		bounds := fog.Image.Bounds()
+		frameW := bounds.Dx() / config.FogImageFrames
+		frameH := bounds.Dy()
+
+		for row := 0; row < s.lawnLayout.Rows; row++ {
+			centerY := s.lawnLayout.StartY + float64(row)*s.lawnLayout.CellHeight + s.lawnLayout.CellHeight/2
+			for col := 0; col < config.GridColumns+config.FogStreetColumns; col++ {
+				density := fog.Density[row][min(col, config.GridColumns-1)]
+				if density <= 0 {
+					continue
+				}
+				frame := (row*3 + col) % config.FogImageFrames
+				frameImg := fog.Image.SubImage(image.Rect(frame*frameW, 0, (frame+1)*frameW, frameH)).(*ebiten.Image)
+
+				centerX := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+				op := &ebiten.DrawImageOptions{}
+				op.GeoM.Translate(centerX-cameraX-float64(frameW)/2, centerY-float64(frameH)/2)
+				op.ColorScale.ScaleAlpha(float32(density * (1 - flash)))
+				screen.DrawImage(frameImg, op)
+			}
+		}
+	}
+
+	if flash > 0 {
+		bounds := screen.Bounds()
+		alpha := uint8(255 * config.FogLightningFlashAlpha * flash)
+		vector.DrawFilledRect(screen, 0, 0, float32(bounds.Dx()), float32(bounds.Dy()), color.NRGBA{R: 255, G: 255, B: 255, A: alpha}, false)
+	}
+}
+
 // drawZombieShadows 渲染僵尸阴影
 // Story 10.7: 为僵尸添加阴影效果以增加场景深度感
 //
@@ -1399,7 +1645,7 @@ func (s *RenderSystem) drawZombieShadowsWithClipping(screen *ebiten.Image, zombi
 		// 阴影位置基于 pos.Y 计算，无需额外校正
 		shadowOffsetX := config.ZombieShadowOffsetX // 可配置的 X 偏移量
 		shadowOffsetY := config.ZombieShadowOffsetY // 可配置的 Y 偏移量
-		footY := pos.Y - config.ZombieVerticalOffset + config.CellHeight/2 + shadowOffsetY
+		footY := pos.Y - config.ZombieVerticalOffset + s.lawnLayout.CellHeight/2 + shadowOffsetY
 		shadowWorldX := pos.X - shadowImgWidth/2 + shadowOffsetX
 		shadowWorldRightX := shadowWorldX + shadowImgWidth
 		screenX := shadowWorldX - cameraX
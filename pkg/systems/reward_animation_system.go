@@ -71,6 +71,7 @@ type RewardAnimationSystem struct {
 	reanimSystem    *ReanimSystem      // Reanim系统用于创建和管理动画
 	particleSystem  *ParticleSystem    // 粒子系统用于检查粒子特效完成状态
 	renderSystem    *RenderSystem      // 渲染系统用于绘制Reanim和粒子效果
+	layout          config.LawnLayout  // 当前场景的草坪行布局（卡片包从草坪行中弹出）
 	rewardEntity    ecs.EntityID       // 奖励动画实体ID（卡片包）
 	panelEntity     ecs.EntityID       // 奖励面板实体ID
 	glowEntity      ecs.EntityID       // 光晕粒子发射器实体ID
@@ -88,7 +89,7 @@ type RewardAnimationSystem struct {
 }
 
 // NewRewardAnimationSystem 创建新的奖励动画系统。
-func NewRewardAnimationSystem(em *ecs.EntityManager, gs *game.GameState, rm *game.ResourceManager, sm *game.SceneManager, reanimSys *ReanimSystem, particleSys *ParticleSystem, renderSys *RenderSystem) *RewardAnimationSystem {
+func NewRewardAnimationSystem(em *ecs.EntityManager, gs *game.GameState, rm *game.ResourceManager, sm *game.SceneManager, reanimSys *ReanimSystem, particleSys *ParticleSystem, renderSys *RenderSystem, layout config.LawnLayout) *RewardAnimationSystem {
 	// Story 8.4重构：内部创建所有渲染系统，调用者无需关心
 	panelRenderSystem := NewRewardPanelRenderSystem(em, gs, rm, reanimSys)
 
@@ -111,6 +112,7 @@ func NewRewardAnimationSystem(em *ecs.EntityManager, gs *game.GameState, rm *gam
 		reanimSystem:      reanimSys,
 		particleSystem:    particleSys,
 		renderSystem:      renderSys, // 渲染系统用于绘制Reanim和粒子
+		layout:            layout,
 		rewardEntity:      0,
 		panelEntity:       0,
 		glowEntity:        0,
@@ -146,13 +148,13 @@ func (ras *RewardAnimationSystem) TriggerReward(rewardType string, rewardID stri
 	ras.rewardEntity = ras.entityManager.CreateEntity()
 	ras.isActive = true
 
-	// 随机选择草坪行（2-4行，偏中间位置）
-	randomLane := 1 + rand.Intn(3) // 第2、3或4行
+	// 随机选择草坪行（去掉最上和最下一行，偏中间位置）
+	randomLane := 1 + rand.Intn(ras.layout.Rows-2) // 前院为第2、3或4行
 
 	// 计算起始位置（屏幕坐标，从屏幕右侧弹出）
 	// 屏幕宽度：800，选择屏幕右半部分（500-700）
 	startX := 500.0 + rand.Float64()*200.0 // 屏幕坐标 500-700
-	startY := config.GridWorldStartY + float64(randomLane)*config.CellHeight + config.CellHeight/2.0
+	startY := ras.layout.StartY + float64(randomLane)*ras.layout.CellHeight + ras.layout.CellHeight/2.0
 
 	// 根据奖励类型计算不同的目标位置和初始缩放
 	var targetX, targetY float64
@@ -180,7 +182,7 @@ func (ras *RewardAnimationSystem) TriggerReward(rewardType string, rewardID stri
 	} else {
 		// 植物奖励：目标位置是屏幕中央上方
 		screenCenterX := ras.screenWidth / 2.0
-		lawnTopY := config.GridWorldStartY
+		lawnTopY := ras.layout.StartY
 
 		// 卡片原始尺寸 100x140，目标缩放 1.0
 		cardWidthAtEnd := 100.0
@@ -1090,6 +1092,46 @@ func (ras *RewardAnimationSystem) plantIDToType(plantID string) components.Plant
 		return components.PlantWallnut
 	case "potatomine":
 		return components.PlantPotatoMine
+	case "magnetshroom":
+		return components.PlantMagnetshroom
+	case "hypnoshroom":
+		return components.PlantHypnoshroom
+	case "kernelpult":
+		return components.PlantKernelpult
+	case "cobcannon":
+		return components.PlantCobCannon
+	case "splitpea":
+		return components.PlantSplitPea
+	case "starfruit":
+		return components.PlantStarfruit
+	case "cattail":
+		return components.PlantCattail
+	case "tallnut":
+		return components.PlantTallnut
+	case "gravebuster":
+		return components.PlantGraveBuster
+	case "lilypad":
+		return components.PlantLilyPad
+	case "tanglekelp":
+		return components.PlantTangleKelp
+	case "seashroom":
+		return components.PlantSeaShroom
+	case "plantern":
+		return components.PlantPlantern
+	case "blover":
+		return components.PlantBlover
+	case "flowerpot":
+		return components.PlantFlowerPot
+	case "jalapeno":
+		return components.PlantJalapeno
+	case "iceshroom":
+		return components.PlantIceShroom
+	case "spikeweed":
+		return components.PlantSpikeweed
+	case "chomper":
+		return components.PlantChomper
+	case "umbrellaleaf":
+		return components.PlantUmbrellaLeaf
 	default:
 		return components.PlantUnknown
 	}
@@ -1108,6 +1150,46 @@ func (ras *RewardAnimationSystem) getReanimName(plantID string) string {
 		return "Wallnut"
 	case "potatomine":
 		return "PotatoMine"
+	case "magnetshroom":
+		return "Magnetshroom"
+	case "hypnoshroom":
+		return "Hypnoshroom"
+	case "kernelpult":
+		return "Cornpult"
+	case "cobcannon":
+		return "CobCannon"
+	case "splitpea":
+		return "SplitPea"
+	case "starfruit":
+		return "Starfruit"
+	case "cattail":
+		return "Cattail"
+	case "tallnut":
+		return "Tallnut"
+	case "gravebuster":
+		return "Gravebuster"
+	case "lilypad":
+		return "Lilypad"
+	case "tanglekelp":
+		return "Tanglekelp"
+	case "seashroom":
+		return "SeaShroom"
+	case "plantern":
+		return "Plantern"
+	case "blover":
+		return "Blover"
+	case "flowerpot":
+		return "Pot"
+	case "jalapeno":
+		return "Jalapeno"
+	case "iceshroom":
+		return "Iceshroom"
+	case "spikeweed":
+		return "Caltrop"
+	case "chomper":
+		return "Chomper"
+	case "umbrellaleaf":
+		return "Umbrellaleaf"
 	default:
 		return ""
 	}
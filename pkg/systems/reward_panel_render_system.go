@@ -382,6 +382,46 @@ func (rprs *RewardPanelRenderSystem) getPlantType(plantID string) components.Pla
 		return components.PlantWallnut
 	case "potatomine":
 		return components.PlantPotatoMine
+	case "magnetshroom":
+		return components.PlantMagnetshroom
+	case "hypnoshroom":
+		return components.PlantHypnoshroom
+	case "kernelpult":
+		return components.PlantKernelpult
+	case "cobcannon":
+		return components.PlantCobCannon
+	case "splitpea":
+		return components.PlantSplitPea
+	case "starfruit":
+		return components.PlantStarfruit
+	case "cattail":
+		return components.PlantCattail
+	case "tallnut":
+		return components.PlantTallnut
+	case "gravebuster":
+		return components.PlantGraveBuster
+	case "lilypad":
+		return components.PlantLilyPad
+	case "tanglekelp":
+		return components.PlantTangleKelp
+	case "seashroom":
+		return components.PlantSeaShroom
+	case "plantern":
+		return components.PlantPlantern
+	case "blover":
+		return components.PlantBlover
+	case "flowerpot":
+		return components.PlantFlowerPot
+	case "jalapeno":
+		return components.PlantJalapeno
+	case "iceshroom":
+		return components.PlantIceShroom
+	case "spikeweed":
+		return components.PlantSpikeweed
+	case "chomper":
+		return components.PlantChomper
+	case "umbrellaleaf":
+		return components.PlantUmbrellaLeaf
 	default:
 		return components.PlantUnknown
 	}
@@ -401,6 +441,46 @@ func (rprs *RewardPanelRenderSystem) getReanimName(plantType components.PlantTyp
 		return "Wallnut" // 修复：与资源加载时的名称一致（小写n）
 	case components.PlantPotatoMine:
 		return "PotatoMine"
+	case components.PlantMagnetshroom:
+		return "Magnetshroom"
+	case components.PlantHypnoshroom:
+		return "Hypnoshroom"
+	case components.PlantKernelpult:
+		return "Cornpult"
+	case components.PlantCobCannon:
+		return "CobCannon"
+	case components.PlantSplitPea:
+		return "SplitPea"
+	case components.PlantStarfruit:
+		return "Starfruit"
+	case components.PlantCattail:
+		return "Cattail"
+	case components.PlantTallnut:
+		return "Tallnut"
+	case components.PlantGraveBuster:
+		return "Gravebuster"
+	case components.PlantLilyPad:
+		return "Lilypad"
+	case components.PlantTangleKelp:
+		return "Tanglekelp"
+	case components.PlantSeaShroom:
+		return "SeaShroom"
+	case components.PlantPlantern:
+		return "Plantern"
+	case components.PlantBlover:
+		return "Blover"
+	case components.PlantFlowerPot:
+		return "Pot"
+	case components.PlantJalapeno:
+		return "Jalapeno"
+	case components.PlantIceShroom:
+		return "Iceshroom"
+	case components.PlantSpikeweed:
+		return "Caltrop"
+	case components.PlantChomper:
+		return "Chomper"
+	case components.PlantUmbrellaLeaf:
+		return "Umbrellaleaf"
 	default:
 		return ""
 	}
@@ -419,6 +499,46 @@ func (rprs *RewardPanelRenderSystem) getConfigID(plantType components.PlantType)
 		return "wallnut"
 	case components.PlantPotatoMine:
 		return "potatomine"
+	case components.PlantMagnetshroom:
+		return "magnetshroom"
+	case components.PlantHypnoshroom:
+		return "hypnoshroom"
+	case components.PlantKernelpult:
+		return "cornpult"
+	case components.PlantCobCannon:
+		return "cobcannon"
+	case components.PlantSplitPea:
+		return "splitpea"
+	case components.PlantStarfruit:
+		return "starfruit"
+	case components.PlantCattail:
+		return "cattail"
+	case components.PlantTallnut:
+		return "tallnut"
+	case components.PlantGraveBuster:
+		return "gravebuster"
+	case components.PlantLilyPad:
+		return "lilypad"
+	case components.PlantTangleKelp:
+		return "tanglekelp"
+	case components.PlantSeaShroom:
+		return "seashroom"
+	case components.PlantPlantern:
+		return "plantern"
+	case components.PlantBlover:
+		return "blover"
+	case components.PlantFlowerPot:
+		return "pot"
+	case components.PlantJalapeno:
+		return "jalapeno"
+	case components.PlantIceShroom:
+		return "iceshroom"
+	case components.PlantSpikeweed:
+		return "caltrop"
+	case components.PlantChomper:
+		return "chomper"
+	case components.PlantUmbrellaLeaf:
+		return "umbrellaleaf"
 	default:
 		return ""
 	}
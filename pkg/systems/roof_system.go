@@ -0,0 +1,118 @@
+package systems
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+)
+
+// RoofSystem 屋顶系统（屋顶关卡）
+//
+// 职责：
+//   - 植物、僵尸、屋顶清洁车和僵王博士的火球/冰球跟随屋顶斜坡的高度（SlopeFollowerComponent）
+//   - 向右飞行的直线子弹在斜坡升高处撞上屋顶消失（RoofProjectileComponent）
+//
+// 实体的世界坐标Y包含斜坡偏移，计算所在行时需要减去 LawnLayout.SlopeOffsetY
+type RoofSystem struct {
+	entityManager *ecs.EntityManager
+	layout        config.LawnLayout // 屋顶场景的草坪行布局
+}
+
+// NewRoofSystem 创建屋顶系统
+func NewRoofSystem(em *ecs.EntityManager, layout config.LawnLayout) *RoofSystem {
+	return &RoofSystem{
+		entityManager: em,
+		layout:        layout,
+	}
+}
+
+// Update 更新斜坡跟随和子弹撞顶
+func (s *RoofSystem) Update(deltaTime float64) {
+	s.attachSlopeFollowers()
+	s.updateSlopeFollowers()
+	s.updateProjectiles()
+}
+
+// attachSlopeFollowers 为新创建的植物、清洁车和僵尸添加斜坡跟随组件
+// 蹦极僵尸从空中落到目标格子，落点高度由蹦极行为自行计算
+func (s *RoofSystem) attachSlopeFollowers() {
+	for _, entityID := range ecs.GetEntitiesWith1[*components.PositionComponent](s.entityManager) {
+		if ecs.HasComponent[*components.SlopeFollowerComponent](s.entityManager, entityID) {
+			continue
+		}
+		if !s.isGroundEntity(entityID) {
+			continue
+		}
+		ecs.AddComponent(s.entityManager, entityID, &components.SlopeFollowerComponent{})
+	}
+}
+
+// isGroundEntity 判断实体是否站在屋顶上（植物、清洁车、僵尸、火球/冰球）
+func (s *RoofSystem) isGroundEntity(entityID ecs.EntityID) bool {
+	if ecs.HasComponent[*components.PlantComponent](s.entityManager, entityID) ||
+		ecs.HasComponent[*components.LawnmowerComponent](s.entityManager, entityID) ||
+		ecs.HasComponent[*components.ZombossBallComponent](s.entityManager, entityID) {
+		return true
+	}
+	if ecs.HasComponent[*components.BungeeComponent](s.entityManager, entityID) {
+		return false
+	}
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+	return ok && isRoofZombieBehavior(behavior.Type)
+}
+
+// isRoofZombieBehavior 判断行为类型是否是僵尸（包括死亡中的僵尸）
+func isRoofZombieBehavior(behaviorType components.BehaviorType) bool {
+	switch behaviorType {
+	case components.BehaviorZombieBasic,
+		components.BehaviorZombieEating,
+		components.BehaviorZombieDying,
+		components.BehaviorZombieSquashing,
+		components.BehaviorZombieDyingExplosion,
+		components.BehaviorZombieConehead,
+		components.BehaviorZombieBuckethead,
+		components.BehaviorZombieFlag,
+		components.BehaviorZombiePreview:
+		return true
+	}
+	return false
+}
+
+// updateSlopeFollowers 按实体当前X坐标更新斜坡偏移，把变化量叠加到Y坐标上
+func (s *RoofSystem) updateSlopeFollowers() {
+	for _, entityID := range ecs.GetEntitiesWith2[*components.PositionComponent, *components.SlopeFollowerComponent](s.entityManager) {
+		pos, _ := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+		follower, _ := ecs.GetComponent[*components.SlopeFollowerComponent](s.entityManager, entityID)
+
+		offset := s.layout.SlopeOffsetY(pos.X)
+		pos.Y += offset - follower.OffsetY
+		follower.OffsetY = offset
+	}
+}
+
+// updateProjectiles 记录直线子弹的发射高度，飞到高于发射点 RoofProjectileClearance 的斜坡时销毁子弹
+func (s *RoofSystem) updateProjectiles() {
+	for _, entityID := range ecs.GetEntitiesWith3[*components.PositionComponent, *components.VelocityComponent, *components.BehaviorComponent](s.entityManager) {
+		behavior, _ := ecs.GetComponent[*components.BehaviorComponent](s.entityManager, entityID)
+		vel, _ := ecs.GetComponent[*components.VelocityComponent](s.entityManager, entityID)
+		if !components.IsDirectProjectile(behavior.Type) || vel.VX <= 0 {
+			continue
+		}
+
+		pos, _ := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
+		projectile, ok := ecs.GetComponent[*components.RoofProjectileComponent](s.entityManager, entityID)
+		if !ok {
+			ecs.AddComponent(s.entityManager, entityID, &components.RoofProjectileComponent{
+				LaunchOffsetY: s.layout.SlopeOffsetY(pos.X),
+			})
+			continue
+		}
+
+		if projectile.LaunchOffsetY-s.layout.SlopeOffsetY(pos.X) > config.RoofProjectileClearance {
+			log.Printf("[RoofSystem] 子弹 %d 撞上屋顶斜坡 (X=%.1f)", entityID, pos.X)
+			s.entityManager.DestroyEntity(entityID)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+)
+
+// TestRoofSlopeFollowers 测试植物和僵尸跟随屋顶斜坡的高度
+func TestRoofSlopeFollowers(t *testing.T) {
+	roof := config.GetLawnLayout("roof")
+	em := ecs.NewEntityManager()
+	system := NewRoofSystem(em, roof)
+
+	plantX, plantY := cellCenter(roof, 0, 2)
+	plantID := em.CreateEntity()
+	ecs.AddComponent(em, plantID, &components.PositionComponent{X: plantX, Y: plantY})
+	ecs.AddComponent(em, plantID, &components.PlantComponent{PlantType: components.PlantFlowerPot, GridCol: 0, GridRow: 2})
+
+	zombieX, zombieY := cellCenter(roof, config.GridColumns-1, 2)
+	zombieID := em.CreateEntity()
+	zombiePos := &components.PositionComponent{X: zombieX, Y: zombieY}
+	ecs.AddComponent(em, zombieID, zombiePos)
+	ecs.AddComponent(em, zombieID, &components.BehaviorComponent{Type: components.BehaviorZombieBasic})
+
+	system.Update(0.1)
+
+	plantPos, _ := ecs.GetComponent[*components.PositionComponent](em, plantID)
+	if want := plantY + roof.CellSlopeOffsetY(0); plantPos.Y != want {
+		t.Errorf("斜坡上的植物 Y 应为 %.1f，实际 %.1f", want, plantPos.Y)
+	}
+	if zombiePos.Y != zombieY {
+		t.Errorf("平坦区域的僵尸 Y 不应变化，实际 %.1f", zombiePos.Y)
+	}
+
+	// 僵尸向左走到斜坡上，Y 随之下降；走回原位后恢复
+	zombiePos.X = plantX
+	system.Update(0.1)
+	if want := zombieY + roof.CellSlopeOffsetY(0); zombiePos.Y != want {
+		t.Errorf("走到斜坡上的僵尸 Y 应为 %.1f，实际 %.1f", want, zombiePos.Y)
+	}
+	zombiePos.X = zombieX
+	system.Update(0.1)
+	if zombiePos.Y != zombieY {
+		t.Errorf("回到平坦区域的僵尸 Y 应恢复为 %.1f，实际 %.1f", zombieY, zombiePos.Y)
+	}
+}
+
+// TestRoofProjectileHitsSlope 测试斜坡低处发射的豌豆撞上屋顶，平坦区域发射的豌豆不受影响
+func TestRoofProjectileHitsSlope(t *testing.T) {
+	roof := config.GetLawnLayout("roof")
+	em := ecs.NewEntityManager()
+	system := NewRoofSystem(em, roof)
+
+	newPea := func(col int) (ecs.EntityID, *components.PositionComponent) {
+		x, y := cellCenter(roof, col, 1)
+		id := em.CreateEntity()
+		pos := &components.PositionComponent{X: x, Y: y}
+		ecs.AddComponent(em, id, pos)
+		ecs.AddComponent(em, id, &components.VelocityComponent{VX: config.PeaBulletSpeed})
+		ecs.AddComponent(em, id, &components.BehaviorComponent{Type: components.BehaviorPeaProjectile})
+		return id, pos
+	}
+	lowPea, lowPos := newPea(0)
+	flatPea, flatPos := newPea(config.GridColumns - 3)
+	system.Update(0.1)
+
+	for x := lowPos.X; x < config.GridWorldEndX; x += 10 {
+		lowPos.X = x
+		flatPos.X = x + 10
+		system.Update(0.01)
+		em.RemoveMarkedEntities()
+	}
+
+	if ecs.HasComponent[*components.PositionComponent](em, lowPea) {
+		t.Error("斜坡低处发射的豌豆应撞上屋顶消失")
+	}
+	if !ecs.HasComponent[*components.PositionComponent](em, flatPea) {
+		t.Error("平坦区域发射的豌豆不应撞上屋顶")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
 	"github.com/gonewx/pvz/pkg/ecs"
 	"github.com/gonewx/pvz/pkg/game"
 	"github.com/gonewx/pvz/pkg/utils"
@@ -194,6 +195,8 @@ func (s *ShovelInteractionSystem) detectPlantUnderMouse(worldX, worldY float64)
 	// 查询所有植物实体
 	plantEntities := ecs.GetEntitiesWith1[*components.PlantComponent](s.entityManager)
 
+	// 睡莲、花盆上种有植物时优先铲除上面的植物
+	var platform ecs.EntityID
 	for _, entity := range plantEntities {
 		// 获取植物位置
 		posComp, ok := ecs.GetComponent[*components.PositionComponent](s.entityManager, entity)
@@ -202,9 +205,12 @@ func (s *ShovelInteractionSystem) detectPlantUnderMouse(worldX, worldY float64)
 		}
 
 		// 计算植物边界
-		// 使用一个通用的植物检测尺寸
+		// 使用一个通用的植物检测尺寸，多格植物按占用的列数加宽
 		plantWidth := 60.0
 		plantHeight := 80.0
+		if plantComp, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, entity); ok {
+			plantWidth += float64(components.PlantFootprintWidth(plantComp.PlantType)-1) * config.CellWidth
+		}
 
 		plantLeft := posComp.X - plantWidth/2
 		plantRight := posComp.X + plantWidth/2
@@ -214,11 +220,15 @@ func (s *ShovelInteractionSystem) detectPlantUnderMouse(worldX, worldY float64)
 		// 检测鼠标是否在植物边界内
 		if worldX >= plantLeft && worldX <= plantRight &&
 			worldY >= plantTop && worldY <= plantBottom {
+			if plantComp, ok := ecs.GetComponent[*components.PlantComponent](s.entityManager, entity); ok && plantComp.PlantType.IsPlatform() {
+				platform = entity
+				continue
+			}
 			return entity
 		}
 	}
 
-	return 0
+	return platform
 }
 
 // removePlant 移除植物
@@ -243,9 +253,20 @@ func (s *ShovelInteractionSystem) removePlant(entityID ecs.EntityID) {
 		lawnGridEntities := ecs.GetEntitiesWith1[*components.LawnGridComponent](s.entityManager)
 		if len(lawnGridEntities) > 0 {
 			gridComp, ok := ecs.GetComponent[*components.LawnGridComponent](s.entityManager, lawnGridEntities[0])
-			if ok && plantComp.GridRow >= 0 && plantComp.GridRow < 5 &&
-				plantComp.GridCol >= 0 && plantComp.GridCol < 9 {
-				gridComp.Occupancy[plantComp.GridRow][plantComp.GridCol] = 0 // 0 表示空格子
+			if ok && plantComp.GridRow >= 0 && plantComp.GridRow < config.MaxGridRows && plantComp.PlantType.IsPlatform() {
+				// 睡莲、花盆记录在种植平台层，不占用格子
+				if plantComp.GridCol >= 0 && plantComp.GridCol < config.GridColumns {
+					gridComp.Platforms[plantComp.GridRow][plantComp.GridCol] = 0
+				}
+				log.Printf("[ShovelInteractionSystem] 移除种植平台 %v (%d, %d)", plantComp.PlantType, plantComp.GridRow, plantComp.GridCol)
+			} else if ok && plantComp.GridRow >= 0 && plantComp.GridRow < config.MaxGridRows {
+				// 多格植物（如玉米加农炮）需要释放占用的所有格子
+				width := components.PlantFootprintWidth(plantComp.PlantType)
+				for col := plantComp.GridCol; col < plantComp.GridCol+width; col++ {
+					if col >= 0 && col < config.GridColumns {
+						gridComp.Occupancy[plantComp.GridRow][col] = 0 // 0 表示空格子
+					}
+				}
 				log.Printf("[ShovelInteractionSystem] 释放网格 (%d, %d)", plantComp.GridRow, plantComp.GridCol)
 			}
 		}
@@ -191,8 +191,8 @@ func CheckSceneTypeRestriction(
 		}
 	}
 
-	// 2. 检查舞王限制
-	if zombieType == "dancing" {
+	// 2. 检查舞王限制（舞王召唤的伴舞同样受限）
+	if zombieType == "dancing" || zombieType == "backup_dancer" {
 		// 检查舞王禁止的场景
 		for _, prohibitedScene := range restrictions.DancingRestrictions.ProhibitedScenes {
 			if prohibitedScene == sceneType {
@@ -378,6 +378,14 @@ func TestCheckSceneTypeRestriction(t *testing.T) {
 			expectedValid: true,
 			expectError:   false,
 		},
+		{
+			name:          "backup dancer in roof scene (prohibited)",
+			zombieType:    "backup_dancer",
+			sceneType:     "roof",
+			lane:          2,
+			expectedValid: false,
+			expectError:   true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,41 @@
+package systems
+
+import (
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// RegisterSummonedZombie 登记在 WaveSpawnSystem 之外生成的僵尸（如舞王召唤的伴舞）
+//
+// 被召唤的僵尸：
+//   - 归属召唤者所在波次，计入该波的实时血量（影响血量触发的提前刷新）
+//   - 计入已激活僵尸数和胜利条件所需的消灭数
+//
+// 参数:
+//   - em: 实体管理器
+//   - gs: 游戏状态（为 nil 时只设置波次状态）
+//   - entityID: 被召唤的僵尸实体ID
+//   - waveIndex: 所属波次索引（0-based）
+func RegisterSummonedZombie(em *ecs.EntityManager, gs *game.GameState, entityID ecs.EntityID, waveIndex int) {
+	ecs.AddComponent(em, entityID, &components.ZombieWaveStateComponent{
+		WaveIndex:   waveIndex,
+		IsActivated: true,
+	})
+
+	if gs != nil {
+		gs.RecordZombiesSummoned(1)
+	}
+}
+
+// SummonerWaveIndex 获取召唤者所属的波次索引
+// 召唤者没有波次状态时（如存档恢复的僵尸）返回当前已激活的最后一波
+func SummonerWaveIndex(em *ecs.EntityManager, gs *game.GameState, summonerID ecs.EntityID) int {
+	if waveState, ok := ecs.GetComponent[*components.ZombieWaveStateComponent](em, summonerID); ok {
+		return waveState.WaveIndex
+	}
+	if gs != nil && gs.CurrentWaveIndex > 0 {
+		return gs.CurrentWaveIndex - 1
+	}
+	return 0
+}
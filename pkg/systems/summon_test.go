@@ -0,0 +1,47 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// TestRegisterSummonedZombie 测试被召唤的僵尸归入召唤者所在波次，并计入已激活数和胜利条件
+func TestRegisterSummonedZombie(t *testing.T) {
+	em := ecs.NewEntityManager()
+	gs := &game.GameState{}
+	gs.LoadLevel(&config.LevelConfig{
+		ID: "test",
+		Waves: []config.WaveConfig{
+			{Zombies: []config.ZombieGroup{{Type: "basic", Lanes: []int{1}, Count: 1}}},
+			{Zombies: []config.ZombieGroup{{Type: "dancing", Lanes: []int{3}, Count: 1}}},
+		},
+	})
+
+	summonerID := em.CreateEntity()
+	ecs.AddComponent(em, summonerID, &components.ZombieWaveStateComponent{WaveIndex: 1, IsActivated: true})
+
+	summonedID := em.CreateEntity()
+	waveIndex := SummonerWaveIndex(em, gs, summonerID)
+	RegisterSummonedZombie(em, gs, summonedID, waveIndex)
+
+	waveState, ok := ecs.GetComponent[*components.ZombieWaveStateComponent](em, summonedID)
+	if !ok {
+		t.Fatal("被召唤的僵尸应有波次状态")
+	}
+	if waveState.WaveIndex != 1 || !waveState.IsActivated {
+		t.Errorf("期望归入第 2 波且已激活，实际 %+v", waveState)
+	}
+	if gs.TotalZombiesSpawned != 1 || gs.ZombiesSummoned != 1 {
+		t.Errorf("期望已激活 1、召唤 1，实际 %d、%d", gs.TotalZombiesSpawned, gs.ZombiesSummoned)
+	}
+
+	// 没有波次状态的召唤者（如读档恢复的僵尸）归入最后激活的一波
+	gs.MarkWaveSpawned(0)
+	if got := SummonerWaveIndex(em, gs, em.CreateEntity()); got != 0 {
+		t.Errorf("期望归入第 1 波，实际 %d", got)
+	}
+}
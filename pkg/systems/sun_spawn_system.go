@@ -23,6 +23,7 @@ type SunSpawnSystem struct {
 	minTargetY      float64 // 阳光落地的最小Y坐标
 	maxTargetY      float64 // 阳光落地的最大Y坐标
 	enabled         bool    // 是否启用自动生成（教学关卡初始禁用）
+	intervalScale   float64 // 掉落间隔缩放系数（0 表示不缩放，小游戏规则可以加快阳光掉落）
 }
 
 // NewSunSpawnSystem 创建一个新的阳光生成系统
@@ -148,6 +149,14 @@ func (s *SunSpawnSystem) Disable() {
 	log.Printf("[SunSpawnSystem] Auto spawn DISABLED")
 }
 
+// SetIntervalScale 设置掉落间隔的缩放系数（如锤僵尸模式阳光掉落更快）
+// 当前间隔立即按新系数重新计算
+func (s *SunSpawnSystem) SetIntervalScale(scale float64) {
+	s.intervalScale = scale
+	s.spawnInterval = s.calculateNextInterval()
+	log.Printf("[SunSpawnSystem] Interval scale set to %.2f, next interval=%.2fs", scale, s.spawnInterval)
+}
+
 // Reset 重置阳光生成系统状态（关卡重新开始时调用）
 func (s *SunSpawnSystem) Reset() {
 	s.spawnTimer = 0
@@ -174,5 +183,9 @@ func (s *SunSpawnSystem) calculateNextInterval() float64 {
 	// 总间隔 (厘秒)
 	totalCS := baseCS + randomCS
 	// 转换为秒
-	return float64(totalCS) / 100.0
+	interval := float64(totalCS) / 100.0
+	if s.intervalScale > 0 {
+		interval *= s.intervalScale
+	}
+	return interval
 }
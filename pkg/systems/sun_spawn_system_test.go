@@ -289,3 +289,22 @@ func TestSunSpawnSystem_BowlingLevel_InitialSunZero(t *testing.T) {
 
 	t.Logf("✓ Bowling level (initialSun=0) correctly disables sun spawning")
 }
+
+// TestSunSpawnIntervalScale 测试掉落间隔缩放系数
+func TestSunSpawnIntervalScale(t *testing.T) {
+	em := ecs.NewEntityManager()
+	rm := game.NewResourceManager(nil)
+	system := NewSunSpawnSystem(em, rm, 250.0, 900.0, 100.0, 550.0)
+
+	system.SetIntervalScale(0.5)
+	if system.spawnInterval < 2.125 || system.spawnInterval > 3.50 {
+		t.Errorf("Scaled interval out of range: got %.2f, want 2.125-3.50", system.spawnInterval)
+	}
+	for i := 0; i < 100; i++ {
+		system.sunDroppedCount = 0
+		interval := system.calculateNextInterval()
+		if interval < 2.125 || interval > 3.50 {
+			t.Errorf("Scaled interval (count=0) out of range: got %.2f, want 2.125-3.50", interval)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package systems
+
+import (
+	"time"
+
+	"github.com/gonewx/pvz/pkg/config"
+)
+
+// SurvivalWaveGenerator 生存模式波次生成器
+//
+// 职责：
+//   - 按旗帜组（SurvivalFlagsPerSet 面旗帜 × SurvivalWavesPerFlag 波）生成波次配置
+//   - 僵尸选择由 WaveGenerator 完成（级别容量、权重、阶数、红眼上限和场景限制）
+type SurvivalWaveGenerator struct {
+	*WaveGenerator
+	levelConfig *config.LevelConfig
+}
+
+// NewSurvivalWaveGenerator 创建生存模式波次生成器
+//
+// 参数：
+//   - zombieStats: 僵尸属性配置（级别、权重）
+//   - spawnRules: 僵尸生成规则（阶数、红眼、场景限制）
+//   - levelConfig: 生存模式关卡配置（场景类型、启用的行）
+//   - wavesPerRound: 每轮波次数（GameState.WavesPerRound）
+func NewSurvivalWaveGenerator(zombieStats *config.ZombieStatsConfig, spawnRules *config.SpawnRulesConfig, levelConfig *config.LevelConfig, wavesPerRound int) *SurvivalWaveGenerator {
+	return &SurvivalWaveGenerator{
+		WaveGenerator: NewWaveGenerator(zombieStats, spawnRules, levelConfig, wavesPerRound, time.Now().UnixNano()),
+		levelConfig:   levelConfig,
+	}
+}
+
+// GenerateFlagSet 生成一组旗帜的波次
+//
+// 每 SurvivalWavesPerFlag 波为一面旗帜（旗帜波额外带一只旗帜僵尸），
+// 最后一波标记为最终波（Type="Final"）
+//
+// 参数：
+//   - roundNumber: 当前轮数（生存模式为已完成的旗帜组数）
+//
+// 返回：
+//   - []config.WaveConfig: 本组旗帜的波次配置
+func (g *SurvivalWaveGenerator) GenerateFlagSet(roundNumber int) []config.WaveConfig {
+	return g.GenerateWaves(&config.LevelConfig{ID: g.levelConfig.ID, Flags: config.SurvivalFlagsPerSet}, roundNumber)
+}
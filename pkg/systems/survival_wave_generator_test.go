@@ -0,0 +1,106 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/config"
+)
+
+// newTestSurvivalWaveGenerator 使用正式的僵尸属性和生成规则创建生存模式波次生成器
+func newTestSurvivalWaveGenerator(t *testing.T, sceneType string) (*SurvivalWaveGenerator, *config.SpawnRulesConfig) {
+	t.Helper()
+	zombieStats, err := config.LoadZombieStats("data/zombie_stats.yaml")
+	if err != nil {
+		t.Fatalf("Failed to load zombie stats: %v", err)
+	}
+	spawnRules, err := config.LoadSpawnRules("data/spawn_rules.yaml")
+	if err != nil {
+		t.Fatalf("Failed to load spawn rules: %v", err)
+	}
+	level := &config.LevelConfig{SceneType: sceneType, EnabledLanes: []int{1, 2, 3, 4, 5}}
+	return NewSurvivalWaveGenerator(zombieStats, spawnRules, level, 20), spawnRules
+}
+
+// TestSurvivalWaveGenerator_FlagSetLayout 测试一组旗帜的波次结构：旗帜波带旗帜僵尸，最后一波为最终波，级别总和不超过容量
+func TestSurvivalWaveGenerator_FlagSetLayout(t *testing.T) {
+	generator, _ := newTestSurvivalWaveGenerator(t, "day")
+	waves := generator.GenerateFlagSet(0)
+
+	totalWaves := config.SurvivalFlagsPerSet * config.SurvivalWavesPerFlag
+	if len(waves) != totalWaves {
+		t.Fatalf("Expected %d waves, got %d", totalWaves, len(waves))
+	}
+
+	for i, wave := range waves {
+		waveNum := i + 1
+		isFlag := waveNum%config.SurvivalWavesPerFlag == 0
+		if wave.WaveNum != waveNum || wave.IsFlag != isFlag {
+			t.Errorf("Wave %d: WaveNum=%d IsFlag=%v", waveNum, wave.WaveNum, wave.IsFlag)
+		}
+		if (wave.Type == "Final") != (waveNum == totalWaves) {
+			t.Errorf("Wave %d: unexpected type %q", waveNum, wave.Type)
+		}
+		if len(wave.Zombies) == 0 {
+			t.Fatalf("Wave %d has no zombies", waveNum)
+		}
+
+		levels := 0
+		hasFlagZombie := false
+		for _, group := range wave.Zombies {
+			if group.Type == "flag" {
+				hasFlagZombie = true
+				continue
+			}
+			levels += generator.difficultyEngine.GetZombieLevel(group.Type) * group.Count
+		}
+		if hasFlagZombie != isFlag {
+			t.Errorf("Wave %d: flag zombie present=%v, want %v", waveNum, hasFlagZombie, isFlag)
+		}
+		capacity := generator.difficultyEngine.CalculateLevelCapacity(waveNum, 0, 20, isFlag)
+		if levels > capacity {
+			t.Errorf("Wave %d: total level %d exceeds capacity %d", waveNum, levels, capacity)
+		}
+	}
+}
+
+// TestSurvivalWaveGenerator_Restrictions 测试生成的僵尸遵守阶数的最早波次限制和场景限制
+func TestSurvivalWaveGenerator_Restrictions(t *testing.T) {
+	generator, spawnRules := newTestSurvivalWaveGenerator(t, "roof")
+
+	for attempt := 0; attempt < 20; attempt++ {
+		for _, wave := range generator.GenerateFlagSet(0) {
+			for _, group := range wave.Zombies {
+				if ok, err := CheckTierRestriction(group.Type, wave.WaveNum, 0, spawnRules); !ok {
+					t.Fatalf("Wave %d: %v", wave.WaveNum, err)
+				}
+				if group.Type == "dancing" {
+					t.Fatalf("Wave %d: dancing zombie generated on the roof", wave.WaveNum)
+				}
+				if group.Type == "gargantuar_redeye" {
+					t.Fatalf("Wave %d: red-eye gargantuar generated before round %d", wave.WaveNum, spawnRules.RedEyeRules.StartRound)
+				}
+			}
+		}
+	}
+}
+
+// TestSurvivalWaveGenerator_DifficultyGrowsWithRounds 测试轮数越高，同一波的僵尸级别总和越大
+func TestSurvivalWaveGenerator_DifficultyGrowsWithRounds(t *testing.T) {
+	generator, _ := newTestSurvivalWaveGenerator(t, "day")
+
+	totalLevel := func(waves []config.WaveConfig) int {
+		total := 0
+		for _, wave := range waves {
+			for _, group := range wave.Zombies {
+				total += generator.difficultyEngine.GetZombieLevel(group.Type) * group.Count
+			}
+		}
+		return total
+	}
+
+	first := totalLevel(generator.GenerateFlagSet(0))
+	later := totalLevel(generator.GenerateFlagSet(3))
+	if later <= first {
+		t.Errorf("Expected round 3 to be harder than round 0, got total levels %d vs %d", later, first)
+	}
+}
@@ -0,0 +1,24 @@
+package systems
+
+import (
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/ecs"
+)
+
+// IsZombieInWater 判断僵尸是否已经进入水中（入水中或游泳中）
+func IsZombieInWater(em *ecs.EntityManager, zombieID ecs.EntityID) bool {
+	swim, ok := ecs.GetComponent[*components.SwimComponent](em, zombieID)
+	return ok && swim.InWater()
+}
+
+// IsSubmergedZombie 判断僵尸是否潜在水下（游动中的潜水僵尸）
+//
+// 潜在水下的僵尸无法被子弹和植物攻击，啃食植物时浮出水面
+func IsSubmergedZombie(em *ecs.EntityManager, zombieID ecs.EntityID) bool {
+	swim, ok := ecs.GetComponent[*components.SwimComponent](em, zombieID)
+	if !ok || !swim.Dives || swim.State != components.SwimStateSwimming {
+		return false
+	}
+	behavior, ok := ecs.GetComponent[*components.BehaviorComponent](em, zombieID)
+	return ok && behavior.Type == components.BehaviorZombieBasic
+}
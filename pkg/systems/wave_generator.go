@@ -0,0 +1,291 @@
+package systems
+
+import (
+	"log"
+	"math/rand"
+	"sort"
+
+	"github.com/gonewx/pvz/pkg/config"
+)
+
+// WaveGenerator 程序化波次生成器
+//
+// 职责：
+//   - 为 Type="ExtraPoints" 的波次补满级别容量（难度引擎容量 + ExtraPoints）
+//   - 为省略 waves、只配置 flags 的关卡生成全部波次（每 SurvivalWavesPerFlag 波一面旗帜）
+//   - 按 zombie_stats.yaml 的权重随机选择僵尸类型，遵守阶数、红眼上限、场景限制和波次行限制
+//   - 旗帜波总是带一只旗帜僵尸
+//   - 雪橇僵尸小队只在同一波有冰车僵尸时出现（没有冰道时只会像普通僵尸一样步行）
+//
+// 架构说明：
+//   - 纯数据生成，不创建实体；生成的波次由 WaveSpawnSystem 按常规流程激活
+//   - 使用独立的随机源，相同种子生成相同的波次（便于调试和 CLI 输出）
+//   - 行选择由 LaneAllocator 在生成僵尸时完成，这里只检查类型能否出现在允许的某一行
+type WaveGenerator struct {
+	difficultyEngine *DifficultyEngine
+	spawnRules       *config.SpawnRulesConfig
+	sceneType        string
+	enabledLanes     []int
+	wavesPerRound    int
+	rng              *rand.Rand
+}
+
+// NewWaveGenerator 创建程序化波次生成器
+//
+// 参数：
+//   - zombieStats: 僵尸属性配置（级别、权重）
+//   - spawnRules: 僵尸生成规则（阶数、红眼、场景限制），nil 表示不检查
+//   - levelConfig: 关卡配置（场景类型、启用的行，未配置启用的行时使用场景草坪的全部行）
+//   - wavesPerRound: 每轮波次数（GameState.WavesPerRound）
+//   - seed: 随机种子
+func NewWaveGenerator(zombieStats *config.ZombieStatsConfig, spawnRules *config.SpawnRulesConfig, levelConfig *config.LevelConfig, wavesPerRound int, seed int64) *WaveGenerator {
+	if wavesPerRound <= 0 {
+		wavesPerRound = 20 // 默认值
+	}
+	enabledLanes := levelConfig.EnabledLanes
+	if len(enabledLanes) == 0 {
+		rows := config.GetLawnLayout(levelConfig.SceneType).Rows
+		enabledLanes = make([]int, rows)
+		for i := range enabledLanes {
+			enabledLanes[i] = i + 1
+		}
+	}
+	return &WaveGenerator{
+		difficultyEngine: NewDifficultyEngine(zombieStats),
+		spawnRules:       spawnRules,
+		sceneType:        levelConfig.SceneType,
+		enabledLanes:     enabledLanes,
+		wavesPerRound:    wavesPerRound,
+		rng:              rand.New(rand.NewSource(seed)),
+	}
+}
+
+// NeedsWaveGeneration 检查关卡是否有需要程序生成的波次
+// 省略 waves 但配置了 flags，或者包含 Type="ExtraPoints" 的波次
+func NeedsWaveGeneration(levelConfig *config.LevelConfig) bool {
+	if levelConfig == nil {
+		return false
+	}
+	if len(levelConfig.Waves) == 0 {
+		return levelConfig.Flags > 0
+	}
+	for _, wave := range levelConfig.Waves {
+		if wave.Type == "ExtraPoints" {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateWaves 生成关卡的完整波次配置
+//
+// 省略 waves 的关卡按 Flags 生成 Flags × SurvivalWavesPerFlag 波，最后一波为最终波；
+// 其余关卡复制配置中的波次，只补满 Type="ExtraPoints" 的波次，固定波次保持不变
+//
+// 参数：
+//   - levelConfig: 关卡配置（不会被修改）
+//   - roundNumber: 当前轮数（冒险模式为周目数，生存模式为已完成的旗帜组数）
+//
+// 返回：
+//   - []config.WaveConfig: 生成后的波次配置
+func (g *WaveGenerator) GenerateWaves(levelConfig *config.LevelConfig, roundNumber int) []config.WaveConfig {
+	redEyeCount := 0
+	var waves []config.WaveConfig
+	if len(levelConfig.Waves) == 0 {
+		totalWaves := levelConfig.Flags * config.SurvivalWavesPerFlag
+		waves = make([]config.WaveConfig, 0, totalWaves)
+		for waveNum := 1; waveNum <= totalWaves; waveNum++ {
+			wave := config.WaveConfig{
+				IsFlag:  waveNum%config.SurvivalWavesPerFlag == 0,
+				WaveNum: waveNum,
+				Type:    "Fixed",
+			}
+			if wave.IsFlag {
+				wave.FlagIndex = waveNum / config.SurvivalWavesPerFlag
+			}
+			if waveNum == totalWaves {
+				wave.Type = "Final"
+			}
+			g.fillWave(&wave, roundNumber, &redEyeCount)
+			waves = append(waves, wave)
+		}
+	} else {
+		waves = make([]config.WaveConfig, len(levelConfig.Waves))
+		copy(waves, levelConfig.Waves)
+		for i := range waves {
+			if waves[i].Type == "ExtraPoints" {
+				g.fillWave(&waves[i], roundNumber, &redEyeCount)
+			}
+		}
+	}
+
+	log.Printf("[WaveGenerator] Generated waves for level %s: %d waves, round %d (scene: %s)",
+		levelConfig.ID, len(waves), roundNumber, g.sceneType)
+	return waves
+}
+
+// fillWave 补满单个波次的级别容量
+//
+// 容量 = 难度引擎容量 + ExtraPoints，已配置的僵尸（旗帜僵尸除外）占用容量；
+// 旗帜波缺少旗帜僵尸时补上一只
+func (g *WaveGenerator) fillWave(wave *config.WaveConfig, roundNumber int, redEyeCount *int) {
+	capacity := g.difficultyEngine.CalculateLevelCapacity(wave.WaveNum, roundNumber, g.wavesPerRound, wave.IsFlag) + wave.ExtraPoints
+
+	hasFlagZombie := false
+	hasZomboni := false
+	zombies := make([]config.ZombieGroup, 0, len(wave.Zombies)+1)
+	// 复制一份，避免修改关卡配置共享的切片
+	for _, group := range wave.Zombies {
+		if group.Type == "zomboni" {
+			hasZomboni = true
+		}
+		if group.Type == "flag" {
+			hasFlagZombie = true
+		} else {
+			capacity -= g.difficultyEngine.GetZombieLevel(group.Type) * group.Count
+		}
+		zombies = append(zombies, group)
+	}
+	if wave.IsFlag && !hasFlagZombie {
+		zombies = append([]config.ZombieGroup{{Type: "flag", Count: 1}}, zombies...)
+	}
+
+	picked := g.pickZombies(wave.WaveNum, roundNumber, capacity, wave.LaneRestriction, redEyeCount, hasZomboni)
+	if len(picked) == 0 && len(zombies) == 0 {
+		// 每波至少有一只僵尸（容量不足时为普通僵尸）
+		picked = []config.ZombieGroup{{Type: "basic", Count: 1}}
+	}
+	wave.Zombies = append(zombies, picked...)
+}
+
+// pickZombies 按权重随机选择僵尸，直到级别总和达到容量上限
+// hasZomboni 表示本波已配置冰车僵尸，选中冰车僵尸后雪橇僵尸小队才可被选择
+func (g *WaveGenerator) pickZombies(waveNum, roundNumber, capacity int, laneRestriction []int, redEyeCount *int, hasZomboni bool) []config.ZombieGroup {
+	candidates := g.candidateTypes(waveNum, roundNumber, laneRestriction)
+	counts := make(map[string]int)
+	order := make([]string, 0, len(candidates))
+
+	remaining := capacity
+	for remaining > 0 {
+		zombieType := g.pickWeighted(candidates, remaining, roundNumber, *redEyeCount, hasZomboni)
+		if zombieType == "" {
+			break
+		}
+		if counts[zombieType] == 0 {
+			order = append(order, zombieType)
+		}
+		counts[zombieType]++
+		remaining -= g.difficultyEngine.GetZombieLevel(zombieType)
+		if zombieType == "gargantuar_redeye" {
+			*redEyeCount++
+		}
+		if zombieType == "zomboni" {
+			hasZomboni = true
+		}
+	}
+
+	groups := make([]config.ZombieGroup, 0, len(order))
+	for _, zombieType := range order {
+		groups = append(groups, config.ZombieGroup{Type: zombieType, Count: counts[zombieType]})
+	}
+	return groups
+}
+
+// pickWeighted 从级别不超过剩余容量的候选类型中按权重随机选择一种
+// 本波没有冰车僵尸时不选择雪橇僵尸小队；没有可选类型时返回空字符串
+func (g *WaveGenerator) pickWeighted(candidates []string, remaining, roundNumber, redEyeCount int, hasZomboni bool) string {
+	totalWeight := 0
+	weights := make([]int, len(candidates))
+	for i, zombieType := range candidates {
+		if g.difficultyEngine.GetZombieLevel(zombieType) > remaining {
+			continue
+		}
+		if zombieType == "bobsled" && !hasZomboni {
+			continue
+		}
+		if g.spawnRules != nil {
+			if ok, _ := CheckRedEyeLimit(zombieType, redEyeCount, roundNumber, g.spawnRules); !ok {
+				continue
+			}
+		}
+		weights[i] = g.difficultyEngine.GetZombieStats().GetZombieWeight(zombieType)
+		totalWeight += weights[i]
+	}
+	if totalWeight == 0 {
+		return ""
+	}
+
+	roll := g.rng.Intn(totalWeight)
+	for i, weight := range weights {
+		if roll < weight {
+			return candidates[i]
+		}
+		roll -= weight
+	}
+	return ""
+}
+
+// candidateTypes 获取本波可以出现的僵尸类型（按名称排序）
+//
+// 过滤规则：
+//   - 权重大于 0（伴舞、小鬼等只能被召唤的僵尸不参与随机选择）
+//   - 满足阶数的最早波次限制
+//   - 至少能出现在允许的某一行（水路僵尸、屋顶禁止舞王等场景限制，以及波次的行限制）
+func (g *WaveGenerator) candidateTypes(waveNum, roundNumber int, laneRestriction []int) []string {
+	zombieStats := g.difficultyEngine.GetZombieStats()
+	if zombieStats == nil {
+		return []string{"basic"}
+	}
+
+	lanes := g.allowedLanes(laneRestriction)
+	candidates := make([]string, 0, len(zombieStats.Zombies))
+	for zombieType, stats := range zombieStats.Zombies {
+		if stats.Weight <= 0 {
+			continue
+		}
+		if g.spawnRules != nil {
+			if ok, _ := CheckTierRestriction(zombieType, waveNum, roundNumber, g.spawnRules); !ok {
+				continue
+			}
+			if !g.canSpawnInAnyLane(zombieType, lanes) {
+				continue
+			}
+		}
+		candidates = append(candidates, zombieType)
+	}
+
+	// map 遍历顺序不固定，排序保证相同种子生成相同结果
+	sort.Strings(candidates)
+	return candidates
+}
+
+// allowedLanes 返回本波允许出现僵尸的行：启用的行与波次行限制的交集
+// 交集为空时忽略行限制
+func (g *WaveGenerator) allowedLanes(laneRestriction []int) []int {
+	if len(laneRestriction) == 0 {
+		return g.enabledLanes
+	}
+	lanes := make([]int, 0, len(laneRestriction))
+	for _, lane := range laneRestriction {
+		for _, enabled := range g.enabledLanes {
+			if lane == enabled {
+				lanes = append(lanes, lane)
+				break
+			}
+		}
+	}
+	if len(lanes) == 0 {
+		return g.enabledLanes
+	}
+	return lanes
+}
+
+// canSpawnInAnyLane 检查僵尸类型能否出现在给定的某一行
+func (g *WaveGenerator) canSpawnInAnyLane(zombieType string, lanes []int) bool {
+	for _, lane := range lanes {
+		if ok, _ := CheckSceneTypeRestriction(zombieType, g.sceneType, lane, g.spawnRules); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+package systems
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/config"
+)
+
+// newTestWaveGenerator 使用正式的僵尸属性和生成规则创建波次生成器
+func newTestWaveGenerator(t *testing.T, levelConfig *config.LevelConfig, seed int64) (*WaveGenerator, *config.SpawnRulesConfig) {
+	t.Helper()
+	zombieStats, err := config.LoadZombieStats("data/zombie_stats.yaml")
+	if err != nil {
+		t.Fatalf("Failed to load zombie stats: %v", err)
+	}
+	spawnRules, err := config.LoadSpawnRules("data/spawn_rules.yaml")
+	if err != nil {
+		t.Fatalf("Failed to load spawn rules: %v", err)
+	}
+	return NewWaveGenerator(zombieStats, spawnRules, levelConfig, 20, seed), spawnRules
+}
+
+// TestWaveGenerator_SameSeedSameWaves 测试相同种子生成相同的波次
+func TestWaveGenerator_SameSeedSameWaves(t *testing.T) {
+	level := &config.LevelConfig{ID: "test", SceneType: "day", Flags: 2, EnabledLanes: []int{1, 2, 3, 4, 5}}
+
+	first, _ := newTestWaveGenerator(t, level, 42)
+	second, _ := newTestWaveGenerator(t, level, 42)
+	if !reflect.DeepEqual(first.GenerateWaves(level, 1), second.GenerateWaves(level, 1)) {
+		t.Error("Expected identical waves for the same seed")
+	}
+}
+
+// TestWaveGenerator_ExtraPointsWave 测试 ExtraPoints 波次保留已配置的僵尸并补满容量，固定波次保持不变
+func TestWaveGenerator_ExtraPointsWave(t *testing.T) {
+	fixed := config.WaveConfig{WaveNum: 1, Type: "Fixed", Zombies: []config.ZombieGroup{{Type: "basic", Count: 1}}}
+	level := &config.LevelConfig{
+		ID:           "test",
+		SceneType:    "day",
+		EnabledLanes: []int{1, 2, 3, 4, 5},
+		Waves: []config.WaveConfig{
+			fixed,
+			{WaveNum: 10, IsFlag: true, Type: "ExtraPoints", ExtraPoints: 6, Zombies: []config.ZombieGroup{{Type: "conehead", Count: 1}}},
+		},
+	}
+	generator, _ := newTestWaveGenerator(t, level, 7)
+	waves := generator.GenerateWaves(level, 0)
+
+	if !reflect.DeepEqual(waves[0], fixed) {
+		t.Errorf("Fixed wave changed: %+v", waves[0])
+	}
+	if len(level.Waves[1].Zombies) != 1 {
+		t.Errorf("Level config should not be modified, got %+v", level.Waves[1].Zombies)
+	}
+
+	wave := waves[1]
+	if wave.Zombies[0].Type != "flag" {
+		t.Errorf("Expected a flag zombie first on a flag wave, got %+v", wave.Zombies)
+	}
+	if wave.Zombies[1].Type != "conehead" || wave.Zombies[1].Count != 1 {
+		t.Errorf("Expected the configured conehead kept, got %+v", wave.Zombies)
+	}
+
+	levels := 0
+	for _, group := range wave.Zombies {
+		if group.Type != "flag" {
+			levels += generator.difficultyEngine.GetZombieLevel(group.Type) * group.Count
+		}
+	}
+	capacity := generator.difficultyEngine.CalculateLevelCapacity(10, 0, 20, true) + 6
+	if levels > capacity || levels <= generator.difficultyEngine.GetZombieLevel("conehead") {
+		t.Errorf("Expected the wave filled up to capacity %d, got total level %d", capacity, levels)
+	}
+}
+
+// TestWaveGenerator_LaneRestriction 测试生成的僵尸都能出现在波次行限制的某一行
+func TestWaveGenerator_LaneRestriction(t *testing.T) {
+	level := &config.LevelConfig{
+		ID:           "test",
+		SceneType:    "pool",
+		EnabledLanes: []int{1, 2, 3, 4, 5, 6},
+		Waves: []config.WaveConfig{
+			{WaveNum: 15, Type: "ExtraPoints", ExtraPoints: 20, LaneRestriction: []int{2}},
+		},
+	}
+
+	for seed := int64(0); seed < 20; seed++ {
+		generator, spawnRules := newTestWaveGenerator(t, level, seed)
+		for _, group := range generator.GenerateWaves(level, 0)[0].Zombies {
+			if ok, err := CheckSceneTypeRestriction(group.Type, "pool", 2, spawnRules); !ok {
+				t.Fatalf("Seed %d: %v", seed, err)
+			}
+		}
+	}
+}
+
+// TestWaveGenerator_BobsledNeedsZomboni 测试雪橇僵尸小队只出现在有冰车僵尸的波次
+func TestWaveGenerator_BobsledNeedsZomboni(t *testing.T) {
+	level := &config.LevelConfig{ID: "test", SceneType: "day", Flags: 5, EnabledLanes: []int{1, 2, 3, 4, 5}}
+
+	for seed := int64(0); seed < 20; seed++ {
+		generator, _ := newTestWaveGenerator(t, level, seed)
+		for _, wave := range generator.GenerateWaves(level, 3) {
+			hasBobsled, hasZomboni := false, false
+			for _, group := range wave.Zombies {
+				hasBobsled = hasBobsled || group.Type == "bobsled"
+				hasZomboni = hasZomboni || group.Type == "zomboni"
+			}
+			if hasBobsled && !hasZomboni {
+				t.Fatalf("Seed %d: wave %d has bobsled without zomboni: %+v", seed, wave.WaveNum, wave.Zombies)
+			}
+		}
+	}
+
+	// 已配置冰车僵尸的波次可以补充雪橇僵尸小队
+	zomboniLevel := &config.LevelConfig{
+		ID:           "test",
+		SceneType:    "day",
+		EnabledLanes: []int{1, 2, 3, 4, 5},
+		Waves: []config.WaveConfig{
+			{WaveNum: 15, Type: "ExtraPoints", ExtraPoints: 20, Zombies: []config.ZombieGroup{{Type: "zomboni", Count: 1}}},
+		},
+	}
+	for seed := int64(0); seed < 20; seed++ {
+		generator, _ := newTestWaveGenerator(t, zomboniLevel, seed)
+		for _, group := range generator.GenerateWaves(zomboniLevel, 0)[0].Zombies {
+			if group.Type == "bobsled" {
+				return
+			}
+		}
+	}
+	t.Error("Expected bobsled picked in a wave with a zomboni")
+}
+
+// TestWaveGenerator_PoolScene 测试泳池关卡默认使用 6 行，并生成水路僵尸；前院不生成水路僵尸
+func TestWaveGenerator_PoolScene(t *testing.T) {
+	waterZombies := map[string]bool{"snorkel": true, "dolphinrider": true, "ducky": true}
+	countWaterZombies := func(sceneType string) int {
+		level := &config.LevelConfig{ID: "test", SceneType: sceneType, Flags: 5}
+		count := 0
+		for seed := int64(0); seed < 10; seed++ {
+			generator, _ := newTestWaveGenerator(t, level, seed)
+			for _, wave := range generator.GenerateWaves(level, 0) {
+				for _, group := range wave.Zombies {
+					if waterZombies[group.Type] {
+						count += group.Count
+					}
+				}
+			}
+		}
+		return count
+	}
+
+	generator, _ := newTestWaveGenerator(t, &config.LevelConfig{ID: "test", SceneType: "pool"}, 1)
+	if !reflect.DeepEqual(generator.enabledLanes, []int{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("Expected pool lanes 1-6 by default, got %v", generator.enabledLanes)
+	}
+	if countWaterZombies("pool") == 0 {
+		t.Error("Expected water zombies generated on the pool")
+	}
+	if count := countWaterZombies("day"); count != 0 {
+		t.Errorf("Expected no water zombies on the day lawn, got %d", count)
+	}
+}
+
+// TestNeedsWaveGeneration 测试只有省略 waves 且配置了 flags、或包含 ExtraPoints 波次的关卡需要生成
+func TestNeedsWaveGeneration(t *testing.T) {
+	tests := []struct {
+		name  string
+		level *config.LevelConfig
+		want  bool
+	}{
+		{"nil level", nil, false},
+		{"fixed waves", &config.LevelConfig{Waves: []config.WaveConfig{{Type: "Fixed"}}}, false},
+		{"extra points wave", &config.LevelConfig{Waves: []config.WaveConfig{{Type: "Fixed"}, {Type: "ExtraPoints"}}}, true},
+		{"waves omitted", &config.LevelConfig{Flags: 2}, true},
+		{"boss level", &config.LevelConfig{Boss: true}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NeedsWaveGeneration(tt.level); got != tt.want {
+				t.Errorf("NeedsWaveGeneration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package systems
 
 import (
+	"fmt"
 	"log"
 	"math/rand"
 
@@ -39,6 +40,7 @@ type WaveSpawnSystem struct {
 	constraintID    ecs.EntityID                // Story 17.3: 生成限制组件实体ID
 	laneAllocator   *LaneAllocator              // Story 17.4: 行分配器系统
 	zombiePhysics   *config.ZombiePhysicsConfig // Story 17.9: 僵尸物理配置（出生点、进家边界）
+	layout          config.LawnLayout           // 关卡场景的草坪行布局
 }
 
 // NewWaveSpawnSystem 创建波次生成系统
@@ -62,6 +64,11 @@ func NewWaveSpawnSystem(em *ecs.EntityManager, rm *game.ResourceManager, lc *con
 		spawnRules:      sr,
 		zombiePhysics:   zp,
 	}
+	if lc != nil {
+		sys.layout = config.GetLawnLayout(lc.SceneType)
+	} else {
+		sys.layout = config.GetLawnLayout("day")
+	}
 
 	// Story 17.3: 如果提供了生成规则，创建限制检查组件实体
 	if sr != nil {
@@ -102,6 +109,21 @@ func (s *WaveSpawnSystem) createConstraintEntity() ecs.EntityID {
 	return entityID
 }
 
+// ResetSpawnConstraints 按当前关卡波次重置生成限制
+// 生存模式开始新的一组旗帜时调用：新生成的波次可能包含之前没有的僵尸类型，红眼数量重新计数
+func (s *WaveSpawnSystem) ResetSpawnConstraints() {
+	if s.constraintID == 0 {
+		return
+	}
+	constraint, ok := ecs.GetComponent[*components.SpawnConstraintComponent](s.entityManager, s.constraintID)
+	if !ok {
+		return
+	}
+	constraint.AllowedZombieTypes = s.extractAllowedZombieTypes()
+	constraint.RedEyeCount = 0
+	constraint.CurrentWaveNum = 1
+}
+
 // extractAllowedZombieTypes 从关卡配置中提取允许的僵尸类型
 func (s *WaveSpawnSystem) extractAllowedZombieTypes() []string {
 	typeSet := make(map[string]bool)
@@ -292,7 +314,7 @@ func (s *WaveSpawnSystem) activateZombieNow(entityID ecs.EntityID, waveState *co
 	pos, hasPos := ecs.GetComponent[*components.PositionComponent](s.entityManager, entityID)
 	if hasPos {
 		// 计算当前所在行（0-4）
-		currentRow := int((pos.Y - config.GridWorldStartY - config.ZombieVerticalOffset - config.CellHeight/2.0) / config.CellHeight)
+		currentRow := s.layout.RowAt(pos.X, pos.Y-config.ZombieVerticalOffset)
 		if currentRow < 0 {
 			currentRow = 0
 		}
@@ -428,10 +450,120 @@ func (s *WaveSpawnSystem) SpawnWaveRealtime(waveIndex int) int {
 	// 增加已激活僵尸计数
 	s.gameState.IncrementZombiesSpawned(totalSpawned)
 
+	// 最后一波时，每块墓碑下爬出一只僵尸（计入召唤数量）
+	if waveIndex == len(s.levelConfig.Waves)-1 {
+		s.spawnGraveZombies(waveIndex)
+	}
+
 	log.Printf("[WaveSpawnSystem] SpawnWaveRealtime: wave %d completed, spawned %d zombies", waveIndex+1, totalSpawned)
 	return totalSpawned
 }
 
+// spawnGraveZombies 从每块墓碑下爬出一只普通僵尸
+//
+// 僵尸在墓碑所在格子下沉 GraveZombieRiseDepth，由 BehaviorSystem 逐渐升到地面后开始行走；
+// 墓碑僵尸不在关卡配置中，按召唤僵尸计入胜利条件
+//
+// 返回：
+//
+//	爬出的僵尸数量
+func (s *WaveSpawnSystem) spawnGraveZombies(waveIndex int) int {
+	spawned := 0
+	for _, graveID := range ecs.GetEntitiesWith1[*components.GraveComponent](s.entityManager) {
+		entityID, err := SpawnGraveZombie(s.entityManager, s.resourceManager, s.gameState, s.layout, graveID, "basic", waveIndex, config.GraveZombieRiseDuration)
+		if err != nil {
+			log.Printf("[WaveSpawnSystem] ERROR: Failed to spawn grave zombie: %v", err)
+			continue
+		}
+		spawned++
+		log.Printf("[WaveSpawnSystem] Grave zombie %d rises from grave %d", entityID, graveID)
+	}
+
+	if spawned > 0 {
+		if audioManager := s.gameState.GetAudioManager(); audioManager != nil {
+			audioManager.PlaySound("SOUND_GRAVESTONE_RUMBLE")
+			audioManager.PlaySound("SOUND_DIRT_RISE")
+		}
+	}
+	return spawned
+}
+
+// SpawnGraveZombie 从墓碑下爬出一只僵尸（不播放音效）
+//
+// 僵尸在墓碑所在格子下沉 GraveZombieRiseDepth，由 BehaviorSystem 在 riseDuration 内
+// 逐渐升到地面后开始行走；僵尸按召唤僵尸登记（计入胜利条件）
+//
+// 参数：
+//   - em: 实体管理器
+//   - rm: 资源管理器
+//   - gs: 游戏状态（为 nil 时不计入召唤僵尸数）
+//   - layout: 当前场景的草坪行布局
+//   - graveID: 墓碑实体ID
+//   - zombieType: 僵尸类型（如 "basic"）
+//   - waveIndex: 所属波次索引（0-based）
+//   - riseDuration: 爬出所需的时间（秒）
+//
+// 返回：
+//   - 僵尸实体ID
+//   - 墓碑不存在或僵尸创建失败时返回错误
+func SpawnGraveZombie(em *ecs.EntityManager, rm *game.ResourceManager, gs *game.GameState, layout config.LawnLayout, graveID ecs.EntityID, zombieType string, waveIndex int, riseDuration float64) (ecs.EntityID, error) {
+	grave, ok := ecs.GetComponent[*components.GraveComponent](em, graveID)
+	if !ok {
+		return 0, fmt.Errorf("entity %d is not a grave", graveID)
+	}
+	entityID, err := SpawnZombieAt(em, rm, gs, layout, zombieType, grave.Col, grave.Row, waveIndex)
+	if err != nil {
+		return 0, err
+	}
+
+	x := config.GridWorldStartX + float64(grave.Col)*config.CellWidth + config.CellWidth/2
+	y := zombieSpawnY(layout, grave.Row)
+	if position, ok := ecs.GetComponent[*components.PositionComponent](em, entityID); ok {
+		position.Y = y + config.GraveZombieRiseDepth
+	}
+	ecs.AddComponent(em, entityID, &components.ZombieRiseComponent{
+		Timer:    riseDuration,
+		TargetY:  y,
+		Duration: riseDuration,
+	})
+	if _, err := entities.CreateParticleEffect(em, rm, "ZombieRise", x, y); err != nil {
+		log.Printf("[WaveSpawnSystem] 警告：创建僵尸出土粒子效果失败: %v", err)
+	}
+	return entityID, nil
+}
+
+// SpawnZombieAt 在草坪格子中心生成一只僵尸并立即开始行走（不播放音效）
+//
+// 僵尸按召唤僵尸登记（计入胜利条件），用于墓碑、罐子等不在波次配置中的僵尸
+//
+// 参数：
+//   - em: 实体管理器
+//   - rm: 资源管理器
+//   - gs: 游戏状态（为 nil 时不计入召唤僵尸数）
+//   - layout: 当前场景的草坪行布局
+//   - zombieType: 僵尸类型（如 "basic"）
+//   - col, row: 网格坐标（0-based）
+//   - waveIndex: 所属波次索引（0-based）
+//
+// 返回：
+//   - 僵尸实体ID
+//   - 僵尸创建失败时返回错误
+func SpawnZombieAt(em *ecs.EntityManager, rm *game.ResourceManager, gs *game.GameState, layout config.LawnLayout, zombieType string, col, row, waveIndex int) (ecs.EntityID, error) {
+	x := config.GridWorldStartX + float64(col)*config.CellWidth + config.CellWidth/2
+
+	entityID, err := entities.NewZombieEntityByType(em, rm, zombieType, layout, row, x)
+	if err != nil {
+		return 0, err
+	}
+	RegisterSummonedZombie(em, gs, entityID, waveIndex)
+	entities.ActivateZombie(em, entityID)
+
+	if position, ok := ecs.GetComponent[*components.PositionComponent](em, entityID); ok {
+		position.Y = zombieSpawnY(layout, row)
+	}
+	return entityID, nil
+}
+
 // spawnAndActivateZombie 生成并直接激活单个僵尸（实时生成模式）
 //
 // 生成僵尸后立即设置为激活状态，开始移动和播放行走动画
@@ -451,10 +583,8 @@ func (s *WaveSpawnSystem) spawnAndActivateZombie(zombieType string, lane int, wa
 	if s.spawnRules != nil && s.constraintID != 0 {
 		constraint, ok := ecs.GetComponent[*components.SpawnConstraintComponent](s.entityManager, s.constraintID)
 		if ok {
-			roundNumber := -1
-			if s.gameState.TotalCompletedFlags > 0 {
-				roundNumber = s.gameState.TotalCompletedFlags/2 - 1
-			}
+			// 生存模式下轮数为已完成的旗帜组数
+			roundNumber := s.gameState.GetCurrentRoundNumber()
 
 			valid, reason := ValidateZombieSpawn(zombieType, lane, constraint, roundNumber, s.spawnRules)
 			if !valid {
@@ -474,8 +604,8 @@ func (s *WaveSpawnSystem) spawnAndActivateZombie(zombieType string, lane int, wa
 	if row < 0 {
 		row = 0
 	}
-	if row > 4 {
-		row = 4
+	if row > s.layout.Rows-1 {
+		row = s.layout.Rows - 1
 	}
 
 	// Story 17.9: 判断是否为旗帜波
@@ -489,35 +619,14 @@ func (s *WaveSpawnSystem) spawnAndActivateZombie(zombieType string, lane int, wa
 	spawnY := s.getZombieSpawnY(row)
 
 	// 根据类型创建僵尸
-	var entityID ecs.EntityID
-	var err error
-
-	switch zombieType {
-	case "basic":
-		entityID, err = entities.NewZombieEntity(
-			s.entityManager,
-			s.resourceManager,
-			row,
-			spawnX,
-		)
-	case "conehead":
-		entityID, err = entities.NewConeheadZombieEntity(
-			s.entityManager,
-			s.resourceManager,
-			row,
-			spawnX,
-		)
-	case "buckethead":
-		entityID, err = entities.NewBucketheadZombieEntity(
-			s.entityManager,
-			s.resourceManager,
-			row,
-			spawnX,
-		)
-	default:
-		log.Printf("[WaveSpawnSystem] ERROR: Unknown zombie type '%s'", zombieType)
-		return 0
-	}
+	entityID, err := entities.NewZombieEntityByType(
+		s.entityManager,
+		s.resourceManager,
+		zombieType,
+		s.layout,
+		row,
+		spawnX,
+	)
 
 	if err != nil {
 		log.Printf("[WaveSpawnSystem] ERROR: Failed to spawn zombie: %v", err)
@@ -563,21 +672,16 @@ func (s *WaveSpawnSystem) spawnZombieForWave(zombieType string, lane int, waveIn
 		constraint, ok := ecs.GetComponent[*components.SpawnConstraintComponent](s.entityManager, s.constraintID)
 		if ok {
 			// 计算当前轮数
-			// 公式: RoundNumber = TotalCompletedFlags / 2 - 1
-			// 对于当前关卡，暂时假设 CompletedFlags = 0，即 RoundNumber = -1
-			// TODO: 需要从 GameState 获取 TotalCompletedFlags
-			roundNumber := -1 // 默认第一轮
-			if s.gameState.TotalCompletedFlags > 0 {
-				roundNumber = s.gameState.TotalCompletedFlags/2 - 1
-			}
+			// 公式: RoundNumber = TotalCompletedFlags / 2 - 1（生存模式下为已完成的旗帜组数）
+			roundNumber := s.gameState.GetCurrentRoundNumber()
 
-			// 转换 lane (1-5) 为 row (0-4)
+			// 转换 lane (1-RowMax) 为 row (0-based)
 			row := lane - 1
 			if row < 0 {
 				row = 0
 			}
-			if row > 4 {
-				row = 4
+			if row > s.layout.Rows-1 {
+				row = s.layout.Rows - 1
 			}
 
 			// 使用纯函数验证
@@ -603,11 +707,11 @@ func (s *WaveSpawnSystem) spawnZombieForWave(zombieType string, lane int, waveIn
 		isFlagWave = s.levelConfig.Waves[waveIndex].IsFlag
 	}
 
-	// 开场预览期间，僵尸随机分布在5行上
+	// 开场预览期间，僵尸随机分布在所有行上
 	// 激活后，僵尸会移动到随机选择的有效行
 
-	// 预览行：随机选择一行（0-4）用于开场预览展示
-	previewRow := rand.Intn(5)
+	// 预览行：随机选择一行用于开场预览展示
+	previewRow := rand.Intn(s.layout.Rows)
 
 	// Story 17.9: 计算预览位置（僵尸初始站位）
 	// X坐标：根据波次类型和僵尸类型使用精确坐标
@@ -615,36 +719,14 @@ func (s *WaveSpawnSystem) spawnZombieForWave(zombieType string, lane int, waveIn
 	spawnY := s.getZombieSpawnY(previewRow) // 使用随机行的Y坐标
 
 	// 根据类型创建僵尸
-	var entityID ecs.EntityID
-	var err error
-
-	// 工厂函数不再接受 reanimSystem 参数
-	switch zombieType {
-	case "basic":
-		entityID, err = entities.NewZombieEntity(
-			s.entityManager,
-			s.resourceManager,
-			previewRow,
-			spawnX,
-		)
-	case "conehead":
-		entityID, err = entities.NewConeheadZombieEntity(
-			s.entityManager,
-			s.resourceManager,
-			previewRow,
-			spawnX,
-		)
-	case "buckethead":
-		entityID, err = entities.NewBucketheadZombieEntity(
-			s.entityManager,
-			s.resourceManager,
-			previewRow,
-			spawnX,
-		)
-	default:
-		log.Printf("[WaveSpawnSystem] ERROR: Unknown zombie type '%s'", zombieType)
-		return 0
-	}
+	entityID, err := entities.NewZombieEntityByType(
+		s.entityManager,
+		s.resourceManager,
+		zombieType,
+		s.layout,
+		previewRow,
+		spawnX,
+	)
 
 	if err != nil {
 		log.Printf("[WaveSpawnSystem] ERROR: Failed to spawn zombie: %v", err)
@@ -689,12 +771,17 @@ func (s *WaveSpawnSystem) spawnZombieForWave(zombieType string, lane int, waveIn
 		}
 
 		// Determine correct unit ID based on behavior type
+		// 特殊僵尸（如鸭子救生圈僵尸）使用工厂设置的 UnitID，保证预览时显示各自的道具
 		unitID := types.UnitIDZombie
 		switch behavior.Type {
 		case components.BehaviorZombieConehead:
 			unitID = types.UnitIDZombieConehead
 		case components.BehaviorZombieBuckethead:
 			unitID = types.UnitIDZombieBuckethead
+		default:
+			if behavior.UnitID != "" {
+				unitID = behavior.UnitID
+			}
 		}
 
 		// 使用组件通信替代直接调用
@@ -740,10 +827,10 @@ func (s *WaveSpawnSystem) spawnZombieForWave(zombieType string, lane int, waveIn
 //
 //	生成的僵尸实体ID，如果失败返回 0
 func (s *WaveSpawnSystem) spawnZombieWithOffset(zombieType string, lane int, index int) ecs.EntityID {
-	// 将行号从1-5转换为数组索引0-4
+	// 将行号从1-based转换为数组索引
 	row := lane - 1
-	if row < 0 || row > 4 {
-		log.Printf("[WaveSpawnSystem] ERROR: Invalid lane %d (must be 1-5)", lane)
+	if row < 0 || row >= s.layout.Rows {
+		log.Printf("[WaveSpawnSystem] ERROR: Invalid lane %d (must be 1-%d)", lane, s.layout.Rows)
 		return 0
 	}
 
@@ -757,36 +844,14 @@ func (s *WaveSpawnSystem) spawnZombieWithOffset(zombieType string, lane int, ind
 	targetRow := targetLane - 1
 
 	// 根据僵尸类型调用对应的工厂函数
-	var entityID ecs.EntityID
-	var err error
-
-	// 工厂函数不再接受 reanimSystem 参数
-	switch zombieType {
-	case "basic":
-		entityID, err = entities.NewZombieEntity(
-			s.entityManager,
-			s.resourceManager,
-			row,
-			spawnX,
-		)
-	case "conehead":
-		entityID, err = entities.NewConeheadZombieEntity(
-			s.entityManager,
-			s.resourceManager,
-			row,
-			spawnX,
-		)
-	case "buckethead":
-		entityID, err = entities.NewBucketheadZombieEntity(
-			s.entityManager,
-			s.resourceManager,
-			row,
-			spawnX,
-		)
-	default:
-		log.Printf("[WaveSpawnSystem] ERROR: Unknown zombie type '%s'", zombieType)
-		return 0
-	}
+	entityID, err := entities.NewZombieEntityByType(
+		s.entityManager,
+		s.resourceManager,
+		zombieType,
+		s.layout,
+		row,
+		spawnX,
+	)
 
 	// 检查是否创建成功
 	if err != nil {
@@ -929,8 +994,13 @@ func (s *WaveSpawnSystem) getZombieSpawnMaxX(row int) float64 {
 //
 //	僵尸生成Y坐标（行中心 + 垂直偏移修正值）
 func (s *WaveSpawnSystem) getZombieSpawnY(row int) float64 {
+	return zombieSpawnY(s.layout, row)
+}
+
+// zombieSpawnY 计算僵尸在指定行（0-based）的Y坐标
+func zombieSpawnY(layout config.LawnLayout, row int) float64 {
 	// 计算行中心Y坐标
-	rowCenterY := config.GridWorldStartY + float64(row)*config.CellHeight + config.CellHeight/2.0
+	rowCenterY := layout.StartY + float64(row)*layout.CellHeight + layout.CellHeight/2.0
 
 	// 应用僵尸垂直偏移修正值
 	return rowCenterY + config.ZombieVerticalOffset
@@ -989,7 +1059,7 @@ func abs(x int) int {
 func (s *WaveSpawnSystem) randomEnabledLane() int {
 	// 如果没有关卡配置或无行限制，从所有行中随机选择
 	if s.levelConfig == nil || len(s.levelConfig.EnabledLanes) == 0 {
-		return rand.Intn(5) // 0-4
+		return rand.Intn(s.layout.Rows)
 	}
 
 	// 从 EnabledLanes 中随机选择一个（注意：EnabledLanes 是 1-based）
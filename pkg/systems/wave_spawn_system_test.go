@@ -0,0 +1,85 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/config"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/game"
+)
+
+// newTestWaveSpawnSystem 使用正式的生成规则和僵尸动画资源创建波次生成系统
+func newTestWaveSpawnSystem(t *testing.T, levelConfig *config.LevelConfig) (*WaveSpawnSystem, *ecs.EntityManager) {
+	t.Helper()
+	rm := game.NewResourceManager(getTestAudioContext())
+	if err := rm.LoadResourceConfig("assets/config/resources.yaml"); err != nil {
+		t.Fatalf("Failed to load resource config: %v", err)
+	}
+	if err := rm.LoadReanimResources(); err != nil {
+		t.Fatalf("Failed to load reanim resources: %v", err)
+	}
+	spawnRules, err := config.LoadSpawnRules("data/spawn_rules.yaml")
+	if err != nil {
+		t.Fatalf("Failed to load spawn rules: %v", err)
+	}
+
+	em := ecs.NewEntityManager()
+	gs := &game.GameState{WavesPerRound: 20}
+	return NewWaveSpawnSystem(em, rm, levelConfig, gs, spawnRules, nil), em
+}
+
+// newSingleZombieLevel 创建只在最后一波出现一只指定僵尸的关卡，波次数满足三阶僵尸的最早波次
+func newSingleZombieLevel(sceneType, zombieType string) *config.LevelConfig {
+	waves := make([]config.WaveConfig, 8)
+	for i := range waves {
+		waves[i].WaveNum = i + 1
+	}
+	waves[7].Zombies = []config.ZombieGroup{{Type: zombieType, Count: 1}}
+	return &config.LevelConfig{
+		ID:           "test",
+		SceneType:    sceneType,
+		EnabledLanes: []int{1, 2, 3, 4, 5},
+		Waves:        waves,
+	}
+}
+
+// TestWaveSpawnSystem_SpawnsBungee 测试正式生成规则下关卡波次中的蹦极僵尸能够生成
+func TestWaveSpawnSystem_SpawnsBungee(t *testing.T) {
+	system, em := newTestWaveSpawnSystem(t, newSingleZombieLevel("day", "bungee"))
+
+	if spawned := system.PreSpawnAllWaves(); spawned != 1 {
+		t.Fatalf("Expected 1 zombie spawned, got %d", spawned)
+	}
+	if bungees := ecs.GetEntitiesWith1[*components.BungeeComponent](em); len(bungees) != 1 {
+		t.Errorf("Expected 1 bungee zombie, got %d", len(bungees))
+	}
+}
+
+// TestWaveSpawnSystem_SpawnsLadderAndCatapult 测试正式生成规则下关卡波次中的梯子僵尸和投篮车僵尸能够生成
+func TestWaveSpawnSystem_SpawnsLadderAndCatapult(t *testing.T) {
+	tests := []struct {
+		zombieType string
+		count      func(em *ecs.EntityManager) int
+	}{
+		{"ladder", func(em *ecs.EntityManager) int {
+			return len(ecs.GetEntitiesWith1[*components.LadderZombieComponent](em))
+		}},
+		{"catapult", func(em *ecs.EntityManager) int {
+			return len(ecs.GetEntitiesWith1[*components.CatapultComponent](em))
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zombieType, func(t *testing.T) {
+			system, em := newTestWaveSpawnSystem(t, newSingleZombieLevel("day", tt.zombieType))
+
+			if spawned := system.PreSpawnAllWaves(); spawned != 1 {
+				t.Fatalf("Expected 1 zombie spawned, got %d", spawned)
+			}
+			if got := tt.count(em); got != 1 {
+				t.Errorf("Expected 1 %s zombie, got %d", tt.zombieType, got)
+			}
+		})
+	}
+}
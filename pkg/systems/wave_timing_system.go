@@ -802,6 +802,27 @@ func (s *WaveTimingSystem) isFinalWave(waveIndex int) bool {
 	return false
 }
 
+// RestartWaves 按关卡当前的波次重新开始计时
+//
+// 生存模式开始新的一组旗帜时调用：重置计时器状态，总波次数取自替换后的波次配置，
+// 首波使用非首次游戏的延迟
+func (s *WaveTimingSystem) RestartWaves() {
+	timer := s.getTimerComponent()
+	if timer == nil {
+		return
+	}
+
+	totalWaves := 0
+	if s.levelConfig != nil {
+		totalWaves = len(s.levelConfig.Waves)
+	}
+	*timer = components.WaveTimerComponent{
+		IsFirstWave: true,
+		TotalWaves:  totalWaves,
+	}
+	s.InitializeTimerWithDelay(false, s.levelConfig)
+}
+
 // Pause 暂停计时器
 func (s *WaveTimingSystem) Pause() {
 	timer := s.getTimerComponent()
@@ -1048,6 +1069,7 @@ func (s *WaveTimingSystem) GetWaveHealthInfo() (initialHealth, currentHealth int
 // CalculateCurrentWaveHealth 计算当前波次僵尸的实时总血量
 //
 // Story 17.8: 遍历所有本波僵尸，累加 Health + Armor
+// 被魅惑的僵尸不计入
 // 由 LevelSystem 调用以获取实时血量
 //
 // 参数:
@@ -1072,6 +1094,11 @@ func CalculateCurrentWaveHealth(em *ecs.EntityManager, currentWaveIndex int) int
 			continue
 		}
 
+		// 被魅惑的僵尸已倒戈，不计入本波血量
+		if IsCharmedZombie(em, entity) {
+			continue
+		}
+
 		// 累加血量
 		health, hasHealth := ecs.GetComponent[*components.HealthComponent](em, entity)
 		if hasHealth && health.CurrentHealth > 0 {
@@ -1079,9 +1106,13 @@ func CalculateCurrentWaveHealth(em *ecs.EntityManager, currentWaveIndex int) int
 		}
 
 		// 累加护甲
-		armor, hasArmor := ecs.GetComponent[*components.ArmorComponent](em, entity)
-		if hasArmor && armor.CurrentArmor > 0 {
-			totalHealth += armor.CurrentArmor
+		if helmet := ZombieHelmet(em, entity); helmet != nil {
+			totalHealth += helmet.Durability
+		}
+
+		// 累加手持防具（与 CalculateZombieEffectiveHealth 一致，按 20% 计算）
+		if shield := ZombieShield(em, entity); shield != nil {
+			totalHealth += int(float64(shield.Durability) * 0.20)
 		}
 	}
 
@@ -7,6 +7,7 @@ import (
 	"github.com/gonewx/pvz/pkg/config"
 	"github.com/gonewx/pvz/pkg/ecs"
 	"github.com/gonewx/pvz/pkg/game"
+	"github.com/gonewx/pvz/pkg/types"
 )
 
 // 测试辅助函数
@@ -1120,7 +1121,9 @@ func TestCalculateCurrentWaveHealth(t *testing.T) {
 	zombie1 := em.CreateEntity()
 	ecs.AddComponent(em, zombie1, &components.ZombieWaveStateComponent{WaveIndex: 0})
 	ecs.AddComponent(em, zombie1, &components.HealthComponent{CurrentHealth: 100})
-	ecs.AddComponent(em, zombie1, &components.ArmorComponent{CurrentArmor: 50})
+	ecs.AddComponent(em, zombie1, &components.AccessoryComponent{Pieces: []*components.AccessoryPiece{
+		{Tag: types.AccessoryCone, Tier: 1, Durability: 50, MaxDurability: 370},
+	}})
 
 	zombie2 := em.CreateEntity()
 	ecs.AddComponent(em, zombie2, &components.ZombieWaveStateComponent{WaveIndex: 0})
@@ -1147,6 +1150,28 @@ func TestCalculateCurrentWaveHealth(t *testing.T) {
 	}
 }
 
+// TestCalculateCurrentWaveHealth_ExcludesCharmed 测试被魅惑的僵尸不计入波次血量
+func TestCalculateCurrentWaveHealth_ExcludesCharmed(t *testing.T) {
+	em := ecs.NewEntityManager()
+
+	zombie1 := em.CreateEntity()
+	ecs.AddComponent(em, zombie1, &components.ZombieWaveStateComponent{WaveIndex: 0})
+	ecs.AddComponent(em, zombie1, &components.HealthComponent{CurrentHealth: 270})
+
+	// 被魅惑的僵尸（不应计入）
+	charmed := em.CreateEntity()
+	ecs.AddComponent(em, charmed, &components.ZombieWaveStateComponent{WaveIndex: 0})
+	ecs.AddComponent(em, charmed, &components.HealthComponent{CurrentHealth: 270})
+	ecs.AddComponent(em, charmed, &components.AccessoryComponent{Pieces: []*components.AccessoryPiece{
+		{Tag: types.AccessoryCone, Tier: 1, Durability: 370, MaxDurability: 370},
+	}})
+	ecs.AddComponent(em, charmed, &components.FactionComponent{Faction: components.FactionPlant})
+
+	if totalHealth := CalculateCurrentWaveHealth(em, 0); totalHealth != 270 {
+		t.Errorf("CalculateCurrentWaveHealth = %d, want 270", totalHealth)
+	}
+}
+
 // TestWaveTimingSystem_UpdateWaveCurrentHealth 测试更新当前血量
 func TestWaveTimingSystem_UpdateWaveCurrentHealth(t *testing.T) {
 	em := ecs.NewEntityManager()
@@ -0,0 +1,64 @@
+package systems
+
+import (
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/ecs"
+)
+
+// ZombieHelmet 返回僵尸仍在生效的头部防具（I类饰品），没有或耐久已耗尽时返回 nil
+//
+// 头部防具优先承受子弹、爆炸、投掷等所有伤害
+func ZombieHelmet(em *ecs.EntityManager, zombieID ecs.EntityID) *components.AccessoryPiece {
+	accessory, ok := ecs.GetComponent[*components.AccessoryComponent](em, zombieID)
+	if !ok {
+		return nil
+	}
+	helmet := accessory.Helmet()
+	if helmet == nil || !helmet.IsIntact() {
+		return nil
+	}
+	return helmet
+}
+
+// ZombieShield 返回僵尸仍在生效的手持防具（II类饰品），没有或耐久已耗尽时返回 nil
+//
+// 手持防具只阻挡从正面飞来的直线子弹
+func ZombieShield(em *ecs.EntityManager, zombieID ecs.EntityID) *components.AccessoryPiece {
+	accessory, ok := ecs.GetComponent[*components.AccessoryComponent](em, zombieID)
+	if !ok {
+		return nil
+	}
+	shield := accessory.Shield()
+	if shield == nil || !shield.IsIntact() {
+		return nil
+	}
+	return shield
+}
+
+// ZombieShieldFacing 返回挡在攻击方向上的手持防具，攻击从僵尸背后来或没有防具时返回 nil
+//
+// dirX 为攻击的水平方向（子弹的飞行方向、啃食者指向目标的方向）。
+// 只有迎着僵尸面朝方向而来的攻击会打在防具上，被魅惑的僵尸面朝右侧
+func ZombieShieldFacing(em *ecs.EntityManager, zombieID ecs.EntityID, dirX float64) *components.AccessoryPiece {
+	if dirX*ZombieFacingX(em, zombieID) >= 0 {
+		return nil
+	}
+	return ZombieShield(em, zombieID)
+}
+
+// AbsorbHelmetDamage 头部防具吸收伤害，返回穿透防具后剩余的伤害
+//
+// 用于爆炸、投掷等一次性伤害：防具耐久最多扣到 0，超出部分由身体承受。
+// 防具被打掉后的外观切换和掉落效果由 BehaviorSystem 处理
+func AbsorbHelmetDamage(em *ecs.EntityManager, zombieID ecs.EntityID, damage int) int {
+	helmet := ZombieHelmet(em, zombieID)
+	if helmet == nil {
+		return damage
+	}
+	absorbed := damage
+	if absorbed > helmet.Durability {
+		absorbed = helmet.Durability
+	}
+	helmet.Durability -= absorbed
+	return damage - absorbed
+}
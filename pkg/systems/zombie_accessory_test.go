@@ -0,0 +1,76 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/ecs"
+	"github.com/gonewx/pvz/pkg/types"
+)
+
+// TestAbsorbHelmetDamage 测试头部防具吸收一次性伤害，超出部分穿透
+func TestAbsorbHelmetDamage(t *testing.T) {
+	em := ecs.NewEntityManager()
+	zombieID := em.CreateEntity()
+	cone := &components.AccessoryPiece{Tag: types.AccessoryCone, Tier: 1, Durability: 370, MaxDurability: 370}
+	ecs.AddComponent(em, zombieID, &components.AccessoryComponent{Pieces: []*components.AccessoryPiece{cone}})
+
+	if left := AbsorbHelmetDamage(em, zombieID, 300); left != 0 || cone.Durability != 70 {
+		t.Errorf("Expected helmet to absorb 300 damage, left=%d durability=%d", left, cone.Durability)
+	}
+	if left := AbsorbHelmetDamage(em, zombieID, 100); left != 30 || cone.Durability != 0 {
+		t.Errorf("Expected 30 damage to pass through, left=%d durability=%d", left, cone.Durability)
+	}
+	if ZombieHelmet(em, zombieID) != nil {
+		t.Error("Expected depleted helmet not to be returned")
+	}
+	if left := AbsorbHelmetDamage(em, zombieID, 100); left != 100 {
+		t.Errorf("Expected all damage to pass through without helmet, left=%d", left)
+	}
+}
+
+// TestAccessoryPartImageHidesRemovedPiece 测试已移除饰品的轨道不绘制，其他轨道正常绘制
+func TestAccessoryPartImageHidesRemovedPiece(t *testing.T) {
+	s := &RenderSystem{}
+	accessory := &components.AccessoryComponent{Pieces: []*components.AccessoryPiece{
+		{Tag: types.AccessoryBucket, Tier: 1, Durability: 1100, MaxDurability: 1100, Removed: true},
+	}}
+
+	if _, visible := s.accessoryPartImage(accessory, components.RenderPartData{TrackName: "anim_bucket"}); visible {
+		t.Error("Expected removed bucket track to be hidden")
+	}
+	if _, visible := s.accessoryPartImage(accessory, components.RenderPartData{TrackName: "anim_head1"}); !visible {
+		t.Error("Expected unrelated track to stay visible")
+	}
+}
+
+// TestZombieShieldFacing 测试手持防具只阻挡迎着僵尸面朝方向而来的攻击，被魅惑的僵尸面朝右侧
+func TestZombieShieldFacing(t *testing.T) {
+	tests := []struct {
+		name      string
+		charmed   bool
+		dirX      float64
+		wantBlock bool
+	}{
+		{"普通僵尸挡住向右飞的子弹", false, 1, true},
+		{"普通僵尸挡不住背后向左的攻击", false, -1, false},
+		{"被魅惑僵尸挡住向左的攻击", true, -1, true},
+		{"被魅惑僵尸挡不住背后向右的攻击", true, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em := ecs.NewEntityManager()
+			zombieID := em.CreateEntity()
+			door := &components.AccessoryPiece{Tag: types.AccessoryScreenDoor, Tier: 2, Durability: 1100, MaxDurability: 1100}
+			ecs.AddComponent(em, zombieID, &components.AccessoryComponent{Pieces: []*components.AccessoryPiece{door}})
+			if tt.charmed {
+				ecs.AddComponent(em, zombieID, &components.FactionComponent{Faction: components.FactionPlant})
+			}
+
+			if blocked := ZombieShieldFacing(em, zombieID, tt.dirX) == door; blocked != tt.wantBlock {
+				t.Errorf("期望阻挡=%v，实际=%v", tt.wantBlock, blocked)
+			}
+		})
+	}
+}
@@ -33,12 +33,14 @@ const (
 //  4. 到达后：标记 HasReachedTargetLane = true，清除Y轴速度，启动正常移动
 type ZombieLaneTransitionSystem struct {
 	entityManager *ecs.EntityManager
+	layout        config.LawnLayout // 当前场景的草坪行布局
 }
 
 // NewZombieLaneTransitionSystem 创建僵尸行转换系统
-func NewZombieLaneTransitionSystem(em *ecs.EntityManager) *ZombieLaneTransitionSystem {
+func NewZombieLaneTransitionSystem(em *ecs.EntityManager, layout config.LawnLayout) *ZombieLaneTransitionSystem {
 	return &ZombieLaneTransitionSystem{
 		entityManager: em,
+		layout:        layout,
 	}
 }
 
@@ -85,8 +87,9 @@ func (s *ZombieLaneTransitionSystem) Update(deltaTime float64) {
 		}
 
 		// 计算目标行的中心Y坐标
-		// 必须与僵尸工厂函数的Y坐标计算公式保持一致
-		targetY := config.GridWorldStartY + float64(targetLaneComp.TargetRow)*config.CellHeight + config.CellHeight/2.0 + config.ZombieVerticalOffset
+		// 必须与僵尸工厂函数的Y坐标计算公式保持一致（屋顶斜坡上叠加斜坡偏移）
+		targetY := s.layout.StartY + float64(targetLaneComp.TargetRow)*s.layout.CellHeight + s.layout.CellHeight/2.0 + config.ZombieVerticalOffset +
+			s.layout.SlopeOffsetY(pos.X)
 
 		// 根据转换模式选择不同的处理逻辑
 		switch targetLaneComp.TransitionMode {
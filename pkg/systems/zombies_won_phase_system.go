@@ -242,11 +242,15 @@ func (s *ZombiesWonPhaseSystem) updatePhase2ZombieEntry(
 		log.Printf("[ZombiesWonPhaseSystem] Phase 2 started: Initial camera X=%.2f, zombie starts walking simultaneously",
 			phaseComp.InitialCameraX)
 
-		// Story 8.8: 懒加载房门图片（DelayLoad_Background1 资源组）
+		// Story 8.8: 懒加载房门图片（当前场景背景的 DelayLoad_Background 资源组）
 		// 确保门板图片在渲染前已加载到缓存
 		// 注意：单元测试环境中 resourceManager 可能未初始化配置，需要容错处理
 		if s.resourceManager != nil {
-			if err := s.resourceManager.LoadResourceGroup("DelayLoad_Background1"); err != nil {
+			sceneType := ""
+			if s.gameState != nil && s.gameState.CurrentLevel != nil {
+				sceneType = s.gameState.CurrentLevel.SceneType
+			}
+			if err := s.resourceManager.LoadResourceGroup(config.GetSceneBackground(sceneType).ResourceGroup); err != nil {
 				log.Printf("[ZombiesWonPhaseSystem] 警告：房门图片加载失败: %v", err)
 			} else {
 				log.Printf("[ZombiesWonPhaseSystem] Phase 2: 成功加载房门图片资源")
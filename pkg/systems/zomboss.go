@@ -0,0 +1,50 @@
+package systems
+
+import (
+	"log"
+
+	"github.com/gonewx/pvz/pkg/components"
+	"github.com/gonewx/pvz/pkg/ecs"
+)
+
+// IsZombossHeadExposed 判断实体是否是头部低垂露出弱点的僵王博士
+//
+// 僵王博士只有低头时才能被子弹和植物攻击
+func IsZombossHeadExposed(em *ecs.EntityManager, entityID ecs.EntityID) bool {
+	zomboss, ok := ecs.GetComponent[*components.ZombossComponent](em, entityID)
+	return ok && zomboss.IsHeadExposed()
+}
+
+// GetZomboss 获取场上的僵王博士（没有时返回 false）
+func GetZomboss(em *ecs.EntityManager) (ecs.EntityID, *components.ZombossComponent, bool) {
+	for _, entityID := range ecs.GetEntitiesWith1[*components.ZombossComponent](em) {
+		zomboss, _ := ecs.GetComponent[*components.ZombossComponent](em, entityID)
+		return entityID, zomboss, true
+	}
+	return 0, nil, false
+}
+
+// CounterZombossBalls 用指定属性的攻击消除僵王博士的火球或冰球
+//
+// 只有相反属性的球会被消除：火属性攻击（火爆辣椒）融化冰球，冰属性攻击（寒冰菇）冻结火球。
+// 火爆辣椒只影响所在的一行，寒冰菇影响整个草坪（row 传 -1）
+//
+// 返回被消除的球的数量
+func CounterZombossBalls(em *ecs.EntityManager, element components.ZombossElement, row int) int {
+	count := 0
+	for _, ballID := range ecs.GetEntitiesWith1[*components.ZombossBallComponent](em) {
+		ball, _ := ecs.GetComponent[*components.ZombossBallComponent](em, ballID)
+		if ball.Element.Counter() != element {
+			continue
+		}
+		if row >= 0 && ball.Row != row {
+			continue
+		}
+		em.DestroyEntity(ballID)
+		count++
+	}
+	if count > 0 {
+		log.Printf("[Zomboss] 消除了 %d 个僵王博士的球", count)
+	}
+	return count
+}
@@ -0,0 +1,51 @@
+package types
+
+// AccessoryTag 僵尸饰品（可拆卸装备）标签
+// 每个标签对应僵尸身上一件独立的装备，如铁桶、铁栅门、橄榄球头盔等
+// 磁力菇等植物通过标签识别并移除金属饰品
+type AccessoryTag string
+
+const (
+	// AccessoryNone 无饰品
+	AccessoryNone AccessoryTag = ""
+
+	// I类饰品（头部防具）
+	AccessoryCone           AccessoryTag = "cone"            // 路障（塑料）
+	AccessoryBucket         AccessoryTag = "bucket"          // 铁桶（金属）
+	AccessoryFootballHelmet AccessoryTag = "football_helmet" // 橄榄球头盔（金属）
+
+	// II类饰品（手持防具）
+	AccessoryNewspaper  AccessoryTag = "newspaper"  // 报纸（非金属）
+	AccessoryScreenDoor AccessoryTag = "screendoor" // 铁栅门（金属）
+	AccessoryLadder     AccessoryTag = "ladder"     // 扶梯（金属）
+
+	// 功能性道具（无护甲值，但可被磁力菇吸走）
+	AccessoryPogoStick AccessoryTag = "pogo_stick" // 跳跳杆
+	AccessoryJackBox   AccessoryTag = "jack_box"   // 小丑盒子
+	AccessoryPickaxe   AccessoryTag = "pickaxe"    // 矿工镐
+)
+
+// IsMetal 判断饰品是否为金属材质
+// 金属饰品：铁桶、铁栅门、橄榄球头盔、扶梯、跳跳杆、小丑盒子、矿工镐
+func (t AccessoryTag) IsMetal() bool {
+	switch t {
+	case AccessoryBucket,
+		AccessoryFootballHelmet,
+		AccessoryScreenDoor,
+		AccessoryLadder,
+		AccessoryPogoStick,
+		AccessoryJackBox,
+		AccessoryPickaxe:
+		return true
+	default:
+		return false
+	}
+}
+
+// String 返回饰品标签的字符串表示
+func (t AccessoryTag) String() string {
+	if t == AccessoryNone {
+		return "none"
+	}
+	return string(t)
+}
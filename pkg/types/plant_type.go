@@ -18,8 +18,68 @@ const (
 	PlantCherryBomb
 	// PlantPotatoMine 土豆地雷 (Story 19.10)
 	PlantPotatoMine
+	// PlantMagnetshroom 磁力菇
+	PlantMagnetshroom
+	// PlantHypnoshroom 催眠菇
+	PlantHypnoshroom
+	// PlantKernelpult 玉米投手
+	PlantKernelpult
+	// PlantCobCannon 玉米加农炮（升级植物，占用两格）
+	PlantCobCannon
+	// PlantSplitPea 裂荚射手
+	PlantSplitPea
+	// PlantStarfruit 杨桃
+	PlantStarfruit
+	// PlantCattail 香蒲
+	PlantCattail
+	// PlantTallnut 高坚果
+	PlantTallnut
+	// PlantGraveBuster 墓碑吞噬者（只能种在墓碑上）
+	PlantGraveBuster
+	// PlantLilyPad 睡莲（只能种在水路上，陆生植物可以种在睡莲上）
+	PlantLilyPad
+	// PlantTangleKelp 缠绕海草（只能种在水路上）
+	PlantTangleKelp
+	// PlantSeaShroom 海蘑菇（只能种在水路上）
+	PlantSeaShroom
+	// PlantPlantern 植物灯笼（驱散周围的雾）
+	PlantPlantern
+	// PlantBlover 三叶草（吹散雾和空中的气球僵尸）
+	PlantBlover
+	// PlantFlowerPot 花盆（屋顶上的植物必须种在花盆上）
+	PlantFlowerPot
+	// PlantJalapeno 火爆辣椒（烧毁整行的僵尸）
+	PlantJalapeno
+	// PlantIceShroom 寒冰菇（冻结场上所有僵尸）
+	PlantIceShroom
+	// PlantSpikeweed 地刺（僵尸从上面走过时被扎伤，车辆僵尸被扎破）
+	PlantSpikeweed
+	// PlantChomper 大嘴花（吞下面前的僵尸，咀嚼期间不能攻击）
+	PlantChomper
+	// PlantUmbrellaLeaf 叶子保护伞（保护周围 3x3 格子的植物不被蹦极僵尸偷走）
+	PlantUmbrellaLeaf
 )
 
+// IsAquatic 是否是水生植物（只能种在水路上，不需要睡莲）
+func (p PlantType) IsAquatic() bool {
+	switch p {
+	case PlantLilyPad, PlantTangleKelp, PlantSeaShroom:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsPlatform 是否是种植平台（水路上的睡莲、屋顶上的花盆），其他植物可以种在平台上
+func (p PlantType) IsPlatform() bool {
+	return p == PlantLilyPad || p == PlantFlowerPot
+}
+
+// IsSpike 是否是地刺类植物（僵尸从上面走过而不啃食，巨人的电线杆也不会砸它）
+func (p PlantType) IsSpike() bool {
+	return p == PlantSpikeweed
+}
+
 // String 返回植物类型的字符串表示
 func (p PlantType) String() string {
 	switch p {
@@ -33,6 +93,46 @@ func (p PlantType) String() string {
 		return "CherryBomb"
 	case PlantPotatoMine:
 		return "PotatoMine"
+	case PlantMagnetshroom:
+		return "Magnetshroom"
+	case PlantHypnoshroom:
+		return "Hypnoshroom"
+	case PlantKernelpult:
+		return "Kernelpult"
+	case PlantCobCannon:
+		return "CobCannon"
+	case PlantSplitPea:
+		return "SplitPea"
+	case PlantStarfruit:
+		return "Starfruit"
+	case PlantCattail:
+		return "Cattail"
+	case PlantTallnut:
+		return "Tallnut"
+	case PlantGraveBuster:
+		return "GraveBuster"
+	case PlantLilyPad:
+		return "LilyPad"
+	case PlantTangleKelp:
+		return "TangleKelp"
+	case PlantSeaShroom:
+		return "SeaShroom"
+	case PlantPlantern:
+		return "Plantern"
+	case PlantBlover:
+		return "Blover"
+	case PlantFlowerPot:
+		return "FlowerPot"
+	case PlantJalapeno:
+		return "Jalapeno"
+	case PlantIceShroom:
+		return "IceShroom"
+	case PlantSpikeweed:
+		return "Spikeweed"
+	case PlantChomper:
+		return "Chomper"
+	case PlantUmbrellaLeaf:
+		return "UmbrellaLeaf"
 	default:
 		return "Unknown"
 	}
@@ -58,7 +58,7 @@ const (
 	UnitIDZombieFootball   = "zombie_football"
 	UnitIDZombieDancing    = "zombie_dancing"
 	UnitIDZombieBackupDancer = "zombie_backup_dancer"
-	UnitIDZombieSnorkel    = "zombie_snorkel"
+	UnitIDZombieSnorkel    = "zombie_snorkle"
 	UnitIDZombieDolphinRider = "zombie_dolphinrider"
 	UnitIDZombieDucky      = "zombie_ducky"
 	UnitIDZombieJack       = "zombie_jack"
@@ -74,11 +74,14 @@ const (
 	UnitIDZombieGargantuar = "zombie_gargantuar"
 	UnitIDZombieGargantuarRedeye = "zombie_gargantuar_redeye"
 	UnitIDZombieImp        = "zombie_imp"
-	UnitIDZombieDrZomboss  = "zombie_drzomboss"
+	UnitIDZombieDrZomboss  = "zombie_boss"
 
 	// 特殊状态 UnitID
 	UnitIDZombieCharred = "zombie_charred" // 烧焦状态
 	UnitIDZombiesWon    = "zombieswon"     // 僵尸胜利动画
+
+	UnitIDZombieCharredCatapult = "zombie_charred_catapult" // 投篮车烧焦状态
+	UnitIDZombieCharredZomboni  = "zombie_charred_zamboni"  // 雪橇车烧焦状态
 )
 
 // zombieTypeStringMap 僵尸类型到配置字符串的映射
@@ -146,11 +149,18 @@ var zombieTypeUnitIDMap = map[ZombieType]string{
 // stringToZombieTypeMap 配置字符串到僵尸类型的反向映射
 var stringToZombieTypeMap map[string]ZombieType
 
+// unitIDToZombieTypeMap 动画 UnitID 到僵尸类型的反向映射
+var unitIDToZombieTypeMap map[string]ZombieType
+
 func init() {
 	stringToZombieTypeMap = make(map[string]ZombieType)
 	for zt, s := range zombieTypeStringMap {
 		stringToZombieTypeMap[s] = zt
 	}
+	unitIDToZombieTypeMap = make(map[string]ZombieType)
+	for zt, id := range zombieTypeUnitIDMap {
+		unitIDToZombieTypeMap[id] = zt
+	}
 	// 添加别名映射（处理历史命名不一致）
 	stringToZombieTypeMap["newspaperzombie"] = ZombieNewspaper
 	stringToZombieTypeMap["footballzombie"] = ZombieFootball
@@ -185,6 +195,15 @@ func ZombieTypeFromString(s string) ZombieType {
 	return ZombieUnknown
 }
 
+// ZombieTypeFromUnitID 将动画 UnitID 转换为 ZombieType
+// 用于从 BehaviorComponent.UnitID 反查僵尸类型（如存档、进家边界）
+func ZombieTypeFromUnitID(unitID string) ZombieType {
+	if zt, ok := unitIDToZombieTypeMap[unitID]; ok {
+		return zt
+	}
+	return ZombieUnknown
+}
+
 // ZombieTypeToUnitID 将僵尸类型字符串转换为动画 UnitID
 // 这是一个便捷函数，用于从字符串直接获取 UnitID
 func ZombieTypeToUnitID(zombieType string) string {
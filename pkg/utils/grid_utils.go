@@ -1,5 +1,7 @@
 package utils
 
+import "github.com/gonewx/pvz/pkg/config"
+
 // 草坪网格坐标转换工具函数
 // 本文件提供通用的网格坐标系统转换函数
 // 使用世界坐标系统：所有坐标相对于背景图片左上角，不随摄像机移动而变化
@@ -15,103 +17,45 @@ package utils
 // 参数:
 //   - mouseX, mouseY: 鼠标的屏幕坐标（相对于游戏窗口左上角）
 //   - cameraX: 当前摄像机的X位置（世界坐标偏移量）
-//   - gridWorldStartX, gridWorldStartY: 网格在背景图片中的起始位置（世界坐标）
-//   - columns, rows: 网格的列数和行数
-//   - cellWidth, cellHeight: 每个格子的宽度和高度（像素）
+//   - layout: 草坪行布局（行数、起始Y坐标、行高和坡面）
 //
 // 返回:
-//   - col: 列索引 (0 到 columns-1)
-//   - row: 行索引 (0 到 rows-1)
+//   - col: 列索引 (0 到 GridColumns-1)
+//   - row: 行索引 (0 到 layout.Rows-1)
 //   - isValid: 是否在有效网格范围内
-func MouseToGridCoords(
-	mouseX, mouseY int,
-	cameraX float64,
-	gridWorldStartX, gridWorldStartY float64,
-	columns, rows int,
-	cellWidth, cellHeight float64,
-) (col, row int, isValid bool) {
+func MouseToGridCoords(mouseX, mouseY int, cameraX float64, layout config.LawnLayout) (col, row int, isValid bool) {
 	// 将鼠标屏幕坐标转换为世界坐标
-	worldX := float64(mouseX) + cameraX
-	worldY := float64(mouseY)
-
-	// 计算网格的世界坐标边界
-	gridEndX := gridWorldStartX + float64(columns)*cellWidth
-	gridEndY := gridWorldStartY + float64(rows)*cellHeight
-
-	// 检查是否在网格范围内
-	if worldX < gridWorldStartX || worldX >= gridEndX || worldY < gridWorldStartY || worldY >= gridEndY {
-		return 0, 0, false
-	}
-
-	// 计算列和行索引
-	col = int((worldX - gridWorldStartX) / cellWidth)
-	row = int((worldY - gridWorldStartY) / cellHeight)
-
-	// 边界检查（防止浮点数计算误差导致的越界）
-	if col < 0 {
-		col = 0
-	} else if col >= columns {
-		col = columns - 1
-	}
-
-	if row < 0 {
-		row = 0
-	} else if row >= rows {
-		row = rows - 1
-	}
-
-	return col, row, true
+	return layout.CellAt(float64(mouseX)+cameraX, float64(mouseY))
 }
 
 // GridToScreenCoords 将草坪网格坐标转换为屏幕中心坐标
 //
 // 参数:
-//   - col: 列索引 (0 到 columns-1)
-//   - row: 行索引 (0 到 rows-1)
+//   - col: 列索引 (0 到 GridColumns-1)
+//   - row: 行索引 (0 到 layout.Rows-1)
 //   - cameraX: 当前摄像机的X位置（世界坐标偏移量）
-//   - gridWorldStartX, gridWorldStartY: 网格在背景图片中的起始位置（世界坐标）
-//   - cellWidth, cellHeight: 每个格子的宽度和高度（像素）
+//   - layout: 草坪行布局（行数、起始Y坐标、行高和坡面）
 //
 // 返回:
 //   - centerX, centerY: 格子中心的屏幕坐标
-func GridToScreenCoords(
-	col, row int,
-	cameraX float64,
-	gridWorldStartX, gridWorldStartY float64,
-	cellWidth, cellHeight float64,
-) (centerX, centerY float64) {
-	// 先计算格子中心的世界坐标
-	worldCenterX := gridWorldStartX + float64(col)*cellWidth + cellWidth/2
-	worldCenterY := gridWorldStartY + float64(row)*cellHeight + cellHeight/2
-
-	// 转换为屏幕坐标
-	centerX = worldCenterX - cameraX
-	centerY = worldCenterY
-
-	return centerX, centerY
+func GridToScreenCoords(col, row int, cameraX float64, layout config.LawnLayout) (centerX, centerY float64) {
+	// 先计算格子中心的世界坐标，再转换为屏幕坐标
+	worldCenterX, worldCenterY := layout.CellCenter(col, row)
+	return worldCenterX - cameraX, worldCenterY
 }
 
 // GridToWorldCoords 将草坪网格坐标转换为世界坐标（格子中心）
 // Story 10.4: 用于计算粒子效果位置
 //
 // 参数:
-//   - col: 列索引 (0 到 columns-1)
-//   - row: 行索引 (0 到 rows-1)
-//   - gridWorldStartX, gridWorldStartY: 网格在背景图片中的起始位置（世界坐标）
-//   - cellWidth, cellHeight: 每个格子的宽度和高度（像素）
+//   - col: 列索引 (0 到 GridColumns-1)
+//   - row: 行索引 (0 到 layout.Rows-1)
+//   - layout: 草坪行布局（行数、起始Y坐标、行高和坡面）
 //
 // 返回:
 //   - centerX, centerY: 格子中心的世界坐标
-func GridToWorldCoords(
-	col, row int,
-	gridWorldStartX, gridWorldStartY float64,
-	cellWidth, cellHeight float64,
-) (centerX, centerY float64) {
-	// 计算格子中心的世界坐标
-	centerX = gridWorldStartX + float64(col)*cellWidth + cellWidth/2
-	centerY = gridWorldStartY + float64(row)*cellHeight + cellHeight/2
-
-	return centerX, centerY
+func GridToWorldCoords(col, row int, layout config.LawnLayout) (centerX, centerY float64) {
+	return layout.CellCenter(col, row)
 }
 
 // GetEntityRow 根据实体的世界Y坐标计算其所在的行索引
@@ -138,34 +82,12 @@ func GetEntityRow(worldY, gridWorldStartY, cellHeight float64) int {
 //
 // 参数:
 //   - worldX, worldY: 世界坐标（相对于背景图片左上角）
+//   - layout: 草坪行布局（行数、起始Y坐标、行高和坡面）
 //
 // 返回:
 //   - col: 列索引 (0-8)
-//   - row: 行索引 (0-4)
+//   - row: 行索引 (0 到 layout.Rows-1，前院 0-4，泳池 0-5)
 //   - isValid: 是否在有效网格范围内
-//
-// 注意：此函数使用 pkg/config 中定义的网格配置常量
-func WorldToGridCoords(worldX, worldY float64) (col, row int, isValid bool) {
-	// 使用全局网格配置（从 config 包导入）
-	// 为了避免循环依赖，我们需要确保 config 包不依赖 utils 包
-	// 这个函数将在下一步修复，现在先使用常量值
-	const (
-		GridWorldStartX = 251.0
-		GridWorldStartY = 81.0
-		CellWidth       = 80.0
-		CellHeight      = 100.0
-		GridColumns     = 9
-		GridRows        = 5
-	)
-
-	// 计算列和行索引
-	col = int((worldX - GridWorldStartX) / CellWidth)
-	row = int((worldY - GridWorldStartY) / CellHeight)
-
-	// 边界检查
-	if col < 0 || col >= GridColumns || row < 0 || row >= GridRows {
-		return col, row, false
-	}
-
-	return col, row, true
+func WorldToGridCoords(worldX, worldY float64, layout config.LawnLayout) (col, row int, isValid bool) {
+	return layout.CellAt(worldX, worldY)
 }
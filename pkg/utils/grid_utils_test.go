@@ -12,6 +12,9 @@ const (
 	testCameraX = 220.0 // 与 config.GameCameraX 一致
 )
 
+// dayLayout 前院草坪布局（5 行，行高 100，起始Y 78）
+var dayLayout = config.GetLawnLayout("day")
+
 // TestMouseToGridCoords 测试鼠标坐标到网格坐标的转换
 func TestMouseToGridCoords(t *testing.T) {
 	tests := []struct {
@@ -72,13 +75,7 @@ func TestMouseToGridCoords(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotCol, gotRow, gotValid := MouseToGridCoords(
-				tt.mouseX, tt.mouseY,
-				tt.cameraX,
-				config.GridWorldStartX, config.GridWorldStartY,
-				config.GridColumns, config.GridRows,
-				config.CellWidth, config.CellHeight,
-			)
+			gotCol, gotRow, gotValid := MouseToGridCoords(tt.mouseX, tt.mouseY, tt.cameraX, dayLayout)
 			if gotCol != tt.wantCol || gotRow != tt.wantRow || gotValid != tt.wantValid {
 				t.Errorf("MouseToGridCoords(%d, %d, cameraX=%.1f) = (%d, %d, %v), want (%d, %d, %v)",
 					tt.mouseX, tt.mouseY, tt.cameraX, gotCol, gotRow, gotValid,
@@ -124,13 +121,7 @@ func TestMouseOutOfBounds(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, _, gotValid := MouseToGridCoords(
-				tt.mouseX, tt.mouseY,
-				tt.cameraX,
-				config.GridWorldStartX, config.GridWorldStartY,
-				config.GridColumns, config.GridRows,
-				config.CellWidth, config.CellHeight,
-			)
+			_, _, gotValid := MouseToGridCoords(tt.mouseX, tt.mouseY, tt.cameraX, dayLayout)
 			if gotValid {
 				t.Errorf("MouseToGridCoords(%d, %d, cameraX=%.1f) should be invalid (out of bounds), but got valid=true",
 					tt.mouseX, tt.mouseY, tt.cameraX)
@@ -193,12 +184,7 @@ func TestGridToScreenCoords(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotCentX, gotCentY := GridToScreenCoords(
-				tt.col, tt.row,
-				tt.cameraX,
-				config.GridWorldStartX, config.GridWorldStartY,
-				config.CellWidth, config.CellHeight,
-			)
+			gotCentX, gotCentY := GridToScreenCoords(tt.col, tt.row, tt.cameraX, dayLayout)
 			if gotCentX != tt.wantCentX || gotCentY != tt.wantCentY {
 				t.Errorf("GridToScreenCoords(%d, %d, cameraX=%.1f) = (%.1f, %.1f), want (%.1f, %.1f)",
 					tt.col, tt.row, tt.cameraX, gotCentX, gotCentY,
@@ -216,21 +202,10 @@ func TestRoundTripConversion(t *testing.T) {
 	for _, cameraX := range testCameraPositions {
 		t.Run("CameraX="+string(rune(int(cameraX))), func(t *testing.T) {
 			// 对于网格中心的坐标，进行往返转换应该得到相同的网格索引
-			for row := 0; row < config.GridRows; row++ {
+			for row := 0; row < dayLayout.Rows; row++ {
 				for col := 0; col < config.GridColumns; col++ {
-					centerX, centerY := GridToScreenCoords(
-						col, row,
-						cameraX,
-						config.GridWorldStartX, config.GridWorldStartY,
-						config.CellWidth, config.CellHeight,
-					)
-					gotCol, gotRow, gotValid := MouseToGridCoords(
-						int(centerX), int(centerY),
-						cameraX,
-						config.GridWorldStartX, config.GridWorldStartY,
-						config.GridColumns, config.GridRows,
-						config.CellWidth, config.CellHeight,
-					)
+					centerX, centerY := GridToScreenCoords(col, row, cameraX, dayLayout)
+					gotCol, gotRow, gotValid := MouseToGridCoords(int(centerX), int(centerY), cameraX, dayLayout)
 
 					if !gotValid {
 						t.Errorf("[cameraX=%.1f] Round trip conversion for grid (%d, %d) resulted in invalid", cameraX, col, row)
@@ -345,3 +320,42 @@ func TestGetEntityRow_SameRowDetection(t *testing.T) {
 		})
 	}
 }
+
+// TestRoundTripConversion_Roof 测试屋顶斜坡上的网格坐标往返转换
+func TestRoundTripConversion_Roof(t *testing.T) {
+	roof := config.GetLawnLayout("roof")
+
+	for row := 0; row < roof.Rows; row++ {
+		for col := 0; col < config.GridColumns; col++ {
+			worldX, worldY := GridToWorldCoords(col, row, roof)
+			if wantY := roof.StartY + (float64(row)+0.5)*roof.CellHeight + roof.CellSlopeOffsetY(col); worldY != wantY {
+				t.Errorf("GridToWorldCoords(%d, %d) Y = %.1f, want %.1f", col, row, worldY, wantY)
+			}
+
+			gotCol, gotRow, gotValid := WorldToGridCoords(worldX, worldY, roof)
+			if !gotValid || gotCol != col || gotRow != row {
+				t.Errorf("WorldToGridCoords(%.1f, %.1f) = (%d, %d, %v), want (%d, %d, true)",
+					worldX, worldY, gotCol, gotRow, gotValid, col, row)
+			}
+
+			screenX, screenY := GridToScreenCoords(col, row, testCameraX, roof)
+			gotCol, gotRow, gotValid = MouseToGridCoords(int(screenX), int(screenY), testCameraX, roof)
+			if !gotValid || gotCol != col || gotRow != row {
+				t.Errorf("MouseToGridCoords round trip for (%d, %d) got (%d, %d, %v)", col, row, gotCol, gotRow, gotValid)
+			}
+		}
+	}
+}
+
+// TestMouseToGridCoords_Pool 测试泳池布局的第 6 行只按传入的布局计算，与当前场景无关
+func TestMouseToGridCoords_Pool(t *testing.T) {
+	pool := config.GetLawnLayout("pool")
+	mouseY := int(pool.StartY + 5*pool.CellHeight + pool.CellHeight/2)
+
+	if col, row, ok := MouseToGridCoords(75, mouseY, testCameraX, pool); !ok || col != 0 || row != 5 {
+		t.Errorf("MouseToGridCoords on pool = (%d, %d, %v), want (0, 5, true)", col, row, ok)
+	}
+	if _, row, _ := MouseToGridCoords(75, mouseY, testCameraX, dayLayout); row != 4 {
+		t.Errorf("Expected the same point in the last row of the day lawn, got row %d", row)
+	}
+}
@@ -230,3 +230,56 @@ func GetGroundTrackFrameCount(
 
 	return len(groundFrames)
 }
+
+// CalculateTrackDisplacement 计算指定轨道在一段动画中从首个可见帧到最后一个可见帧的位移
+//
+// 用于 _ground 轨道静止、位移体现在身体轨道上的动画（如撑杆跳僵尸的 anim_jump），
+// 动画播放完毕后由调用方一次性把位移应用到实体位置上。
+//
+// 参数:
+//   - reanimComp: Reanim 组件
+//   - animName: 动画名称（如 "anim_jump"）
+//   - trackName: 轨道名称（如 "Zombie_polevaulter_body1"）
+//
+// 返回:
+//   - deltaX, deltaY: 轨道位移（Reanim 坐标，向左为负）
+//   - error: 如果动画或轨道不存在返回错误
+func CalculateTrackDisplacement(
+	reanimComp *components.ReanimComponent,
+	animName string,
+	trackName string,
+) (deltaX, deltaY float64, err error) {
+	if reanimComp == nil {
+		return 0, 0, fmt.Errorf("reanimComp is nil")
+	}
+
+	animVisibles, ok := reanimComp.AnimVisiblesMap[animName]
+	if !ok || len(animVisibles) == 0 {
+		return 0, 0, fmt.Errorf("animation '%s' not found", animName)
+	}
+
+	frames, ok := reanimComp.MergedTracks[trackName]
+	if !ok || len(frames) == 0 {
+		return 0, 0, fmt.Errorf("track '%s' not found or empty", trackName)
+	}
+
+	first, last := -1, -1
+	for i, val := range animVisibles {
+		if val == 0 {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+	if first < 0 {
+		return 0, 0, fmt.Errorf("no visible frames in animation %s", animName)
+	}
+	if last >= len(frames) {
+		return 0, 0, fmt.Errorf("frame index %d out of range (0-%d)", last, len(frames)-1)
+	}
+
+	startX, startY := getGroundPosition(frames, first)
+	endX, endY := getGroundPosition(frames, last)
+	return endX - startX, endY - startY, nil
+}
@@ -333,3 +333,41 @@ func TestCalculateRootMotionDelta_SmallMovement(t *testing.T) {
 		t.Errorf("expected deltaY=%.2f, got %.2f", expectedDeltaY, deltaY)
 	}
 }
+
+// TestCalculateTrackDisplacement 测试轨道在动画可见范围内的位移计算
+func TestCalculateTrackDisplacement(t *testing.T) {
+	x0, x1, x2, x3 := 5.0, 40.0, -20.0, -110.0
+	y := 0.0
+
+	comp := &components.ReanimComponent{
+		MergedTracks: map[string][]reanim.Frame{
+			"body": {
+				{X: &x0, Y: &y}, // 帧 0：不属于 anim_jump
+				{X: &x1, Y: &y}, // 帧 1：anim_jump 首帧
+				{X: &x2, Y: &y},
+				{X: &x3, Y: &y}, // 帧 3：anim_jump 末帧
+			},
+		},
+		AnimVisiblesMap: map[string][]int{
+			"anim_jump": {-1, 0, 0, 0},
+		},
+	}
+
+	deltaX, deltaY, err := CalculateTrackDisplacement(comp, "anim_jump", "body")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if math.Abs(deltaX-(-150.0)) > 0.001 {
+		t.Errorf("expected deltaX=-150.0, got %.2f", deltaX)
+	}
+	if math.Abs(deltaY) > 0.001 {
+		t.Errorf("expected deltaY=0.0, got %.2f", deltaY)
+	}
+
+	if _, _, err := CalculateTrackDisplacement(comp, "anim_missing", "body"); err == nil {
+		t.Error("expected error for missing animation")
+	}
+	if _, _, err := CalculateTrackDisplacement(comp, "anim_jump", "missing"); err == nil {
+		t.Error("expected error for missing track")
+	}
+}